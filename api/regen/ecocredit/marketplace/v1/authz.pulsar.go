@@ -0,0 +1,1209 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package marketplacev1
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	v1beta1 "github.com/cosmos/cosmos-sdk/api/cosmos/base/v1beta1"
+	_ "github.com/gogo/protobuf/gogoproto"
+	v1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_SellOrderAuthorization_1_list)(nil)
+
+type _SellOrderAuthorization_1_list struct {
+	list *[]*v1.CreditLimit
+}
+
+func (x *_SellOrderAuthorization_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrderAuthorization_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CreditLimit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrderAuthorization_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1.CreditLimit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrderAuthorization_1_list) AppendMutable() protoreflect.Value {
+	v := new(v1.CreditLimit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_1_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrderAuthorization_1_list) NewElement() protoreflect.Value {
+	v := new(v1.CreditLimit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SellOrderAuthorization_2_list)(nil)
+
+type _SellOrderAuthorization_2_list struct {
+	list *[]string
+}
+
+func (x *_SellOrderAuthorization_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrderAuthorization_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SellOrderAuthorization_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrderAuthorization_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrderAuthorization_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SellOrderAuthorization at list field AllowedAskDenoms as it is not of Message kind"))
+}
+
+func (x *_SellOrderAuthorization_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrderAuthorization_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SellOrderAuthorization_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SellOrderAuthorization_4_list)(nil)
+
+type _SellOrderAuthorization_4_list struct {
+	list *[]string
+}
+
+func (x *_SellOrderAuthorization_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrderAuthorization_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_SellOrderAuthorization_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrderAuthorization_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrderAuthorization_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SellOrderAuthorization at list field AllowedBuyers as it is not of Message kind"))
+}
+
+func (x *_SellOrderAuthorization_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrderAuthorization_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_SellOrderAuthorization_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_SellOrderAuthorization_5_list)(nil)
+
+type _SellOrderAuthorization_5_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_SellOrderAuthorization_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrderAuthorization_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrderAuthorization_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrderAuthorization_5_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrderAuthorization_5_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_SellOrderAuthorization_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_SellOrderAuthorization                    protoreflect.MessageDescriptor
+	fd_SellOrderAuthorization_sell_limits        protoreflect.FieldDescriptor
+	fd_SellOrderAuthorization_allowed_ask_denoms protoreflect.FieldDescriptor
+	fd_SellOrderAuthorization_expiration         protoreflect.FieldDescriptor
+	fd_SellOrderAuthorization_allowed_buyers     protoreflect.FieldDescriptor
+	fd_SellOrderAuthorization_min_ask_prices     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_authz_proto_init()
+	md_SellOrderAuthorization = File_regen_ecocredit_marketplace_v1_authz_proto.Messages().ByName("SellOrderAuthorization")
+	fd_SellOrderAuthorization_sell_limits = md_SellOrderAuthorization.Fields().ByName("sell_limits")
+	fd_SellOrderAuthorization_allowed_ask_denoms = md_SellOrderAuthorization.Fields().ByName("allowed_ask_denoms")
+	fd_SellOrderAuthorization_expiration = md_SellOrderAuthorization.Fields().ByName("expiration")
+	fd_SellOrderAuthorization_allowed_buyers = md_SellOrderAuthorization.Fields().ByName("allowed_buyers")
+	fd_SellOrderAuthorization_min_ask_prices = md_SellOrderAuthorization.Fields().ByName("min_ask_prices")
+}
+
+var _ protoreflect.Message = (*fastReflection_SellOrderAuthorization)(nil)
+
+type fastReflection_SellOrderAuthorization SellOrderAuthorization
+
+func (x *SellOrderAuthorization) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SellOrderAuthorization)(x)
+}
+
+func (x *SellOrderAuthorization) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_authz_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SellOrderAuthorization_messageType fastReflection_SellOrderAuthorization_messageType
+var _ protoreflect.MessageType = fastReflection_SellOrderAuthorization_messageType{}
+
+type fastReflection_SellOrderAuthorization_messageType struct{}
+
+func (x fastReflection_SellOrderAuthorization_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SellOrderAuthorization)(nil)
+}
+func (x fastReflection_SellOrderAuthorization_messageType) New() protoreflect.Message {
+	return new(fastReflection_SellOrderAuthorization)
+}
+func (x fastReflection_SellOrderAuthorization_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderAuthorization
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SellOrderAuthorization) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrderAuthorization
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SellOrderAuthorization) Type() protoreflect.MessageType {
+	return _fastReflection_SellOrderAuthorization_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SellOrderAuthorization) New() protoreflect.Message {
+	return new(fastReflection_SellOrderAuthorization)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SellOrderAuthorization) Interface() protoreflect.ProtoMessage {
+	return (*SellOrderAuthorization)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SellOrderAuthorization) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.SellLimits) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrderAuthorization_1_list{list: &x.SellLimits})
+		if !f(fd_SellOrderAuthorization_sell_limits, value) {
+			return
+		}
+	}
+	if len(x.AllowedAskDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrderAuthorization_2_list{list: &x.AllowedAskDenoms})
+		if !f(fd_SellOrderAuthorization_allowed_ask_denoms, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_SellOrderAuthorization_expiration, value) {
+			return
+		}
+	}
+	if len(x.AllowedBuyers) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrderAuthorization_4_list{list: &x.AllowedBuyers})
+		if !f(fd_SellOrderAuthorization_allowed_buyers, value) {
+			return
+		}
+	}
+	if len(x.MinAskPrices) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrderAuthorization_5_list{list: &x.MinAskPrices})
+		if !f(fd_SellOrderAuthorization_min_ask_prices, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SellOrderAuthorization) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		return len(x.SellLimits) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		return len(x.AllowedAskDenoms) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		return len(x.AllowedBuyers) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		return len(x.MinAskPrices) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAuthorization) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		x.SellLimits = nil
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		x.AllowedAskDenoms = nil
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		x.AllowedBuyers = nil
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		x.MinAskPrices = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SellOrderAuthorization) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		if len(x.SellLimits) == 0 {
+			return protoreflect.ValueOfList(&_SellOrderAuthorization_1_list{})
+		}
+		listValue := &_SellOrderAuthorization_1_list{list: &x.SellLimits}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		if len(x.AllowedAskDenoms) == 0 {
+			return protoreflect.ValueOfList(&_SellOrderAuthorization_2_list{})
+		}
+		listValue := &_SellOrderAuthorization_2_list{list: &x.AllowedAskDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		if len(x.AllowedBuyers) == 0 {
+			return protoreflect.ValueOfList(&_SellOrderAuthorization_4_list{})
+		}
+		listValue := &_SellOrderAuthorization_4_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		if len(x.MinAskPrices) == 0 {
+			return protoreflect.ValueOfList(&_SellOrderAuthorization_5_list{})
+		}
+		listValue := &_SellOrderAuthorization_5_list{list: &x.MinAskPrices}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAuthorization) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		lv := value.List()
+		clv := lv.(*_SellOrderAuthorization_1_list)
+		x.SellLimits = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		lv := value.List()
+		clv := lv.(*_SellOrderAuthorization_2_list)
+		x.AllowedAskDenoms = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		lv := value.List()
+		clv := lv.(*_SellOrderAuthorization_4_list)
+		x.AllowedBuyers = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		lv := value.List()
+		clv := lv.(*_SellOrderAuthorization_5_list)
+		x.MinAskPrices = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAuthorization) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		if x.SellLimits == nil {
+			x.SellLimits = []*v1.CreditLimit{}
+		}
+		value := &_SellOrderAuthorization_1_list{list: &x.SellLimits}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		if x.AllowedAskDenoms == nil {
+			x.AllowedAskDenoms = []string{}
+		}
+		value := &_SellOrderAuthorization_2_list{list: &x.AllowedAskDenoms}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		if x.AllowedBuyers == nil {
+			x.AllowedBuyers = []string{}
+		}
+		value := &_SellOrderAuthorization_4_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		if x.MinAskPrices == nil {
+			x.MinAskPrices = []*v1beta1.Coin{}
+		}
+		value := &_SellOrderAuthorization_5_list{list: &x.MinAskPrices}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SellOrderAuthorization) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits":
+		list := []*v1.CreditLimit{}
+		return protoreflect.ValueOfList(&_SellOrderAuthorization_1_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_ask_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SellOrderAuthorization_2_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.allowed_buyers":
+		list := []string{}
+		return protoreflect.ValueOfList(&_SellOrderAuthorization_4_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_SellOrderAuthorization_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrderAuthorization"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrderAuthorization does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SellOrderAuthorization) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SellOrderAuthorization", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SellOrderAuthorization) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrderAuthorization) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SellOrderAuthorization) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SellOrderAuthorization) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SellOrderAuthorization)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.SellLimits) > 0 {
+			for _, e := range x.SellLimits {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AllowedAskDenoms) > 0 {
+			for _, s := range x.AllowedAskDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for _, s := range x.AllowedBuyers {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MinAskPrices) > 0 {
+			for _, e := range x.MinAskPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderAuthorization)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinAskPrices) > 0 {
+			for iNdEx := len(x.MinAskPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MinAskPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for iNdEx := len(x.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedBuyers[iNdEx])
+				copy(dAtA[i:], x.AllowedBuyers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedBuyers[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.AllowedAskDenoms) > 0 {
+			for iNdEx := len(x.AllowedAskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedAskDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedAskDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedAskDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.SellLimits) > 0 {
+			for iNdEx := len(x.SellLimits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SellLimits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SellOrderAuthorization)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderAuthorization: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrderAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellLimits", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SellLimits = append(x.SellLimits, &v1.CreditLimit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SellLimits[len(x.SellLimits)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedAskDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedAskDenoms = append(x.AllowedAskDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBuyers = append(x.AllowedBuyers, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinAskPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinAskPrices = append(x.MinAskPrices, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinAskPrices[len(x.MinAskPrices)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/marketplace/v1/authz.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// SellOrderAuthorization allows the grantee to create sell orders on behalf of
+// the granter with Msg/Sell. The sell limits are decremented by the quantity of
+// each sell order created.
+type SellOrderAuthorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sell_limits are the limits on the credits the grantee can sell. Credits
+	// from a credit batch without a limit on the credit batch or its credit class
+	// cannot be sold.
+	SellLimits []*v1.CreditLimit `protobuf:"bytes,1,rep,name=sell_limits,json=sellLimits,proto3" json:"sell_limits,omitempty"`
+	// allowed_ask_denoms are the denoms in which the grantee can set the ask
+	// price of a sell order. If empty, any allowed denom can be used.
+	AllowedAskDenoms []string `protobuf:"bytes,2,rep,name=allowed_ask_denoms,json=allowedAskDenoms,proto3" json:"allowed_ask_denoms,omitempty"`
+	// expiration is the time after which the authorization can no longer be
+	// used. If empty, the authorization is only bound by the expiration of the
+	// grant.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// allowed_buyers are the addresses of the buyers that the grantee can allow
+	// to buy credits from private sell orders. The allowed buyers of each private
+	// sell order must be a subset of allowed_buyers and private sell orders with
+	// an allowed buyer group policy cannot be created. If empty, the grantee
+	// cannot create private sell orders.
+	AllowedBuyers []string `protobuf:"bytes,4,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// min_ask_prices are the optional minimum ask prices of the sell orders. If
+	// set, the ask price of each sell order must be in the denom of one of the
+	// minimum ask prices and its amount must be greater than or equal to the
+	// amount of that minimum ask price. The floor amount of a sell order with a
+	// price decay on auto-renewal must also be greater than or equal to it.
+	MinAskPrices []*v1beta1.Coin `protobuf:"bytes,5,rep,name=min_ask_prices,json=minAskPrices,proto3" json:"min_ask_prices,omitempty"`
+}
+
+func (x *SellOrderAuthorization) Reset() {
+	*x = SellOrderAuthorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_authz_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrderAuthorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrderAuthorization) ProtoMessage() {}
+
+// Deprecated: Use SellOrderAuthorization.ProtoReflect.Descriptor instead.
+func (*SellOrderAuthorization) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_authz_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SellOrderAuthorization) GetSellLimits() []*v1.CreditLimit {
+	if x != nil {
+		return x.SellLimits
+	}
+	return nil
+}
+
+func (x *SellOrderAuthorization) GetAllowedAskDenoms() []string {
+	if x != nil {
+		return x.AllowedAskDenoms
+	}
+	return nil
+}
+
+func (x *SellOrderAuthorization) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *SellOrderAuthorization) GetAllowedBuyers() []string {
+	if x != nil {
+		return x.AllowedBuyers
+	}
+	return nil
+}
+
+func (x *SellOrderAuthorization) GetMinAskPrices() []*v1beta1.Coin {
+	if x != nil {
+		return x.MinAskPrices
+	}
+	return nil
+}
+
+var File_regen_ecocredit_marketplace_v1_authz_proto protoreflect.FileDescriptor
+
+var file_regen_ecocredit_marketplace_v1_authz_proto_rawDesc = []byte{
+	0x0a, 0x2a, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61,
+	0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f,
+	0x76, 0x31, 0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7,
+	0x02, 0x0a, 0x16, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x73, 0x65, 0x6c,
+	0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52,
+	0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x41, 0x73, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x40, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x42, 0x04, 0x90, 0xdf, 0x1f, 0x01, 0x52,
+	0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x42, 0x75, 0x79, 0x65,
+	0x72, 0x73, 0x12, 0x71, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x6b, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31,
+	0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x30, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x41, 0x73, 0x6b, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x3a, 0x11, 0xca, 0xb4, 0x2d, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xa3, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d,
+	0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42,
+	0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
+	0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa, 0x02, 0x1e, 0x52, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1e, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2a,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c,
+	0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x52, 0x65, 0x67,
+	0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_regen_ecocredit_marketplace_v1_authz_proto_rawDescOnce sync.Once
+	file_regen_ecocredit_marketplace_v1_authz_proto_rawDescData = file_regen_ecocredit_marketplace_v1_authz_proto_rawDesc
+)
+
+func file_regen_ecocredit_marketplace_v1_authz_proto_rawDescGZIP() []byte {
+	file_regen_ecocredit_marketplace_v1_authz_proto_rawDescOnce.Do(func() {
+		file_regen_ecocredit_marketplace_v1_authz_proto_rawDescData = protoimpl.X.CompressGZIP(file_regen_ecocredit_marketplace_v1_authz_proto_rawDescData)
+	})
+	return file_regen_ecocredit_marketplace_v1_authz_proto_rawDescData
+}
+
+var file_regen_ecocredit_marketplace_v1_authz_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_regen_ecocredit_marketplace_v1_authz_proto_goTypes = []interface{}{
+	(*SellOrderAuthorization)(nil), // 0: regen.ecocredit.marketplace.v1.SellOrderAuthorization
+	(*v1.CreditLimit)(nil),         // 1: regen.ecocredit.v1.CreditLimit
+	(*timestamppb.Timestamp)(nil),  // 2: google.protobuf.Timestamp
+	(*v1beta1.Coin)(nil),           // 3: cosmos.base.v1beta1.Coin
+}
+var file_regen_ecocredit_marketplace_v1_authz_proto_depIdxs = []int32{
+	1, // 0: regen.ecocredit.marketplace.v1.SellOrderAuthorization.sell_limits:type_name -> regen.ecocredit.v1.CreditLimit
+	2, // 1: regen.ecocredit.marketplace.v1.SellOrderAuthorization.expiration:type_name -> google.protobuf.Timestamp
+	3, // 2: regen.ecocredit.marketplace.v1.SellOrderAuthorization.min_ask_prices:type_name -> cosmos.base.v1beta1.Coin
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_regen_ecocredit_marketplace_v1_authz_proto_init() }
+func file_regen_ecocredit_marketplace_v1_authz_proto_init() {
+	if File_regen_ecocredit_marketplace_v1_authz_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_regen_ecocredit_marketplace_v1_authz_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellOrderAuthorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_regen_ecocredit_marketplace_v1_authz_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_regen_ecocredit_marketplace_v1_authz_proto_goTypes,
+		DependencyIndexes: file_regen_ecocredit_marketplace_v1_authz_proto_depIdxs,
+		MessageInfos:      file_regen_ecocredit_marketplace_v1_authz_proto_msgTypes,
+	}.Build()
+	File_regen_ecocredit_marketplace_v1_authz_proto = out.File
+	file_regen_ecocredit_marketplace_v1_authz_proto_rawDesc = nil
+	file_regen_ecocredit_marketplace_v1_authz_proto_goTypes = nil
+	file_regen_ecocredit_marketplace_v1_authz_proto_depIdxs = nil
+}
@@ -35,6 +35,53 @@ type QueryClient interface {
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsRequest, opts ...grpc.CallOption) (*QueryAllowedDenomsResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error)
+	// MarketDepth queries the aggregated depth of a market, i.e. the total
+	// quantity of credits of the sell orders and buy orders at each price.
+	MarketDepth(ctx context.Context, in *QueryMarketDepthRequest, opts ...grpc.CallOption) (*QueryMarketDepthResponse, error)
+	// BestAskByBatch queries the lowest ask price of the sell orders of a credit
+	// batch in each market.
+	BestAskByBatch(ctx context.Context, in *QueryBestAskByBatchRequest, opts ...grpc.CallOption) (*QueryBestAskByBatchResponse, error)
+	// BestAskByProject queries the lowest ask price of the sell orders of the
+	// credit batches of a project in each market.
+	BestAskByProject(ctx context.Context, in *QueryBestAskByProjectRequest, opts ...grpc.CallOption) (*QueryBestAskByProjectResponse, error)
+	// BestAskByClass queries the lowest ask price of the sell orders of the
+	// credit batches of a credit class in each market.
+	BestAskByClass(ctx context.Context, in *QueryBestAskByClassRequest, opts ...grpc.CallOption) (*QueryBestAskByClassResponse, error)
+	// TradesByBatch queries a paginated list of all trades of the credits of a
+	// credit batch.
+	TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error)
+	// TradesByBuyer queries a paginated list of all trades in which the account
+	// bought credits.
+	TradesByBuyer(ctx context.Context, in *QueryTradesByBuyerRequest, opts ...grpc.CallOption) (*QueryTradesByBuyerResponse, error)
+	// TradesBySeller queries a paginated list of all trades in which the
+	// account sold credits.
+	TradesBySeller(ctx context.Context, in *QueryTradesBySellerRequest, opts ...grpc.CallOption) (*QueryTradesBySellerResponse, error)
+	// TradesByTimeRange queries a paginated list of all trades completed within
+	// a time range.
+	TradesByTimeRange(ctx context.Context, in *QueryTradesByTimeRangeRequest, opts ...grpc.CallOption) (*QueryTradesByTimeRangeResponse, error)
+	// Auction queries an auction by its unique identifier.
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// Auctions queries a paginated list of all auctions.
+	Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error)
+	// QuoteRequest queries a quote request by its unique identifier.
+	QuoteRequest(ctx context.Context, in *QueryQuoteRequestRequest, opts ...grpc.CallOption) (*QueryQuoteRequestResponse, error)
+	// QuoteRequests queries a paginated list of all quote requests.
+	QuoteRequests(ctx context.Context, in *QueryQuoteRequestsRequest, opts ...grpc.CallOption) (*QueryQuoteRequestsResponse, error)
+	// Quotes queries a paginated list of the quotes of a quote request.
+	Quotes(ctx context.Context, in *QueryQuotesRequest, opts ...grpc.CallOption) (*QueryQuotesResponse, error)
+	// Twap queries the time-weighted average price of a market, or of a credit
+	// batch in a market, within a time range. The price in effect at each
+	// second is weighted by the quantity of credits traded at that price, so
+	// that trades of small quantities have little effect on the average. Only
+	// the price observations within the retention window of the price history
+	// are available.
+	Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error)
+	// Candles queries the open, high, low and close prices and the volume of a
+	// market, or of a credit batch in a market, in each interval of a time
+	// range.
+	Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
 }
 
 type queryClient struct {
@@ -90,6 +137,150 @@ func (c *queryClient) AllowedDenoms(ctx context.Context, in *QueryAllowedDenomsR
 	return out, nil
 }
 
+func (c *queryClient) Markets(ctx context.Context, in *QueryMarketsRequest, opts ...grpc.CallOption) (*QueryMarketsResponse, error) {
+	out := new(QueryMarketsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Markets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketDepth(ctx context.Context, in *QueryMarketDepthRequest, opts ...grpc.CallOption) (*QueryMarketDepthResponse, error) {
+	out := new(QueryMarketDepthResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/MarketDepth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BestAskByBatch(ctx context.Context, in *QueryBestAskByBatchRequest, opts ...grpc.CallOption) (*QueryBestAskByBatchResponse, error) {
+	out := new(QueryBestAskByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BestAskByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BestAskByProject(ctx context.Context, in *QueryBestAskByProjectRequest, opts ...grpc.CallOption) (*QueryBestAskByProjectResponse, error) {
+	out := new(QueryBestAskByProjectResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BestAskByProject", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BestAskByClass(ctx context.Context, in *QueryBestAskByClassRequest, opts ...grpc.CallOption) (*QueryBestAskByClassResponse, error) {
+	out := new(QueryBestAskByClassResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/BestAskByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByBatch(ctx context.Context, in *QueryTradesByBatchRequest, opts ...grpc.CallOption) (*QueryTradesByBatchResponse, error) {
+	out := new(QueryTradesByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByBuyer(ctx context.Context, in *QueryTradesByBuyerRequest, opts ...grpc.CallOption) (*QueryTradesByBuyerResponse, error) {
+	out := new(QueryTradesByBuyerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByBuyer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesBySeller(ctx context.Context, in *QueryTradesBySellerRequest, opts ...grpc.CallOption) (*QueryTradesBySellerResponse, error) {
+	out := new(QueryTradesBySellerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesBySeller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TradesByTimeRange(ctx context.Context, in *QueryTradesByTimeRangeRequest, opts ...grpc.CallOption) (*QueryTradesByTimeRangeResponse, error) {
+	out := new(QueryTradesByTimeRangeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/TradesByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Auction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Auctions(ctx context.Context, in *QueryAuctionsRequest, opts ...grpc.CallOption) (*QueryAuctionsResponse, error) {
+	out := new(QueryAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Auctions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteRequest(ctx context.Context, in *QueryQuoteRequestRequest, opts ...grpc.CallOption) (*QueryQuoteRequestResponse, error) {
+	out := new(QueryQuoteRequestResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/QuoteRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QuoteRequests(ctx context.Context, in *QueryQuoteRequestsRequest, opts ...grpc.CallOption) (*QueryQuoteRequestsResponse, error) {
+	out := new(QueryQuoteRequestsResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/QuoteRequests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Quotes(ctx context.Context, in *QueryQuotesRequest, opts ...grpc.CallOption) (*QueryQuotesResponse, error) {
+	out := new(QueryQuotesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Quotes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Twap(ctx context.Context, in *QueryTwapRequest, opts ...grpc.CallOption) (*QueryTwapResponse, error) {
+	out := new(QueryTwapResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Twap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Candles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Query/Candles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -107,6 +298,53 @@ type QueryServer interface {
 	// AllowedDenoms queries a paginated list of all bank denoms allowed to be
 	// used in the marketplace.
 	AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error)
+	// Markets queries a paginated list of all markets.
+	Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error)
+	// MarketDepth queries the aggregated depth of a market, i.e. the total
+	// quantity of credits of the sell orders and buy orders at each price.
+	MarketDepth(context.Context, *QueryMarketDepthRequest) (*QueryMarketDepthResponse, error)
+	// BestAskByBatch queries the lowest ask price of the sell orders of a credit
+	// batch in each market.
+	BestAskByBatch(context.Context, *QueryBestAskByBatchRequest) (*QueryBestAskByBatchResponse, error)
+	// BestAskByProject queries the lowest ask price of the sell orders of the
+	// credit batches of a project in each market.
+	BestAskByProject(context.Context, *QueryBestAskByProjectRequest) (*QueryBestAskByProjectResponse, error)
+	// BestAskByClass queries the lowest ask price of the sell orders of the
+	// credit batches of a credit class in each market.
+	BestAskByClass(context.Context, *QueryBestAskByClassRequest) (*QueryBestAskByClassResponse, error)
+	// TradesByBatch queries a paginated list of all trades of the credits of a
+	// credit batch.
+	TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error)
+	// TradesByBuyer queries a paginated list of all trades in which the account
+	// bought credits.
+	TradesByBuyer(context.Context, *QueryTradesByBuyerRequest) (*QueryTradesByBuyerResponse, error)
+	// TradesBySeller queries a paginated list of all trades in which the
+	// account sold credits.
+	TradesBySeller(context.Context, *QueryTradesBySellerRequest) (*QueryTradesBySellerResponse, error)
+	// TradesByTimeRange queries a paginated list of all trades completed within
+	// a time range.
+	TradesByTimeRange(context.Context, *QueryTradesByTimeRangeRequest) (*QueryTradesByTimeRangeResponse, error)
+	// Auction queries an auction by its unique identifier.
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// Auctions queries a paginated list of all auctions.
+	Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error)
+	// QuoteRequest queries a quote request by its unique identifier.
+	QuoteRequest(context.Context, *QueryQuoteRequestRequest) (*QueryQuoteRequestResponse, error)
+	// QuoteRequests queries a paginated list of all quote requests.
+	QuoteRequests(context.Context, *QueryQuoteRequestsRequest) (*QueryQuoteRequestsResponse, error)
+	// Quotes queries a paginated list of the quotes of a quote request.
+	Quotes(context.Context, *QueryQuotesRequest) (*QueryQuotesResponse, error)
+	// Twap queries the time-weighted average price of a market, or of a credit
+	// batch in a market, within a time range. The price in effect at each
+	// second is weighted by the quantity of credits traded at that price, so
+	// that trades of small quantities have little effect on the average. Only
+	// the price observations within the retention window of the price history
+	// are available.
+	Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error)
+	// Candles queries the open, high, low and close prices and the volume of a
+	// market, or of a credit batch in a market, in each interval of a time
+	// range.
+	Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -129,6 +367,54 @@ func (UnimplementedQueryServer) SellOrdersBySeller(context.Context, *QuerySellOr
 func (UnimplementedQueryServer) AllowedDenoms(context.Context, *QueryAllowedDenomsRequest) (*QueryAllowedDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllowedDenoms not implemented")
 }
+func (UnimplementedQueryServer) Markets(context.Context, *QueryMarketsRequest) (*QueryMarketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Markets not implemented")
+}
+func (UnimplementedQueryServer) MarketDepth(context.Context, *QueryMarketDepthRequest) (*QueryMarketDepthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketDepth not implemented")
+}
+func (UnimplementedQueryServer) BestAskByBatch(context.Context, *QueryBestAskByBatchRequest) (*QueryBestAskByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestAskByBatch not implemented")
+}
+func (UnimplementedQueryServer) BestAskByProject(context.Context, *QueryBestAskByProjectRequest) (*QueryBestAskByProjectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestAskByProject not implemented")
+}
+func (UnimplementedQueryServer) BestAskByClass(context.Context, *QueryBestAskByClassRequest) (*QueryBestAskByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestAskByClass not implemented")
+}
+func (UnimplementedQueryServer) TradesByBatch(context.Context, *QueryTradesByBatchRequest) (*QueryTradesByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByBatch not implemented")
+}
+func (UnimplementedQueryServer) TradesByBuyer(context.Context, *QueryTradesByBuyerRequest) (*QueryTradesByBuyerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByBuyer not implemented")
+}
+func (UnimplementedQueryServer) TradesBySeller(context.Context, *QueryTradesBySellerRequest) (*QueryTradesBySellerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesBySeller not implemented")
+}
+func (UnimplementedQueryServer) TradesByTimeRange(context.Context, *QueryTradesByTimeRangeRequest) (*QueryTradesByTimeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TradesByTimeRange not implemented")
+}
+func (UnimplementedQueryServer) Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (UnimplementedQueryServer) Auctions(context.Context, *QueryAuctionsRequest) (*QueryAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auctions not implemented")
+}
+func (UnimplementedQueryServer) QuoteRequest(context.Context, *QueryQuoteRequestRequest) (*QueryQuoteRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRequest not implemented")
+}
+func (UnimplementedQueryServer) QuoteRequests(context.Context, *QueryQuoteRequestsRequest) (*QueryQuoteRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuoteRequests not implemented")
+}
+func (UnimplementedQueryServer) Quotes(context.Context, *QueryQuotesRequest) (*QueryQuotesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Quotes not implemented")
+}
+func (UnimplementedQueryServer) Twap(context.Context, *QueryTwapRequest) (*QueryTwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Twap not implemented")
+}
+func (UnimplementedQueryServer) Candles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Candles not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -232,6 +518,294 @@ func _Query_AllowedDenoms_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Markets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Markets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Markets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Markets(ctx, req.(*QueryMarketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketDepth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMarketDepthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketDepth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/MarketDepth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketDepth(ctx, req.(*QueryMarketDepthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestAskByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestAskByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestAskByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BestAskByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestAskByBatch(ctx, req.(*QueryBestAskByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestAskByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestAskByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestAskByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BestAskByProject",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestAskByProject(ctx, req.(*QueryBestAskByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BestAskByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBestAskByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestAskByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/BestAskByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestAskByClass(ctx, req.(*QueryBestAskByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByBatch(ctx, req.(*QueryTradesByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByBuyer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByBuyerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByBuyer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByBuyer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByBuyer(ctx, req.(*QueryTradesByBuyerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesBySeller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesBySellerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesBySeller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesBySeller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesBySeller(ctx, req.(*QueryTradesBySellerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TradesByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesByTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TradesByTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/TradesByTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TradesByTimeRange(ctx, req.(*QueryTradesByTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Auctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auctions(ctx, req.(*QueryAuctionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/QuoteRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteRequest(ctx, req.(*QueryQuoteRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuoteRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuoteRequestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuoteRequests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/QuoteRequests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuoteRequests(ctx, req.(*QueryQuoteRequestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Quotes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryQuotesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Quotes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Quotes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Quotes(ctx, req.(*QueryQuotesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Twap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Twap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Twap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Twap(ctx, req.(*QueryTwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Candles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Candles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Query/Candles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Candles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -259,6 +833,70 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AllowedDenoms",
 			Handler:    _Query_AllowedDenoms_Handler,
 		},
+		{
+			MethodName: "Markets",
+			Handler:    _Query_Markets_Handler,
+		},
+		{
+			MethodName: "MarketDepth",
+			Handler:    _Query_MarketDepth_Handler,
+		},
+		{
+			MethodName: "BestAskByBatch",
+			Handler:    _Query_BestAskByBatch_Handler,
+		},
+		{
+			MethodName: "BestAskByProject",
+			Handler:    _Query_BestAskByProject_Handler,
+		},
+		{
+			MethodName: "BestAskByClass",
+			Handler:    _Query_BestAskByClass_Handler,
+		},
+		{
+			MethodName: "TradesByBatch",
+			Handler:    _Query_TradesByBatch_Handler,
+		},
+		{
+			MethodName: "TradesByBuyer",
+			Handler:    _Query_TradesByBuyer_Handler,
+		},
+		{
+			MethodName: "TradesBySeller",
+			Handler:    _Query_TradesBySeller_Handler,
+		},
+		{
+			MethodName: "TradesByTimeRange",
+			Handler:    _Query_TradesByTimeRange_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "Auctions",
+			Handler:    _Query_Auctions_Handler,
+		},
+		{
+			MethodName: "QuoteRequest",
+			Handler:    _Query_QuoteRequest_Handler,
+		},
+		{
+			MethodName: "QuoteRequests",
+			Handler:    _Query_QuoteRequests_Handler,
+		},
+		{
+			MethodName: "Quotes",
+			Handler:    _Query_Quotes_Handler,
+		},
+		{
+			MethodName: "Twap",
+			Handler:    _Query_Twap_Handler,
+		},
+		{
+			MethodName: "Candles",
+			Handler:    _Query_Candles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/marketplace/v1/query.proto",
@@ -168,6 +168,151 @@ func NewSellOrderTable(db ormtable.Schema) (SellOrderTable, error) {
 	return sellOrderTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
+type BuyOrderTable interface {
+	Insert(ctx context.Context, buyOrder *BuyOrder) error
+	InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error)
+	Update(ctx context.Context, buyOrder *BuyOrder) error
+	Save(ctx context.Context, buyOrder *BuyOrder) error
+	Delete(ctx context.Context, buyOrder *BuyOrder) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*BuyOrder, error)
+	List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error
+	DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error
+
+	doNotImplement()
+}
+
+type BuyOrderIterator struct {
+	ormtable.Iterator
+}
+
+func (i BuyOrderIterator) Value() (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	err := i.UnmarshalMessage(&buyOrder)
+	return &buyOrder, err
+}
+
+type BuyOrderIndexKey interface {
+	id() uint32
+	values() []interface{}
+	buyOrderIndexKey()
+}
+
+// primary key starting index..
+type BuyOrderPrimaryKey = BuyOrderIdIndexKey
+
+type BuyOrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderIdIndexKey) id() uint32            { return 0 }
+func (x BuyOrderIdIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderIdIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderIdIndexKey) WithId(id uint64) BuyOrderIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type BuyOrderBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderBuyerIndexKey) id() uint32            { return 1 }
+func (x BuyOrderBuyerIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderBuyerIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderBuyerIndexKey) WithBuyer(buyer []byte) BuyOrderBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type BuyOrderExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x BuyOrderExpirationIndexKey) id() uint32            { return 2 }
+func (x BuyOrderExpirationIndexKey) values() []interface{} { return x.vs }
+func (x BuyOrderExpirationIndexKey) buyOrderIndexKey()     {}
+
+func (this BuyOrderExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) BuyOrderExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type buyOrderTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this buyOrderTable) Insert(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Insert(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Update(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Update(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Save(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Save(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Delete(ctx context.Context, buyOrder *BuyOrder) error {
+	return this.table.Delete(ctx, buyOrder)
+}
+
+func (this buyOrderTable) InsertReturningID(ctx context.Context, buyOrder *BuyOrder) (uint64, error) {
+	return this.table.InsertReturningID(ctx, buyOrder)
+}
+
+func (this buyOrderTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this buyOrderTable) Get(ctx context.Context, id uint64) (*BuyOrder, error) {
+	var buyOrder BuyOrder
+	found, err := this.table.PrimaryKey().Get(ctx, &buyOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &buyOrder, nil
+}
+
+func (this buyOrderTable) List(ctx context.Context, prefixKey BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) ListRange(ctx context.Context, from, to BuyOrderIndexKey, opts ...ormlist.Option) (BuyOrderIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BuyOrderIterator{it}, err
+}
+
+func (this buyOrderTable) DeleteBy(ctx context.Context, prefixKey BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this buyOrderTable) DeleteRange(ctx context.Context, from, to BuyOrderIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this buyOrderTable) doNotImplement() {}
+
+var _ BuyOrderTable = buyOrderTable{}
+
+func NewBuyOrderTable(db ormtable.Schema) (BuyOrderTable, error) {
+	table := db.GetTable(&BuyOrder{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BuyOrder{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return buyOrderTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
 type AllowedDenomTable interface {
 	Insert(ctx context.Context, allowedDenom *AllowedDenom) error
 	Update(ctx context.Context, allowedDenom *AllowedDenom) error
@@ -480,55 +625,919 @@ func NewMarketTable(db ormtable.Schema) (MarketTable, error) {
 	return marketTable{table.(ormtable.AutoIncrementTable)}, nil
 }
 
-type StateStore interface {
-	SellOrderTable() SellOrderTable
-	AllowedDenomTable() AllowedDenomTable
-	MarketTable() MarketTable
+type TradeTable interface {
+	Insert(ctx context.Context, trade *Trade) error
+	InsertReturningID(ctx context.Context, trade *Trade) (uint64, error)
+	Update(ctx context.Context, trade *Trade) error
+	Save(ctx context.Context, trade *Trade) error
+	Delete(ctx context.Context, trade *Trade) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Trade, error)
+	List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error)
+	DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error
+	DeleteRange(ctx context.Context, from, to TradeIndexKey) error
 
 	doNotImplement()
 }
 
-type stateStore struct {
-	sellOrder    SellOrderTable
-	allowedDenom AllowedDenomTable
-	market       MarketTable
+type TradeIterator struct {
+	ormtable.Iterator
 }
 
-func (x stateStore) SellOrderTable() SellOrderTable {
-	return x.sellOrder
+func (i TradeIterator) Value() (*Trade, error) {
+	var trade Trade
+	err := i.UnmarshalMessage(&trade)
+	return &trade, err
 }
 
-func (x stateStore) AllowedDenomTable() AllowedDenomTable {
-	return x.allowedDenom
+type TradeIndexKey interface {
+	id() uint32
+	values() []interface{}
+	tradeIndexKey()
 }
 
-func (x stateStore) MarketTable() MarketTable {
-	return x.market
+// primary key starting index..
+type TradePrimaryKey = TradeIdIndexKey
+
+type TradeIdIndexKey struct {
+	vs []interface{}
 }
 
-func (stateStore) doNotImplement() {}
+func (x TradeIdIndexKey) id() uint32            { return 0 }
+func (x TradeIdIndexKey) values() []interface{} { return x.vs }
+func (x TradeIdIndexKey) tradeIndexKey()        {}
 
-var _ StateStore = stateStore{}
+func (this TradeIdIndexKey) WithId(id uint64) TradeIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
 
-func NewStateStore(db ormtable.Schema) (StateStore, error) {
-	sellOrderTable, err := NewSellOrderTable(db)
+type TradeBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeBatchKeyIndexKey) id() uint32            { return 1 }
+func (x TradeBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x TradeBatchKeyIndexKey) tradeIndexKey()        {}
+
+func (this TradeBatchKeyIndexKey) WithBatchKey(batch_key uint64) TradeBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type TradeBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeBuyerIndexKey) id() uint32            { return 2 }
+func (x TradeBuyerIndexKey) values() []interface{} { return x.vs }
+func (x TradeBuyerIndexKey) tradeIndexKey()        {}
+
+func (this TradeBuyerIndexKey) WithBuyer(buyer []byte) TradeBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type TradeSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeSellerIndexKey) id() uint32            { return 3 }
+func (x TradeSellerIndexKey) values() []interface{} { return x.vs }
+func (x TradeSellerIndexKey) tradeIndexKey()        {}
+
+func (this TradeSellerIndexKey) WithSeller(seller []byte) TradeSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type TradeTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x TradeTimestampIndexKey) id() uint32            { return 4 }
+func (x TradeTimestampIndexKey) values() []interface{} { return x.vs }
+func (x TradeTimestampIndexKey) tradeIndexKey()        {}
+
+func (this TradeTimestampIndexKey) WithTimestamp(timestamp *timestamppb.Timestamp) TradeTimestampIndexKey {
+	this.vs = []interface{}{timestamp}
+	return this
+}
+
+type tradeTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this tradeTable) Insert(ctx context.Context, trade *Trade) error {
+	return this.table.Insert(ctx, trade)
+}
+
+func (this tradeTable) Update(ctx context.Context, trade *Trade) error {
+	return this.table.Update(ctx, trade)
+}
+
+func (this tradeTable) Save(ctx context.Context, trade *Trade) error {
+	return this.table.Save(ctx, trade)
+}
+
+func (this tradeTable) Delete(ctx context.Context, trade *Trade) error {
+	return this.table.Delete(ctx, trade)
+}
+
+func (this tradeTable) InsertReturningID(ctx context.Context, trade *Trade) (uint64, error) {
+	return this.table.InsertReturningID(ctx, trade)
+}
+
+func (this tradeTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this tradeTable) Get(ctx context.Context, id uint64) (*Trade, error) {
+	var trade Trade
+	found, err := this.table.PrimaryKey().Get(ctx, &trade, id)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &trade, nil
+}
 
-	allowedDenomTable, err := NewAllowedDenomTable(db)
+func (this tradeTable) List(ctx context.Context, prefixKey TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) ListRange(ctx context.Context, from, to TradeIndexKey, opts ...ormlist.Option) (TradeIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return TradeIterator{it}, err
+}
+
+func (this tradeTable) DeleteBy(ctx context.Context, prefixKey TradeIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this tradeTable) DeleteRange(ctx context.Context, from, to TradeIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this tradeTable) doNotImplement() {}
+
+var _ TradeTable = tradeTable{}
+
+func NewTradeTable(db ormtable.Schema) (TradeTable, error) {
+	table := db.GetTable(&Trade{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Trade{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return tradeTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type AuctionTable interface {
+	Insert(ctx context.Context, auction *Auction) error
+	InsertReturningID(ctx context.Context, auction *Auction) (uint64, error)
+	Update(ctx context.Context, auction *Auction) error
+	Save(ctx context.Context, auction *Auction) error
+	Delete(ctx context.Context, auction *Auction) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Auction, error)
+	List(ctx context.Context, prefixKey AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error)
+	ListRange(ctx context.Context, from, to AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error)
+	DeleteBy(ctx context.Context, prefixKey AuctionIndexKey) error
+	DeleteRange(ctx context.Context, from, to AuctionIndexKey) error
+
+	doNotImplement()
+}
+
+type AuctionIterator struct {
+	ormtable.Iterator
+}
+
+func (i AuctionIterator) Value() (*Auction, error) {
+	var auction Auction
+	err := i.UnmarshalMessage(&auction)
+	return &auction, err
+}
+
+type AuctionIndexKey interface {
+	id() uint32
+	values() []interface{}
+	auctionIndexKey()
+}
+
+// primary key starting index..
+type AuctionPrimaryKey = AuctionIdIndexKey
+
+type AuctionIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionIdIndexKey) id() uint32            { return 0 }
+func (x AuctionIdIndexKey) values() []interface{} { return x.vs }
+func (x AuctionIdIndexKey) auctionIndexKey()      {}
+
+func (this AuctionIdIndexKey) WithId(id uint64) AuctionIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type AuctionSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionSellerIndexKey) id() uint32            { return 1 }
+func (x AuctionSellerIndexKey) values() []interface{} { return x.vs }
+func (x AuctionSellerIndexKey) auctionIndexKey()      {}
+
+func (this AuctionSellerIndexKey) WithSeller(seller []byte) AuctionSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type AuctionBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionBatchKeyIndexKey) id() uint32            { return 2 }
+func (x AuctionBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x AuctionBatchKeyIndexKey) auctionIndexKey()      {}
+
+func (this AuctionBatchKeyIndexKey) WithBatchKey(batch_key uint64) AuctionBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type AuctionEndTimeIndexKey struct {
+	vs []interface{}
+}
+
+func (x AuctionEndTimeIndexKey) id() uint32            { return 3 }
+func (x AuctionEndTimeIndexKey) values() []interface{} { return x.vs }
+func (x AuctionEndTimeIndexKey) auctionIndexKey()      {}
+
+func (this AuctionEndTimeIndexKey) WithEndTime(end_time *timestamppb.Timestamp) AuctionEndTimeIndexKey {
+	this.vs = []interface{}{end_time}
+	return this
+}
+
+type auctionTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this auctionTable) Insert(ctx context.Context, auction *Auction) error {
+	return this.table.Insert(ctx, auction)
+}
+
+func (this auctionTable) Update(ctx context.Context, auction *Auction) error {
+	return this.table.Update(ctx, auction)
+}
+
+func (this auctionTable) Save(ctx context.Context, auction *Auction) error {
+	return this.table.Save(ctx, auction)
+}
+
+func (this auctionTable) Delete(ctx context.Context, auction *Auction) error {
+	return this.table.Delete(ctx, auction)
+}
+
+func (this auctionTable) InsertReturningID(ctx context.Context, auction *Auction) (uint64, error) {
+	return this.table.InsertReturningID(ctx, auction)
+}
+
+func (this auctionTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this auctionTable) Get(ctx context.Context, id uint64) (*Auction, error) {
+	var auction Auction
+	found, err := this.table.PrimaryKey().Get(ctx, &auction, id)
 	if err != nil {
 		return nil, err
 	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &auction, nil
+}
 
-	marketTable, err := NewMarketTable(db)
+func (this auctionTable) List(ctx context.Context, prefixKey AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return AuctionIterator{it}, err
+}
+
+func (this auctionTable) ListRange(ctx context.Context, from, to AuctionIndexKey, opts ...ormlist.Option) (AuctionIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return AuctionIterator{it}, err
+}
+
+func (this auctionTable) DeleteBy(ctx context.Context, prefixKey AuctionIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this auctionTable) DeleteRange(ctx context.Context, from, to AuctionIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this auctionTable) doNotImplement() {}
+
+var _ AuctionTable = auctionTable{}
+
+func NewAuctionTable(db ormtable.Schema) (AuctionTable, error) {
+	table := db.GetTable(&Auction{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Auction{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return auctionTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type QuoteRequestTable interface {
+	Insert(ctx context.Context, quoteRequest *QuoteRequest) error
+	InsertReturningID(ctx context.Context, quoteRequest *QuoteRequest) (uint64, error)
+	Update(ctx context.Context, quoteRequest *QuoteRequest) error
+	Save(ctx context.Context, quoteRequest *QuoteRequest) error
+	Delete(ctx context.Context, quoteRequest *QuoteRequest) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*QuoteRequest, error)
+	List(ctx context.Context, prefixKey QuoteRequestIndexKey, opts ...ormlist.Option) (QuoteRequestIterator, error)
+	ListRange(ctx context.Context, from, to QuoteRequestIndexKey, opts ...ormlist.Option) (QuoteRequestIterator, error)
+	DeleteBy(ctx context.Context, prefixKey QuoteRequestIndexKey) error
+	DeleteRange(ctx context.Context, from, to QuoteRequestIndexKey) error
+
+	doNotImplement()
+}
+
+type QuoteRequestIterator struct {
+	ormtable.Iterator
+}
+
+func (i QuoteRequestIterator) Value() (*QuoteRequest, error) {
+	var quoteRequest QuoteRequest
+	err := i.UnmarshalMessage(&quoteRequest)
+	return &quoteRequest, err
+}
+
+type QuoteRequestIndexKey interface {
+	id() uint32
+	values() []interface{}
+	quoteRequestIndexKey()
+}
+
+// primary key starting index..
+type QuoteRequestPrimaryKey = QuoteRequestIdIndexKey
+
+type QuoteRequestIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteRequestIdIndexKey) id() uint32            { return 0 }
+func (x QuoteRequestIdIndexKey) values() []interface{} { return x.vs }
+func (x QuoteRequestIdIndexKey) quoteRequestIndexKey() {}
+
+func (this QuoteRequestIdIndexKey) WithId(id uint64) QuoteRequestIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type QuoteRequestBuyerIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteRequestBuyerIndexKey) id() uint32            { return 1 }
+func (x QuoteRequestBuyerIndexKey) values() []interface{} { return x.vs }
+func (x QuoteRequestBuyerIndexKey) quoteRequestIndexKey() {}
+
+func (this QuoteRequestBuyerIndexKey) WithBuyer(buyer []byte) QuoteRequestBuyerIndexKey {
+	this.vs = []interface{}{buyer}
+	return this
+}
+
+type QuoteRequestExpirationIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteRequestExpirationIndexKey) id() uint32            { return 2 }
+func (x QuoteRequestExpirationIndexKey) values() []interface{} { return x.vs }
+func (x QuoteRequestExpirationIndexKey) quoteRequestIndexKey() {}
+
+func (this QuoteRequestExpirationIndexKey) WithExpiration(expiration *timestamppb.Timestamp) QuoteRequestExpirationIndexKey {
+	this.vs = []interface{}{expiration}
+	return this
+}
+
+type quoteRequestTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this quoteRequestTable) Insert(ctx context.Context, quoteRequest *QuoteRequest) error {
+	return this.table.Insert(ctx, quoteRequest)
+}
+
+func (this quoteRequestTable) Update(ctx context.Context, quoteRequest *QuoteRequest) error {
+	return this.table.Update(ctx, quoteRequest)
+}
+
+func (this quoteRequestTable) Save(ctx context.Context, quoteRequest *QuoteRequest) error {
+	return this.table.Save(ctx, quoteRequest)
+}
+
+func (this quoteRequestTable) Delete(ctx context.Context, quoteRequest *QuoteRequest) error {
+	return this.table.Delete(ctx, quoteRequest)
+}
+
+func (this quoteRequestTable) InsertReturningID(ctx context.Context, quoteRequest *QuoteRequest) (uint64, error) {
+	return this.table.InsertReturningID(ctx, quoteRequest)
+}
+
+func (this quoteRequestTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this quoteRequestTable) Get(ctx context.Context, id uint64) (*QuoteRequest, error) {
+	var quoteRequest QuoteRequest
+	found, err := this.table.PrimaryKey().Get(ctx, &quoteRequest, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &quoteRequest, nil
+}
+
+func (this quoteRequestTable) List(ctx context.Context, prefixKey QuoteRequestIndexKey, opts ...ormlist.Option) (QuoteRequestIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return QuoteRequestIterator{it}, err
+}
+
+func (this quoteRequestTable) ListRange(ctx context.Context, from, to QuoteRequestIndexKey, opts ...ormlist.Option) (QuoteRequestIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return QuoteRequestIterator{it}, err
+}
+
+func (this quoteRequestTable) DeleteBy(ctx context.Context, prefixKey QuoteRequestIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this quoteRequestTable) DeleteRange(ctx context.Context, from, to QuoteRequestIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this quoteRequestTable) doNotImplement() {}
+
+var _ QuoteRequestTable = quoteRequestTable{}
+
+func NewQuoteRequestTable(db ormtable.Schema) (QuoteRequestTable, error) {
+	table := db.GetTable(&QuoteRequest{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&QuoteRequest{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return quoteRequestTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type QuoteTable interface {
+	Insert(ctx context.Context, quote *Quote) error
+	InsertReturningID(ctx context.Context, quote *Quote) (uint64, error)
+	Update(ctx context.Context, quote *Quote) error
+	Save(ctx context.Context, quote *Quote) error
+	Delete(ctx context.Context, quote *Quote) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Quote, error)
+	HasBySellOrderId(ctx context.Context, sell_order_id uint64) (found bool, err error)
+	// GetBySellOrderId returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	GetBySellOrderId(ctx context.Context, sell_order_id uint64) (*Quote, error)
+	List(ctx context.Context, prefixKey QuoteIndexKey, opts ...ormlist.Option) (QuoteIterator, error)
+	ListRange(ctx context.Context, from, to QuoteIndexKey, opts ...ormlist.Option) (QuoteIterator, error)
+	DeleteBy(ctx context.Context, prefixKey QuoteIndexKey) error
+	DeleteRange(ctx context.Context, from, to QuoteIndexKey) error
+
+	doNotImplement()
+}
+
+type QuoteIterator struct {
+	ormtable.Iterator
+}
+
+func (i QuoteIterator) Value() (*Quote, error) {
+	var quote Quote
+	err := i.UnmarshalMessage(&quote)
+	return &quote, err
+}
+
+type QuoteIndexKey interface {
+	id() uint32
+	values() []interface{}
+	quoteIndexKey()
+}
+
+// primary key starting index..
+type QuotePrimaryKey = QuoteIdIndexKey
+
+type QuoteIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteIdIndexKey) id() uint32            { return 0 }
+func (x QuoteIdIndexKey) values() []interface{} { return x.vs }
+func (x QuoteIdIndexKey) quoteIndexKey()        {}
+
+func (this QuoteIdIndexKey) WithId(id uint64) QuoteIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type QuoteQuoteRequestIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteQuoteRequestIdIndexKey) id() uint32            { return 1 }
+func (x QuoteQuoteRequestIdIndexKey) values() []interface{} { return x.vs }
+func (x QuoteQuoteRequestIdIndexKey) quoteIndexKey()        {}
+
+func (this QuoteQuoteRequestIdIndexKey) WithQuoteRequestId(quote_request_id uint64) QuoteQuoteRequestIdIndexKey {
+	this.vs = []interface{}{quote_request_id}
+	return this
+}
+
+type QuoteSellerIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteSellerIndexKey) id() uint32            { return 2 }
+func (x QuoteSellerIndexKey) values() []interface{} { return x.vs }
+func (x QuoteSellerIndexKey) quoteIndexKey()        {}
+
+func (this QuoteSellerIndexKey) WithSeller(seller []byte) QuoteSellerIndexKey {
+	this.vs = []interface{}{seller}
+	return this
+}
+
+type QuoteSellOrderIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x QuoteSellOrderIdIndexKey) id() uint32            { return 3 }
+func (x QuoteSellOrderIdIndexKey) values() []interface{} { return x.vs }
+func (x QuoteSellOrderIdIndexKey) quoteIndexKey()        {}
+
+func (this QuoteSellOrderIdIndexKey) WithSellOrderId(sell_order_id uint64) QuoteSellOrderIdIndexKey {
+	this.vs = []interface{}{sell_order_id}
+	return this
+}
+
+type quoteTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this quoteTable) Insert(ctx context.Context, quote *Quote) error {
+	return this.table.Insert(ctx, quote)
+}
+
+func (this quoteTable) Update(ctx context.Context, quote *Quote) error {
+	return this.table.Update(ctx, quote)
+}
+
+func (this quoteTable) Save(ctx context.Context, quote *Quote) error {
+	return this.table.Save(ctx, quote)
+}
+
+func (this quoteTable) Delete(ctx context.Context, quote *Quote) error {
+	return this.table.Delete(ctx, quote)
+}
+
+func (this quoteTable) InsertReturningID(ctx context.Context, quote *Quote) (uint64, error) {
+	return this.table.InsertReturningID(ctx, quote)
+}
+
+func (this quoteTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this quoteTable) Get(ctx context.Context, id uint64) (*Quote, error) {
+	var quote Quote
+	found, err := this.table.PrimaryKey().Get(ctx, &quote, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &quote, nil
+}
+
+func (this quoteTable) HasBySellOrderId(ctx context.Context, sell_order_id uint64) (found bool, err error) {
+	return this.table.GetIndexByID(3).(ormtable.UniqueIndex).Has(ctx,
+		sell_order_id,
+	)
+}
+
+func (this quoteTable) GetBySellOrderId(ctx context.Context, sell_order_id uint64) (*Quote, error) {
+	var quote Quote
+	found, err := this.table.GetIndexByID(3).(ormtable.UniqueIndex).Get(ctx, &quote,
+		sell_order_id,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &quote, nil
+}
+
+func (this quoteTable) List(ctx context.Context, prefixKey QuoteIndexKey, opts ...ormlist.Option) (QuoteIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return QuoteIterator{it}, err
+}
+
+func (this quoteTable) ListRange(ctx context.Context, from, to QuoteIndexKey, opts ...ormlist.Option) (QuoteIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return QuoteIterator{it}, err
+}
+
+func (this quoteTable) DeleteBy(ctx context.Context, prefixKey QuoteIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this quoteTable) DeleteRange(ctx context.Context, from, to QuoteIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this quoteTable) doNotImplement() {}
+
+var _ QuoteTable = quoteTable{}
+
+func NewQuoteTable(db ormtable.Schema) (QuoteTable, error) {
+	table := db.GetTable(&Quote{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Quote{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return quoteTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type PriceObservationTable interface {
+	Insert(ctx context.Context, priceObservation *PriceObservation) error
+	Update(ctx context.Context, priceObservation *PriceObservation) error
+	Save(ctx context.Context, priceObservation *PriceObservation) error
+	Delete(ctx context.Context, priceObservation *PriceObservation) error
+	Has(ctx context.Context, market_id uint64, batch_key uint64, timestamp *timestamppb.Timestamp) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, market_id uint64, batch_key uint64, timestamp *timestamppb.Timestamp) (*PriceObservation, error)
+	List(ctx context.Context, prefixKey PriceObservationIndexKey, opts ...ormlist.Option) (PriceObservationIterator, error)
+	ListRange(ctx context.Context, from, to PriceObservationIndexKey, opts ...ormlist.Option) (PriceObservationIterator, error)
+	DeleteBy(ctx context.Context, prefixKey PriceObservationIndexKey) error
+	DeleteRange(ctx context.Context, from, to PriceObservationIndexKey) error
+
+	doNotImplement()
+}
+
+type PriceObservationIterator struct {
+	ormtable.Iterator
+}
+
+func (i PriceObservationIterator) Value() (*PriceObservation, error) {
+	var priceObservation PriceObservation
+	err := i.UnmarshalMessage(&priceObservation)
+	return &priceObservation, err
+}
+
+type PriceObservationIndexKey interface {
+	id() uint32
+	values() []interface{}
+	priceObservationIndexKey()
+}
+
+// primary key starting index..
+type PriceObservationPrimaryKey = PriceObservationMarketIdBatchKeyTimestampIndexKey
+
+type PriceObservationMarketIdBatchKeyTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x PriceObservationMarketIdBatchKeyTimestampIndexKey) id() uint32                { return 0 }
+func (x PriceObservationMarketIdBatchKeyTimestampIndexKey) values() []interface{}     { return x.vs }
+func (x PriceObservationMarketIdBatchKeyTimestampIndexKey) priceObservationIndexKey() {}
+
+func (this PriceObservationMarketIdBatchKeyTimestampIndexKey) WithMarketId(market_id uint64) PriceObservationMarketIdBatchKeyTimestampIndexKey {
+	this.vs = []interface{}{market_id}
+	return this
+}
+
+func (this PriceObservationMarketIdBatchKeyTimestampIndexKey) WithMarketIdBatchKey(market_id uint64, batch_key uint64) PriceObservationMarketIdBatchKeyTimestampIndexKey {
+	this.vs = []interface{}{market_id, batch_key}
+	return this
+}
+
+func (this PriceObservationMarketIdBatchKeyTimestampIndexKey) WithMarketIdBatchKeyTimestamp(market_id uint64, batch_key uint64, timestamp *timestamppb.Timestamp) PriceObservationMarketIdBatchKeyTimestampIndexKey {
+	this.vs = []interface{}{market_id, batch_key, timestamp}
+	return this
+}
+
+type PriceObservationTimestampIndexKey struct {
+	vs []interface{}
+}
+
+func (x PriceObservationTimestampIndexKey) id() uint32                { return 1 }
+func (x PriceObservationTimestampIndexKey) values() []interface{}     { return x.vs }
+func (x PriceObservationTimestampIndexKey) priceObservationIndexKey() {}
+
+func (this PriceObservationTimestampIndexKey) WithTimestamp(timestamp *timestamppb.Timestamp) PriceObservationTimestampIndexKey {
+	this.vs = []interface{}{timestamp}
+	return this
+}
+
+type priceObservationTable struct {
+	table ormtable.Table
+}
+
+func (this priceObservationTable) Insert(ctx context.Context, priceObservation *PriceObservation) error {
+	return this.table.Insert(ctx, priceObservation)
+}
+
+func (this priceObservationTable) Update(ctx context.Context, priceObservation *PriceObservation) error {
+	return this.table.Update(ctx, priceObservation)
+}
+
+func (this priceObservationTable) Save(ctx context.Context, priceObservation *PriceObservation) error {
+	return this.table.Save(ctx, priceObservation)
+}
+
+func (this priceObservationTable) Delete(ctx context.Context, priceObservation *PriceObservation) error {
+	return this.table.Delete(ctx, priceObservation)
+}
+
+func (this priceObservationTable) Has(ctx context.Context, market_id uint64, batch_key uint64, timestamp *timestamppb.Timestamp) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, market_id, batch_key, timestamp)
+}
+
+func (this priceObservationTable) Get(ctx context.Context, market_id uint64, batch_key uint64, timestamp *timestamppb.Timestamp) (*PriceObservation, error) {
+	var priceObservation PriceObservation
+	found, err := this.table.PrimaryKey().Get(ctx, &priceObservation, market_id, batch_key, timestamp)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &priceObservation, nil
+}
+
+func (this priceObservationTable) List(ctx context.Context, prefixKey PriceObservationIndexKey, opts ...ormlist.Option) (PriceObservationIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return PriceObservationIterator{it}, err
+}
+
+func (this priceObservationTable) ListRange(ctx context.Context, from, to PriceObservationIndexKey, opts ...ormlist.Option) (PriceObservationIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return PriceObservationIterator{it}, err
+}
+
+func (this priceObservationTable) DeleteBy(ctx context.Context, prefixKey PriceObservationIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this priceObservationTable) DeleteRange(ctx context.Context, from, to PriceObservationIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this priceObservationTable) doNotImplement() {}
+
+var _ PriceObservationTable = priceObservationTable{}
+
+func NewPriceObservationTable(db ormtable.Schema) (PriceObservationTable, error) {
+	table := db.GetTable(&PriceObservation{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&PriceObservation{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return priceObservationTable{table}, nil
+}
+
+type StateStore interface {
+	SellOrderTable() SellOrderTable
+	BuyOrderTable() BuyOrderTable
+	AllowedDenomTable() AllowedDenomTable
+	MarketTable() MarketTable
+	TradeTable() TradeTable
+	AuctionTable() AuctionTable
+	QuoteRequestTable() QuoteRequestTable
+	QuoteTable() QuoteTable
+	PriceObservationTable() PriceObservationTable
+
+	doNotImplement()
+}
+
+type stateStore struct {
+	sellOrder        SellOrderTable
+	buyOrder         BuyOrderTable
+	allowedDenom     AllowedDenomTable
+	market           MarketTable
+	trade            TradeTable
+	auction          AuctionTable
+	quoteRequest     QuoteRequestTable
+	quote            QuoteTable
+	priceObservation PriceObservationTable
+}
+
+func (x stateStore) SellOrderTable() SellOrderTable {
+	return x.sellOrder
+}
+
+func (x stateStore) BuyOrderTable() BuyOrderTable {
+	return x.buyOrder
+}
+
+func (x stateStore) AllowedDenomTable() AllowedDenomTable {
+	return x.allowedDenom
+}
+
+func (x stateStore) MarketTable() MarketTable {
+	return x.market
+}
+
+func (x stateStore) TradeTable() TradeTable {
+	return x.trade
+}
+
+func (x stateStore) AuctionTable() AuctionTable {
+	return x.auction
+}
+
+func (x stateStore) QuoteRequestTable() QuoteRequestTable {
+	return x.quoteRequest
+}
+
+func (x stateStore) QuoteTable() QuoteTable {
+	return x.quote
+}
+
+func (x stateStore) PriceObservationTable() PriceObservationTable {
+	return x.priceObservation
+}
+
+func (stateStore) doNotImplement() {}
+
+var _ StateStore = stateStore{}
+
+func NewStateStore(db ormtable.Schema) (StateStore, error) {
+	sellOrderTable, err := NewSellOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderTable, err := NewBuyOrderTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	allowedDenomTable, err := NewAllowedDenomTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	marketTable, err := NewMarketTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	tradeTable, err := NewTradeTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	auctionTable, err := NewAuctionTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteRequestTable, err := NewQuoteRequestTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteTable, err := NewQuoteTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	priceObservationTable, err := NewPriceObservationTable(db)
 	if err != nil {
 		return nil, err
 	}
 
 	return stateStore{
 		sellOrderTable,
+		buyOrderTable,
 		allowedDenomTable,
 		marketTable,
+		tradeTable,
+		auctionTable,
+		quoteRequestTable,
+		quoteTable,
+		priceObservationTable,
 	}, nil
 }
@@ -8,23 +8,77 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoiface "google.golang.org/protobuf/runtime/protoiface"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	reflect "reflect"
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_SellOrder_13_list)(nil)
+
+type _SellOrder_13_list struct {
+	list *[][]byte
+}
+
+func (x *_SellOrder_13_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_SellOrder_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_SellOrder_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_SellOrder_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_SellOrder_13_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message SellOrder at list field AllowedBuyers as it is not of Message kind"))
+}
+
+func (x *_SellOrder_13_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_SellOrder_13_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_SellOrder_13_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_SellOrder                     protoreflect.MessageDescriptor
-	fd_SellOrder_id                  protoreflect.FieldDescriptor
-	fd_SellOrder_seller              protoreflect.FieldDescriptor
-	fd_SellOrder_batch_key           protoreflect.FieldDescriptor
-	fd_SellOrder_quantity            protoreflect.FieldDescriptor
-	fd_SellOrder_market_id           protoreflect.FieldDescriptor
-	fd_SellOrder_ask_amount          protoreflect.FieldDescriptor
-	fd_SellOrder_disable_auto_retire protoreflect.FieldDescriptor
-	fd_SellOrder_expiration          protoreflect.FieldDescriptor
-	fd_SellOrder_maker               protoreflect.FieldDescriptor
+	md_SellOrder                            protoreflect.MessageDescriptor
+	fd_SellOrder_id                         protoreflect.FieldDescriptor
+	fd_SellOrder_seller                     protoreflect.FieldDescriptor
+	fd_SellOrder_batch_key                  protoreflect.FieldDescriptor
+	fd_SellOrder_quantity                   protoreflect.FieldDescriptor
+	fd_SellOrder_market_id                  protoreflect.FieldDescriptor
+	fd_SellOrder_ask_amount                 protoreflect.FieldDescriptor
+	fd_SellOrder_disable_auto_retire        protoreflect.FieldDescriptor
+	fd_SellOrder_expiration                 protoreflect.FieldDescriptor
+	fd_SellOrder_maker                      protoreflect.FieldDescriptor
+	fd_SellOrder_min_fill_quantity          protoreflect.FieldDescriptor
+	fd_SellOrder_lot_size                   protoreflect.FieldDescriptor
+	fd_SellOrder_allowed_buyers             protoreflect.FieldDescriptor
+	fd_SellOrder_allowed_buyer_group_policy protoreflect.FieldDescriptor
+	fd_SellOrder_time_in_force              protoreflect.FieldDescriptor
+	fd_SellOrder_auto_renew                 protoreflect.FieldDescriptor
+	fd_SellOrder_renewals                   protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -39,6 +93,13 @@ func init() {
 	fd_SellOrder_disable_auto_retire = md_SellOrder.Fields().ByName("disable_auto_retire")
 	fd_SellOrder_expiration = md_SellOrder.Fields().ByName("expiration")
 	fd_SellOrder_maker = md_SellOrder.Fields().ByName("maker")
+	fd_SellOrder_min_fill_quantity = md_SellOrder.Fields().ByName("min_fill_quantity")
+	fd_SellOrder_lot_size = md_SellOrder.Fields().ByName("lot_size")
+	fd_SellOrder_allowed_buyers = md_SellOrder.Fields().ByName("allowed_buyers")
+	fd_SellOrder_allowed_buyer_group_policy = md_SellOrder.Fields().ByName("allowed_buyer_group_policy")
+	fd_SellOrder_time_in_force = md_SellOrder.Fields().ByName("time_in_force")
+	fd_SellOrder_auto_renew = md_SellOrder.Fields().ByName("auto_renew")
+	fd_SellOrder_renewals = md_SellOrder.Fields().ByName("renewals")
 }
 
 var _ protoreflect.Message = (*fastReflection_SellOrder)(nil)
@@ -160,6 +221,48 @@ func (x *fastReflection_SellOrder) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.MinFillQuantity != "" {
+		value := protoreflect.ValueOfString(x.MinFillQuantity)
+		if !f(fd_SellOrder_min_fill_quantity, value) {
+			return
+		}
+	}
+	if x.LotSize != "" {
+		value := protoreflect.ValueOfString(x.LotSize)
+		if !f(fd_SellOrder_lot_size, value) {
+			return
+		}
+	}
+	if len(x.AllowedBuyers) != 0 {
+		value := protoreflect.ValueOfList(&_SellOrder_13_list{list: &x.AllowedBuyers})
+		if !f(fd_SellOrder_allowed_buyers, value) {
+			return
+		}
+	}
+	if len(x.AllowedBuyerGroupPolicy) != 0 {
+		value := protoreflect.ValueOfBytes(x.AllowedBuyerGroupPolicy)
+		if !f(fd_SellOrder_allowed_buyer_group_policy, value) {
+			return
+		}
+	}
+	if x.TimeInForce != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.TimeInForce))
+		if !f(fd_SellOrder_time_in_force, value) {
+			return
+		}
+	}
+	if x.AutoRenew != nil {
+		value := protoreflect.ValueOfMessage(x.AutoRenew.ProtoReflect())
+		if !f(fd_SellOrder_auto_renew, value) {
+			return
+		}
+	}
+	if x.Renewals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Renewals)
+		if !f(fd_SellOrder_renewals, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -193,6 +296,20 @@ func (x *fastReflection_SellOrder) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Expiration != nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return x.Maker != false
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		return x.MinFillQuantity != ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		return x.LotSize != ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		return len(x.AllowedBuyers) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		return len(x.AllowedBuyerGroupPolicy) != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		return x.TimeInForce != 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		return x.AutoRenew != nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		return x.Renewals != uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -227,6 +344,20 @@ func (x *fastReflection_SellOrder) Clear(fd protoreflect.FieldDescriptor) {
 		x.Expiration = nil
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = false
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		x.MinFillQuantity = ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		x.LotSize = ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		x.AllowedBuyers = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		x.AllowedBuyerGroupPolicy = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		x.TimeInForce = 0
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		x.AutoRenew = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		x.Renewals = uint32(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -270,6 +401,30 @@ func (x *fastReflection_SellOrder) Get(descriptor protoreflect.FieldDescriptor)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		value := x.Maker
 		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		value := x.MinFillQuantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		value := x.LotSize
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if len(x.AllowedBuyers) == 0 {
+			return protoreflect.ValueOfList(&_SellOrder_13_list{})
+		}
+		listValue := &_SellOrder_13_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		value := x.AllowedBuyerGroupPolicy
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		value := x.TimeInForce
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		value := x.AutoRenew
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		value := x.Renewals
+		return protoreflect.ValueOfUint32(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -308,6 +463,22 @@ func (x *fastReflection_SellOrder) Set(fd protoreflect.FieldDescriptor, value pr
 		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		x.Maker = value.Bool()
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		x.MinFillQuantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		x.LotSize = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		lv := value.List()
+		clv := lv.(*_SellOrder_13_list)
+		x.AllowedBuyers = *clv.list
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		x.AllowedBuyerGroupPolicy = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		x.TimeInForce = (TimeInForce)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		x.AutoRenew = value.Message().Interface().(*SellOrder_AutoRenew)
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		x.Renewals = uint32(value.Uint())
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -333,6 +504,17 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 			x.Expiration = new(timestamppb.Timestamp)
 		}
 		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		if x.AllowedBuyers == nil {
+			x.AllowedBuyers = [][]byte{}
+		}
+		value := &_SellOrder_13_list{list: &x.AllowedBuyers}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		if x.AutoRenew == nil {
+			x.AutoRenew = new(SellOrder_AutoRenew)
+		}
+		return protoreflect.ValueOfMessage(x.AutoRenew.ProtoReflect())
 	case "regen.ecocredit.marketplace.v1.SellOrder.id":
 		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.seller":
@@ -349,6 +531,16 @@ func (x *fastReflection_SellOrder) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		panic(fmt.Errorf("field maker of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		panic(fmt.Errorf("field min_fill_quantity of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		panic(fmt.Errorf("field lot_size of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		panic(fmt.Errorf("field allowed_buyer_group_policy of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		panic(fmt.Errorf("field time_in_force of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		panic(fmt.Errorf("field renewals of message regen.ecocredit.marketplace.v1.SellOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -381,6 +573,22 @@ func (x *fastReflection_SellOrder) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
 	case "regen.ecocredit.marketplace.v1.SellOrder.maker":
 		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.SellOrder.min_fill_quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SellOrder.lot_size":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyers":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_SellOrder_13_list{list: &list})
+	case "regen.ecocredit.marketplace.v1.SellOrder.allowed_buyer_group_policy":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.SellOrder.time_in_force":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.SellOrder.auto_renew":
+		m := new(SellOrder_AutoRenew)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.renewals":
+		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder"))
@@ -481,6 +689,34 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 		if x.Maker {
 			n += 2
 		}
+		l = len(x.MinFillQuantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LotSize)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for _, b := range x.AllowedBuyers {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.AllowedBuyerGroupPolicy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.TimeInForce != 0 {
+			n += 1 + runtime.Sov(uint64(x.TimeInForce))
+		}
+		if x.AutoRenew != nil {
+			l = options.Size(x.AutoRenew)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.Renewals != 0 {
+			n += 2 + runtime.Sov(uint64(x.Renewals))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -510,6 +746,64 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.Renewals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Renewals))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if x.AutoRenew != nil {
+			encoded, err := options.Marshal(x.AutoRenew)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.TimeInForce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.TimeInForce))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.AllowedBuyerGroupPolicy) > 0 {
+			i -= len(x.AllowedBuyerGroupPolicy)
+			copy(dAtA[i:], x.AllowedBuyerGroupPolicy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedBuyerGroupPolicy)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.AllowedBuyers) > 0 {
+			for iNdEx := len(x.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedBuyers[iNdEx])
+				copy(dAtA[i:], x.AllowedBuyers[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedBuyers[iNdEx])))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.LotSize) > 0 {
+			i -= len(x.LotSize)
+			copy(dAtA[i:], x.LotSize)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LotSize)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.MinFillQuantity) > 0 {
+			i -= len(x.MinFillQuantity)
+			copy(dAtA[i:], x.MinFillQuantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinFillQuantity)))
+			i--
+			dAtA[i] = 0x5a
+		}
 		if x.Maker {
 			i--
 			if x.Maker {
@@ -860,238 +1154,459 @@ func (x *fastReflection_SellOrder) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.Maker = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinFillQuantity", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MinFillQuantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_AllowedDenom               protoreflect.MessageDescriptor
-	fd_AllowedDenom_bank_denom    protoreflect.FieldDescriptor
-	fd_AllowedDenom_display_denom protoreflect.FieldDescriptor
-	fd_AllowedDenom_exponent      protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
-	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
-	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
-	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
-}
-
-var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
-
-type fastReflection_AllowedDenom AllowedDenom
-
-func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(x)
-}
-
-func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
-var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
-
-type fastReflection_AllowedDenom_messageType struct{}
-
-func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AllowedDenom)(nil)
-}
-func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
-}
-func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
-}
-
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
-	return md_AllowedDenom
-}
-
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
-	return _fastReflection_AllowedDenom_messageType
-}
-
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
-	return new(fastReflection_AllowedDenom)
-}
-
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
-	return (*AllowedDenom)(x)
-}
-
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_AllowedDenom_bank_denom, value) {
-			return
-		}
-	}
-	if x.DisplayDenom != "" {
-		value := protoreflect.ValueOfString(x.DisplayDenom)
-		if !f(fd_AllowedDenom_display_denom, value) {
-			return
-		}
-	}
-	if x.Exponent != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Exponent)
-		if !f(fd_AllowedDenom_exponent, value) {
-			return
-		}
-	}
-}
-
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		return x.DisplayDenom != ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		return x.Exponent != uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LotSize = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBuyers = append(x.AllowedBuyers, make([]byte, postIndex-iNdEx))
+				copy(x.AllowedBuyers[len(x.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyerGroupPolicy", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedBuyerGroupPolicy = append(x.AllowedBuyerGroupPolicy[:0], dAtA[iNdEx:postIndex]...)
+				if x.AllowedBuyerGroupPolicy == nil {
+					x.AllowedBuyerGroupPolicy = []byte{}
+				}
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+				}
+				x.TimeInForce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.TimeInForce |= TimeInForce(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.AutoRenew == nil {
+					x.AutoRenew = &SellOrder_AutoRenew{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AutoRenew); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Renewals", wireType)
+				}
+				x.Renewals = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Renewals |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
-	}
-}
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = ""
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(0)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
 	}
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		value := x.BankDenom
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		value := x.DisplayDenom
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		value := x.Exponent
-		return protoreflect.ValueOfUint32(value)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+var (
+	md_SellOrder_AutoRenew                protoreflect.MessageDescriptor
+	fd_SellOrder_AutoRenew_renewal_period protoreflect.FieldDescriptor
+	fd_SellOrder_AutoRenew_price_decay    protoreflect.FieldDescriptor
+	fd_SellOrder_AutoRenew_floor_amount   protoreflect.FieldDescriptor
+	fd_SellOrder_AutoRenew_max_renewals   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_SellOrder_AutoRenew = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("SellOrder").Messages().ByName("AutoRenew")
+	fd_SellOrder_AutoRenew_renewal_period = md_SellOrder_AutoRenew.Fields().ByName("renewal_period")
+	fd_SellOrder_AutoRenew_price_decay = md_SellOrder_AutoRenew.Fields().ByName("price_decay")
+	fd_SellOrder_AutoRenew_floor_amount = md_SellOrder_AutoRenew.Fields().ByName("floor_amount")
+	fd_SellOrder_AutoRenew_max_renewals = md_SellOrder_AutoRenew.Fields().ByName("max_renewals")
+}
+
+var _ protoreflect.Message = (*fastReflection_SellOrder_AutoRenew)(nil)
+
+type fastReflection_SellOrder_AutoRenew SellOrder_AutoRenew
+
+func (x *SellOrder_AutoRenew) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SellOrder_AutoRenew)(x)
+}
+
+func (x *SellOrder_AutoRenew) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+var _fastReflection_SellOrder_AutoRenew_messageType fastReflection_SellOrder_AutoRenew_messageType
+var _ protoreflect.MessageType = fastReflection_SellOrder_AutoRenew_messageType{}
+
+type fastReflection_SellOrder_AutoRenew_messageType struct{}
+
+func (x fastReflection_SellOrder_AutoRenew_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SellOrder_AutoRenew)(nil)
+}
+func (x fastReflection_SellOrder_AutoRenew_messageType) New() protoreflect.Message {
+	return new(fastReflection_SellOrder_AutoRenew)
+}
+func (x fastReflection_SellOrder_AutoRenew_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrder_AutoRenew
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SellOrder_AutoRenew) Descriptor() protoreflect.MessageDescriptor {
+	return md_SellOrder_AutoRenew
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SellOrder_AutoRenew) Type() protoreflect.MessageType {
+	return _fastReflection_SellOrder_AutoRenew_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SellOrder_AutoRenew) New() protoreflect.Message {
+	return new(fastReflection_SellOrder_AutoRenew)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SellOrder_AutoRenew) Interface() protoreflect.ProtoMessage {
+	return (*SellOrder_AutoRenew)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SellOrder_AutoRenew) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.RenewalPeriod != nil {
+		value := protoreflect.ValueOfMessage(x.RenewalPeriod.ProtoReflect())
+		if !f(fd_SellOrder_AutoRenew_renewal_period, value) {
+			return
+		}
+	}
+	if x.PriceDecay != "" {
+		value := protoreflect.ValueOfString(x.PriceDecay)
+		if !f(fd_SellOrder_AutoRenew_price_decay, value) {
+			return
+		}
+	}
+	if x.FloorAmount != "" {
+		value := protoreflect.ValueOfString(x.FloorAmount)
+		if !f(fd_SellOrder_AutoRenew_floor_amount, value) {
+			return
+		}
+	}
+	if x.MaxRenewals != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxRenewals)
+		if !f(fd_SellOrder_AutoRenew_max_renewals, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SellOrder_AutoRenew) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		x.DisplayDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		x.Exponent = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		return x.RenewalPeriod != nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
+		return x.PriceDecay != ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
+		return x.FloorAmount != ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
+		return x.MaxRenewals != uint32(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrder_AutoRenew) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		x.RenewalPeriod = nil
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
+		x.PriceDecay = ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
+		x.FloorAmount = ""
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
+		x.MaxRenewals = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SellOrder_AutoRenew) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		value := x.RenewalPeriod
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
+		value := x.PriceDecay
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
+		value := x.FloorAmount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
+		value := x.MaxRenewals
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SellOrder_AutoRenew) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		x.RenewalPeriod = value.Message().Interface().(*durationpb.Duration)
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
+		x.PriceDecay = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
+		x.FloorAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
+		x.MaxRenewals = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1105,48 +1620,56 @@ func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SellOrder_AutoRenew) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
-		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
-		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		if x.RenewalPeriod == nil {
+			x.RenewalPeriod = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.RenewalPeriod.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
+		panic(fmt.Errorf("field price_decay of message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
+		panic(fmt.Errorf("field floor_amount of message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew is not mutable"))
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
+		panic(fmt.Errorf("field max_renewals of message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SellOrder_AutoRenew) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.price_decay":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.floor_amount":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+	case "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.max_renewals":
 		return protoreflect.ValueOfUint32(uint32(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.SellOrder.AutoRenew does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SellOrder_AutoRenew) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.SellOrder.AutoRenew", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1154,7 +1677,7 @@ func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SellOrder_AutoRenew) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1165,7 +1688,7 @@ func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SellOrder_AutoRenew) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1177,7 +1700,7 @@ func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AllowedDenom) IsValid() bool {
+func (x *fastReflection_SellOrder_AutoRenew) IsValid() bool {
 	return x != nil
 }
 
@@ -1187,9 +1710,9 @@ func (x *fastReflection_AllowedDenom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SellOrder_AutoRenew) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*SellOrder_AutoRenew)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1201,16 +1724,20 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.BankDenom)
+		if x.RenewalPeriod != nil {
+			l = options.Size(x.RenewalPeriod)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PriceDecay)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DisplayDenom)
+		l = len(x.FloorAmount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.Exponent != 0 {
-			n += 1 + runtime.Sov(uint64(x.Exponent))
+		if x.MaxRenewals != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxRenewals))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1222,7 +1749,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*SellOrder_AutoRenew)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1241,22 +1768,36 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.Exponent != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+		if x.MaxRenewals != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxRenewals))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x20
 		}
-		if len(x.DisplayDenom) > 0 {
-			i -= len(x.DisplayDenom)
-			copy(dAtA[i:], x.DisplayDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+		if len(x.FloorAmount) > 0 {
+			i -= len(x.FloorAmount)
+			copy(dAtA[i:], x.FloorAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FloorAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.PriceDecay) > 0 {
+			i -= len(x.PriceDecay)
+			copy(dAtA[i:], x.PriceDecay)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceDecay)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+		if x.RenewalPeriod != nil {
+			encoded, err := options.Marshal(x.RenewalPeriod)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -1271,7 +1812,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AllowedDenom)
+		x := input.Message.Interface().(*SellOrder_AutoRenew)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1303,15 +1844,51 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrder_AutoRenew: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SellOrder_AutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RenewalPeriod", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.RenewalPeriod == nil {
+					x.RenewalPeriod = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RenewalPeriod); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceDecay", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1339,11 +1916,11 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				x.PriceDecay = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FloorAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1371,13 +1948,13 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				x.FloorAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 4:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRenewals", wireType)
 				}
-				x.Exponent = 0
+				x.MaxRenewals = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1387,7 +1964,7 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Exponent |= uint32(b&0x7F) << shift
+					x.MaxRenewals |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -1427,33 +2004,99 @@ func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var _ protoreflect.List = (*_BuyOrder_14_list)(nil)
+
+type _BuyOrder_14_list struct {
+	list *[]uint64
+}
+
+func (x *_BuyOrder_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_BuyOrder_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfUint64((*x.list)[i])
+}
+
+func (x *_BuyOrder_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_BuyOrder_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Uint()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_BuyOrder_14_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message BuyOrder at list field FailedSellOrderIds as it is not of Message kind"))
+}
+
+func (x *_BuyOrder_14_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_BuyOrder_14_list) NewElement() protoreflect.Value {
+	v := uint64(0)
+	return protoreflect.ValueOfUint64(v)
+}
+
+func (x *_BuyOrder_14_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Market                    protoreflect.MessageDescriptor
-	fd_Market_id                 protoreflect.FieldDescriptor
-	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
-	fd_Market_bank_denom         protoreflect.FieldDescriptor
-	fd_Market_precision_modifier protoreflect.FieldDescriptor
+	md_BuyOrder                         protoreflect.MessageDescriptor
+	fd_BuyOrder_id                      protoreflect.FieldDescriptor
+	fd_BuyOrder_buyer                   protoreflect.FieldDescriptor
+	fd_BuyOrder_selection               protoreflect.FieldDescriptor
+	fd_BuyOrder_quantity                protoreflect.FieldDescriptor
+	fd_BuyOrder_market_id               protoreflect.FieldDescriptor
+	fd_BuyOrder_bid_amount              protoreflect.FieldDescriptor
+	fd_BuyOrder_maker                   protoreflect.FieldDescriptor
+	fd_BuyOrder_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrder_expiration              protoreflect.FieldDescriptor
+	fd_BuyOrder_buyer_fee               protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_beneficiary  protoreflect.FieldDescriptor
+	fd_BuyOrder_retirement_reason       protoreflect.FieldDescriptor
+	fd_BuyOrder_failed_sell_order_ids   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_marketplace_v1_state_proto_init()
-	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
-	fd_Market_id = md_Market.Fields().ByName("id")
-	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
-	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
-	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
+	md_BuyOrder = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder")
+	fd_BuyOrder_id = md_BuyOrder.Fields().ByName("id")
+	fd_BuyOrder_buyer = md_BuyOrder.Fields().ByName("buyer")
+	fd_BuyOrder_selection = md_BuyOrder.Fields().ByName("selection")
+	fd_BuyOrder_quantity = md_BuyOrder.Fields().ByName("quantity")
+	fd_BuyOrder_market_id = md_BuyOrder.Fields().ByName("market_id")
+	fd_BuyOrder_bid_amount = md_BuyOrder.Fields().ByName("bid_amount")
+	fd_BuyOrder_maker = md_BuyOrder.Fields().ByName("maker")
+	fd_BuyOrder_disable_auto_retire = md_BuyOrder.Fields().ByName("disable_auto_retire")
+	fd_BuyOrder_retirement_jurisdiction = md_BuyOrder.Fields().ByName("retirement_jurisdiction")
+	fd_BuyOrder_expiration = md_BuyOrder.Fields().ByName("expiration")
+	fd_BuyOrder_buyer_fee = md_BuyOrder.Fields().ByName("buyer_fee")
+	fd_BuyOrder_retirement_beneficiary = md_BuyOrder.Fields().ByName("retirement_beneficiary")
+	fd_BuyOrder_retirement_reason = md_BuyOrder.Fields().ByName("retirement_reason")
+	fd_BuyOrder_failed_sell_order_ids = md_BuyOrder.Fields().ByName("failed_sell_order_ids")
 }
 
-var _ protoreflect.Message = (*fastReflection_Market)(nil)
+var _ protoreflect.Message = (*fastReflection_BuyOrder)(nil)
 
-type fastReflection_Market Market
+type fastReflection_BuyOrder BuyOrder
 
-func (x *Market) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Market)(x)
+func (x *BuyOrder) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(x)
 }
 
-func (x *Market) slowProtoReflect() protoreflect.Message {
-	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+func (x *BuyOrder) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1464,43 +2107,43 @@ func (x *Market) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Market_messageType fastReflection_Market_messageType
-var _ protoreflect.MessageType = fastReflection_Market_messageType{}
+var _fastReflection_BuyOrder_messageType fastReflection_BuyOrder_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_messageType{}
 
-type fastReflection_Market_messageType struct{}
+type fastReflection_BuyOrder_messageType struct{}
 
-func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Market)(nil)
+func (x fastReflection_BuyOrder_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder)(nil)
 }
-func (x fastReflection_Market_messageType) New() protoreflect.Message {
-	return new(fastReflection_Market)
+func (x fastReflection_BuyOrder_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
-func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
+func (x fastReflection_BuyOrder_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
-	return md_Market
+func (x *fastReflection_BuyOrder) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Market) Type() protoreflect.MessageType {
-	return _fastReflection_Market_messageType
+func (x *fastReflection_BuyOrder) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Market) New() protoreflect.Message {
-	return new(fastReflection_Market)
+func (x *fastReflection_BuyOrder) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
-	return (*Market)(x)
+func (x *fastReflection_BuyOrder) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1508,28 +2151,88 @@ func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_BuyOrder) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Id != uint64(0) {
 		value := protoreflect.ValueOfUint64(x.Id)
-		if !f(fd_Market_id, value) {
+		if !f(fd_BuyOrder_id, value) {
 			return
 		}
 	}
-	if x.CreditTypeAbbrev != "" {
-		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
-		if !f(fd_Market_credit_type_abbrev, value) {
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_BuyOrder_buyer, value) {
 			return
 		}
 	}
-	if x.BankDenom != "" {
-		value := protoreflect.ValueOfString(x.BankDenom)
-		if !f(fd_Market_bank_denom, value) {
+	if x.Selection != nil {
+		value := protoreflect.ValueOfMessage(x.Selection.ProtoReflect())
+		if !f(fd_BuyOrder_selection, value) {
 			return
 		}
 	}
-	if x.PrecisionModifier != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
-		if !f(fd_Market_precision_modifier, value) {
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_BuyOrder_quantity, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_BuyOrder_market_id, value) {
+			return
+		}
+	}
+	if x.BidAmount != "" {
+		value := protoreflect.ValueOfString(x.BidAmount)
+		if !f(fd_BuyOrder_bid_amount, value) {
+			return
+		}
+	}
+	if x.Maker != false {
+		value := protoreflect.ValueOfBool(x.Maker)
+		if !f(fd_BuyOrder_maker, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_BuyOrder_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_BuyOrder_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_BuyOrder_expiration, value) {
+			return
+		}
+	}
+	if x.BuyerFee != "" {
+		value := protoreflect.ValueOfString(x.BuyerFee)
+		if !f(fd_BuyOrder_buyer_fee, value) {
+			return
+		}
+	}
+	if x.RetirementBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.RetirementBeneficiary)
+		if !f(fd_BuyOrder_retirement_beneficiary, value) {
+			return
+		}
+	}
+	if x.RetirementReason != "" {
+		value := protoreflect.ValueOfString(x.RetirementReason)
+		if !f(fd_BuyOrder_retirement_reason, value) {
+			return
+		}
+	}
+	if len(x.FailedSellOrderIds) != 0 {
+		value := protoreflect.ValueOfList(&_BuyOrder_14_list{list: &x.FailedSellOrderIds})
+		if !f(fd_BuyOrder_failed_sell_order_ids, value) {
 			return
 		}
 	}
@@ -1546,21 +2249,41 @@ func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BuyOrder) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
 		return x.Id != uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		return x.CreditTypeAbbrev != ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		return x.BankDenom != ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return x.PrecisionModifier != uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		return x.Selection != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		return x.BidAmount != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		return x.Maker != false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		return x.BuyerFee != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		return x.RetirementBeneficiary != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		return x.RetirementReason != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		return len(x.FailedSellOrderIds) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1570,21 +2293,41 @@ func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BuyOrder) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
 		x.Id = uint64(0)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = ""
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = ""
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		x.Selection = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		x.Maker = false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		x.BuyerFee = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		x.RetirementBeneficiary = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		x.RetirementReason = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		x.FailedSellOrderIds = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1594,25 +2337,58 @@ func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
 		value := x.Id
 		return protoreflect.ValueOfUint64(value)
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		value := x.CreditTypeAbbrev
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		value := x.Selection
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		value := x.Quantity
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		value := x.BankDenom
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		value := x.BidAmount
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		value := x.PrecisionModifier
-		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		value := x.Maker
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		value := x.BuyerFee
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		value := x.RetirementBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		value := x.RetirementReason
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		if len(x.FailedSellOrderIds) == 0 {
+			return protoreflect.ValueOfList(&_BuyOrder_14_list{})
+		}
+		listValue := &_BuyOrder_14_list{list: &x.FailedSellOrderIds}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1626,21 +2402,43 @@ func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BuyOrder) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
 		x.Id = value.Uint()
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		x.CreditTypeAbbrev = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		x.BankDenom = value.Interface().(string)
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		x.PrecisionModifier = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		x.Selection = value.Message().Interface().(*BuyOrder_Selection)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		x.BidAmount = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		x.Maker = value.Bool()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		x.BuyerFee = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		x.RetirementBeneficiary = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		x.RetirementReason = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		lv := value.List()
+		clv := lv.(*_BuyOrder_14_list)
+		x.FailedSellOrderIds = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1654,52 +2452,105 @@ func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
-		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
-		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
-		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		if x.Selection == nil {
+			x.Selection = new(BuyOrder_Selection)
+		}
+		return protoreflect.ValueOfMessage(x.Selection.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		if x.FailedSellOrderIds == nil {
+			x.FailedSellOrderIds = []uint64{}
+		}
+		value := &_BuyOrder_14_list{list: &x.FailedSellOrderIds}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
+		panic(fmt.Errorf("field bid_amount of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		panic(fmt.Errorf("field maker of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		panic(fmt.Errorf("field buyer_fee of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		panic(fmt.Errorf("field retirement_beneficiary of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		panic(fmt.Errorf("field retirement_reason of message regen.ecocredit.marketplace.v1.BuyOrder is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BuyOrder) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.marketplace.v1.Market.id":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.id":
 		return protoreflect.ValueOfUint64(uint64(0))
-	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.selection":
+		m := new(BuyOrder_Selection)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.quantity":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+	case "regen.ecocredit.marketplace.v1.BuyOrder.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.bid_amount":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
-		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.maker":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.buyer_fee":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.retirement_reason":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.failed_sell_order_ids":
+		list := []uint64{}
+		return protoreflect.ValueOfList(&_BuyOrder_14_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BuyOrder) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1707,7 +2558,7 @@ func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BuyOrder) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1718,7 +2569,7 @@ func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BuyOrder) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1730,7 +2581,7 @@ func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Market) IsValid() bool {
+func (x *fastReflection_BuyOrder) IsValid() bool {
 	return x != nil
 }
 
@@ -1740,9 +2591,9 @@ func (x *fastReflection_Market) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BuyOrder) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Market)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1757,16 +2608,57 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 		if x.Id != 0 {
 			n += 1 + runtime.Sov(uint64(x.Id))
 		}
-		l = len(x.CreditTypeAbbrev)
+		l = len(x.Buyer)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BankDenom)
+		if x.Selection != nil {
+			l = options.Size(x.Selection)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.PrecisionModifier != 0 {
-			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.BidAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Maker {
+			n += 2
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BuyerFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementBeneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.FailedSellOrderIds) > 0 {
+			l = 0
+			for _, e := range x.FailedSellOrderIds {
+				l += runtime.Sov(uint64(e))
+			}
+			n += 1 + runtime.Sov(uint64(l)) + l
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1778,7 +2670,7 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
+		x := input.Message.Interface().(*BuyOrder)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1797,46 +2689,149 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.PrecisionModifier != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
+		if len(x.FailedSellOrderIds) > 0 {
+			var pksize2 int
+			for _, num := range x.FailedSellOrderIds {
+				pksize2 += runtime.Sov(uint64(num))
+			}
+			i -= pksize2
+			j1 := i
+			for _, num := range x.FailedSellOrderIds {
+				for num >= 1<<7 {
+					dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+					num >>= 7
+					j1++
+				}
+				dAtA[j1] = uint8(num)
+				j1++
+			}
+			i = runtime.EncodeVarint(dAtA, i, uint64(pksize2))
 			i--
-			dAtA[i] = 0x20
+			dAtA[i] = 0x72
 		}
-		if len(x.BankDenom) > 0 {
-			i -= len(x.BankDenom)
-			copy(dAtA[i:], x.BankDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+		if len(x.RetirementReason) > 0 {
+			i -= len(x.RetirementReason)
+			copy(dAtA[i:], x.RetirementReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementReason)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x6a
 		}
-		if len(x.CreditTypeAbbrev) > 0 {
-			i -= len(x.CreditTypeAbbrev)
-			copy(dAtA[i:], x.CreditTypeAbbrev)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+		if len(x.RetirementBeneficiary) > 0 {
+			i -= len(x.RetirementBeneficiary)
+			copy(dAtA[i:], x.RetirementBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementBeneficiary)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
-		if x.Id != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+		if len(x.BuyerFee) > 0 {
+			i -= len(x.BuyerFee)
+			copy(dAtA[i:], x.BuyerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BuyerFee)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x5a
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Market)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.Maker {
+			i--
+			if x.Maker {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.BidAmount) > 0 {
+			i -= len(x.BidAmount)
+			copy(dAtA[i:], x.BidAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidAmount)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Selection != nil {
+			encoded, err := options.Marshal(x.Selection)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
 			}, nil
 		}
 		options := runtime.UnmarshalInputToOptions(input)
@@ -1864,10 +2859,10 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1891,7 +2886,77 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 				}
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Selection == nil {
+					x.Selection = &BuyOrder_Selection{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Selection); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1919,11 +2984,30 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				x.Quantity = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1951,13 +3035,13 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				x.BidAmount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 4:
+			case 7:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
 				}
-				x.PrecisionModifier = 0
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1967,331 +3051,9143 @@ func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.PrecisionModifier |= uint32(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				x.Maker = bool(v != 0)
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuyerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 14:
+				if wireType == 0 {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					x.FailedSellOrderIds = append(x.FailedSellOrderIds, v)
+				} else if wireType == 2 {
+					var packedLen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						packedLen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if packedLen < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					postIndex := iNdEx + packedLen
+					if postIndex < 0 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+					}
+					if postIndex > l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					var elementCount int
+					var count int
+					for _, integer := range dAtA[iNdEx:postIndex] {
+						if integer < 128 {
+							count++
+						}
+					}
+					elementCount = count
+					if elementCount != 0 && len(x.FailedSellOrderIds) == 0 {
+						x.FailedSellOrderIds = make([]uint64, 0, elementCount)
+					}
+					for iNdEx < postIndex {
+						var v uint64
+						for shift := uint(0); ; shift += 7 {
+							if shift >= 64 {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+							}
+							if iNdEx >= l {
+								return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+							}
+							b := dAtA[iNdEx]
+							iNdEx++
+							v |= uint64(b&0x7F) << shift
+							if b < 0x80 {
+								break
+							}
+						}
+						x.FailedSellOrderIds = append(x.FailedSellOrderIds, v)
+					}
+				} else {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailedSellOrderIds", wireType)
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BuyOrder_Selection                      protoreflect.MessageDescriptor
+	fd_BuyOrder_Selection_batch_key            protoreflect.FieldDescriptor
+	fd_BuyOrder_Selection_project_key          protoreflect.FieldDescriptor
+	fd_BuyOrder_Selection_class_key            protoreflect.FieldDescriptor
+	fd_BuyOrder_Selection_project_jurisdiction protoreflect.FieldDescriptor
+	fd_BuyOrder_Selection_min_start_date       protoreflect.FieldDescriptor
+	fd_BuyOrder_Selection_max_end_date         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_BuyOrder_Selection = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("BuyOrder").Messages().ByName("Selection")
+	fd_BuyOrder_Selection_batch_key = md_BuyOrder_Selection.Fields().ByName("batch_key")
+	fd_BuyOrder_Selection_project_key = md_BuyOrder_Selection.Fields().ByName("project_key")
+	fd_BuyOrder_Selection_class_key = md_BuyOrder_Selection.Fields().ByName("class_key")
+	fd_BuyOrder_Selection_project_jurisdiction = md_BuyOrder_Selection.Fields().ByName("project_jurisdiction")
+	fd_BuyOrder_Selection_min_start_date = md_BuyOrder_Selection.Fields().ByName("min_start_date")
+	fd_BuyOrder_Selection_max_end_date = md_BuyOrder_Selection.Fields().ByName("max_end_date")
+}
+
+var _ protoreflect.Message = (*fastReflection_BuyOrder_Selection)(nil)
+
+type fastReflection_BuyOrder_Selection BuyOrder_Selection
+
+func (x *BuyOrder_Selection) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Selection)(x)
+}
+
+func (x *BuyOrder_Selection) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BuyOrder_Selection_messageType fastReflection_BuyOrder_Selection_messageType
+var _ protoreflect.MessageType = fastReflection_BuyOrder_Selection_messageType{}
+
+type fastReflection_BuyOrder_Selection_messageType struct{}
+
+func (x fastReflection_BuyOrder_Selection_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BuyOrder_Selection)(nil)
+}
+func (x fastReflection_BuyOrder_Selection_messageType) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Selection)
+}
+func (x fastReflection_BuyOrder_Selection_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Selection
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BuyOrder_Selection) Descriptor() protoreflect.MessageDescriptor {
+	return md_BuyOrder_Selection
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BuyOrder_Selection) Type() protoreflect.MessageType {
+	return _fastReflection_BuyOrder_Selection_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BuyOrder_Selection) New() protoreflect.Message {
+	return new(fastReflection_BuyOrder_Selection)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BuyOrder_Selection) Interface() protoreflect.ProtoMessage {
+	return (*BuyOrder_Selection)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BuyOrder_Selection) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Sum != nil {
+		switch o := x.Sum.(type) {
+		case *BuyOrder_Selection_BatchKey:
+			v := o.BatchKey
+			value := protoreflect.ValueOfUint64(v)
+			if !f(fd_BuyOrder_Selection_batch_key, value) {
+				return
+			}
+		case *BuyOrder_Selection_ProjectKey:
+			v := o.ProjectKey
+			value := protoreflect.ValueOfUint64(v)
+			if !f(fd_BuyOrder_Selection_project_key, value) {
+				return
+			}
+		case *BuyOrder_Selection_ClassKey:
+			v := o.ClassKey
+			value := protoreflect.ValueOfUint64(v)
+			if !f(fd_BuyOrder_Selection_class_key, value) {
+				return
+			}
+		}
+	}
+	if x.ProjectJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.ProjectJurisdiction)
+		if !f(fd_BuyOrder_Selection_project_jurisdiction, value) {
+			return
+		}
+	}
+	if x.MinStartDate != nil {
+		value := protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+		if !f(fd_BuyOrder_Selection_min_start_date, value) {
+			return
+		}
+	}
+	if x.MaxEndDate != nil {
+		value := protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+		if !f(fd_BuyOrder_Selection_max_end_date, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BuyOrder_Selection) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Selection_BatchKey); ok {
+			return true
+		} else {
+			return false
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Selection_ProjectKey); ok {
+			return true
+		} else {
+			return false
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		if x.Sum == nil {
+			return false
+		} else if _, ok := x.Sum.(*BuyOrder_Selection_ClassKey); ok {
+			return true
+		} else {
+			return false
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		return x.ProjectJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		return x.MinStartDate != nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		return x.MaxEndDate != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Selection) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		x.Sum = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		x.Sum = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		x.Sum = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		x.ProjectJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		x.MinStartDate = nil
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		x.MaxEndDate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BuyOrder_Selection) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		if x.Sum == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		} else if v, ok := x.Sum.(*BuyOrder_Selection_BatchKey); ok {
+			return protoreflect.ValueOfUint64(v.BatchKey)
+		} else {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		if x.Sum == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		} else if v, ok := x.Sum.(*BuyOrder_Selection_ProjectKey); ok {
+			return protoreflect.ValueOfUint64(v.ProjectKey)
+		} else {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		if x.Sum == nil {
+			return protoreflect.ValueOfUint64(uint64(0))
+		} else if v, ok := x.Sum.(*BuyOrder_Selection_ClassKey); ok {
+			return protoreflect.ValueOfUint64(v.ClassKey)
+		} else {
+			return protoreflect.ValueOfUint64(uint64(0))
+		}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		value := x.ProjectJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		value := x.MinStartDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		value := x.MaxEndDate
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Selection) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		cv := value.Uint()
+		x.Sum = &BuyOrder_Selection_BatchKey{BatchKey: cv}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		cv := value.Uint()
+		x.Sum = &BuyOrder_Selection_ProjectKey{ProjectKey: cv}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		cv := value.Uint()
+		x.Sum = &BuyOrder_Selection_ClassKey{ClassKey: cv}
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		x.ProjectJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		x.MinStartDate = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		x.MaxEndDate = value.Message().Interface().(*timestamppb.Timestamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Selection) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		if x.MinStartDate == nil {
+			x.MinStartDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MinStartDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		if x.MaxEndDate == nil {
+			x.MaxEndDate = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.MaxEndDate.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.BuyOrder.Selection is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		panic(fmt.Errorf("field project_key of message regen.ecocredit.marketplace.v1.BuyOrder.Selection is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		panic(fmt.Errorf("field class_key of message regen.ecocredit.marketplace.v1.BuyOrder.Selection is not mutable"))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		panic(fmt.Errorf("field project_jurisdiction of message regen.ecocredit.marketplace.v1.BuyOrder.Selection is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BuyOrder_Selection) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.class_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.project_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.BuyOrder.Selection"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.BuyOrder.Selection does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BuyOrder_Selection) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	case "regen.ecocredit.marketplace.v1.BuyOrder.Selection.sum":
+		if x.Sum == nil {
+			return nil
+		}
+		switch x.Sum.(type) {
+		case *BuyOrder_Selection_BatchKey:
+			return x.Descriptor().Fields().ByName("batch_key")
+		case *BuyOrder_Selection_ProjectKey:
+			return x.Descriptor().Fields().ByName("project_key")
+		case *BuyOrder_Selection_ClassKey:
+			return x.Descriptor().Fields().ByName("class_key")
+		}
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.BuyOrder.Selection", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BuyOrder_Selection) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BuyOrder_Selection) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BuyOrder_Selection) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BuyOrder_Selection) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BuyOrder_Selection)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		switch x := x.Sum.(type) {
+		case *BuyOrder_Selection_BatchKey:
+			if x == nil {
+				break
+			}
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		case *BuyOrder_Selection_ProjectKey:
+			if x == nil {
+				break
+			}
+			n += 1 + runtime.Sov(uint64(x.ProjectKey))
+		case *BuyOrder_Selection_ClassKey:
+			if x == nil {
+				break
+			}
+			n += 1 + runtime.Sov(uint64(x.ClassKey))
+		}
+		l = len(x.ProjectJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MinStartDate != nil {
+			l = options.Size(x.MinStartDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxEndDate != nil {
+			l = options.Size(x.MaxEndDate)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_Selection)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		switch x := x.Sum.(type) {
+		case *BuyOrder_Selection_BatchKey:
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x8
+		case *BuyOrder_Selection_ProjectKey:
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ProjectKey))
+			i--
+			dAtA[i] = 0x10
+		case *BuyOrder_Selection_ClassKey:
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClassKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.MaxEndDate != nil {
+			encoded, err := options.Marshal(x.MaxEndDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.MinStartDate != nil {
+			encoded, err := options.Marshal(x.MinStartDate)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ProjectJurisdiction) > 0 {
+			i -= len(x.ProjectJurisdiction)
+			copy(dAtA[i:], x.ProjectJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectJurisdiction)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BuyOrder_Selection)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Selection: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BuyOrder_Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Sum = &BuyOrder_Selection_BatchKey{v}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Sum = &BuyOrder_Selection_ProjectKey{v}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				}
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Sum = &BuyOrder_Selection_ClassKey{v}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MinStartDate == nil {
+					x.MinStartDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MinStartDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.MaxEndDate == nil {
+					x.MaxEndDate = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MaxEndDate); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AllowedDenom               protoreflect.MessageDescriptor
+	fd_AllowedDenom_bank_denom    protoreflect.FieldDescriptor
+	fd_AllowedDenom_display_denom protoreflect.FieldDescriptor
+	fd_AllowedDenom_exponent      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_AllowedDenom = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("AllowedDenom")
+	fd_AllowedDenom_bank_denom = md_AllowedDenom.Fields().ByName("bank_denom")
+	fd_AllowedDenom_display_denom = md_AllowedDenom.Fields().ByName("display_denom")
+	fd_AllowedDenom_exponent = md_AllowedDenom.Fields().ByName("exponent")
+}
+
+var _ protoreflect.Message = (*fastReflection_AllowedDenom)(nil)
+
+type fastReflection_AllowedDenom AllowedDenom
+
+func (x *AllowedDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(x)
+}
+
+func (x *AllowedDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AllowedDenom_messageType fastReflection_AllowedDenom_messageType
+var _ protoreflect.MessageType = fastReflection_AllowedDenom_messageType{}
+
+type fastReflection_AllowedDenom_messageType struct{}
+
+func (x fastReflection_AllowedDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AllowedDenom)(nil)
+}
+func (x fastReflection_AllowedDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+func (x fastReflection_AllowedDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AllowedDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_AllowedDenom
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AllowedDenom) Type() protoreflect.MessageType {
+	return _fastReflection_AllowedDenom_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AllowedDenom) New() protoreflect.Message {
+	return new(fastReflection_AllowedDenom)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AllowedDenom) Interface() protoreflect.ProtoMessage {
+	return (*AllowedDenom)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AllowedDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_AllowedDenom_bank_denom, value) {
+			return
+		}
+	}
+	if x.DisplayDenom != "" {
+		value := protoreflect.ValueOfString(x.DisplayDenom)
+		if !f(fd_AllowedDenom_display_denom, value) {
+			return
+		}
+	}
+	if x.Exponent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Exponent)
+		if !f(fd_AllowedDenom_exponent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AllowedDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return x.DisplayDenom != ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return x.Exponent != uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = ""
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AllowedDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		value := x.DisplayDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		value := x.Exponent
+		return protoreflect.ValueOfUint32(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		x.DisplayDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		x.Exponent = uint32(value.Uint())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		panic(fmt.Errorf("field display_denom of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		panic(fmt.Errorf("field exponent of message regen.ecocredit.marketplace.v1.AllowedDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AllowedDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.display_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.AllowedDenom.exponent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.AllowedDenom"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.AllowedDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AllowedDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.AllowedDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AllowedDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AllowedDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AllowedDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AllowedDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DisplayDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Exponent != 0 {
+			n += 1 + runtime.Sov(uint64(x.Exponent))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Exponent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Exponent))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.DisplayDenom) > 0 {
+			i -= len(x.DisplayDenom)
+			copy(dAtA[i:], x.DisplayDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisplayDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AllowedDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DisplayDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+				}
+				x.Exponent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Exponent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Market                    protoreflect.MessageDescriptor
+	fd_Market_id                 protoreflect.FieldDescriptor
+	fd_Market_credit_type_abbrev protoreflect.FieldDescriptor
+	fd_Market_bank_denom         protoreflect.FieldDescriptor
+	fd_Market_precision_modifier protoreflect.FieldDescriptor
+	fd_Market_clearing_mode      protoreflect.FieldDescriptor
+	fd_Market_epoch_length       protoreflect.FieldDescriptor
+	fd_Market_buyer_fee          protoreflect.FieldDescriptor
+	fd_Market_seller_fee         protoreflect.FieldDescriptor
+	fd_Market_fee_destination    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Market = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Market")
+	fd_Market_id = md_Market.Fields().ByName("id")
+	fd_Market_credit_type_abbrev = md_Market.Fields().ByName("credit_type_abbrev")
+	fd_Market_bank_denom = md_Market.Fields().ByName("bank_denom")
+	fd_Market_precision_modifier = md_Market.Fields().ByName("precision_modifier")
+	fd_Market_clearing_mode = md_Market.Fields().ByName("clearing_mode")
+	fd_Market_epoch_length = md_Market.Fields().ByName("epoch_length")
+	fd_Market_buyer_fee = md_Market.Fields().ByName("buyer_fee")
+	fd_Market_seller_fee = md_Market.Fields().ByName("seller_fee")
+	fd_Market_fee_destination = md_Market.Fields().ByName("fee_destination")
+}
+
+var _ protoreflect.Message = (*fastReflection_Market)(nil)
+
+type fastReflection_Market Market
+
+func (x *Market) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Market)(x)
+}
+
+func (x *Market) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Market_messageType fastReflection_Market_messageType
+var _ protoreflect.MessageType = fastReflection_Market_messageType{}
+
+type fastReflection_Market_messageType struct{}
+
+func (x fastReflection_Market_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Market)(nil)
+}
+func (x fastReflection_Market_messageType) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+func (x fastReflection_Market_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Market) Descriptor() protoreflect.MessageDescriptor {
+	return md_Market
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Market) Type() protoreflect.MessageType {
+	return _fastReflection_Market_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Market) New() protoreflect.Message {
+	return new(fastReflection_Market)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Market) Interface() protoreflect.ProtoMessage {
+	return (*Market)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Market) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Market_id, value) {
+			return
+		}
+	}
+	if x.CreditTypeAbbrev != "" {
+		value := protoreflect.ValueOfString(x.CreditTypeAbbrev)
+		if !f(fd_Market_credit_type_abbrev, value) {
+			return
+		}
+	}
+	if x.BankDenom != "" {
+		value := protoreflect.ValueOfString(x.BankDenom)
+		if !f(fd_Market_bank_denom, value) {
+			return
+		}
+	}
+	if x.PrecisionModifier != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.PrecisionModifier)
+		if !f(fd_Market_precision_modifier, value) {
+			return
+		}
+	}
+	if x.ClearingMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.ClearingMode))
+		if !f(fd_Market_clearing_mode, value) {
+			return
+		}
+	}
+	if x.EpochLength != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.EpochLength)
+		if !f(fd_Market_epoch_length, value) {
+			return
+		}
+	}
+	if x.BuyerFee != "" {
+		value := protoreflect.ValueOfString(x.BuyerFee)
+		if !f(fd_Market_buyer_fee, value) {
+			return
+		}
+	}
+	if x.SellerFee != "" {
+		value := protoreflect.ValueOfString(x.SellerFee)
+		if !f(fd_Market_seller_fee, value) {
+			return
+		}
+	}
+	if x.FeeDestination != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.FeeDestination))
+		if !f(fd_Market_fee_destination, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Market) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return x.CreditTypeAbbrev != ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return x.BankDenom != ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return x.PrecisionModifier != uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		return x.ClearingMode != 0
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		return x.EpochLength != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		return x.BuyerFee != ""
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		return x.SellerFee != ""
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		return x.FeeDestination != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = ""
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = ""
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(0)
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		x.ClearingMode = 0
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		x.EpochLength = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		x.BuyerFee = ""
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		x.SellerFee = ""
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		x.FeeDestination = 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Market) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		value := x.CreditTypeAbbrev
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		value := x.BankDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		value := x.PrecisionModifier
+		return protoreflect.ValueOfUint32(value)
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		value := x.ClearingMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		value := x.EpochLength
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		value := x.BuyerFee
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		value := x.SellerFee
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		value := x.FeeDestination
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		x.CreditTypeAbbrev = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		x.BankDenom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		x.PrecisionModifier = uint32(value.Uint())
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		x.ClearingMode = (ClearingMode)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		x.EpochLength = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		x.BuyerFee = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		x.SellerFee = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		x.FeeDestination = (FeeDestination)(value.Enum())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		panic(fmt.Errorf("field credit_type_abbrev of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		panic(fmt.Errorf("field bank_denom of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		panic(fmt.Errorf("field precision_modifier of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		panic(fmt.Errorf("field clearing_mode of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		panic(fmt.Errorf("field epoch_length of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		panic(fmt.Errorf("field buyer_fee of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		panic(fmt.Errorf("field seller_fee of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		panic(fmt.Errorf("field fee_destination of message regen.ecocredit.marketplace.v1.Market is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Market) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Market.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Market.credit_type_abbrev":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.bank_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.precision_modifier":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "regen.ecocredit.marketplace.v1.Market.clearing_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.Market.epoch_length":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Market.buyer_fee":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.seller_fee":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Market.fee_destination":
+		return protoreflect.ValueOfEnum(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Market"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Market does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Market) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Market", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Market) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Market) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Market) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Market) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.CreditTypeAbbrev)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BankDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PrecisionModifier != 0 {
+			n += 1 + runtime.Sov(uint64(x.PrecisionModifier))
+		}
+		if x.ClearingMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.ClearingMode))
+		}
+		if x.EpochLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.EpochLength))
+		}
+		l = len(x.BuyerFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SellerFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FeeDestination != 0 {
+			n += 1 + runtime.Sov(uint64(x.FeeDestination))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FeeDestination != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FeeDestination))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.SellerFee) > 0 {
+			i -= len(x.SellerFee)
+			copy(dAtA[i:], x.SellerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SellerFee)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.BuyerFee) > 0 {
+			i -= len(x.BuyerFee)
+			copy(dAtA[i:], x.BuyerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BuyerFee)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.EpochLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EpochLength))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.ClearingMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ClearingMode))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.PrecisionModifier != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PrecisionModifier))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.BankDenom) > 0 {
+			i -= len(x.BankDenom)
+			copy(dAtA[i:], x.BankDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BankDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CreditTypeAbbrev) > 0 {
+			i -= len(x.CreditTypeAbbrev)
+			copy(dAtA[i:], x.CreditTypeAbbrev)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CreditTypeAbbrev)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Market)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BankDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+				}
+				x.PrecisionModifier = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PrecisionModifier |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClearingMode", wireType)
+				}
+				x.ClearingMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ClearingMode |= ClearingMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+				}
+				x.EpochLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EpochLength |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuyerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SellerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+				}
+				x.FeeDestination = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FeeDestination |= FeeDestination(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Trade               protoreflect.MessageDescriptor
+	fd_Trade_id            protoreflect.FieldDescriptor
+	fd_Trade_sell_order_id protoreflect.FieldDescriptor
+	fd_Trade_buyer         protoreflect.FieldDescriptor
+	fd_Trade_seller        protoreflect.FieldDescriptor
+	fd_Trade_batch_key     protoreflect.FieldDescriptor
+	fd_Trade_quantity      protoreflect.FieldDescriptor
+	fd_Trade_price         protoreflect.FieldDescriptor
+	fd_Trade_denom         protoreflect.FieldDescriptor
+	fd_Trade_retired       protoreflect.FieldDescriptor
+	fd_Trade_timestamp     protoreflect.FieldDescriptor
+	fd_Trade_auction_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Trade = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Trade")
+	fd_Trade_id = md_Trade.Fields().ByName("id")
+	fd_Trade_sell_order_id = md_Trade.Fields().ByName("sell_order_id")
+	fd_Trade_buyer = md_Trade.Fields().ByName("buyer")
+	fd_Trade_seller = md_Trade.Fields().ByName("seller")
+	fd_Trade_batch_key = md_Trade.Fields().ByName("batch_key")
+	fd_Trade_quantity = md_Trade.Fields().ByName("quantity")
+	fd_Trade_price = md_Trade.Fields().ByName("price")
+	fd_Trade_denom = md_Trade.Fields().ByName("denom")
+	fd_Trade_retired = md_Trade.Fields().ByName("retired")
+	fd_Trade_timestamp = md_Trade.Fields().ByName("timestamp")
+	fd_Trade_auction_id = md_Trade.Fields().ByName("auction_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Trade)(nil)
+
+type fastReflection_Trade Trade
+
+func (x *Trade) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Trade)(x)
+}
+
+func (x *Trade) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Trade_messageType fastReflection_Trade_messageType
+var _ protoreflect.MessageType = fastReflection_Trade_messageType{}
+
+type fastReflection_Trade_messageType struct{}
+
+func (x fastReflection_Trade_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Trade)(nil)
+}
+func (x fastReflection_Trade_messageType) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+func (x fastReflection_Trade_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Trade) Descriptor() protoreflect.MessageDescriptor {
+	return md_Trade
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Trade) Type() protoreflect.MessageType {
+	return _fastReflection_Trade_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Trade) New() protoreflect.Message {
+	return new(fastReflection_Trade)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Trade) Interface() protoreflect.ProtoMessage {
+	return (*Trade)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Trade) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Trade_id, value) {
+			return
+		}
+	}
+	if x.SellOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SellOrderId)
+		if !f(fd_Trade_sell_order_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_Trade_buyer, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Trade_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Trade_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Trade_quantity, value) {
+			return
+		}
+	}
+	if x.Price != "" {
+		value := protoreflect.ValueOfString(x.Price)
+		if !f(fd_Trade_price, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Trade_denom, value) {
+			return
+		}
+	}
+	if x.Retired != false {
+		value := protoreflect.ValueOfBool(x.Retired)
+		if !f(fd_Trade_retired, value) {
+			return
+		}
+	}
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_Trade_timestamp, value) {
+			return
+		}
+	}
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_Trade_auction_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Trade) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		return x.SellOrderId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		return x.Price != ""
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		return x.Denom != ""
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		return x.Retired != false
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		return x.Timestamp != nil
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		return x.AuctionId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		x.SellOrderId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		x.Price = ""
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		x.Denom = ""
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		x.Retired = false
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = nil
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		x.AuctionId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Trade) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		value := x.SellOrderId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		value := x.Price
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		value := x.Retired
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		x.SellOrderId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		x.Price = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		x.Denom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		x.Retired = value.Bool()
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		x.AuctionId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		panic(fmt.Errorf("field sell_order_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		panic(fmt.Errorf("field price of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		panic(fmt.Errorf("field denom of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		panic(fmt.Errorf("field retired of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		panic(fmt.Errorf("field auction_id of message regen.ecocredit.marketplace.v1.Trade is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Trade) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Trade.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.sell_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Trade.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Trade.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Trade.retired":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.Trade.timestamp":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Trade.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Trade"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Trade does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Trade) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Trade", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Trade) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Trade) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Trade) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Trade) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.SellOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SellOrderId))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Price)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Retired {
+			n += 2
+		}
+		if x.Timestamp != nil {
+			l = options.Size(x.Timestamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Timestamp != nil {
+			encoded, err := options.Marshal(x.Timestamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.Retired {
+			i--
+			if x.Retired {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Price) > 0 {
+			i -= len(x.Price)
+			copy(dAtA[i:], x.Price)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Price)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.SellOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SellOrderId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Trade)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				}
+				x.SellOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SellOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Price = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Retired = bool(v != 0)
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timestamp == nil {
+					x.Timestamp = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Auction                 protoreflect.MessageDescriptor
+	fd_Auction_id              protoreflect.FieldDescriptor
+	fd_Auction_seller          protoreflect.FieldDescriptor
+	fd_Auction_batch_key       protoreflect.FieldDescriptor
+	fd_Auction_quantity        protoreflect.FieldDescriptor
+	fd_Auction_auction_type    protoreflect.FieldDescriptor
+	fd_Auction_denom           protoreflect.FieldDescriptor
+	fd_Auction_start_price     protoreflect.FieldDescriptor
+	fd_Auction_reserve_price   protoreflect.FieldDescriptor
+	fd_Auction_start_time      protoreflect.FieldDescriptor
+	fd_Auction_end_time        protoreflect.FieldDescriptor
+	fd_Auction_price_decrement protoreflect.FieldDescriptor
+	fd_Auction_decay_interval  protoreflect.FieldDescriptor
+	fd_Auction_bidder          protoreflect.FieldDescriptor
+	fd_Auction_bid_price       protoreflect.FieldDescriptor
+	fd_Auction_market_id       protoreflect.FieldDescriptor
+	fd_Auction_buyer_fee       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Auction = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Auction")
+	fd_Auction_id = md_Auction.Fields().ByName("id")
+	fd_Auction_seller = md_Auction.Fields().ByName("seller")
+	fd_Auction_batch_key = md_Auction.Fields().ByName("batch_key")
+	fd_Auction_quantity = md_Auction.Fields().ByName("quantity")
+	fd_Auction_auction_type = md_Auction.Fields().ByName("auction_type")
+	fd_Auction_denom = md_Auction.Fields().ByName("denom")
+	fd_Auction_start_price = md_Auction.Fields().ByName("start_price")
+	fd_Auction_reserve_price = md_Auction.Fields().ByName("reserve_price")
+	fd_Auction_start_time = md_Auction.Fields().ByName("start_time")
+	fd_Auction_end_time = md_Auction.Fields().ByName("end_time")
+	fd_Auction_price_decrement = md_Auction.Fields().ByName("price_decrement")
+	fd_Auction_decay_interval = md_Auction.Fields().ByName("decay_interval")
+	fd_Auction_bidder = md_Auction.Fields().ByName("bidder")
+	fd_Auction_bid_price = md_Auction.Fields().ByName("bid_price")
+	fd_Auction_market_id = md_Auction.Fields().ByName("market_id")
+	fd_Auction_buyer_fee = md_Auction.Fields().ByName("buyer_fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_Auction)(nil)
+
+type fastReflection_Auction Auction
+
+func (x *Auction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Auction)(x)
+}
+
+func (x *Auction) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Auction_messageType fastReflection_Auction_messageType
+var _ protoreflect.MessageType = fastReflection_Auction_messageType{}
+
+type fastReflection_Auction_messageType struct{}
+
+func (x fastReflection_Auction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Auction)(nil)
+}
+func (x fastReflection_Auction_messageType) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+func (x fastReflection_Auction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Auction) Descriptor() protoreflect.MessageDescriptor {
+	return md_Auction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Auction) Type() protoreflect.MessageType {
+	return _fastReflection_Auction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Auction) New() protoreflect.Message {
+	return new(fastReflection_Auction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Auction) Interface() protoreflect.ProtoMessage {
+	return (*Auction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Auction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Auction_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Auction_seller, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_Auction_batch_key, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_Auction_quantity, value) {
+			return
+		}
+	}
+	if x.AuctionType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.AuctionType))
+		if !f(fd_Auction_auction_type, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Auction_denom, value) {
+			return
+		}
+	}
+	if x.StartPrice != "" {
+		value := protoreflect.ValueOfString(x.StartPrice)
+		if !f(fd_Auction_start_price, value) {
+			return
+		}
+	}
+	if x.ReservePrice != "" {
+		value := protoreflect.ValueOfString(x.ReservePrice)
+		if !f(fd_Auction_reserve_price, value) {
+			return
+		}
+	}
+	if x.StartTime != nil {
+		value := protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+		if !f(fd_Auction_start_time, value) {
+			return
+		}
+	}
+	if x.EndTime != nil {
+		value := protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+		if !f(fd_Auction_end_time, value) {
+			return
+		}
+	}
+	if x.PriceDecrement != "" {
+		value := protoreflect.ValueOfString(x.PriceDecrement)
+		if !f(fd_Auction_price_decrement, value) {
+			return
+		}
+	}
+	if x.DecayInterval != nil {
+		value := protoreflect.ValueOfMessage(x.DecayInterval.ProtoReflect())
+		if !f(fd_Auction_decay_interval, value) {
+			return
+		}
+	}
+	if len(x.Bidder) != 0 {
+		value := protoreflect.ValueOfBytes(x.Bidder)
+		if !f(fd_Auction_bidder, value) {
+			return
+		}
+	}
+	if x.BidPrice != "" {
+		value := protoreflect.ValueOfString(x.BidPrice)
+		if !f(fd_Auction_bid_price, value) {
+			return
+		}
+	}
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_Auction_market_id, value) {
+			return
+		}
+	}
+	if x.BuyerFee != "" {
+		value := protoreflect.ValueOfString(x.BuyerFee)
+		if !f(fd_Auction_buyer_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Auction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		return x.AuctionType != 0
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		return x.Denom != ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		return x.StartPrice != ""
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		return x.ReservePrice != ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		return x.StartTime != nil
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		return x.EndTime != nil
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		return x.PriceDecrement != ""
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		return x.DecayInterval != nil
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		return len(x.Bidder) != 0
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		return x.BidPrice != ""
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		return x.BuyerFee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		x.AuctionType = 0
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		x.Denom = ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		x.StartPrice = ""
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		x.ReservePrice = ""
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		x.StartTime = nil
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		x.EndTime = nil
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		x.PriceDecrement = ""
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		x.DecayInterval = nil
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		x.Bidder = nil
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		x.BidPrice = ""
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		x.BuyerFee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Auction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		value := x.AuctionType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		value := x.StartPrice
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		value := x.ReservePrice
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		value := x.StartTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		value := x.EndTime
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		value := x.PriceDecrement
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		value := x.DecayInterval
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		value := x.Bidder
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		value := x.BidPrice
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		value := x.BuyerFee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		x.AuctionType = (AuctionType)(value.Enum())
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		x.Denom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		x.StartPrice = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		x.ReservePrice = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		x.StartTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		x.EndTime = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		x.PriceDecrement = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		x.DecayInterval = value.Message().Interface().(*durationpb.Duration)
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		x.Bidder = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		x.BidPrice = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		x.BuyerFee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		if x.StartTime == nil {
+			x.StartTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.StartTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		if x.EndTime == nil {
+			x.EndTime = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.EndTime.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		if x.DecayInterval == nil {
+			x.DecayInterval = new(durationpb.Duration)
+		}
+		return protoreflect.ValueOfMessage(x.DecayInterval.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		panic(fmt.Errorf("field auction_type of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		panic(fmt.Errorf("field denom of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		panic(fmt.Errorf("field start_price of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		panic(fmt.Errorf("field reserve_price of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		panic(fmt.Errorf("field price_decrement of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		panic(fmt.Errorf("field bidder of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		panic(fmt.Errorf("field bid_price of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		panic(fmt.Errorf("field buyer_fee of message regen.ecocredit.marketplace.v1.Auction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Auction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Auction.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Auction.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.auction_type":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.marketplace.v1.Auction.denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.start_price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.reserve_price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.start_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.end_time":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.price_decrement":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.decay_interval":
+		m := new(durationpb.Duration)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.Auction.bidder":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Auction.bid_price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.Auction.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Auction.buyer_fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Auction"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Auction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Auction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Auction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Auction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Auction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Auction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Auction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AuctionType != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionType))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.StartPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReservePrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartTime != nil {
+			l = options.Size(x.StartTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.EndTime != nil {
+			l = options.Size(x.EndTime)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PriceDecrement)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DecayInterval != nil {
+			l = options.Size(x.DecayInterval)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Bidder)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BidPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		l = len(x.BuyerFee)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BuyerFee) > 0 {
+			i -= len(x.BuyerFee)
+			copy(dAtA[i:], x.BuyerFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BuyerFee)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.BidPrice) > 0 {
+			i -= len(x.BidPrice)
+			copy(dAtA[i:], x.BidPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BidPrice)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if len(x.Bidder) > 0 {
+			i -= len(x.Bidder)
+			copy(dAtA[i:], x.Bidder)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Bidder)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.DecayInterval != nil {
+			encoded, err := options.Marshal(x.DecayInterval)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x62
+		}
+		if len(x.PriceDecrement) > 0 {
+			i -= len(x.PriceDecrement)
+			copy(dAtA[i:], x.PriceDecrement)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PriceDecrement)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if x.EndTime != nil {
+			encoded, err := options.Marshal(x.EndTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.StartTime != nil {
+			encoded, err := options.Marshal(x.StartTime)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.ReservePrice) > 0 {
+			i -= len(x.ReservePrice)
+			copy(dAtA[i:], x.ReservePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReservePrice)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.StartPrice) > 0 {
+			i -= len(x.StartPrice)
+			copy(dAtA[i:], x.StartPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartPrice)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.AuctionType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionType))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Auction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+				}
+				x.AuctionType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionType |= AuctionType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReservePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.StartTime == nil {
+					x.StartTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.StartTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.EndTime == nil {
+					x.EndTime = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EndTime); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PriceDecrement", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PriceDecrement = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.DecayInterval == nil {
+					x.DecayInterval = &durationpb.Duration{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DecayInterval); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Bidder = append(x.Bidder[:0], dAtA[iNdEx:postIndex]...)
+				if x.Bidder == nil {
+					x.Bidder = []byte{}
+				}
+				iNdEx = postIndex
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BidPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BidPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BuyerFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_QuoteRequest                         protoreflect.MessageDescriptor
+	fd_QuoteRequest_id                      protoreflect.FieldDescriptor
+	fd_QuoteRequest_buyer                   protoreflect.FieldDescriptor
+	fd_QuoteRequest_selection               protoreflect.FieldDescriptor
+	fd_QuoteRequest_quantity                protoreflect.FieldDescriptor
+	fd_QuoteRequest_denom                   protoreflect.FieldDescriptor
+	fd_QuoteRequest_disable_auto_retire     protoreflect.FieldDescriptor
+	fd_QuoteRequest_retirement_jurisdiction protoreflect.FieldDescriptor
+	fd_QuoteRequest_expiration              protoreflect.FieldDescriptor
+	fd_QuoteRequest_retirement_beneficiary  protoreflect.FieldDescriptor
+	fd_QuoteRequest_retirement_reason       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_QuoteRequest = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("QuoteRequest")
+	fd_QuoteRequest_id = md_QuoteRequest.Fields().ByName("id")
+	fd_QuoteRequest_buyer = md_QuoteRequest.Fields().ByName("buyer")
+	fd_QuoteRequest_selection = md_QuoteRequest.Fields().ByName("selection")
+	fd_QuoteRequest_quantity = md_QuoteRequest.Fields().ByName("quantity")
+	fd_QuoteRequest_denom = md_QuoteRequest.Fields().ByName("denom")
+	fd_QuoteRequest_disable_auto_retire = md_QuoteRequest.Fields().ByName("disable_auto_retire")
+	fd_QuoteRequest_retirement_jurisdiction = md_QuoteRequest.Fields().ByName("retirement_jurisdiction")
+	fd_QuoteRequest_expiration = md_QuoteRequest.Fields().ByName("expiration")
+	fd_QuoteRequest_retirement_beneficiary = md_QuoteRequest.Fields().ByName("retirement_beneficiary")
+	fd_QuoteRequest_retirement_reason = md_QuoteRequest.Fields().ByName("retirement_reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_QuoteRequest)(nil)
+
+type fastReflection_QuoteRequest QuoteRequest
+
+func (x *QuoteRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_QuoteRequest)(x)
+}
+
+func (x *QuoteRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_QuoteRequest_messageType fastReflection_QuoteRequest_messageType
+var _ protoreflect.MessageType = fastReflection_QuoteRequest_messageType{}
+
+type fastReflection_QuoteRequest_messageType struct{}
+
+func (x fastReflection_QuoteRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_QuoteRequest)(nil)
+}
+func (x fastReflection_QuoteRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_QuoteRequest)
+}
+func (x fastReflection_QuoteRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteRequest
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_QuoteRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_QuoteRequest
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_QuoteRequest) Type() protoreflect.MessageType {
+	return _fastReflection_QuoteRequest_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_QuoteRequest) New() protoreflect.Message {
+	return new(fastReflection_QuoteRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_QuoteRequest) Interface() protoreflect.ProtoMessage {
+	return (*QuoteRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_QuoteRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_QuoteRequest_id, value) {
+			return
+		}
+	}
+	if len(x.Buyer) != 0 {
+		value := protoreflect.ValueOfBytes(x.Buyer)
+		if !f(fd_QuoteRequest_buyer, value) {
+			return
+		}
+	}
+	if x.Selection != nil {
+		value := protoreflect.ValueOfMessage(x.Selection.ProtoReflect())
+		if !f(fd_QuoteRequest_selection, value) {
+			return
+		}
+	}
+	if x.Quantity != "" {
+		value := protoreflect.ValueOfString(x.Quantity)
+		if !f(fd_QuoteRequest_quantity, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_QuoteRequest_denom, value) {
+			return
+		}
+	}
+	if x.DisableAutoRetire != false {
+		value := protoreflect.ValueOfBool(x.DisableAutoRetire)
+		if !f(fd_QuoteRequest_disable_auto_retire, value) {
+			return
+		}
+	}
+	if x.RetirementJurisdiction != "" {
+		value := protoreflect.ValueOfString(x.RetirementJurisdiction)
+		if !f(fd_QuoteRequest_retirement_jurisdiction, value) {
+			return
+		}
+	}
+	if x.Expiration != nil {
+		value := protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+		if !f(fd_QuoteRequest_expiration, value) {
+			return
+		}
+	}
+	if x.RetirementBeneficiary != "" {
+		value := protoreflect.ValueOfString(x.RetirementBeneficiary)
+		if !f(fd_QuoteRequest_retirement_beneficiary, value) {
+			return
+		}
+	}
+	if x.RetirementReason != "" {
+		value := protoreflect.ValueOfString(x.RetirementReason)
+		if !f(fd_QuoteRequest_retirement_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_QuoteRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		return len(x.Buyer) != 0
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		return x.Selection != nil
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		return x.Quantity != ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		return x.Denom != ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		return x.DisableAutoRetire != false
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		return x.RetirementJurisdiction != ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		return x.Expiration != nil
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		return x.RetirementBeneficiary != ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		return x.RetirementReason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		x.Buyer = nil
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		x.Selection = nil
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		x.Quantity = ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		x.Denom = ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		x.DisableAutoRetire = false
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		x.RetirementJurisdiction = ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		x.Expiration = nil
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		x.RetirementBeneficiary = ""
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		x.RetirementReason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_QuoteRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		value := x.Buyer
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		value := x.Selection
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		value := x.Quantity
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		value := x.DisableAutoRetire
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		value := x.RetirementJurisdiction
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		value := x.Expiration
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		value := x.RetirementBeneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		value := x.RetirementReason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		x.Buyer = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		x.Selection = value.Message().Interface().(*BuyOrder_Selection)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		x.Quantity = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		x.Denom = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		x.DisableAutoRetire = value.Bool()
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		x.RetirementJurisdiction = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		x.Expiration = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		x.RetirementBeneficiary = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		x.RetirementReason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		if x.Selection == nil {
+			x.Selection = new(BuyOrder_Selection)
+		}
+		return protoreflect.ValueOfMessage(x.Selection.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		if x.Expiration == nil {
+			x.Expiration = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Expiration.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		panic(fmt.Errorf("field buyer of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		panic(fmt.Errorf("field quantity of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		panic(fmt.Errorf("field denom of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		panic(fmt.Errorf("field disable_auto_retire of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		panic(fmt.Errorf("field retirement_jurisdiction of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		panic(fmt.Errorf("field retirement_beneficiary of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		panic(fmt.Errorf("field retirement_reason of message regen.ecocredit.marketplace.v1.QuoteRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_QuoteRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.buyer":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.selection":
+		m := new(BuyOrder_Selection)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.quantity":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.disable_auto_retire":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_jurisdiction":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.expiration":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.QuoteRequest.retirement_reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.QuoteRequest"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.QuoteRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_QuoteRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.QuoteRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_QuoteRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_QuoteRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_QuoteRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_QuoteRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*QuoteRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Buyer)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Selection != nil {
+			l = options.Size(x.Selection)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Quantity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DisableAutoRetire {
+			n += 2
+		}
+		l = len(x.RetirementJurisdiction)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Expiration != nil {
+			l = options.Size(x.Expiration)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementBeneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.RetirementReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*QuoteRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RetirementReason) > 0 {
+			i -= len(x.RetirementReason)
+			copy(dAtA[i:], x.RetirementReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementReason)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.RetirementBeneficiary) > 0 {
+			i -= len(x.RetirementBeneficiary)
+			copy(dAtA[i:], x.RetirementBeneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementBeneficiary)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if x.Expiration != nil {
+			encoded, err := options.Marshal(x.Expiration)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.RetirementJurisdiction) > 0 {
+			i -= len(x.RetirementJurisdiction)
+			copy(dAtA[i:], x.RetirementJurisdiction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RetirementJurisdiction)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DisableAutoRetire {
+			i--
+			if x.DisableAutoRetire {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Quantity) > 0 {
+			i -= len(x.Quantity)
+			copy(dAtA[i:], x.Quantity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Quantity)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Selection != nil {
+			encoded, err := options.Marshal(x.Selection)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Buyer) > 0 {
+			i -= len(x.Buyer)
+			copy(dAtA[i:], x.Buyer)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Buyer)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*QuoteRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: QuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Buyer = append(x.Buyer[:0], dAtA[iNdEx:postIndex]...)
+				if x.Buyer == nil {
+					x.Buyer = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Selection == nil {
+					x.Selection = &BuyOrder_Selection{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Selection); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Quantity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.DisableAutoRetire = bool(v != 0)
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Expiration == nil {
+					x.Expiration = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Expiration); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RetirementReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Quote                  protoreflect.MessageDescriptor
+	fd_Quote_id               protoreflect.FieldDescriptor
+	fd_Quote_quote_request_id protoreflect.FieldDescriptor
+	fd_Quote_seller           protoreflect.FieldDescriptor
+	fd_Quote_sell_order_id    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_Quote = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("Quote")
+	fd_Quote_id = md_Quote.Fields().ByName("id")
+	fd_Quote_quote_request_id = md_Quote.Fields().ByName("quote_request_id")
+	fd_Quote_seller = md_Quote.Fields().ByName("seller")
+	fd_Quote_sell_order_id = md_Quote.Fields().ByName("sell_order_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_Quote)(nil)
+
+type fastReflection_Quote Quote
+
+func (x *Quote) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Quote)(x)
+}
+
+func (x *Quote) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Quote_messageType fastReflection_Quote_messageType
+var _ protoreflect.MessageType = fastReflection_Quote_messageType{}
+
+type fastReflection_Quote_messageType struct{}
+
+func (x fastReflection_Quote_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Quote)(nil)
+}
+func (x fastReflection_Quote_messageType) New() protoreflect.Message {
+	return new(fastReflection_Quote)
+}
+func (x fastReflection_Quote_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Quote
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Quote) Descriptor() protoreflect.MessageDescriptor {
+	return md_Quote
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Quote) Type() protoreflect.MessageType {
+	return _fastReflection_Quote_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Quote) New() protoreflect.Message {
+	return new(fastReflection_Quote)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Quote) Interface() protoreflect.ProtoMessage {
+	return (*Quote)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Quote) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_Quote_id, value) {
+			return
+		}
+	}
+	if x.QuoteRequestId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QuoteRequestId)
+		if !f(fd_Quote_quote_request_id, value) {
+			return
+		}
+	}
+	if len(x.Seller) != 0 {
+		value := protoreflect.ValueOfBytes(x.Seller)
+		if !f(fd_Quote_seller, value) {
+			return
+		}
+	}
+	if x.SellOrderId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SellOrderId)
+		if !f(fd_Quote_sell_order_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Quote) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		return x.Id != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		return x.QuoteRequestId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		return len(x.Seller) != 0
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		return x.SellOrderId != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Quote) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		x.Id = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		x.QuoteRequestId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		x.Seller = nil
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		x.SellOrderId = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Quote) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		value := x.QuoteRequestId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		value := x.Seller
+		return protoreflect.ValueOfBytes(value)
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		value := x.SellOrderId
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Quote) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		x.Id = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		x.QuoteRequestId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		x.Seller = value.Bytes()
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		x.SellOrderId = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Quote) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		panic(fmt.Errorf("field id of message regen.ecocredit.marketplace.v1.Quote is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		panic(fmt.Errorf("field quote_request_id of message regen.ecocredit.marketplace.v1.Quote is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		panic(fmt.Errorf("field seller of message regen.ecocredit.marketplace.v1.Quote is not mutable"))
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		panic(fmt.Errorf("field sell_order_id of message regen.ecocredit.marketplace.v1.Quote is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Quote) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.Quote.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Quote.quote_request_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.Quote.seller":
+		return protoreflect.ValueOfBytes(nil)
+	case "regen.ecocredit.marketplace.v1.Quote.sell_order_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.Quote"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.Quote does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Quote) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.Quote", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Quote) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Quote) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Quote) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Quote) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Quote)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		if x.QuoteRequestId != 0 {
+			n += 1 + runtime.Sov(uint64(x.QuoteRequestId))
+		}
+		l = len(x.Seller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SellOrderId != 0 {
+			n += 1 + runtime.Sov(uint64(x.SellOrderId))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Quote)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.SellOrderId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SellOrderId))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Seller) > 0 {
+			i -= len(x.Seller)
+			copy(dAtA[i:], x.Seller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Seller)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.QuoteRequestId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QuoteRequestId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Quote)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Quote: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Quote: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuoteRequestId", wireType)
+				}
+				x.QuoteRequestId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QuoteRequestId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Seller = append(x.Seller[:0], dAtA[iNdEx:postIndex]...)
+				if x.Seller == nil {
+					x.Seller = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+				}
+				x.SellOrderId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SellOrderId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PriceObservation                   protoreflect.MessageDescriptor
+	fd_PriceObservation_market_id         protoreflect.FieldDescriptor
+	fd_PriceObservation_batch_key         protoreflect.FieldDescriptor
+	fd_PriceObservation_timestamp         protoreflect.FieldDescriptor
+	fd_PriceObservation_cumulative_price  protoreflect.FieldDescriptor
+	fd_PriceObservation_open              protoreflect.FieldDescriptor
+	fd_PriceObservation_high              protoreflect.FieldDescriptor
+	fd_PriceObservation_low               protoreflect.FieldDescriptor
+	fd_PriceObservation_close             protoreflect.FieldDescriptor
+	fd_PriceObservation_volume            protoreflect.FieldDescriptor
+	fd_PriceObservation_value             protoreflect.FieldDescriptor
+	fd_PriceObservation_cumulative_volume protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_marketplace_v1_state_proto_init()
+	md_PriceObservation = File_regen_ecocredit_marketplace_v1_state_proto.Messages().ByName("PriceObservation")
+	fd_PriceObservation_market_id = md_PriceObservation.Fields().ByName("market_id")
+	fd_PriceObservation_batch_key = md_PriceObservation.Fields().ByName("batch_key")
+	fd_PriceObservation_timestamp = md_PriceObservation.Fields().ByName("timestamp")
+	fd_PriceObservation_cumulative_price = md_PriceObservation.Fields().ByName("cumulative_price")
+	fd_PriceObservation_open = md_PriceObservation.Fields().ByName("open")
+	fd_PriceObservation_high = md_PriceObservation.Fields().ByName("high")
+	fd_PriceObservation_low = md_PriceObservation.Fields().ByName("low")
+	fd_PriceObservation_close = md_PriceObservation.Fields().ByName("close")
+	fd_PriceObservation_volume = md_PriceObservation.Fields().ByName("volume")
+	fd_PriceObservation_value = md_PriceObservation.Fields().ByName("value")
+	fd_PriceObservation_cumulative_volume = md_PriceObservation.Fields().ByName("cumulative_volume")
+}
+
+var _ protoreflect.Message = (*fastReflection_PriceObservation)(nil)
+
+type fastReflection_PriceObservation PriceObservation
+
+func (x *PriceObservation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PriceObservation)(x)
+}
+
+func (x *PriceObservation) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PriceObservation_messageType fastReflection_PriceObservation_messageType
+var _ protoreflect.MessageType = fastReflection_PriceObservation_messageType{}
+
+type fastReflection_PriceObservation_messageType struct{}
+
+func (x fastReflection_PriceObservation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PriceObservation)(nil)
+}
+func (x fastReflection_PriceObservation_messageType) New() protoreflect.Message {
+	return new(fastReflection_PriceObservation)
+}
+func (x fastReflection_PriceObservation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceObservation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PriceObservation) Descriptor() protoreflect.MessageDescriptor {
+	return md_PriceObservation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PriceObservation) Type() protoreflect.MessageType {
+	return _fastReflection_PriceObservation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PriceObservation) New() protoreflect.Message {
+	return new(fastReflection_PriceObservation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PriceObservation) Interface() protoreflect.ProtoMessage {
+	return (*PriceObservation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PriceObservation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MarketId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.MarketId)
+		if !f(fd_PriceObservation_market_id, value) {
+			return
+		}
+	}
+	if x.BatchKey != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BatchKey)
+		if !f(fd_PriceObservation_batch_key, value) {
+			return
+		}
+	}
+	if x.Timestamp != nil {
+		value := protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+		if !f(fd_PriceObservation_timestamp, value) {
+			return
+		}
+	}
+	if x.CumulativePrice != "" {
+		value := protoreflect.ValueOfString(x.CumulativePrice)
+		if !f(fd_PriceObservation_cumulative_price, value) {
+			return
+		}
+	}
+	if x.Open != "" {
+		value := protoreflect.ValueOfString(x.Open)
+		if !f(fd_PriceObservation_open, value) {
+			return
+		}
+	}
+	if x.High != "" {
+		value := protoreflect.ValueOfString(x.High)
+		if !f(fd_PriceObservation_high, value) {
+			return
+		}
+	}
+	if x.Low != "" {
+		value := protoreflect.ValueOfString(x.Low)
+		if !f(fd_PriceObservation_low, value) {
+			return
+		}
+	}
+	if x.Close != "" {
+		value := protoreflect.ValueOfString(x.Close)
+		if !f(fd_PriceObservation_close, value) {
+			return
+		}
+	}
+	if x.Volume != "" {
+		value := protoreflect.ValueOfString(x.Volume)
+		if !f(fd_PriceObservation_volume, value) {
+			return
+		}
+	}
+	if x.Value != "" {
+		value := protoreflect.ValueOfString(x.Value)
+		if !f(fd_PriceObservation_value, value) {
+			return
+		}
+	}
+	if x.CumulativeVolume != "" {
+		value := protoreflect.ValueOfString(x.CumulativeVolume)
+		if !f(fd_PriceObservation_cumulative_volume, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PriceObservation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		return x.MarketId != uint64(0)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		return x.BatchKey != uint64(0)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		return x.Timestamp != nil
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		return x.CumulativePrice != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		return x.Open != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		return x.High != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		return x.Low != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		return x.Close != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		return x.Volume != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		return x.Value != ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		return x.CumulativeVolume != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceObservation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		x.MarketId = uint64(0)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		x.BatchKey = uint64(0)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		x.Timestamp = nil
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		x.CumulativePrice = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		x.Open = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		x.High = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		x.Low = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		x.Close = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		x.Volume = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		x.Value = ""
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		x.CumulativeVolume = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PriceObservation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		value := x.MarketId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		value := x.BatchKey
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		value := x.Timestamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		value := x.CumulativePrice
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		value := x.Open
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		value := x.High
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		value := x.Low
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		value := x.Close
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		value := x.Volume
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		value := x.Value
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		value := x.CumulativeVolume
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceObservation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		x.MarketId = value.Uint()
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		x.BatchKey = value.Uint()
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		x.Timestamp = value.Message().Interface().(*timestamppb.Timestamp)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		x.CumulativePrice = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		x.Open = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		x.High = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		x.Low = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		x.Close = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		x.Volume = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		x.Value = value.Interface().(string)
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		x.CumulativeVolume = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceObservation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		if x.Timestamp == nil {
+			x.Timestamp = new(timestamppb.Timestamp)
+		}
+		return protoreflect.ValueOfMessage(x.Timestamp.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		panic(fmt.Errorf("field market_id of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		panic(fmt.Errorf("field batch_key of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		panic(fmt.Errorf("field cumulative_price of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		panic(fmt.Errorf("field open of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		panic(fmt.Errorf("field high of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		panic(fmt.Errorf("field low of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		panic(fmt.Errorf("field close of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		panic(fmt.Errorf("field volume of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		panic(fmt.Errorf("field value of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		panic(fmt.Errorf("field cumulative_volume of message regen.ecocredit.marketplace.v1.PriceObservation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PriceObservation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.marketplace.v1.PriceObservation.market_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.batch_key":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.marketplace.v1.PriceObservation.timestamp":
+		m := new(timestamppb.Timestamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_price":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.open":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.high":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.low":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.close":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.volume":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.value":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.marketplace.v1.PriceObservation.cumulative_volume":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.marketplace.v1.PriceObservation"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.marketplace.v1.PriceObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PriceObservation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.marketplace.v1.PriceObservation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PriceObservation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PriceObservation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PriceObservation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PriceObservation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PriceObservation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.MarketId != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarketId))
+		}
+		if x.BatchKey != 0 {
+			n += 1 + runtime.Sov(uint64(x.BatchKey))
+		}
+		if x.Timestamp != nil {
+			l = options.Size(x.Timestamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativePrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Open)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.High)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Low)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Close)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Volume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Value)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CumulativeVolume)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PriceObservation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CumulativeVolume) > 0 {
+			i -= len(x.CumulativeVolume)
+			copy(dAtA[i:], x.CumulativeVolume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativeVolume)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.Value) > 0 {
+			i -= len(x.Value)
+			copy(dAtA[i:], x.Value)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Value)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if len(x.Volume) > 0 {
+			i -= len(x.Volume)
+			copy(dAtA[i:], x.Volume)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Volume)))
+			i--
+			dAtA[i] = 0x4a
+		}
+		if len(x.Close) > 0 {
+			i -= len(x.Close)
+			copy(dAtA[i:], x.Close)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Close)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Low) > 0 {
+			i -= len(x.Low)
+			copy(dAtA[i:], x.Low)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Low)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.High) > 0 {
+			i -= len(x.High)
+			copy(dAtA[i:], x.High)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.High)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Open) > 0 {
+			i -= len(x.Open)
+			copy(dAtA[i:], x.Open)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Open)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.CumulativePrice) > 0 {
+			i -= len(x.CumulativePrice)
+			copy(dAtA[i:], x.CumulativePrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CumulativePrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.Timestamp != nil {
+			encoded, err := options.Marshal(x.Timestamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.BatchKey != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BatchKey))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.MarketId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarketId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PriceObservation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+				}
+				x.MarketId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarketId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				}
+				x.BatchKey = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BatchKey |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Timestamp == nil {
+					x.Timestamp = &timestamppb.Timestamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Timestamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativePrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Open = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.High = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Low = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Close = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Volume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Value = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CumulativeVolume", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CumulativeVolume = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/marketplace/v1/state.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// AuctionType defines how the price of an auction is discovered.
+type AuctionType int32
+
+const (
+	// AUCTION_TYPE_UNSPECIFIED is an invalid auction type.
+	AuctionType_AUCTION_TYPE_UNSPECIFIED AuctionType = 0
+	// AUCTION_TYPE_DUTCH is a descending price auction. The price decays from
+	// the start price by the price decrement at the end of each decay interval
+	// until it reaches the reserve price and the first bid greater than or equal
+	// to the current price wins the auction at the current price.
+	AuctionType_AUCTION_TYPE_DUTCH AuctionType = 1
+	// AUCTION_TYPE_ENGLISH is an ascending price auction. Bids are accepted
+	// within the bidding window of the auction and the highest bid wins the
+	// auction at the price of the bid if it is greater than or equal to the
+	// reserve price.
+	AuctionType_AUCTION_TYPE_ENGLISH AuctionType = 2
+)
+
+// Enum value maps for AuctionType.
+var (
+	AuctionType_name = map[int32]string{
+		0: "AUCTION_TYPE_UNSPECIFIED",
+		1: "AUCTION_TYPE_DUTCH",
+		2: "AUCTION_TYPE_ENGLISH",
+	}
+	AuctionType_value = map[string]int32{
+		"AUCTION_TYPE_UNSPECIFIED": 0,
+		"AUCTION_TYPE_DUTCH":       1,
+		"AUCTION_TYPE_ENGLISH":     2,
+	}
+)
+
+func (x AuctionType) Enum() *AuctionType {
+	p := new(AuctionType)
+	*p = x
+	return p
+}
+
+func (x AuctionType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AuctionType) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0].Descriptor()
+}
+
+func (AuctionType) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[0]
+}
+
+func (x AuctionType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AuctionType.Descriptor instead.
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+// TimeInForce defines how long a sell order remains open.
+type TimeInForce int32
+
+const (
+	// TIME_IN_FORCE_UNSPECIFIED keeps the sell order open until it is filled,
+	// cancelled or until the optional expiration is reached.
+	TimeInForce_TIME_IN_FORCE_UNSPECIFIED TimeInForce = 0
+	// TIME_IN_FORCE_GOOD_TIL_CANCELLED keeps the sell order open until it is
+	// filled or cancelled. A good-til-cancelled sell order has no expiration.
+	TimeInForce_TIME_IN_FORCE_GOOD_TIL_CANCELLED TimeInForce = 1
+	// TIME_IN_FORCE_GOOD_TIL_DATE keeps the sell order open until it is filled,
+	// cancelled or until the expiration is reached. A good-til-date sell order
+	// requires an expiration.
+	TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE TimeInForce = 2
+	// TIME_IN_FORCE_IMMEDIATE_OR_CANCEL fills the sell order against the
+	// matching buy orders in the order book when the sell order is created and
+	// cancels any quantity that is not filled. Immediate-or-cancel sell orders
+	// are only supported in markets with continuous clearing.
+	TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL TimeInForce = 3
+)
+
+// Enum value maps for TimeInForce.
+var (
+	TimeInForce_name = map[int32]string{
+		0: "TIME_IN_FORCE_UNSPECIFIED",
+		1: "TIME_IN_FORCE_GOOD_TIL_CANCELLED",
+		2: "TIME_IN_FORCE_GOOD_TIL_DATE",
+		3: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+	}
+	TimeInForce_value = map[string]int32{
+		"TIME_IN_FORCE_UNSPECIFIED":         0,
+		"TIME_IN_FORCE_GOOD_TIL_CANCELLED":  1,
+		"TIME_IN_FORCE_GOOD_TIL_DATE":       2,
+		"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 3,
+	}
+)
+
+func (x TimeInForce) Enum() *TimeInForce {
+	p := new(TimeInForce)
+	*p = x
+	return p
+}
+
+func (x TimeInForce) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TimeInForce) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[1].Descriptor()
+}
+
+func (TimeInForce) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[1]
+}
+
+func (x TimeInForce) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TimeInForce.Descriptor instead.
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+// FeeDestination defines where the fees collected in a market are sent.
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_BURN burns the fees.
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 0
+	// FEE_DESTINATION_COMMUNITY_POOL sends the fees to the community pool.
+	FeeDestination_FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 1
+	// FEE_DESTINATION_CLASS_ADMIN sends the fees to the admin of the credit
+	// class of the credits purchased.
+	FeeDestination_FEE_DESTINATION_CLASS_ADMIN FeeDestination = 2
+)
+
+// Enum value maps for FeeDestination.
+var (
+	FeeDestination_name = map[int32]string{
+		0: "FEE_DESTINATION_BURN",
+		1: "FEE_DESTINATION_COMMUNITY_POOL",
+		2: "FEE_DESTINATION_CLASS_ADMIN",
+	}
+	FeeDestination_value = map[string]int32{
+		"FEE_DESTINATION_BURN":           0,
+		"FEE_DESTINATION_COMMUNITY_POOL": 1,
+		"FEE_DESTINATION_CLASS_ADMIN":    2,
+	}
+)
+
+func (x FeeDestination) Enum() *FeeDestination {
+	p := new(FeeDestination)
+	*p = x
+	return p
+}
+
+func (x FeeDestination) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FeeDestination) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[2].Descriptor()
+}
+
+func (FeeDestination) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[2]
+}
+
+func (x FeeDestination) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FeeDestination.Descriptor instead.
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+// ClearingMode defines how crossing buy orders and sell orders in the order
+// book of a market are cleared.
+type ClearingMode int32
+
+const (
+	// CLEARING_MODE_CONTINUOUS clears crossing orders every block at the ask
+	// price of each sell order in price-time priority.
+	ClearingMode_CLEARING_MODE_CONTINUOUS ClearingMode = 0
+	// CLEARING_MODE_BATCH_AUCTION clears crossing orders once every epoch in a
+	// batch auction at a single uniform price for all orders in the market.
+	ClearingMode_CLEARING_MODE_BATCH_AUCTION ClearingMode = 1
+)
+
+// Enum value maps for ClearingMode.
+var (
+	ClearingMode_name = map[int32]string{
+		0: "CLEARING_MODE_CONTINUOUS",
+		1: "CLEARING_MODE_BATCH_AUCTION",
+	}
+	ClearingMode_value = map[string]int32{
+		"CLEARING_MODE_CONTINUOUS":    0,
+		"CLEARING_MODE_BATCH_AUCTION": 1,
+	}
+)
+
+func (x ClearingMode) Enum() *ClearingMode {
+	p := new(ClearingMode)
+	*p = x
+	return p
+}
+
+func (x ClearingMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ClearingMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[3].Descriptor()
+}
+
+func (ClearingMode) Type() protoreflect.EnumType {
+	return &file_regen_ecocredit_marketplace_v1_state_proto_enumTypes[3]
+}
+
+func (x ClearingMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ClearingMode.Descriptor instead.
+func (ClearingMode) EnumDescriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+// SellOrder represents the information for a sell order.
+type SellOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of sell order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is selling credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch used internally
+	// for efficient lookups. This links a sell order to a credit batch.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being sold.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this sell order exists and specifies
+	// the bank_denom that ask_amount corresponds to forming the ask_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ask_amount is the integer amount (encoded as a string) that the seller is
+	// asking for each credit unit of the batch. Each credit unit of the batch
+	// will be sold for at least the ask_amount. The ask_amount corresponds to the
+	// Market.denom to form the ask price.
+	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows a
+	// buyer to disable auto-retirement in their buy order enabling them to
+	// resell the credits to another buyer.
+	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// expiration is an optional timestamp when the sell order expires. When the
+	// expiration time is reached, the sell order is removed from state.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching buy orders.
+	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// min_fill_quantity is an optional minimum decimal quantity of credits that
+	// can be bought in a single fill of the sell order. The remaining quantity
+	// of the sell order can always be bought in full even if it is less than
+	// the min_fill_quantity. Buy orders are only matched with the sell order in
+	// the order book if their quantity, rounded down to the lot size, is greater
+	// than or equal to the min_fill_quantity.
+	MinFillQuantity string `protobuf:"bytes,11,opt,name=min_fill_quantity,json=minFillQuantity,proto3" json:"min_fill_quantity,omitempty"`
+	// lot_size is an optional decimal quantity of credits that the quantity of
+	// each fill of the sell order must be a multiple of. The quantity of the
+	// sell order is always a multiple of the lot_size.
+	LotSize string `protobuf:"bytes,12,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	// allowed_buyers is an optional list of the addresses of the buyers that are
+	// allowed to buy credits from the sell order. If allowed_buyers or
+	// allowed_buyer_group_policy is set, the sell order is private and it can
+	// only be filled by the allowed buyers with MsgBuyDirect.
+	AllowedBuyers [][]byte `protobuf:"bytes,13,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// allowed_buyer_group_policy is an optional address of an x/group group
+	// policy. The members of the group of the group policy are allowed to buy
+	// credits from the sell order in addition to the allowed_buyers.
+	AllowedBuyerGroupPolicy []byte `protobuf:"bytes,14,opt,name=allowed_buyer_group_policy,json=allowedBuyerGroupPolicy,proto3" json:"allowed_buyer_group_policy,omitempty"`
+	// time_in_force is the time in force of the sell order. Immediate-or-cancel
+	// sell orders never rest in state so the time in force of a sell order in
+	// state is either unspecified, good-til-cancelled or good-til-date.
+	TimeInForce TimeInForce `protobuf:"varint,15,opt,name=time_in_force,json=timeInForce,proto3,enum=regen.ecocredit.marketplace.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// auto_renew is the optional auto-renewal of the sell order. If set, the
+	// sell order is re-listed at a decayed ask price when the expiration is
+	// reached rather than being removed from state.
+	AutoRenew *SellOrder_AutoRenew `protobuf:"bytes,16,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// renewals is the number of times the sell order has been re-listed.
+	Renewals uint32 `protobuf:"varint,17,opt,name=renewals,proto3" json:"renewals,omitempty"`
+}
+
+func (x *SellOrder) Reset() {
+	*x = SellOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellOrder) ProtoMessage() {}
+
+// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
+func (*SellOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SellOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *SellOrder) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *SellOrder) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *SellOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *SellOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *SellOrder) GetAskAmount() string {
+	if x != nil {
+		return x.AskAmount
+	}
+	return ""
+}
+
+func (x *SellOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *SellOrder) GetMaker() bool {
+	if x != nil {
+		return x.Maker
+	}
+	return false
+}
+
+func (x *SellOrder) GetMinFillQuantity() string {
+	if x != nil {
+		return x.MinFillQuantity
+	}
+	return ""
+}
+
+func (x *SellOrder) GetLotSize() string {
+	if x != nil {
+		return x.LotSize
+	}
+	return ""
+}
+
+func (x *SellOrder) GetAllowedBuyers() [][]byte {
+	if x != nil {
+		return x.AllowedBuyers
+	}
+	return nil
+}
+
+func (x *SellOrder) GetAllowedBuyerGroupPolicy() []byte {
+	if x != nil {
+		return x.AllowedBuyerGroupPolicy
+	}
+	return nil
+}
+
+func (x *SellOrder) GetTimeInForce() TimeInForce {
+	if x != nil {
+		return x.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (x *SellOrder) GetAutoRenew() *SellOrder_AutoRenew {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return nil
+}
+
+func (x *SellOrder) GetRenewals() uint32 {
+	if x != nil {
+		return x.Renewals
+	}
+	return 0
+}
+
+// BuyOrder represents the information for a buy order. Buy orders rest in the
+// order book until they are filled by one or more matching sell orders.
+type BuyOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of buy order.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// selection is the selection of credits the buy order is bidding for.
+	Selection *BuyOrder_Selection `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"`
+	// quantity is the decimal quantity of credits being bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. The bid_amount corresponds to the
+	// Market.denom to form the bid price.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching sell orders.
+	Maker bool `protobuf:"varint,7,opt,name=maker,proto3" json:"maker,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive the credits in a tradable state. A buy order with
+	// auto-retirement disabled can only be filled by sell orders that also have
+	// auto-retirement disabled.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// buyer_fee is the buyer fee of the market at the time the buy order was
+	// created. The fee for the quantity of the buy order at the bid price is
+	// held in escrow along with the bid and it is charged when the buy order
+	// is filled.
+	BuyerFee string `protobuf:"bytes,11,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// retirement_beneficiary is the optional name or address of the person or
+	// organization on whose behalf the credits will be retired, which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,12,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits, which
+	// will be used only if disable_auto_retire is false.
+	RetirementReason string `protobuf:"bytes,13,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// failed_sell_order_ids are the IDs of the sell orders from which the buy
+	// order failed to be filled by the order book. The buy order is no longer
+	// matched with these sell orders so that a fill that fails is not retried
+	// in every block.
+	FailedSellOrderIds []uint64 `protobuf:"varint,14,rep,packed,name=failed_sell_order_ids,json=failedSellOrderIds,proto3" json:"failed_sell_order_ids,omitempty"`
+}
+
+func (x *BuyOrder) Reset() {
+	*x = BuyOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyOrder) ProtoMessage() {}
+
+// Deprecated: Use BuyOrder.ProtoReflect.Descriptor instead.
+func (*BuyOrder) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuyOrder) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetSelection() *BuyOrder_Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *BuyOrder) GetBidAmount() string {
+	if x != nil {
+		return x.BidAmount
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetMaker() bool {
+	if x != nil {
+		return x.Maker
+	}
+	return false
+}
+
+func (x *BuyOrder) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *BuyOrder) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *BuyOrder) GetBuyerFee() string {
+	if x != nil {
+		return x.BuyerFee
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetRetirementBeneficiary() string {
+	if x != nil {
+		return x.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetRetirementReason() string {
+	if x != nil {
+		return x.RetirementReason
+	}
+	return ""
+}
+
+func (x *BuyOrder) GetFailedSellOrderIds() []uint64 {
+	if x != nil {
+		return x.FailedSellOrderIds
+	}
+	return nil
+}
+
+// AllowedDenom represents the information for an allowed ask/bid denom.
+type AllowedDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the denom to display to the user and is informational.
+	// Because the denom is likely an IBC denom, this should be chosen by
+	// governance to represent the consensus trusted name of the denom.
+	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the denom to the display_denom and is
+	// informational
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (x *AllowedDenom) Reset() {
+	*x = AllowedDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedDenom) ProtoMessage() {}
+
+// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
+func (*AllowedDenom) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AllowedDenom) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetDisplayDenom() string {
+	if x != nil {
+		return x.DisplayDenom
+	}
+	return ""
+}
+
+func (x *AllowedDenom) GetExponent() uint32 {
+	if x != nil {
+		return x.Exponent
+	}
+	return 0
+}
+
+// Market describes a distinctly processed market between a credit type and
+// allowed bank denom. Each market has its own precision in the order book
+// and is processed independently of other markets. Governance must enable
+// markets one by one. Every additional enabled market potentially adds more
+// processing overhead to the blockchain and potentially weakens liquidity in
+// competing markets. For instance, enabling side by side USD/Carbon and
+// EUR/Carbon markets may have the end result that each market individually has
+// less liquidity and longer settlement times. Such decisions should be taken
+// with care.
+type Market struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is an allowed bank denom, the denom of a basket token or the
+	// denom of a credit batch. Markets with the denom of a basket token or of a
+	// credit batch are only used for sell orders that ask for basket tokens or
+	// for credits of another credit batch. Amounts in a market with the denom
+	// of a credit batch are integer amounts of the smallest unit of the credit
+	// type precision of that batch.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// precision_modifier is an optional modifier used to convert arbitrary
+	// precision integer bank amounts to uint32 values used for sorting in the
+	// order book. Given an arbitrary precision integer x, its uint32 conversion
+	// will be x / 10^precision_modifier using round half away from zero
+	// rounding.
+	//
+	// uint32 values range from 0 to 4,294,967,295.
+	// This allows for a full 9 digits of precision. In most real world markets
+	// this amount of precision is sufficient and most common downside -
+	// that some orders with very miniscule price differences may be ordered
+	// equivalently (because of rounding) - is acceptable.
+	// Note that this rounding will not affect settlement price which will
+	// always be done exactly.
+	//
+	// Given a USD stable coin with 6 decimal digits, a precision_modifier
+	// of 0 is probably acceptable as long as credits are always less than
+	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
+	// in this case), prices can rise up to $4,294,000/unit. Either scenario
+	// is probably quite acceptable given that carbon prices are unlikely to
+	// rise above $1000/ton any time in the near future.
+	//
+	// If credit prices, exceed the maximum range of uint32 with this
+	// precision_modifier, orders with high prices will fail and governance
+	// will need to adjust precision_modifier to allow for higher prices in
+	// exchange for less precision at the lower end.
+	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// clearing_mode is the mode in which crossing buy orders and sell orders in
+	// the order book of the market are cleared.
+	ClearingMode ClearingMode `protobuf:"varint,5,opt,name=clearing_mode,json=clearingMode,proto3,enum=regen.ecocredit.marketplace.v1.ClearingMode" json:"clearing_mode,omitempty"`
+	// epoch_length is the number of blocks between the batch auctions of the
+	// market. It is only set when clearing_mode is
+	// CLEARING_MODE_BATCH_AUCTION.
+	EpochLength uint64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// buyer_fee is the fee paid by the buyer in addition to the total cost of
+	// the credits purchased, expressed as a decimal fraction of the total cost
+	// (e.g. "0.01" for a fee of 1%). No fee is charged if buyer_fee is empty.
+	BuyerFee string `protobuf:"bytes,7,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the fee deducted from the total cost of the credits sold
+	// before it is paid to the seller, expressed as a decimal fraction of the
+	// total cost. No fee is charged if seller_fee is empty.
+	//
+	// The buyer fee and the seller fee are not charged in a market of the
+	// credits of another credit batch (i.e. swaps of credits for credits)
+	// because the fee destinations only accept bank coins.
+	SellerFee string `protobuf:"bytes,8,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
+	// fee_destination is the destination of the fees collected in the market.
+	FeeDestination FeeDestination `protobuf:"varint,9,opt,name=fee_destination,json=feeDestination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestination" json:"fee_destination,omitempty"`
+}
+
+func (x *Market) Reset() {
+	*x = Market{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Market) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Market) ProtoMessage() {}
+
+// Deprecated: Use Market.ProtoReflect.Descriptor instead.
+func (*Market) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Market) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Market) GetCreditTypeAbbrev() string {
+	if x != nil {
+		return x.CreditTypeAbbrev
+	}
+	return ""
+}
+
+func (x *Market) GetBankDenom() string {
+	if x != nil {
+		return x.BankDenom
+	}
+	return ""
+}
+
+func (x *Market) GetPrecisionModifier() uint32 {
+	if x != nil {
+		return x.PrecisionModifier
+	}
+	return 0
+}
+
+func (x *Market) GetClearingMode() ClearingMode {
+	if x != nil {
+		return x.ClearingMode
+	}
+	return ClearingMode_CLEARING_MODE_CONTINUOUS
+}
+
+func (x *Market) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *Market) GetBuyerFee() string {
+	if x != nil {
+		return x.BuyerFee
+	}
+	return ""
+}
+
+func (x *Market) GetSellerFee() string {
+	if x != nil {
+		return x.SellerFee
+	}
+	return ""
+}
+
+func (x *Market) GetFeeDestination() FeeDestination {
+	if x != nil {
+		return x.FeeDestination
+	}
+	return FeeDestination_FEE_DESTINATION_BURN
+}
+
+// Trade represents the information for a completed fill of a sell order. A
+// trade is recorded each time credits of a sell order are bought, either
+// directly or by a buy order in the order book.
+type Trade struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sell_order_id is the ID of the sell order that was filled.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buyer is the address of the account that bought the credits.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the credits
+	// that were traded.
+	BatchKey uint64 `protobuf:"varint,5,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits that were traded.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price is the integer amount (encoded as a string) paid for each credit
+	// unit. The price is the ask amount of the sell order for direct purchases
+	// and the settlement price of the order book for buy orders.
+	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// denom is the denom of the price, i.e. the bank denom of the market.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
+	// retired indicates whether the credits were retired upon purchase.
+	Retired bool `protobuf:"varint,9,opt,name=retired,proto3" json:"retired,omitempty"`
+	// timestamp is the block time at which the trade was completed.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// auction_id is the ID of the auction that was settled if the credits were
+	// bought in an auction, in which case sell_order_id is zero.
+	AuctionId uint64 `protobuf:"varint,11,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (x *Trade) Reset() {
+	*x = Trade{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trade) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trade) ProtoMessage() {}
+
+// Deprecated: Use Trade.ProtoReflect.Descriptor instead.
+func (*Trade) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Trade) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Trade) GetSellOrderId() uint64 {
+	if x != nil {
+		return x.SellOrderId
+	}
+	return 0
+}
+
+func (x *Trade) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *Trade) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Trade) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Trade) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Trade) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *Trade) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Trade) GetRetired() bool {
+	if x != nil {
+		return x.Retired
 	}
+	return false
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/marketplace/v1/state.proto
+func (x *Trade) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+func (x *Trade) GetAuctionId() uint64 {
+	if x != nil {
+		return x.AuctionId
+	}
+	return 0
+}
 
-// SellOrder represents the information for a sell order.
-type SellOrder struct {
+// Auction represents the information for an auction of credits from a credit
+// batch. The credits are held in escrow until the auction is settled or
+// cancelled.
+type Auction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique ID of sell order.
+	// id is the unique ID of the auction.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// seller is the address of the account that is selling credits.
+	// seller is the address of the account that is auctioning credits.
 	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
-	// batch_key is the table row identifier of the credit batch used internally
-	// for efficient lookups. This links a sell order to a credit batch.
+	// batch_key is the table row identifier of the credit batch of the credits
+	// being auctioned.
 	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
-	// quantity is the decimal quantity of credits being sold.
+	// quantity is the decimal quantity of credits being auctioned.
 	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	// market_id is the market in which this sell order exists and specifies
-	// the bank_denom that ask_amount corresponds to forming the ask_price.
-	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
-	// ask_amount is the integer amount (encoded as a string) that the seller is
-	// asking for each credit unit of the batch. Each credit unit of the batch
-	// will be sold for at least the ask_amount. The ask_amount corresponds to the
-	// Market.denom to form the ask price.
-	AskAmount string `protobuf:"bytes,6,opt,name=ask_amount,json=askAmount,proto3" json:"ask_amount,omitempty"`
-	// disable_auto_retire disables auto-retirement of credits which allows a
-	// buyer to disable auto-retirement in their buy order enabling them to
-	// resell the credits to another buyer.
-	DisableAutoRetire bool `protobuf:"varint,7,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
-	// expiration is an optional timestamp when the sell order expires. When the
-	// expiration time is reached, the sell order is removed from state.
-	Expiration *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=expiration,proto3" json:"expiration,omitempty"`
-	// maker indicates that this is a maker order, meaning that when it hit
-	// the order book, there were no matching buy orders.
-	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// auction_type is the type of the auction.
+	AuctionType AuctionType `protobuf:"varint,5,opt,name=auction_type,json=auctionType,proto3,enum=regen.ecocredit.marketplace.v1.AuctionType" json:"auction_type,omitempty"`
+	// denom is the bank denom of the prices of the auction.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_price is the integer amount (encoded as a string) of the price for
+	// each credit unit at the start of the auction. The price of a dutch auction
+	// decays from the start price and the first bid of an english auction must
+	// be greater than or equal to the start price.
+	StartPrice string `protobuf:"bytes,7,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	// reserve_price is the integer amount (encoded as a string) of the lowest
+	// price for each credit unit at which the credits are sold.
+	ReservePrice string `protobuf:"bytes,8,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	// start_time is the time at which the auction starts accepting bids.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time at which the auction stops accepting bids and is
+	// settled. The end time of a dutch auction is set to the time of the first
+	// accepted bid.
+	EndTime *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// price_decrement is the integer amount (encoded as a string) that the price
+	// of a dutch auction decays by at the end of each decay interval.
+	PriceDecrement string `protobuf:"bytes,11,opt,name=price_decrement,json=priceDecrement,proto3" json:"price_decrement,omitempty"`
+	// decay_interval is the interval at which the price of a dutch auction
+	// decays.
+	DecayInterval *durationpb.Duration `protobuf:"bytes,12,opt,name=decay_interval,json=decayInterval,proto3" json:"decay_interval,omitempty"`
+	// bidder is the address of the account that placed the highest bid, i.e.
+	// the winning bid of the auction if the auction were settled now.
+	Bidder []byte `protobuf:"bytes,13,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_price is the integer amount (encoded as a string) of the price for
+	// each credit unit of the highest bid. The total cost of the highest bid and
+	// the buyer fee are held in escrow until the auction is settled.
+	BidPrice string `protobuf:"bytes,14,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	// market_id is the market in which the credits are auctioned. The fees of
+	// the market are charged when the auction is settled with a winning bid.
+	MarketId uint64 `protobuf:"varint,15,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// buyer_fee is the buyer fee rate of the market when the highest bid was
+	// placed. The buyer fee is held in escrow with the total cost of the bid so
+	// that the fee paid or refunded does not depend on later fee changes.
+	BuyerFee string `protobuf:"bytes,16,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+}
+
+func (x *Auction) Reset() {
+	*x = Auction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Auction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auction) ProtoMessage() {}
+
+// Deprecated: Use Auction.ProtoReflect.Descriptor instead.
+func (*Auction) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Auction) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Auction) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Auction) GetBatchKey() uint64 {
+	if x != nil {
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *Auction) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *Auction) GetAuctionType() AuctionType {
+	if x != nil {
+		return x.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+}
+
+func (x *Auction) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Auction) GetStartPrice() string {
+	if x != nil {
+		return x.StartPrice
+	}
+	return ""
+}
+
+func (x *Auction) GetReservePrice() string {
+	if x != nil {
+		return x.ReservePrice
+	}
+	return ""
+}
+
+func (x *Auction) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *Auction) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *Auction) GetPriceDecrement() string {
+	if x != nil {
+		return x.PriceDecrement
+	}
+	return ""
+}
+
+func (x *Auction) GetDecayInterval() *durationpb.Duration {
+	if x != nil {
+		return x.DecayInterval
+	}
+	return nil
+}
+
+func (x *Auction) GetBidder() []byte {
+	if x != nil {
+		return x.Bidder
+	}
+	return nil
+}
+
+func (x *Auction) GetBidPrice() string {
+	if x != nil {
+		return x.BidPrice
+	}
+	return ""
+}
+
+func (x *Auction) GetMarketId() uint64 {
+	if x != nil {
+		return x.MarketId
+	}
+	return 0
+}
+
+func (x *Auction) GetBuyerFee() string {
+	if x != nil {
+		return x.BuyerFee
+	}
+	return ""
+}
+
+// QuoteRequest represents the information for a request for quote (RFQ). A
+// quote request asks holders of credits matching the selection to submit
+// quotes for the quantity of credits and the buyer accepts one of the quotes.
+type QuoteRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the quote request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is requesting quotes.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// selection is the selection of credits the quotes must be for.
+	Selection *BuyOrder_Selection `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"`
+	// quantity is the decimal quantity of credits being requested.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// denom is the bank denom in which the quotes must be priced.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive the credits in a tradable state when a quote is
+	// accepted.
+	DisableAutoRetire bool `protobuf:"varint,6,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,7,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the quote request expires. When
+	// the expiration time is reached, the quote request and its quotes are
+	// removed from state and the credits of the quotes are returned to the
+	// sellers.
+	Expiration *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// retirement_beneficiary is the optional name or address of the person or
+	// organization on whose behalf the credits will be retired, which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,9,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits, which
+	// will be used only if disable_auto_retire is false.
+	RetirementReason string `protobuf:"bytes,10,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+}
+
+func (x *QuoteRequest) Reset() {
+	*x = QuoteRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *QuoteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuoteRequest) ProtoMessage() {}
+
+// Deprecated: Use QuoteRequest.ProtoReflect.Descriptor instead.
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *QuoteRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *QuoteRequest) GetBuyer() []byte {
+	if x != nil {
+		return x.Buyer
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetSelection() *BuyOrder_Selection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetQuantity() string {
+	if x != nil {
+		return x.Quantity
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetDisableAutoRetire() bool {
+	if x != nil {
+		return x.DisableAutoRetire
+	}
+	return false
+}
+
+func (x *QuoteRequest) GetRetirementJurisdiction() string {
+	if x != nil {
+		return x.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetExpiration() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Expiration
+	}
+	return nil
+}
+
+func (x *QuoteRequest) GetRetirementBeneficiary() string {
+	if x != nil {
+		return x.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (x *QuoteRequest) GetRetirementReason() string {
+	if x != nil {
+		return x.RetirementReason
+	}
+	return ""
+}
+
+// Quote represents the information for a quote submitted in response to a
+// quote request. The credits of a quote are held in escrow in a private sell
+// order that only the buyer of the quote request is allowed to fill.
+type Quote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// id is the unique ID of the quote.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// quote_request_id is the ID of the quote request the quote responds to.
+	QuoteRequestId uint64 `protobuf:"varint,2,opt,name=quote_request_id,json=quoteRequestId,proto3" json:"quote_request_id,omitempty"`
+	// seller is the address of the account that submitted the quote.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// sell_order_id is the ID of the private sell order holding the credits of
+	// the quote in escrow at the quoted price.
+	SellOrderId uint64 `protobuf:"varint,4,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+}
+
+func (x *Quote) Reset() {
+	*x = Quote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quote) ProtoMessage() {}
+
+// Deprecated: Use Quote.ProtoReflect.Descriptor instead.
+func (*Quote) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Quote) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Quote) GetQuoteRequestId() uint64 {
+	if x != nil {
+		return x.QuoteRequestId
+	}
+	return 0
+}
+
+func (x *Quote) GetSeller() []byte {
+	if x != nil {
+		return x.Seller
+	}
+	return nil
+}
+
+func (x *Quote) GetSellOrderId() uint64 {
+	if x != nil {
+		return x.SellOrderId
+	}
+	return 0
 }
 
-func (x *SellOrder) Reset() {
-	*x = SellOrder{}
+// PriceObservation represents the price accumulator of a credit batch in a
+// market, or of all credit batches in a market, at the block time of a block
+// in which credits were traded. An observation is recorded for each block in
+// which a sell order is filled and is updated by each fill in the block.
+// Observations are pruned once they are older than the retention window of
+// the price history.
+type PriceObservation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// market_id is the ID of the market in which the credits were traded.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the credits
+	// that were traded. The batch key is zero for the observations of all
+	// credit batches in the market.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// timestamp is the block time of the observation.
+	Timestamp *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// cumulative_price is the decimal sum (encoded as a string) of the value of
+	// each previous observation multiplied by the number of seconds until the
+	// next observation. The price in effect between two observations is the
+	// average price of the trades of the earlier observation, weighted by the
+	// quantity of credits traded.
+	CumulativePrice string `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3" json:"cumulative_price,omitempty"`
+	// open is the integer amount (encoded as a string) of the price of the
+	// first trade in the block.
+	Open string `protobuf:"bytes,5,opt,name=open,proto3" json:"open,omitempty"`
+	// high is the integer amount (encoded as a string) of the highest price of
+	// the trades in the block.
+	High string `protobuf:"bytes,6,opt,name=high,proto3" json:"high,omitempty"`
+	// low is the integer amount (encoded as a string) of the lowest price of
+	// the trades in the block.
+	Low string `protobuf:"bytes,7,opt,name=low,proto3" json:"low,omitempty"`
+	// close is the integer amount (encoded as a string) of the price of the
+	// last trade in the block.
+	Close string `protobuf:"bytes,8,opt,name=close,proto3" json:"close,omitempty"`
+	// volume is the decimal quantity of credits traded in the block.
+	Volume string `protobuf:"bytes,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	// value is the decimal sum (encoded as a string) of the price multiplied by
+	// the quantity of each trade in the block.
+	Value string `protobuf:"bytes,10,opt,name=value,proto3" json:"value,omitempty"`
+	// cumulative_volume is the decimal sum (encoded as a string) of the volume
+	// of each previous observation multiplied by the number of seconds until
+	// the next observation. The quantity-weighted average price between two
+	// observations is the change in cumulative_price divided by the change in
+	// cumulative_volume.
+	CumulativeVolume string `protobuf:"bytes,11,opt,name=cumulative_volume,json=cumulativeVolume,proto3" json:"cumulative_volume,omitempty"`
+}
+
+func (x *PriceObservation) Reset() {
+	*x = PriceObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[0]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SellOrder) String() string {
+func (x *PriceObservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SellOrder) ProtoMessage() {}
+func (*PriceObservation) ProtoMessage() {}
 
-// Deprecated: Use SellOrder.ProtoReflect.Descriptor instead.
-func (*SellOrder) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use PriceObservation.ProtoReflect.Descriptor instead.
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SellOrder) GetId() uint64 {
+func (x *PriceObservation) GetMarketId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.MarketId
 	}
 	return 0
 }
 
-func (x *SellOrder) GetSeller() []byte {
+func (x *PriceObservation) GetBatchKey() uint64 {
 	if x != nil {
-		return x.Seller
+		return x.BatchKey
+	}
+	return 0
+}
+
+func (x *PriceObservation) GetTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
 	}
 	return nil
 }
 
-func (x *SellOrder) GetBatchKey() uint64 {
+func (x *PriceObservation) GetCumulativePrice() string {
 	if x != nil {
-		return x.BatchKey
+		return x.CumulativePrice
 	}
-	return 0
+	return ""
 }
 
-func (x *SellOrder) GetQuantity() string {
+func (x *PriceObservation) GetOpen() string {
 	if x != nil {
-		return x.Quantity
+		return x.Open
 	}
 	return ""
 }
 
-func (x *SellOrder) GetMarketId() uint64 {
+func (x *PriceObservation) GetHigh() string {
 	if x != nil {
-		return x.MarketId
+		return x.High
 	}
-	return 0
+	return ""
 }
 
-func (x *SellOrder) GetAskAmount() string {
+func (x *PriceObservation) GetLow() string {
 	if x != nil {
-		return x.AskAmount
+		return x.Low
 	}
 	return ""
 }
 
-func (x *SellOrder) GetDisableAutoRetire() bool {
+func (x *PriceObservation) GetClose() string {
 	if x != nil {
-		return x.DisableAutoRetire
+		return x.Close
 	}
-	return false
+	return ""
 }
 
-func (x *SellOrder) GetExpiration() *timestamppb.Timestamp {
+func (x *PriceObservation) GetVolume() string {
 	if x != nil {
-		return x.Expiration
+		return x.Volume
 	}
-	return nil
+	return ""
 }
 
-func (x *SellOrder) GetMaker() bool {
+func (x *PriceObservation) GetValue() string {
 	if x != nil {
-		return x.Maker
+		return x.Value
 	}
-	return false
+	return ""
 }
 
-// AllowedDenom represents the information for an allowed ask/bid denom.
-type AllowedDenom struct {
+func (x *PriceObservation) GetCumulativeVolume() string {
+	if x != nil {
+		return x.CumulativeVolume
+	}
+	return ""
+}
+
+// AutoRenew defines how a sell order is re-listed when it expires.
+type SellOrder_AutoRenew struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
-	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// display_denom is the denom to display to the user and is informational.
-	// Because the denom is likely an IBC denom, this should be chosen by
-	// governance to represent the consensus trusted name of the denom.
-	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
-	// exponent is the exponent that relates the denom to the display_denom and is
-	// informational
-	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+	// renewal_period is the period by which the expiration of the sell order
+	// is extended each time the sell order is re-listed.
+	RenewalPeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=renewal_period,json=renewalPeriod,proto3" json:"renewal_period,omitempty"`
+	// price_decay is the optional decimal fraction by which the ask amount of
+	// the sell order is reduced each time the sell order is re-listed (e.g.
+	// "0.05" for a reduction of 5%). The ask amount is not reduced if
+	// price_decay is empty.
+	PriceDecay string `protobuf:"bytes,2,opt,name=price_decay,json=priceDecay,proto3" json:"price_decay,omitempty"`
+	// floor_amount is the optional integer amount (encoded as a string) below
+	// which the ask amount of the sell order is not reduced.
+	FloorAmount string `protobuf:"bytes,3,opt,name=floor_amount,json=floorAmount,proto3" json:"floor_amount,omitempty"`
+	// max_renewals is the optional maximum number of times the sell order is
+	// re-listed. The sell order is re-listed until it is filled or cancelled
+	// if max_renewals is zero.
+	MaxRenewals uint32 `protobuf:"varint,4,opt,name=max_renewals,json=maxRenewals,proto3" json:"max_renewals,omitempty"`
 }
 
-func (x *AllowedDenom) Reset() {
-	*x = AllowedDenom{}
+func (x *SellOrder_AutoRenew) Reset() {
+	*x = SellOrder_AutoRenew{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AllowedDenom) String() string {
+func (x *SellOrder_AutoRenew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowedDenom) ProtoMessage() {}
+func (*SellOrder_AutoRenew) ProtoMessage() {}
 
-// Deprecated: Use AllowedDenom.ProtoReflect.Descriptor instead.
-func (*AllowedDenom) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use SellOrder_AutoRenew.ProtoReflect.Descriptor instead.
+func (*SellOrder_AutoRenew) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *AllowedDenom) GetBankDenom() string {
+func (x *SellOrder_AutoRenew) GetRenewalPeriod() *durationpb.Duration {
 	if x != nil {
-		return x.BankDenom
+		return x.RenewalPeriod
+	}
+	return nil
+}
+
+func (x *SellOrder_AutoRenew) GetPriceDecay() string {
+	if x != nil {
+		return x.PriceDecay
 	}
 	return ""
 }
 
-func (x *AllowedDenom) GetDisplayDenom() string {
+func (x *SellOrder_AutoRenew) GetFloorAmount() string {
 	if x != nil {
-		return x.DisplayDenom
+		return x.FloorAmount
 	}
 	return ""
 }
 
-func (x *AllowedDenom) GetExponent() uint32 {
+func (x *SellOrder_AutoRenew) GetMaxRenewals() uint32 {
 	if x != nil {
-		return x.Exponent
+		return x.MaxRenewals
 	}
 	return 0
 }
 
-// Market describes a distinctly processed market between a credit type and
-// allowed bank denom. Each market has its own precision in the order book
-// and is processed independently of other markets. Governance must enable
-// markets one by one. Every additional enabled market potentially adds more
-// processing overhead to the blockchain and potentially weakens liquidity in
-// competing markets. For instance, enabling side by side USD/Carbon and
-// EUR/Carbon markets may have the end result that each market individually has
-// less liquidity and longer settlement times. Such decisions should be taken
-// with care.
-type Market struct {
+// Selection defines the credits a buy order is bidding for.
+type BuyOrder_Selection struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// id is the unique ID of the market.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type.
-	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// bank_denom is an allowed bank denom.
-	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// precision_modifier is an optional modifier used to convert arbitrary
-	// precision integer bank amounts to uint32 values used for sorting in the
-	// order book. Given an arbitrary precision integer x, its uint32 conversion
-	// will be x / 10^precision_modifier using round half away from zero
-	// rounding.
-	//
-	// uint32 values range from 0 to 4,294,967,295.
-	// This allows for a full 9 digits of precision. In most real world markets
-	// this amount of precision is sufficient and most common downside -
-	// that some orders with very miniscule price differences may be ordered
-	// equivalently (because of rounding) - is acceptable.
-	// Note that this rounding will not affect settlement price which will
-	// always be done exactly.
-	//
-	// Given a USD stable coin with 6 decimal digits, a precision_modifier
-	// of 0 is probably acceptable as long as credits are always less than
-	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
-	// in this case), prices can rise up to $4,294,000/unit. Either scenario
-	// is probably quite acceptable given that carbon prices are unlikely to
-	// rise above $1000/ton any time in the near future.
+	// sum is the selection type.
 	//
-	// If credit prices, exceed the maximum range of uint32 with this
-	// precision_modifier, orders with high prices will fail and governance
-	// will need to adjust precision_modifier to allow for higher prices in
-	// exchange for less precision at the lower end.
-	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// Types that are assignable to Sum:
+	//	*BuyOrder_Selection_BatchKey
+	//	*BuyOrder_Selection_ProjectKey
+	//	*BuyOrder_Selection_ClassKey
+	Sum isBuyOrder_Selection_Sum `protobuf_oneof:"sum"`
+	// project_jurisdiction optionally restricts a class selection to credits
+	// from projects within the jurisdiction.
+	ProjectJurisdiction string `protobuf:"bytes,4,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
+	// min_start_date optionally restricts a project or class selection to
+	// credit batches with a start date on or after the min_start_date.
+	MinStartDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date optionally restricts a project or class selection to
+	// credit batches with an end date on or before the max_end_date.
+	MaxEndDate *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
 }
 
-func (x *Market) Reset() {
-	*x = Market{}
+func (x *BuyOrder_Selection) Reset() {
+	*x = BuyOrder_Selection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2]
+		mi := &file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Market) String() string {
+func (x *BuyOrder_Selection) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Market) ProtoMessage() {}
+func (*BuyOrder_Selection) ProtoMessage() {}
 
-// Deprecated: Use Market.ProtoReflect.Descriptor instead.
-func (*Market) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use BuyOrder_Selection.ProtoReflect.Descriptor instead.
+func (*BuyOrder_Selection) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP(), []int{1, 0}
 }
 
-func (x *Market) GetId() uint64 {
+func (x *BuyOrder_Selection) GetSum() isBuyOrder_Selection_Sum {
 	if x != nil {
-		return x.Id
+		return x.Sum
+	}
+	return nil
+}
+
+func (x *BuyOrder_Selection) GetBatchKey() uint64 {
+	if x, ok := x.GetSum().(*BuyOrder_Selection_BatchKey); ok {
+		return x.BatchKey
 	}
 	return 0
 }
 
-func (x *Market) GetCreditTypeAbbrev() string {
+func (x *BuyOrder_Selection) GetProjectKey() uint64 {
+	if x, ok := x.GetSum().(*BuyOrder_Selection_ProjectKey); ok {
+		return x.ProjectKey
+	}
+	return 0
+}
+
+func (x *BuyOrder_Selection) GetClassKey() uint64 {
+	if x, ok := x.GetSum().(*BuyOrder_Selection_ClassKey); ok {
+		return x.ClassKey
+	}
+	return 0
+}
+
+func (x *BuyOrder_Selection) GetProjectJurisdiction() string {
 	if x != nil {
-		return x.CreditTypeAbbrev
+		return x.ProjectJurisdiction
 	}
 	return ""
 }
 
-func (x *Market) GetBankDenom() string {
+func (x *BuyOrder_Selection) GetMinStartDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BankDenom
+		return x.MinStartDate
 	}
-	return ""
+	return nil
 }
 
-func (x *Market) GetPrecisionModifier() uint32 {
+func (x *BuyOrder_Selection) GetMaxEndDate() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PrecisionModifier
+		return x.MaxEndDate
 	}
-	return 0
+	return nil
 }
 
+type isBuyOrder_Selection_Sum interface {
+	isBuyOrder_Selection_Sum()
+}
+
+type BuyOrder_Selection_BatchKey struct {
+	// batch_key is the table row identifier of the credit batch being
+	// bought.
+	BatchKey uint64 `protobuf:"varint,1,opt,name=batch_key,json=batchKey,proto3,oneof"`
+}
+
+type BuyOrder_Selection_ProjectKey struct {
+	// project_key is the table row identifier of the project from which
+	// credits of any batch are being bought.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3,oneof"`
+}
+
+type BuyOrder_Selection_ClassKey struct {
+	// class_key is the table row identifier of the credit class from which
+	// credits of any batch are being bought.
+	ClassKey uint64 `protobuf:"varint,3,opt,name=class_key,json=classKey,proto3,oneof"`
+}
+
+func (*BuyOrder_Selection_BatchKey) isBuyOrder_Selection_Sum() {}
+
+func (*BuyOrder_Selection_ProjectKey) isBuyOrder_Selection_Sum() {}
+
+func (*BuyOrder_Selection_ClassKey) isBuyOrder_Selection_Sum() {}
+
 var File_regen_ecocredit_marketplace_v1_state_proto protoreflect.FileDescriptor
 
 var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
@@ -2301,9 +12197,11 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61,
 	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x1d, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6f, 0x72, 0x6d, 0x2f, 0x76, 0x31, 0x61, 0x6c, 0x70, 0x68, 0x61,
-	0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
+	0x31, 0x2f, 0x6f, 0x72, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe7, 0x02, 0x0a,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x8a, 0x07, 0x0a,
 	0x09, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65,
 	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c,
@@ -2322,52 +12220,325 @@ var file_regen_ecocredit_marketplace_v1_state_proto_rawDesc = []byte{
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x3a, 0x3b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03,
-	0x35, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74,
-	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c,
-	0x65, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x10, 0x03, 0x18, 0x01, 0x22, 0x9b, 0x01, 0x0a, 0x0c, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e,
-	0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61,
-	0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64,
-	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x65,
-	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x65,
-	0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a,
-	0x0c, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x13, 0x0a,
-	0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01,
-	0x18, 0x01, 0x18, 0x03, 0x22, 0xcb, 0x01, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61,
-	0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x62, 0x62, 0x72, 0x65, 0x76, 0x12, 0x1d, 0x0a,
-	0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2d, 0x0a, 0x12,
-	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69,
-	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73,
-	0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x3a, 0x35, 0xf2, 0x9e, 0xd3,
+	0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
+	0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x46, 0x69, 0x6c, 0x6c, 0x51, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x6f, 0x74, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6c, 0x6f, 0x74, 0x53, 0x69, 0x7a, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x62, 0x75, 0x79, 0x65,
+	0x72, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x73, 0x12, 0x3b, 0x0a, 0x1a, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x5f, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x5f, 0x70,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x17, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x42, 0x75, 0x79, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x4f, 0x0a, 0x0d, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x52, 0x0b, 0x74, 0x69, 0x6d, 0x65, 0x49, 0x6e,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0a, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x6e, 0x65, 0x77, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x72, 0x65, 0x6e,
+	0x65, 0x77, 0x61, 0x6c, 0x73, 0x1a, 0xb4, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x6e, 0x65, 0x77, 0x12, 0x40, 0x0a, 0x0e, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x5f, 0x70,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x64,
+	0x65, 0x63, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x44, 0x65, 0x63, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x6c,
+	0x6f, 0x6f, 0x72, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0b, 0x6d, 0x61, 0x78, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x6c, 0x73, 0x3a, 0x3b, 0xf2, 0x9e,
+	0xd3, 0x8e, 0x03, 0x35, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73,
+	0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x03, 0x18, 0x01, 0x22, 0x9f, 0x07, 0x0a, 0x08, 0x42, 0x75,
+	0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x09,
+	0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x32, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x69, 0x64, 0x5f, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x69, 0x64,
+	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x61, 0x6b, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x13,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74,
+	0x69, 0x72, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x46, 0x65, 0x65, 0x12, 0x35,
+	0x0a, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x6e,
+	0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15,
+	0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69,
+	0x63, 0x69, 0x61, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x15, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x6c,
+	0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28,
+	0x04, 0x52, 0x12, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x53, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64,
+	0x65, 0x72, 0x49, 0x64, 0x73, 0x1a, 0xa6, 0x02, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b,
+	0x65, 0x79, 0x12, 0x21, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x1d, 0x0a, 0x09, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x48, 0x00, 0x52, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x4b, 0x65, 0x79, 0x12, 0x31, 0x0a, 0x14, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f,
+	0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73,
+	0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0c, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x45, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x73, 0x75, 0x6d, 0x3a, 0x2b,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x18, 0x02, 0x22, 0x9b, 0x01, 0x0a, 0x0c,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x0d, 0x64,
+	0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x1a, 0x0a, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x65, 0x78, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x3a, 0x2b, 0xf2, 0x9e,
+	0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x0c, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x13, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01, 0x18, 0x03, 0x22, 0xd6, 0x03, 0x0a, 0x06, 0x4d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x41, 0x62, 0x62, 0x72,
+	0x65, 0x76, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x6e, 0x6b, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x12, 0x2d, 0x0a, 0x12, 0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x70,
+	0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x51, 0x0a, 0x0d, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x6f, 0x64,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2c, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e,
+	0x67, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x0c, 0x63, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x4d,
+	0x6f, 0x64, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f, 0x63, 0x68,
+	0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f,
+	0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72,
+	0x46, 0x65, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x12, 0x57, 0x0a, 0x0f, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2e, 0x2e, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x66, 0x65, 0x65,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x35, 0xf2, 0x9e, 0xd3,
 	0x8e, 0x03, 0x2f, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1d, 0x63,
 	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x62, 0x62, 0x72, 0x65,
 	0x76, 0x2c, 0x62, 0x61, 0x6e, 0x6b, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x10, 0x01, 0x18, 0x01,
-	0x18, 0x04, 0x42, 0xa3, 0x02, 0x0a, 0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61,
-	0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
-	0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76,
-	0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x76, 0x31, 0xa2,
-	0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c,
-	0x61, 0x63, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c,
-	0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x21, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x18, 0x04, 0x22, 0x88, 0x03, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x64, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0d,
+	0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x12, 0x38, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x3a, 0x45, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x3f, 0x0a, 0x06,
+	0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x6b, 0x65, 0x79, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x10, 0x04, 0x18, 0x05, 0x22, 0x9d, 0x05,
+	0x0a, 0x07, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c,
+	0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61, 0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1a,
+	0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x2b, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d,
+	0x65, 0x12, 0x35, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x64, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x63, 0x65, 0x44, 0x65, 0x63, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x40, 0x0a, 0x0e, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x69, 0x64, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62,
+	0x69, 0x64, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x62, 0x69, 0x64, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x75, 0x79, 0x65, 0x72, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x75, 0x79, 0x65, 0x72, 0x46,
+	0x65, 0x65, 0x3a, 0x39, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x33, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64,
+	0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0d,
+	0x0a, 0x09, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x10, 0x02, 0x12, 0x0c, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x10, 0x03, 0x18, 0x06, 0x22, 0xee, 0x03,
+	0x0a, 0x0c, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62,
+	0x75, 0x79, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e,
+	0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x79, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x73, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x2e, 0x0a, 0x13, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x75,
+	0x74, 0x6f, 0x52, 0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x37, 0x0a, 0x17, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65, 0x74, 0x69, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x4a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a,
+	0x16, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x62, 0x65, 0x6e, 0x65,
+	0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x15, 0x72,
+	0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63,
+	0x69, 0x61, 0x72, 0x79, 0x12, 0x2b, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x3a, 0x2b, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x25, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10,
+	0x01, 0x12, 0x09, 0x0a, 0x05, 0x62, 0x75, 0x79, 0x65, 0x72, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x02, 0x18, 0x07, 0x22, 0xc6,
+	0x01, 0x0a, 0x05, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74,
+	0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x65,
+	0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x73, 0x65, 0x6c, 0x6c, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x3a, 0x47,
+	0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x41, 0x0a, 0x06, 0x0a, 0x02, 0x69, 0x64, 0x10, 0x01, 0x12, 0x14,
+	0x0a, 0x10, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x65, 0x6c, 0x6c, 0x65, 0x72, 0x10, 0x02,
+	0x12, 0x13, 0x0a, 0x0d, 0x73, 0x65, 0x6c, 0x6c, 0x5f, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x10, 0x03, 0x18, 0x01, 0x18, 0x08, 0x22, 0x96, 0x03, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x08, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x61, 0x74,
+	0x63, 0x68, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x62, 0x61,
+	0x74, 0x63, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x38, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x70,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x6d, 0x75,
+	0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6f,
+	0x70, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x70, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x69, 0x67, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68,
+	0x69, 0x67, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6c, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x76,
+	0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x76, 0x6f, 0x6c,
+	0x75, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x75, 0x6d,
+	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x56, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x3a, 0x38, 0xf2, 0x9e, 0xd3, 0x8e, 0x03, 0x32, 0x0a, 0x1f,
+	0x0a, 0x1d, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x2c, 0x62, 0x61, 0x74, 0x63,
+	0x68, 0x5f, 0x6b, 0x65, 0x79, 0x2c, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x0d, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x10, 0x01, 0x18, 0x09,
+	0x2a, 0x5d, 0x0a, 0x0b, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x1c, 0x0a, 0x18, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x16, 0x0a,
+	0x12, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x55,
+	0x54, 0x43, 0x48, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x4e, 0x47, 0x4c, 0x49, 0x53, 0x48, 0x10, 0x02, 0x2a,
+	0x9a, 0x01, 0x0a, 0x0b, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x19, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24,
+	0x0a, 0x20, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f,
+	0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e, 0x5f,
+	0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x47, 0x4f, 0x4f, 0x44, 0x5f, 0x54, 0x49, 0x4c, 0x5f, 0x44,
+	0x41, 0x54, 0x45, 0x10, 0x02, 0x12, 0x25, 0x0a, 0x21, 0x54, 0x49, 0x4d, 0x45, 0x5f, 0x49, 0x4e,
+	0x5f, 0x46, 0x4f, 0x52, 0x43, 0x45, 0x5f, 0x49, 0x4d, 0x4d, 0x45, 0x44, 0x49, 0x41, 0x54, 0x45,
+	0x5f, 0x4f, 0x52, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x10, 0x03, 0x2a, 0x6f, 0x0a, 0x0e,
+	0x46, 0x65, 0x65, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x14, 0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x42, 0x55, 0x52, 0x4e, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x46, 0x45, 0x45, 0x5f,
+	0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d,
+	0x55, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b,
+	0x46, 0x45, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x54, 0x49, 0x4e, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x43, 0x4c, 0x41, 0x53, 0x53, 0x5f, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x02, 0x2a, 0x4d, 0x0a,
+	0x0c, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x69, 0x6e, 0x67, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x18, 0x43, 0x4c, 0x45, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x43,
+	0x4f, 0x4e, 0x54, 0x49, 0x4e, 0x55, 0x4f, 0x55, 0x53, 0x10, 0x00, 0x12, 0x1f, 0x0a, 0x1b, 0x43,
+	0x4c, 0x45, 0x41, 0x52, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x42, 0x41, 0x54,
+	0x43, 0x48, 0x5f, 0x41, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x42, 0xa3, 0x02, 0x0a,
+	0x22, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x2e, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x2e, 0x76, 0x31, 0x42, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50,
+	0x01, 0x5a, 0x56, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65,
+	0x67, 0x65, 0x6e, 0x2d, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2d, 0x6c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67,
+	0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x6d, 0x61, 0x72,
+	0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x4d, 0xaa,
+	0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
+	0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x2a, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
+	0x64, 0x69, 0x74, 0x5c, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x21, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69,
+	0x74, 0x3a, 0x3a, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x3a, 0x3a,
+	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2382,20 +12553,51 @@ func file_regen_ecocredit_marketplace_v1_state_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_marketplace_v1_state_proto_rawDescData
 }
 
-var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_regen_ecocredit_marketplace_v1_state_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_regen_ecocredit_marketplace_v1_state_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_regen_ecocredit_marketplace_v1_state_proto_goTypes = []interface{}{
-	(*SellOrder)(nil),             // 0: regen.ecocredit.marketplace.v1.SellOrder
-	(*AllowedDenom)(nil),          // 1: regen.ecocredit.marketplace.v1.AllowedDenom
-	(*Market)(nil),                // 2: regen.ecocredit.marketplace.v1.Market
-	(*timestamppb.Timestamp)(nil), // 3: google.protobuf.Timestamp
+	(AuctionType)(0),              // 0: regen.ecocredit.marketplace.v1.AuctionType
+	(TimeInForce)(0),              // 1: regen.ecocredit.marketplace.v1.TimeInForce
+	(FeeDestination)(0),           // 2: regen.ecocredit.marketplace.v1.FeeDestination
+	(ClearingMode)(0),             // 3: regen.ecocredit.marketplace.v1.ClearingMode
+	(*SellOrder)(nil),             // 4: regen.ecocredit.marketplace.v1.SellOrder
+	(*BuyOrder)(nil),              // 5: regen.ecocredit.marketplace.v1.BuyOrder
+	(*AllowedDenom)(nil),          // 6: regen.ecocredit.marketplace.v1.AllowedDenom
+	(*Market)(nil),                // 7: regen.ecocredit.marketplace.v1.Market
+	(*Trade)(nil),                 // 8: regen.ecocredit.marketplace.v1.Trade
+	(*Auction)(nil),               // 9: regen.ecocredit.marketplace.v1.Auction
+	(*QuoteRequest)(nil),          // 10: regen.ecocredit.marketplace.v1.QuoteRequest
+	(*Quote)(nil),                 // 11: regen.ecocredit.marketplace.v1.Quote
+	(*PriceObservation)(nil),      // 12: regen.ecocredit.marketplace.v1.PriceObservation
+	(*SellOrder_AutoRenew)(nil),   // 13: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew
+	(*BuyOrder_Selection)(nil),    // 14: regen.ecocredit.marketplace.v1.BuyOrder.Selection
+	(*timestamppb.Timestamp)(nil), // 15: google.protobuf.Timestamp
+	(*durationpb.Duration)(nil),   // 16: google.protobuf.Duration
 }
 var file_regen_ecocredit_marketplace_v1_state_proto_depIdxs = []int32{
-	3, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	15, // 0: regen.ecocredit.marketplace.v1.SellOrder.expiration:type_name -> google.protobuf.Timestamp
+	1,  // 1: regen.ecocredit.marketplace.v1.SellOrder.time_in_force:type_name -> regen.ecocredit.marketplace.v1.TimeInForce
+	13, // 2: regen.ecocredit.marketplace.v1.SellOrder.auto_renew:type_name -> regen.ecocredit.marketplace.v1.SellOrder.AutoRenew
+	14, // 3: regen.ecocredit.marketplace.v1.BuyOrder.selection:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.Selection
+	15, // 4: regen.ecocredit.marketplace.v1.BuyOrder.expiration:type_name -> google.protobuf.Timestamp
+	3,  // 5: regen.ecocredit.marketplace.v1.Market.clearing_mode:type_name -> regen.ecocredit.marketplace.v1.ClearingMode
+	2,  // 6: regen.ecocredit.marketplace.v1.Market.fee_destination:type_name -> regen.ecocredit.marketplace.v1.FeeDestination
+	15, // 7: regen.ecocredit.marketplace.v1.Trade.timestamp:type_name -> google.protobuf.Timestamp
+	0,  // 8: regen.ecocredit.marketplace.v1.Auction.auction_type:type_name -> regen.ecocredit.marketplace.v1.AuctionType
+	15, // 9: regen.ecocredit.marketplace.v1.Auction.start_time:type_name -> google.protobuf.Timestamp
+	15, // 10: regen.ecocredit.marketplace.v1.Auction.end_time:type_name -> google.protobuf.Timestamp
+	16, // 11: regen.ecocredit.marketplace.v1.Auction.decay_interval:type_name -> google.protobuf.Duration
+	14, // 12: regen.ecocredit.marketplace.v1.QuoteRequest.selection:type_name -> regen.ecocredit.marketplace.v1.BuyOrder.Selection
+	15, // 13: regen.ecocredit.marketplace.v1.QuoteRequest.expiration:type_name -> google.protobuf.Timestamp
+	15, // 14: regen.ecocredit.marketplace.v1.PriceObservation.timestamp:type_name -> google.protobuf.Timestamp
+	16, // 15: regen.ecocredit.marketplace.v1.SellOrder.AutoRenew.renewal_period:type_name -> google.protobuf.Duration
+	15, // 16: regen.ecocredit.marketplace.v1.BuyOrder.Selection.min_start_date:type_name -> google.protobuf.Timestamp
+	15, // 17: regen.ecocredit.marketplace.v1.BuyOrder.Selection.max_end_date:type_name -> google.protobuf.Timestamp
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_marketplace_v1_state_proto_init() }
@@ -2417,7 +12619,7 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowedDenom); i {
+			switch v := v.(*BuyOrder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2429,6 +12631,18 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 			}
 		}
 		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Market); i {
 			case 0:
 				return &v.state
@@ -2440,19 +12654,109 @@ func file_regen_ecocredit_marketplace_v1_state_proto_init() {
 				return nil
 			}
 		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trade); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Auction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PriceObservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellOrder_AutoRenew); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyOrder_Selection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_regen_ecocredit_marketplace_v1_state_proto_msgTypes[10].OneofWrappers = []interface{}{
+		(*BuyOrder_Selection_BatchKey)(nil),
+		(*BuyOrder_Selection_ProjectKey)(nil),
+		(*BuyOrder_Selection_ClassKey)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_marketplace_v1_state_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_regen_ecocredit_marketplace_v1_state_proto_goTypes,
 		DependencyIndexes: file_regen_ecocredit_marketplace_v1_state_proto_depIdxs,
+		EnumInfos:         file_regen_ecocredit_marketplace_v1_state_proto_enumTypes,
 		MessageInfos:      file_regen_ecocredit_marketplace_v1_state_proto_msgTypes,
 	}.Build()
 	File_regen_ecocredit_marketplace_v1_state_proto = out.File
@@ -30,10 +30,55 @@ type MsgClient interface {
 	CancelSellOrder(ctx context.Context, in *MsgCancelSellOrder, opts ...grpc.CallOption) (*MsgCancelSellOrderResponse, error)
 	// BuyDirect purchases credits directly from the specified sell order.
 	BuyDirect(ctx context.Context, in *MsgBuyDirect, opts ...grpc.CallOption) (*MsgBuyDirectResponse, error)
+	// Buy creates new buy orders. The funds of the buy orders are held in escrow
+	// until the buy orders are filled, cancelled or expire.
+	Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error)
 	// AddAllowedDenom is a governance method that allows the addition of
 	// new allowed denom.
 	// Since Revision 1
 	AddAllowedDenom(ctx context.Context, in *MsgAddAllowedDenom, opts ...grpc.CallOption) (*MsgAddAllowedDenomResponse, error)
+	// RemoveAllowedDenom is a governance method that removes a denom from the
+	// list of allowed denoms. The quote requests of the denom are closed. The
+	// open sell orders, buy orders and auctions of the denom are either
+	// cancelled, returning the escrowed credits and funds to their owners, or
+	// kept until they expire. The markets of the denom are kept so that their
+	// settings apply again if the denom is allowed again.
+	RemoveAllowedDenom(ctx context.Context, in *MsgRemoveAllowedDenom, opts ...grpc.CallOption) (*MsgRemoveAllowedDenomResponse, error)
+	// SetMarketClearingMode is a governance method that sets the clearing mode of
+	// the market for a credit type and an allowed denom.
+	SetMarketClearingMode(ctx context.Context, in *MsgSetMarketClearingMode, opts ...grpc.CallOption) (*MsgSetMarketClearingModeResponse, error)
+	// SetMarketFees is a governance method that sets the buyer fee, the seller
+	// fee and the fee destination of the market for a credit type and an
+	// allowed denom.
+	SetMarketFees(ctx context.Context, in *MsgSetMarketFees, opts ...grpc.CallOption) (*MsgSetMarketFeesResponse, error)
+	// CreateAuction creates a new dutch or english auction and moves the credits
+	// being auctioned into escrow. The auction is settled in the begin blocker
+	// once it ends.
+	CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error)
+	// Bid places a bid in an auction and moves the total cost of the bid and the
+	// buyer fee of the market into escrow. The escrowed funds of the previous
+	// highest bid of an english auction are returned to the bidder.
+	Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgBidResponse, error)
+	// CancelAuction cancels an auction that has not received any bids and
+	// returns the credits from escrow.
+	CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error)
+	// CreateQuoteRequest creates a new request for quote (RFQ) for a quantity of
+	// credits matching a selection of credits.
+	CreateQuoteRequest(ctx context.Context, in *MsgCreateQuoteRequest, opts ...grpc.CallOption) (*MsgCreateQuoteRequestResponse, error)
+	// SubmitQuote submits a quote in response to a quote request and moves the
+	// quoted credits into escrow in a private sell order for the buyer of the
+	// quote request. The sell order of a quote cannot be updated and the quote
+	// request is closed when the sell order of a quote is filled.
+	SubmitQuote(ctx context.Context, in *MsgSubmitQuote, opts ...grpc.CallOption) (*MsgSubmitQuoteResponse, error)
+	// AcceptQuote accepts a quote of a quote request, filling the sell order of
+	// the quote, and closes the quote request, returning the credits of the
+	// other quotes to their sellers.
+	AcceptQuote(ctx context.Context, in *MsgAcceptQuote, opts ...grpc.CallOption) (*MsgAcceptQuoteResponse, error)
+	// CancelQuoteRequest cancels a quote request and returns the credits of its
+	// quotes to their sellers.
+	CancelQuoteRequest(ctx context.Context, in *MsgCancelQuoteRequest, opts ...grpc.CallOption) (*MsgCancelQuoteRequestResponse, error)
 }
 
 type msgClient struct {
@@ -80,6 +125,24 @@ func (c *msgClient) BuyDirect(ctx context.Context, in *MsgBuyDirect, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) Buy(ctx context.Context, in *MsgBuy, opts ...grpc.CallOption) (*MsgBuyResponse, error) {
+	out := new(MsgBuyResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/Buy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelBuyOrder(ctx context.Context, in *MsgCancelBuyOrder, opts ...grpc.CallOption) (*MsgCancelBuyOrderResponse, error) {
+	out := new(MsgCancelBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddAllowedDenom(ctx context.Context, in *MsgAddAllowedDenom, opts ...grpc.CallOption) (*MsgAddAllowedDenomResponse, error) {
 	out := new(MsgAddAllowedDenomResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/AddAllowedDenom", in, out, opts...)
@@ -89,6 +152,96 @@ func (c *msgClient) AddAllowedDenom(ctx context.Context, in *MsgAddAllowedDenom,
 	return out, nil
 }
 
+func (c *msgClient) RemoveAllowedDenom(ctx context.Context, in *MsgRemoveAllowedDenom, opts ...grpc.CallOption) (*MsgRemoveAllowedDenomResponse, error) {
+	out := new(MsgRemoveAllowedDenomResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/RemoveAllowedDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketClearingMode(ctx context.Context, in *MsgSetMarketClearingMode, opts ...grpc.CallOption) (*MsgSetMarketClearingModeResponse, error) {
+	out := new(MsgSetMarketClearingModeResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/SetMarketClearingMode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMarketFees(ctx context.Context, in *MsgSetMarketFees, opts ...grpc.CallOption) (*MsgSetMarketFeesResponse, error) {
+	out := new(MsgSetMarketFeesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/SetMarketFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateAuction(ctx context.Context, in *MsgCreateAuction, opts ...grpc.CallOption) (*MsgCreateAuctionResponse, error) {
+	out := new(MsgCreateAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Bid(ctx context.Context, in *MsgBid, opts ...grpc.CallOption) (*MsgBidResponse, error) {
+	out := new(MsgBidResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/Bid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelAuction(ctx context.Context, in *MsgCancelAuction, opts ...grpc.CallOption) (*MsgCancelAuctionResponse, error) {
+	out := new(MsgCancelAuctionResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateQuoteRequest(ctx context.Context, in *MsgCreateQuoteRequest, opts ...grpc.CallOption) (*MsgCreateQuoteRequestResponse, error) {
+	out := new(MsgCreateQuoteRequestResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CreateQuoteRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitQuote(ctx context.Context, in *MsgSubmitQuote, opts ...grpc.CallOption) (*MsgSubmitQuoteResponse, error) {
+	out := new(MsgSubmitQuoteResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/SubmitQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptQuote(ctx context.Context, in *MsgAcceptQuote, opts ...grpc.CallOption) (*MsgAcceptQuoteResponse, error) {
+	out := new(MsgAcceptQuoteResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/AcceptQuote", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelQuoteRequest(ctx context.Context, in *MsgCancelQuoteRequest, opts ...grpc.CallOption) (*MsgCancelQuoteRequestResponse, error) {
+	out := new(MsgCancelQuoteRequestResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.marketplace.v1.Msg/CancelQuoteRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -101,10 +254,55 @@ type MsgServer interface {
 	CancelSellOrder(context.Context, *MsgCancelSellOrder) (*MsgCancelSellOrderResponse, error)
 	// BuyDirect purchases credits directly from the specified sell order.
 	BuyDirect(context.Context, *MsgBuyDirect) (*MsgBuyDirectResponse, error)
+	// Buy creates new buy orders. The funds of the buy orders are held in escrow
+	// until the buy orders are filled, cancelled or expire.
+	Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error)
+	// CancelBuyOrder cancels a buy order and returns the funds from escrow.
+	CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error)
 	// AddAllowedDenom is a governance method that allows the addition of
 	// new allowed denom.
 	// Since Revision 1
 	AddAllowedDenom(context.Context, *MsgAddAllowedDenom) (*MsgAddAllowedDenomResponse, error)
+	// RemoveAllowedDenom is a governance method that removes a denom from the
+	// list of allowed denoms. The quote requests of the denom are closed. The
+	// open sell orders, buy orders and auctions of the denom are either
+	// cancelled, returning the escrowed credits and funds to their owners, or
+	// kept until they expire. The markets of the denom are kept so that their
+	// settings apply again if the denom is allowed again.
+	RemoveAllowedDenom(context.Context, *MsgRemoveAllowedDenom) (*MsgRemoveAllowedDenomResponse, error)
+	// SetMarketClearingMode is a governance method that sets the clearing mode of
+	// the market for a credit type and an allowed denom.
+	SetMarketClearingMode(context.Context, *MsgSetMarketClearingMode) (*MsgSetMarketClearingModeResponse, error)
+	// SetMarketFees is a governance method that sets the buyer fee, the seller
+	// fee and the fee destination of the market for a credit type and an
+	// allowed denom.
+	SetMarketFees(context.Context, *MsgSetMarketFees) (*MsgSetMarketFeesResponse, error)
+	// CreateAuction creates a new dutch or english auction and moves the credits
+	// being auctioned into escrow. The auction is settled in the begin blocker
+	// once it ends.
+	CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error)
+	// Bid places a bid in an auction and moves the total cost of the bid and the
+	// buyer fee of the market into escrow. The escrowed funds of the previous
+	// highest bid of an english auction are returned to the bidder.
+	Bid(context.Context, *MsgBid) (*MsgBidResponse, error)
+	// CancelAuction cancels an auction that has not received any bids and
+	// returns the credits from escrow.
+	CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error)
+	// CreateQuoteRequest creates a new request for quote (RFQ) for a quantity of
+	// credits matching a selection of credits.
+	CreateQuoteRequest(context.Context, *MsgCreateQuoteRequest) (*MsgCreateQuoteRequestResponse, error)
+	// SubmitQuote submits a quote in response to a quote request and moves the
+	// quoted credits into escrow in a private sell order for the buyer of the
+	// quote request. The sell order of a quote cannot be updated and the quote
+	// request is closed when the sell order of a quote is filled.
+	SubmitQuote(context.Context, *MsgSubmitQuote) (*MsgSubmitQuoteResponse, error)
+	// AcceptQuote accepts a quote of a quote request, filling the sell order of
+	// the quote, and closes the quote request, returning the credits of the
+	// other quotes to their sellers.
+	AcceptQuote(context.Context, *MsgAcceptQuote) (*MsgAcceptQuoteResponse, error)
+	// CancelQuoteRequest cancels a quote request and returns the credits of its
+	// quotes to their sellers.
+	CancelQuoteRequest(context.Context, *MsgCancelQuoteRequest) (*MsgCancelQuoteRequestResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -124,9 +322,45 @@ func (UnimplementedMsgServer) CancelSellOrder(context.Context, *MsgCancelSellOrd
 func (UnimplementedMsgServer) BuyDirect(context.Context, *MsgBuyDirect) (*MsgBuyDirectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BuyDirect not implemented")
 }
+func (UnimplementedMsgServer) Buy(context.Context, *MsgBuy) (*MsgBuyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Buy not implemented")
+}
+func (UnimplementedMsgServer) CancelBuyOrder(context.Context, *MsgCancelBuyOrder) (*MsgCancelBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBuyOrder not implemented")
+}
 func (UnimplementedMsgServer) AddAllowedDenom(context.Context, *MsgAddAllowedDenom) (*MsgAddAllowedDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddAllowedDenom not implemented")
 }
+func (UnimplementedMsgServer) RemoveAllowedDenom(context.Context, *MsgRemoveAllowedDenom) (*MsgRemoveAllowedDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAllowedDenom not implemented")
+}
+func (UnimplementedMsgServer) SetMarketClearingMode(context.Context, *MsgSetMarketClearingMode) (*MsgSetMarketClearingModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketClearingMode not implemented")
+}
+func (UnimplementedMsgServer) SetMarketFees(context.Context, *MsgSetMarketFees) (*MsgSetMarketFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMarketFees not implemented")
+}
+func (UnimplementedMsgServer) CreateAuction(context.Context, *MsgCreateAuction) (*MsgCreateAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAuction not implemented")
+}
+func (UnimplementedMsgServer) Bid(context.Context, *MsgBid) (*MsgBidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bid not implemented")
+}
+func (UnimplementedMsgServer) CancelAuction(context.Context, *MsgCancelAuction) (*MsgCancelAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAuction not implemented")
+}
+func (UnimplementedMsgServer) CreateQuoteRequest(context.Context, *MsgCreateQuoteRequest) (*MsgCreateQuoteRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateQuoteRequest not implemented")
+}
+func (UnimplementedMsgServer) SubmitQuote(context.Context, *MsgSubmitQuote) (*MsgSubmitQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitQuote not implemented")
+}
+func (UnimplementedMsgServer) AcceptQuote(context.Context, *MsgAcceptQuote) (*MsgAcceptQuoteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptQuote not implemented")
+}
+func (UnimplementedMsgServer) CancelQuoteRequest(context.Context, *MsgCancelQuoteRequest) (*MsgCancelQuoteRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelQuoteRequest not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -212,6 +446,42 @@ func _Msg_BuyDirect_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Buy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBuy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Buy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/Buy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Buy(ctx, req.(*MsgBuy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelBuyOrder(ctx, req.(*MsgCancelBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddAllowedDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddAllowedDenom)
 	if err := dec(in); err != nil {
@@ -230,6 +500,186 @@ func _Msg_AddAllowedDenom_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RemoveAllowedDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveAllowedDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveAllowedDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/RemoveAllowedDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveAllowedDenom(ctx, req.(*MsgRemoveAllowedDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketClearingMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketClearingMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketClearingMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/SetMarketClearingMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketClearingMode(ctx, req.(*MsgSetMarketClearingMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMarketFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMarketFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMarketFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/SetMarketFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMarketFees(ctx, req.(*MsgSetMarketFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateAuction(ctx, req.(*MsgCreateAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Bid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Bid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/Bid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Bid(ctx, req.(*MsgBid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelAuction(ctx, req.(*MsgCancelAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateQuoteRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateQuoteRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CreateQuoteRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateQuoteRequest(ctx, req.(*MsgCreateQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitQuote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/SubmitQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitQuote(ctx, req.(*MsgSubmitQuote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptQuote_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptQuote)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptQuote(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/AcceptQuote",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptQuote(ctx, req.(*MsgAcceptQuote))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelQuoteRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelQuoteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelQuoteRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.marketplace.v1.Msg/CancelQuoteRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelQuoteRequest(ctx, req.(*MsgCancelQuoteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -253,10 +703,58 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "BuyDirect",
 			Handler:    _Msg_BuyDirect_Handler,
 		},
+		{
+			MethodName: "Buy",
+			Handler:    _Msg_Buy_Handler,
+		},
+		{
+			MethodName: "CancelBuyOrder",
+			Handler:    _Msg_CancelBuyOrder_Handler,
+		},
 		{
 			MethodName: "AddAllowedDenom",
 			Handler:    _Msg_AddAllowedDenom_Handler,
 		},
+		{
+			MethodName: "RemoveAllowedDenom",
+			Handler:    _Msg_RemoveAllowedDenom_Handler,
+		},
+		{
+			MethodName: "SetMarketClearingMode",
+			Handler:    _Msg_SetMarketClearingMode_Handler,
+		},
+		{
+			MethodName: "SetMarketFees",
+			Handler:    _Msg_SetMarketFees_Handler,
+		},
+		{
+			MethodName: "CreateAuction",
+			Handler:    _Msg_CreateAuction_Handler,
+		},
+		{
+			MethodName: "Bid",
+			Handler:    _Msg_Bid_Handler,
+		},
+		{
+			MethodName: "CancelAuction",
+			Handler:    _Msg_CancelAuction_Handler,
+		},
+		{
+			MethodName: "CreateQuoteRequest",
+			Handler:    _Msg_CreateQuoteRequest_Handler,
+		},
+		{
+			MethodName: "SubmitQuote",
+			Handler:    _Msg_SubmitQuote_Handler,
+		},
+		{
+			MethodName: "AcceptQuote",
+			Handler:    _Msg_AcceptQuote_Handler,
+		},
+		{
+			MethodName: "CancelQuoteRequest",
+			Handler:    _Msg_CancelQuoteRequest_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/marketplace/v1/tx.proto",
@@ -3075,11 +3075,14 @@ func (x *fastReflection_EventTransfer) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventRetire              protoreflect.MessageDescriptor
-	fd_EventRetire_owner        protoreflect.FieldDescriptor
-	fd_EventRetire_batch_denom  protoreflect.FieldDescriptor
-	fd_EventRetire_amount       protoreflect.FieldDescriptor
-	fd_EventRetire_jurisdiction protoreflect.FieldDescriptor
+	md_EventRetire               protoreflect.MessageDescriptor
+	fd_EventRetire_owner         protoreflect.FieldDescriptor
+	fd_EventRetire_batch_denom   protoreflect.FieldDescriptor
+	fd_EventRetire_amount        protoreflect.FieldDescriptor
+	fd_EventRetire_jurisdiction  protoreflect.FieldDescriptor
+	fd_EventRetire_retirement_id protoreflect.FieldDescriptor
+	fd_EventRetire_beneficiary   protoreflect.FieldDescriptor
+	fd_EventRetire_reason        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -3089,6 +3092,9 @@ func init() {
 	fd_EventRetire_batch_denom = md_EventRetire.Fields().ByName("batch_denom")
 	fd_EventRetire_amount = md_EventRetire.Fields().ByName("amount")
 	fd_EventRetire_jurisdiction = md_EventRetire.Fields().ByName("jurisdiction")
+	fd_EventRetire_retirement_id = md_EventRetire.Fields().ByName("retirement_id")
+	fd_EventRetire_beneficiary = md_EventRetire.Fields().ByName("beneficiary")
+	fd_EventRetire_reason = md_EventRetire.Fields().ByName("reason")
 }
 
 var _ protoreflect.Message = (*fastReflection_EventRetire)(nil)
@@ -3180,6 +3186,24 @@ func (x *fastReflection_EventRetire) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.RetirementId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RetirementId)
+		if !f(fd_EventRetire_retirement_id, value) {
+			return
+		}
+	}
+	if x.Beneficiary != "" {
+		value := protoreflect.ValueOfString(x.Beneficiary)
+		if !f(fd_EventRetire_beneficiary, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventRetire_reason, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3203,6 +3227,12 @@ func (x *fastReflection_EventRetire) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Amount != ""
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		return x.Jurisdiction != ""
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		return x.RetirementId != uint64(0)
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		return x.Beneficiary != ""
+	case "regen.ecocredit.v1.EventRetire.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3227,6 +3257,12 @@ func (x *fastReflection_EventRetire) Clear(fd protoreflect.FieldDescriptor) {
 		x.Amount = ""
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		x.Jurisdiction = ""
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		x.RetirementId = uint64(0)
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		x.Beneficiary = ""
+	case "regen.ecocredit.v1.EventRetire.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3255,6 +3291,15 @@ func (x *fastReflection_EventRetire) Get(descriptor protoreflect.FieldDescriptor
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		value := x.Jurisdiction
 		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		value := x.RetirementId
+		return protoreflect.ValueOfUint64(value)
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		value := x.Beneficiary
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventRetire.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3283,6 +3328,12 @@ func (x *fastReflection_EventRetire) Set(fd protoreflect.FieldDescriptor, value
 		x.Amount = value.Interface().(string)
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		x.Jurisdiction = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		x.RetirementId = value.Uint()
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		x.Beneficiary = value.Interface().(string)
+	case "regen.ecocredit.v1.EventRetire.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3311,6 +3362,12 @@ func (x *fastReflection_EventRetire) Mutable(fd protoreflect.FieldDescriptor) pr
 		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventRetire is not mutable"))
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		panic(fmt.Errorf("field jurisdiction of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		panic(fmt.Errorf("field retirement_id of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		panic(fmt.Errorf("field beneficiary of message regen.ecocredit.v1.EventRetire is not mutable"))
+	case "regen.ecocredit.v1.EventRetire.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventRetire is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3332,6 +3389,12 @@ func (x *fastReflection_EventRetire) NewField(fd protoreflect.FieldDescriptor) p
 		return protoreflect.ValueOfString("")
 	case "regen.ecocredit.v1.EventRetire.jurisdiction":
 		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRetire.retirement_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "regen.ecocredit.v1.EventRetire.beneficiary":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventRetire.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventRetire"))
@@ -3417,6 +3480,17 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.RetirementId != 0 {
+			n += 1 + runtime.Sov(uint64(x.RetirementId))
+		}
+		l = len(x.Beneficiary)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3446,6 +3520,25 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Beneficiary) > 0 {
+			i -= len(x.Beneficiary)
+			copy(dAtA[i:], x.Beneficiary)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Beneficiary)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.RetirementId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RetirementId))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.Jurisdiction) > 0 {
 			i -= len(x.Jurisdiction)
 			copy(dAtA[i:], x.Jurisdiction)
@@ -3651,6 +3744,89 @@ func (x *fastReflection_EventRetire) ProtoMethods() *protoiface.Methods {
 				}
 				x.Jurisdiction = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RetirementId", wireType)
+				}
+				x.RetirementId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RetirementId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Beneficiary = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4299,25 +4475,33 @@ func (x *fastReflection_EventCancel) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventUpdateClassAdmin          protoreflect.MessageDescriptor
-	fd_EventUpdateClassAdmin_class_id protoreflect.FieldDescriptor
+	md_EventReverse             protoreflect.MessageDescriptor
+	fd_EventReverse_owner       protoreflect.FieldDescriptor
+	fd_EventReverse_batch_denom protoreflect.FieldDescriptor
+	fd_EventReverse_amount      protoreflect.FieldDescriptor
+	fd_EventReverse_buffer      protoreflect.FieldDescriptor
+	fd_EventReverse_reason      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventUpdateClassAdmin = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassAdmin")
-	fd_EventUpdateClassAdmin_class_id = md_EventUpdateClassAdmin.Fields().ByName("class_id")
+	md_EventReverse = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventReverse")
+	fd_EventReverse_owner = md_EventReverse.Fields().ByName("owner")
+	fd_EventReverse_batch_denom = md_EventReverse.Fields().ByName("batch_denom")
+	fd_EventReverse_amount = md_EventReverse.Fields().ByName("amount")
+	fd_EventReverse_buffer = md_EventReverse.Fields().ByName("buffer")
+	fd_EventReverse_reason = md_EventReverse.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventUpdateClassAdmin)(nil)
+var _ protoreflect.Message = (*fastReflection_EventReverse)(nil)
 
-type fastReflection_EventUpdateClassAdmin EventUpdateClassAdmin
+type fastReflection_EventReverse EventReverse
 
-func (x *EventUpdateClassAdmin) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassAdmin)(x)
+func (x *EventReverse) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventReverse)(x)
 }
 
-func (x *EventUpdateClassAdmin) slowProtoReflect() protoreflect.Message {
+func (x *EventReverse) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4329,43 +4513,43 @@ func (x *EventUpdateClassAdmin) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventUpdateClassAdmin_messageType fastReflection_EventUpdateClassAdmin_messageType
-var _ protoreflect.MessageType = fastReflection_EventUpdateClassAdmin_messageType{}
+var _fastReflection_EventReverse_messageType fastReflection_EventReverse_messageType
+var _ protoreflect.MessageType = fastReflection_EventReverse_messageType{}
 
-type fastReflection_EventUpdateClassAdmin_messageType struct{}
+type fastReflection_EventReverse_messageType struct{}
 
-func (x fastReflection_EventUpdateClassAdmin_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassAdmin)(nil)
+func (x fastReflection_EventReverse_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventReverse)(nil)
 }
-func (x fastReflection_EventUpdateClassAdmin_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassAdmin)
+func (x fastReflection_EventReverse_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventReverse)
 }
-func (x fastReflection_EventUpdateClassAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassAdmin
+func (x fastReflection_EventReverse_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReverse
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventUpdateClassAdmin) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassAdmin
+func (x *fastReflection_EventReverse) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventReverse
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventUpdateClassAdmin) Type() protoreflect.MessageType {
-	return _fastReflection_EventUpdateClassAdmin_messageType
+func (x *fastReflection_EventReverse) Type() protoreflect.MessageType {
+	return _fastReflection_EventReverse_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventUpdateClassAdmin) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassAdmin)
+func (x *fastReflection_EventReverse) New() protoreflect.Message {
+	return new(fastReflection_EventReverse)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventUpdateClassAdmin) Interface() protoreflect.ProtoMessage {
-	return (*EventUpdateClassAdmin)(x)
+func (x *fastReflection_EventReverse) Interface() protoreflect.ProtoMessage {
+	return (*EventReverse)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4373,10 +4557,34 @@ func (x *fastReflection_EventUpdateClassAdmin) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventUpdateClassAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ClassId != "" {
-		value := protoreflect.ValueOfString(x.ClassId)
-		if !f(fd_EventUpdateClassAdmin_class_id, value) {
+func (x *fastReflection_EventReverse) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Owner != "" {
+		value := protoreflect.ValueOfString(x.Owner)
+		if !f(fd_EventReverse_owner, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventReverse_batch_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventReverse_amount, value) {
+			return
+		}
+	}
+	if x.Buffer != false {
+		value := protoreflect.ValueOfBool(x.Buffer)
+		if !f(fd_EventReverse_buffer, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventReverse_reason, value) {
 			return
 		}
 	}
@@ -4393,15 +4601,23 @@ func (x *fastReflection_EventUpdateClassAdmin) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventUpdateClassAdmin) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventReverse) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
-		return x.ClassId != ""
+	case "regen.ecocredit.v1.EventReverse.owner":
+		return x.Owner != ""
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventReverse.amount":
+		return x.Amount != ""
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		return x.Buffer != false
+	case "regen.ecocredit.v1.EventReverse.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4411,15 +4627,23 @@ func (x *fastReflection_EventUpdateClassAdmin) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassAdmin) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventReverse) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
-		x.ClassId = ""
+	case "regen.ecocredit.v1.EventReverse.owner":
+		x.Owner = ""
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventReverse.amount":
+		x.Amount = ""
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		x.Buffer = false
+	case "regen.ecocredit.v1.EventReverse.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4429,16 +4653,28 @@ func (x *fastReflection_EventUpdateClassAdmin) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventUpdateClassAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventReverse) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
-		value := x.ClassId
+	case "regen.ecocredit.v1.EventReverse.owner":
+		value := x.Owner
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReverse.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		value := x.Buffer
+		return protoreflect.ValueOfBool(value)
+	case "regen.ecocredit.v1.EventReverse.reason":
+		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4452,15 +4688,23 @@ func (x *fastReflection_EventUpdateClassAdmin) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventReverse) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
-		x.ClassId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReverse.owner":
+		x.Owner = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReverse.amount":
+		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		x.Buffer = value.Bool()
+	case "regen.ecocredit.v1.EventReverse.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4474,40 +4718,56 @@ func (x *fastReflection_EventUpdateClassAdmin) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventReverse) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
-		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassAdmin is not mutable"))
+	case "regen.ecocredit.v1.EventReverse.owner":
+		panic(fmt.Errorf("field owner of message regen.ecocredit.v1.EventReverse is not mutable"))
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventReverse is not mutable"))
+	case "regen.ecocredit.v1.EventReverse.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventReverse is not mutable"))
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		panic(fmt.Errorf("field buffer of message regen.ecocredit.v1.EventReverse is not mutable"))
+	case "regen.ecocredit.v1.EventReverse.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventReverse is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventUpdateClassAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventReverse) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
+	case "regen.ecocredit.v1.EventReverse.owner":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReverse.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReverse.amount":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventReverse.buffer":
+		return protoreflect.ValueOfBool(false)
+	case "regen.ecocredit.v1.EventReverse.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventReverse"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventReverse does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventUpdateClassAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventReverse) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassAdmin", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventReverse", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4515,7 +4775,7 @@ func (x *fastReflection_EventUpdateClassAdmin) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventUpdateClassAdmin) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventReverse) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4526,7 +4786,7 @@ func (x *fastReflection_EventUpdateClassAdmin) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassAdmin) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventReverse) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4538,7 +4798,7 @@ func (x *fastReflection_EventUpdateClassAdmin) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventUpdateClassAdmin) IsValid() bool {
+func (x *fastReflection_EventReverse) IsValid() bool {
 	return x != nil
 }
 
@@ -4548,9 +4808,9 @@ func (x *fastReflection_EventUpdateClassAdmin) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventReverse) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventUpdateClassAdmin)
+		x := input.Message.Interface().(*EventReverse)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4562,7 +4822,22 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.ClassId)
+		l = len(x.Owner)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Buffer {
+			n += 2
+		}
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4576,7 +4851,7 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassAdmin)
+		x := input.Message.Interface().(*EventReverse)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4595,25 +4870,56 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ClassId) > 0 {
-			i -= len(x.ClassId)
-			copy(dAtA[i:], x.ClassId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x2a
 		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
+		if x.Buffer {
+			i--
+			if x.Buffer {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
 		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Owner) > 0 {
+			i -= len(x.Owner)
+			copy(dAtA[i:], x.Owner)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Owner)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassAdmin)
+		x := input.Message.Interface().(*EventReverse)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4645,15 +4951,15 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassAdmin: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReverse: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventReverse: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4681,7 +4987,123 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ClassId = string(dAtA[iNdEx:postIndex])
+				x.Owner = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Buffer", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Buffer = bool(v != 0)
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4719,25 +5141,25 @@ func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_EventUpdateClassIssuers          protoreflect.MessageDescriptor
-	fd_EventUpdateClassIssuers_class_id protoreflect.FieldDescriptor
+	md_EventUpdateClassAdmin          protoreflect.MessageDescriptor
+	fd_EventUpdateClassAdmin_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventUpdateClassIssuers = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassIssuers")
-	fd_EventUpdateClassIssuers_class_id = md_EventUpdateClassIssuers.Fields().ByName("class_id")
+	md_EventUpdateClassAdmin = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassAdmin")
+	fd_EventUpdateClassAdmin_class_id = md_EventUpdateClassAdmin.Fields().ByName("class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventUpdateClassIssuers)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassAdmin)(nil)
 
-type fastReflection_EventUpdateClassIssuers EventUpdateClassIssuers
+type fastReflection_EventUpdateClassAdmin EventUpdateClassAdmin
 
-func (x *EventUpdateClassIssuers) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassIssuers)(x)
+func (x *EventUpdateClassAdmin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassAdmin)(x)
 }
 
-func (x *EventUpdateClassIssuers) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateClassAdmin) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4749,43 +5171,43 @@ func (x *EventUpdateClassIssuers) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventUpdateClassIssuers_messageType fastReflection_EventUpdateClassIssuers_messageType
-var _ protoreflect.MessageType = fastReflection_EventUpdateClassIssuers_messageType{}
+var _fastReflection_EventUpdateClassAdmin_messageType fastReflection_EventUpdateClassAdmin_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassAdmin_messageType{}
 
-type fastReflection_EventUpdateClassIssuers_messageType struct{}
+type fastReflection_EventUpdateClassAdmin_messageType struct{}
 
-func (x fastReflection_EventUpdateClassIssuers_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassIssuers)(nil)
+func (x fastReflection_EventUpdateClassAdmin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassAdmin)(nil)
 }
-func (x fastReflection_EventUpdateClassIssuers_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassIssuers)
+func (x fastReflection_EventUpdateClassAdmin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassAdmin)
 }
-func (x fastReflection_EventUpdateClassIssuers_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassIssuers
+func (x fastReflection_EventUpdateClassAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassAdmin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventUpdateClassIssuers) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassIssuers
+func (x *fastReflection_EventUpdateClassAdmin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassAdmin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventUpdateClassIssuers) Type() protoreflect.MessageType {
-	return _fastReflection_EventUpdateClassIssuers_messageType
+func (x *fastReflection_EventUpdateClassAdmin) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassAdmin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventUpdateClassIssuers) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassIssuers)
+func (x *fastReflection_EventUpdateClassAdmin) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassAdmin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventUpdateClassIssuers) Interface() protoreflect.ProtoMessage {
-	return (*EventUpdateClassIssuers)(x)
+func (x *fastReflection_EventUpdateClassAdmin) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassAdmin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4793,10 +5215,10 @@ func (x *fastReflection_EventUpdateClassIssuers) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventUpdateClassIssuers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventUpdateClassAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ClassId != "" {
 		value := protoreflect.ValueOfString(x.ClassId)
-		if !f(fd_EventUpdateClassIssuers_class_id, value) {
+		if !f(fd_EventUpdateClassAdmin_class_id, value) {
 			return
 		}
 	}
@@ -4813,15 +5235,15 @@ func (x *fastReflection_EventUpdateClassIssuers) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventUpdateClassIssuers) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateClassAdmin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
 		return x.ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4831,15 +5253,15 @@ func (x *fastReflection_EventUpdateClassIssuers) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassIssuers) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateClassAdmin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
 		x.ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4849,16 +5271,16 @@ func (x *fastReflection_EventUpdateClassIssuers) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventUpdateClassIssuers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
 		value := x.ClassId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4872,15 +5294,15 @@ func (x *fastReflection_EventUpdateClassIssuers) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassIssuers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateClassAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
 		x.ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4894,40 +5316,40 @@ func (x *fastReflection_EventUpdateClassIssuers) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassIssuers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
-		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassIssuers is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassAdmin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventUpdateClassIssuers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassAdmin.class_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventUpdateClassIssuers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateClassAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassIssuers", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassAdmin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4935,7 +5357,7 @@ func (x *fastReflection_EventUpdateClassIssuers) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventUpdateClassIssuers) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateClassAdmin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4946,7 +5368,7 @@ func (x *fastReflection_EventUpdateClassIssuers) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassIssuers) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateClassAdmin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4958,7 +5380,7 @@ func (x *fastReflection_EventUpdateClassIssuers) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventUpdateClassIssuers) IsValid() bool {
+func (x *fastReflection_EventUpdateClassAdmin) IsValid() bool {
 	return x != nil
 }
 
@@ -4968,9 +5390,9 @@ func (x *fastReflection_EventUpdateClassIssuers) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateClassAdmin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventUpdateClassIssuers)
+		x := input.Message.Interface().(*EventUpdateClassAdmin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4996,7 +5418,7 @@ func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassIssuers)
+		x := input.Message.Interface().(*EventUpdateClassAdmin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5033,7 +5455,7 @@ func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassIssuers)
+		x := input.Message.Interface().(*EventUpdateClassAdmin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5065,10 +5487,10 @@ func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassIssuers: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassAdmin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassIssuers: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5139,25 +5561,25 @@ func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_EventUpdateClassMetadata          protoreflect.MessageDescriptor
-	fd_EventUpdateClassMetadata_class_id protoreflect.FieldDescriptor
+	md_EventUpdateClassIssuers          protoreflect.MessageDescriptor
+	fd_EventUpdateClassIssuers_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventUpdateClassMetadata = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassMetadata")
-	fd_EventUpdateClassMetadata_class_id = md_EventUpdateClassMetadata.Fields().ByName("class_id")
+	md_EventUpdateClassIssuers = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassIssuers")
+	fd_EventUpdateClassIssuers_class_id = md_EventUpdateClassIssuers.Fields().ByName("class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventUpdateClassMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassIssuers)(nil)
 
-type fastReflection_EventUpdateClassMetadata EventUpdateClassMetadata
+type fastReflection_EventUpdateClassIssuers EventUpdateClassIssuers
 
-func (x *EventUpdateClassMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassMetadata)(x)
+func (x *EventUpdateClassIssuers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassIssuers)(x)
 }
 
-func (x *EventUpdateClassMetadata) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateClassIssuers) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5169,43 +5591,43 @@ func (x *EventUpdateClassMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventUpdateClassMetadata_messageType fastReflection_EventUpdateClassMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_EventUpdateClassMetadata_messageType{}
+var _fastReflection_EventUpdateClassIssuers_messageType fastReflection_EventUpdateClassIssuers_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassIssuers_messageType{}
 
-type fastReflection_EventUpdateClassMetadata_messageType struct{}
+type fastReflection_EventUpdateClassIssuers_messageType struct{}
 
-func (x fastReflection_EventUpdateClassMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventUpdateClassMetadata)(nil)
+func (x fastReflection_EventUpdateClassIssuers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassIssuers)(nil)
 }
-func (x fastReflection_EventUpdateClassMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassMetadata)
+func (x fastReflection_EventUpdateClassIssuers_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassIssuers)
 }
-func (x fastReflection_EventUpdateClassMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassMetadata
+func (x fastReflection_EventUpdateClassIssuers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassIssuers
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventUpdateClassMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateClassMetadata
+func (x *fastReflection_EventUpdateClassIssuers) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassIssuers
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventUpdateClassMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_EventUpdateClassMetadata_messageType
+func (x *fastReflection_EventUpdateClassIssuers) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassIssuers_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventUpdateClassMetadata) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateClassMetadata)
+func (x *fastReflection_EventUpdateClassIssuers) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassIssuers)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventUpdateClassMetadata) Interface() protoreflect.ProtoMessage {
-	return (*EventUpdateClassMetadata)(x)
+func (x *fastReflection_EventUpdateClassIssuers) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassIssuers)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5213,10 +5635,10 @@ func (x *fastReflection_EventUpdateClassMetadata) Interface() protoreflect.Proto
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventUpdateClassMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventUpdateClassIssuers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ClassId != "" {
 		value := protoreflect.ValueOfString(x.ClassId)
-		if !f(fd_EventUpdateClassMetadata_class_id, value) {
+		if !f(fd_EventUpdateClassIssuers_class_id, value) {
 			return
 		}
 	}
@@ -5233,15 +5655,15 @@ func (x *fastReflection_EventUpdateClassMetadata) Range(f func(protoreflect.Fiel
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventUpdateClassMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateClassIssuers) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
 		return x.ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5251,15 +5673,15 @@ func (x *fastReflection_EventUpdateClassMetadata) Has(fd protoreflect.FieldDescr
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateClassIssuers) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
 		x.ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5269,16 +5691,16 @@ func (x *fastReflection_EventUpdateClassMetadata) Clear(fd protoreflect.FieldDes
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventUpdateClassMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassIssuers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
 		value := x.ClassId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5292,15 +5714,15 @@ func (x *fastReflection_EventUpdateClassMetadata) Get(descriptor protoreflect.Fi
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateClassIssuers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
 		x.ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5314,40 +5736,40 @@ func (x *fastReflection_EventUpdateClassMetadata) Set(fd protoreflect.FieldDescr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassIssuers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
-		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassMetadata is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassIssuers is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventUpdateClassMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassIssuers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+	case "regen.ecocredit.v1.EventUpdateClassIssuers.class_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassIssuers"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassIssuers does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventUpdateClassMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateClassIssuers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassIssuers", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5355,7 +5777,7 @@ func (x *fastReflection_EventUpdateClassMetadata) WhichOneof(d protoreflect.Oneo
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventUpdateClassMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateClassIssuers) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5366,7 +5788,7 @@ func (x *fastReflection_EventUpdateClassMetadata) GetUnknown() protoreflect.RawF
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateClassMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateClassIssuers) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5378,7 +5800,7 @@ func (x *fastReflection_EventUpdateClassMetadata) SetUnknown(fields protoreflect
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventUpdateClassMetadata) IsValid() bool {
+func (x *fastReflection_EventUpdateClassIssuers) IsValid() bool {
 	return x != nil
 }
 
@@ -5388,9 +5810,9 @@ func (x *fastReflection_EventUpdateClassMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateClassIssuers) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventUpdateClassMetadata)
+		x := input.Message.Interface().(*EventUpdateClassIssuers)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5416,7 +5838,7 @@ func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Met
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassMetadata)
+		x := input.Message.Interface().(*EventUpdateClassIssuers)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5453,7 +5875,7 @@ func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Met
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateClassMetadata)
+		x := input.Message.Interface().(*EventUpdateClassIssuers)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5485,10 +5907,10 @@ func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Met
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassMetadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassIssuers: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassIssuers: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -5559,25 +5981,25 @@ func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_EventUpdateProjectAdmin            protoreflect.MessageDescriptor
-	fd_EventUpdateProjectAdmin_project_id protoreflect.FieldDescriptor
+	md_EventUpdateClassMetadata          protoreflect.MessageDescriptor
+	fd_EventUpdateClassMetadata_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventUpdateProjectAdmin = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectAdmin")
-	fd_EventUpdateProjectAdmin_project_id = md_EventUpdateProjectAdmin.Fields().ByName("project_id")
+	md_EventUpdateClassMetadata = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassMetadata")
+	fd_EventUpdateClassMetadata_class_id = md_EventUpdateClassMetadata.Fields().ByName("class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventUpdateProjectAdmin)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassMetadata)(nil)
 
-type fastReflection_EventUpdateProjectAdmin EventUpdateProjectAdmin
+type fastReflection_EventUpdateClassMetadata EventUpdateClassMetadata
 
-func (x *EventUpdateProjectAdmin) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventUpdateProjectAdmin)(x)
+func (x *EventUpdateClassMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassMetadata)(x)
 }
 
-func (x *EventUpdateProjectAdmin) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateClassMetadata) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5589,43 +6011,43 @@ func (x *EventUpdateProjectAdmin) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventUpdateProjectAdmin_messageType fastReflection_EventUpdateProjectAdmin_messageType
-var _ protoreflect.MessageType = fastReflection_EventUpdateProjectAdmin_messageType{}
+var _fastReflection_EventUpdateClassMetadata_messageType fastReflection_EventUpdateClassMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassMetadata_messageType{}
 
-type fastReflection_EventUpdateProjectAdmin_messageType struct{}
+type fastReflection_EventUpdateClassMetadata_messageType struct{}
 
-func (x fastReflection_EventUpdateProjectAdmin_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventUpdateProjectAdmin)(nil)
+func (x fastReflection_EventUpdateClassMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassMetadata)(nil)
 }
-func (x fastReflection_EventUpdateProjectAdmin_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateProjectAdmin)
+func (x fastReflection_EventUpdateClassMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassMetadata)
 }
-func (x fastReflection_EventUpdateProjectAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateProjectAdmin
+func (x fastReflection_EventUpdateClassMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventUpdateProjectAdmin) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateProjectAdmin
+func (x *fastReflection_EventUpdateClassMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventUpdateProjectAdmin) Type() protoreflect.MessageType {
-	return _fastReflection_EventUpdateProjectAdmin_messageType
+func (x *fastReflection_EventUpdateClassMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventUpdateProjectAdmin) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateProjectAdmin)
+func (x *fastReflection_EventUpdateClassMetadata) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventUpdateProjectAdmin) Interface() protoreflect.ProtoMessage {
-	return (*EventUpdateProjectAdmin)(x)
+func (x *fastReflection_EventUpdateClassMetadata) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5633,10 +6055,10 @@ func (x *fastReflection_EventUpdateProjectAdmin) Interface() protoreflect.ProtoM
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventUpdateProjectAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProjectId != "" {
-		value := protoreflect.ValueOfString(x.ProjectId)
-		if !f(fd_EventUpdateProjectAdmin_project_id, value) {
+func (x *fastReflection_EventUpdateClassMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventUpdateClassMetadata_class_id, value) {
 			return
 		}
 	}
@@ -5653,15 +6075,15 @@ func (x *fastReflection_EventUpdateProjectAdmin) Range(f func(protoreflect.Field
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventUpdateProjectAdmin) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateClassMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
-		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+		return x.ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5671,15 +6093,15 @@ func (x *fastReflection_EventUpdateProjectAdmin) Has(fd protoreflect.FieldDescri
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectAdmin) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateClassMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
-		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+		x.ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5689,16 +6111,16 @@ func (x *fastReflection_EventUpdateProjectAdmin) Clear(fd protoreflect.FieldDesc
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventUpdateProjectAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
-		value := x.ProjectId
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+		value := x.ClassId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5712,15 +6134,15 @@ func (x *fastReflection_EventUpdateProjectAdmin) Get(descriptor protoreflect.Fie
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateClassMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
-		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+		x.ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5734,40 +6156,40 @@ func (x *fastReflection_EventUpdateProjectAdmin) Set(fd protoreflect.FieldDescri
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
-		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectAdmin is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventUpdateProjectAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+	case "regen.ecocredit.v1.EventUpdateClassMetadata.class_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventUpdateProjectAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateClassMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectAdmin", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5775,7 +6197,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) WhichOneof(d protoreflect.Oneof
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventUpdateProjectAdmin) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateClassMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5786,7 +6208,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) GetUnknown() protoreflect.RawFi
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectAdmin) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateClassMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5798,7 +6220,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) SetUnknown(fields protoreflect.
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventUpdateProjectAdmin) IsValid() bool {
+func (x *fastReflection_EventUpdateClassMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -5808,9 +6230,9 @@ func (x *fastReflection_EventUpdateProjectAdmin) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateClassMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventUpdateProjectAdmin)
+		x := input.Message.Interface().(*EventUpdateClassMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5822,7 +6244,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 		var n int
 		var l int
 		_ = l
-		l = len(x.ProjectId)
+		l = len(x.ClassId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5836,7 +6258,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateProjectAdmin)
+		x := input.Message.Interface().(*EventUpdateClassMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5855,10 +6277,10 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ProjectId) > 0 {
-			i -= len(x.ProjectId)
-			copy(dAtA[i:], x.ProjectId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5873,7 +6295,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateProjectAdmin)
+		x := input.Message.Interface().(*EventUpdateClassMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5905,15 +6327,15 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectAdmin: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5941,7 +6363,7 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				x.ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5979,25 +6401,25 @@ func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Meth
 }
 
 var (
-	md_EventUpdateProjectMetadata            protoreflect.MessageDescriptor
-	fd_EventUpdateProjectMetadata_project_id protoreflect.FieldDescriptor
+	md_EventUpdateClassBuffer          protoreflect.MessageDescriptor
+	fd_EventUpdateClassBuffer_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventUpdateProjectMetadata = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectMetadata")
-	fd_EventUpdateProjectMetadata_project_id = md_EventUpdateProjectMetadata.Fields().ByName("project_id")
+	md_EventUpdateClassBuffer = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassBuffer")
+	fd_EventUpdateClassBuffer_class_id = md_EventUpdateClassBuffer.Fields().ByName("class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventUpdateProjectMetadata)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassBuffer)(nil)
 
-type fastReflection_EventUpdateProjectMetadata EventUpdateProjectMetadata
+type fastReflection_EventUpdateClassBuffer EventUpdateClassBuffer
 
-func (x *EventUpdateProjectMetadata) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventUpdateProjectMetadata)(x)
+func (x *EventUpdateClassBuffer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassBuffer)(x)
 }
 
-func (x *EventUpdateProjectMetadata) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateClassBuffer) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6009,43 +6431,43 @@ func (x *EventUpdateProjectMetadata) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventUpdateProjectMetadata_messageType fastReflection_EventUpdateProjectMetadata_messageType
-var _ protoreflect.MessageType = fastReflection_EventUpdateProjectMetadata_messageType{}
+var _fastReflection_EventUpdateClassBuffer_messageType fastReflection_EventUpdateClassBuffer_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassBuffer_messageType{}
 
-type fastReflection_EventUpdateProjectMetadata_messageType struct{}
+type fastReflection_EventUpdateClassBuffer_messageType struct{}
 
-func (x fastReflection_EventUpdateProjectMetadata_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventUpdateProjectMetadata)(nil)
+func (x fastReflection_EventUpdateClassBuffer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassBuffer)(nil)
 }
-func (x fastReflection_EventUpdateProjectMetadata_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateProjectMetadata)
+func (x fastReflection_EventUpdateClassBuffer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassBuffer)
 }
-func (x fastReflection_EventUpdateProjectMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateProjectMetadata
+func (x fastReflection_EventUpdateClassBuffer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassBuffer
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventUpdateProjectMetadata) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventUpdateProjectMetadata
+func (x *fastReflection_EventUpdateClassBuffer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassBuffer
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventUpdateProjectMetadata) Type() protoreflect.MessageType {
-	return _fastReflection_EventUpdateProjectMetadata_messageType
+func (x *fastReflection_EventUpdateClassBuffer) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassBuffer_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventUpdateProjectMetadata) New() protoreflect.Message {
-	return new(fastReflection_EventUpdateProjectMetadata)
+func (x *fastReflection_EventUpdateClassBuffer) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassBuffer)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventUpdateProjectMetadata) Interface() protoreflect.ProtoMessage {
-	return (*EventUpdateProjectMetadata)(x)
+func (x *fastReflection_EventUpdateClassBuffer) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassBuffer)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6053,10 +6475,10 @@ func (x *fastReflection_EventUpdateProjectMetadata) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventUpdateProjectMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.ProjectId != "" {
-		value := protoreflect.ValueOfString(x.ProjectId)
-		if !f(fd_EventUpdateProjectMetadata_project_id, value) {
+func (x *fastReflection_EventUpdateClassBuffer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventUpdateClassBuffer_class_id, value) {
 			return
 		}
 	}
@@ -6073,15 +6495,15 @@ func (x *fastReflection_EventUpdateProjectMetadata) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventUpdateProjectMetadata) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateClassBuffer) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
-		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		return x.ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6091,15 +6513,15 @@ func (x *fastReflection_EventUpdateProjectMetadata) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectMetadata) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateClassBuffer) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
-		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		x.ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6109,16 +6531,16 @@ func (x *fastReflection_EventUpdateProjectMetadata) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventUpdateProjectMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassBuffer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
-		value := x.ProjectId
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		value := x.ClassId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6132,15 +6554,15 @@ func (x *fastReflection_EventUpdateProjectMetadata) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateClassBuffer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
-		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		x.ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6154,40 +6576,40 @@ func (x *fastReflection_EventUpdateProjectMetadata) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassBuffer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
-		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectMetadata is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassBuffer is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventUpdateProjectMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassBuffer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+	case "regen.ecocredit.v1.EventUpdateClassBuffer.class_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassBuffer"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassBuffer does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventUpdateProjectMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateClassBuffer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectMetadata", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassBuffer", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6195,7 +6617,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventUpdateProjectMetadata) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateClassBuffer) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6206,7 +6628,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventUpdateProjectMetadata) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateClassBuffer) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6218,7 +6640,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventUpdateProjectMetadata) IsValid() bool {
+func (x *fastReflection_EventUpdateClassBuffer) IsValid() bool {
 	return x != nil
 }
 
@@ -6228,9 +6650,9 @@ func (x *fastReflection_EventUpdateProjectMetadata) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateClassBuffer) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventUpdateProjectMetadata)
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6242,7 +6664,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.ProjectId)
+		l = len(x.ClassId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6256,7 +6678,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateProjectMetadata)
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6275,10 +6697,10 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ProjectId) > 0 {
-			i -= len(x.ProjectId)
-			copy(dAtA[i:], x.ProjectId)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6293,7 +6715,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventUpdateProjectMetadata)
+		x := input.Message.Interface().(*EventUpdateClassBuffer)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6325,15 +6747,15 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectMetadata: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassBuffer: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassBuffer: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6361,7 +6783,7 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				x.ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6399,25 +6821,25 @@ func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_EventSealBatch             protoreflect.MessageDescriptor
-	fd_EventSealBatch_batch_denom protoreflect.FieldDescriptor
+	md_EventUpdateProjectAdmin            protoreflect.MessageDescriptor
+	fd_EventUpdateProjectAdmin_project_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventSealBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventSealBatch")
-	fd_EventSealBatch_batch_denom = md_EventSealBatch.Fields().ByName("batch_denom")
+	md_EventUpdateProjectAdmin = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectAdmin")
+	fd_EventUpdateProjectAdmin_project_id = md_EventUpdateProjectAdmin.Fields().ByName("project_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventSealBatch)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectAdmin)(nil)
 
-type fastReflection_EventSealBatch EventSealBatch
+type fastReflection_EventUpdateProjectAdmin EventUpdateProjectAdmin
 
-func (x *EventSealBatch) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventSealBatch)(x)
+func (x *EventUpdateProjectAdmin) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectAdmin)(x)
 }
 
-func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateProjectAdmin) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6429,43 +6851,43 @@ func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventSealBatch_messageType fastReflection_EventSealBatch_messageType
-var _ protoreflect.MessageType = fastReflection_EventSealBatch_messageType{}
+var _fastReflection_EventUpdateProjectAdmin_messageType fastReflection_EventUpdateProjectAdmin_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectAdmin_messageType{}
 
-type fastReflection_EventSealBatch_messageType struct{}
+type fastReflection_EventUpdateProjectAdmin_messageType struct{}
 
-func (x fastReflection_EventSealBatch_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventSealBatch)(nil)
+func (x fastReflection_EventUpdateProjectAdmin_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectAdmin)(nil)
 }
-func (x fastReflection_EventSealBatch_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventSealBatch)
+func (x fastReflection_EventUpdateProjectAdmin_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectAdmin)
 }
-func (x fastReflection_EventSealBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSealBatch
+func (x fastReflection_EventUpdateProjectAdmin_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectAdmin
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventSealBatch) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventSealBatch
+func (x *fastReflection_EventUpdateProjectAdmin) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectAdmin
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventSealBatch) Type() protoreflect.MessageType {
-	return _fastReflection_EventSealBatch_messageType
+func (x *fastReflection_EventUpdateProjectAdmin) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectAdmin_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventSealBatch) New() protoreflect.Message {
-	return new(fastReflection_EventSealBatch)
+func (x *fastReflection_EventUpdateProjectAdmin) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectAdmin)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
-	return (*EventSealBatch)(x)
+func (x *fastReflection_EventUpdateProjectAdmin) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectAdmin)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6473,10 +6895,10 @@ func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.BatchDenom != "" {
-		value := protoreflect.ValueOfString(x.BatchDenom)
-		if !f(fd_EventSealBatch_batch_denom, value) {
+func (x *fastReflection_EventUpdateProjectAdmin) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventUpdateProjectAdmin_project_id, value) {
 			return
 		}
 	}
@@ -6493,15 +6915,15 @@ func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateProjectAdmin) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+		return x.ProjectId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6511,15 +6933,15 @@ func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateProjectAdmin) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+		x.ProjectId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6529,16 +6951,16 @@ func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectAdmin) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		value := x.BatchDenom
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+		value := x.ProjectId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6552,15 +6974,15 @@ func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateProjectAdmin) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+		x.ProjectId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6574,40 +6996,40 @@ func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectAdmin) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
-		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventSealBatch is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectAdmin is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventSealBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectAdmin) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateProjectAdmin.project_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectAdmin"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectAdmin does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateProjectAdmin) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventSealBatch", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectAdmin", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6615,7 +7037,7 @@ func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateProjectAdmin) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6626,7 +7048,7 @@ func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateProjectAdmin) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6638,7 +7060,7 @@ func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventSealBatch) IsValid() bool {
+func (x *fastReflection_EventUpdateProjectAdmin) IsValid() bool {
 	return x != nil
 }
 
@@ -6648,9 +7070,9 @@ func (x *fastReflection_EventSealBatch) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateProjectAdmin) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateProjectAdmin)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6662,7 +7084,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.BatchDenom)
+		l = len(x.ProjectId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -6676,7 +7098,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateProjectAdmin)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6695,10 +7117,10 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BatchDenom) > 0 {
-			i -= len(x.BatchDenom)
-			copy(dAtA[i:], x.BatchDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -6713,7 +7135,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventSealBatch)
+		x := input.Message.Interface().(*EventUpdateProjectAdmin)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6745,15 +7167,15 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectAdmin: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectAdmin: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6781,7 +7203,7 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6819,25 +7241,25 @@ func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventAddCreditType              protoreflect.MessageDescriptor
-	fd_EventAddCreditType_abbreviation protoreflect.FieldDescriptor
+	md_EventUpdateProjectMetadata            protoreflect.MessageDescriptor
+	fd_EventUpdateProjectMetadata_project_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventAddCreditType = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAddCreditType")
-	fd_EventAddCreditType_abbreviation = md_EventAddCreditType.Fields().ByName("abbreviation")
+	md_EventUpdateProjectMetadata = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectMetadata")
+	fd_EventUpdateProjectMetadata_project_id = md_EventUpdateProjectMetadata.Fields().ByName("project_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventAddCreditType)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectMetadata)(nil)
 
-type fastReflection_EventAddCreditType EventAddCreditType
+type fastReflection_EventUpdateProjectMetadata EventUpdateProjectMetadata
 
-func (x *EventAddCreditType) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventAddCreditType)(x)
+func (x *EventUpdateProjectMetadata) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectMetadata)(x)
 }
 
-func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateProjectMetadata) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6849,43 +7271,43 @@ func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventAddCreditType_messageType fastReflection_EventAddCreditType_messageType
-var _ protoreflect.MessageType = fastReflection_EventAddCreditType_messageType{}
+var _fastReflection_EventUpdateProjectMetadata_messageType fastReflection_EventUpdateProjectMetadata_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectMetadata_messageType{}
 
-type fastReflection_EventAddCreditType_messageType struct{}
+type fastReflection_EventUpdateProjectMetadata_messageType struct{}
 
-func (x fastReflection_EventAddCreditType_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventAddCreditType)(nil)
+func (x fastReflection_EventUpdateProjectMetadata_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectMetadata)(nil)
 }
-func (x fastReflection_EventAddCreditType_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventAddCreditType)
+func (x fastReflection_EventUpdateProjectMetadata_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectMetadata)
 }
-func (x fastReflection_EventAddCreditType_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventAddCreditType
+func (x fastReflection_EventUpdateProjectMetadata_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectMetadata
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventAddCreditType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventAddCreditType
+func (x *fastReflection_EventUpdateProjectMetadata) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectMetadata
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventAddCreditType) Type() protoreflect.MessageType {
-	return _fastReflection_EventAddCreditType_messageType
+func (x *fastReflection_EventUpdateProjectMetadata) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectMetadata_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventAddCreditType) New() protoreflect.Message {
-	return new(fastReflection_EventAddCreditType)
+func (x *fastReflection_EventUpdateProjectMetadata) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectMetadata)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessage {
-	return (*EventAddCreditType)(x)
+func (x *fastReflection_EventUpdateProjectMetadata) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectMetadata)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6893,10 +7315,10 @@ func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Abbreviation != "" {
-		value := protoreflect.ValueOfString(x.Abbreviation)
-		if !f(fd_EventAddCreditType_abbreviation, value) {
+func (x *fastReflection_EventUpdateProjectMetadata) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventUpdateProjectMetadata_project_id, value) {
 			return
 		}
 	}
@@ -6913,15 +7335,15 @@ func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateProjectMetadata) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		return x.Abbreviation != ""
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+		return x.ProjectId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6931,15 +7353,15 @@ func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateProjectMetadata) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		x.Abbreviation = ""
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+		x.ProjectId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6949,16 +7371,16 @@ func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectMetadata) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		value := x.Abbreviation
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+		value := x.ProjectId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6972,15 +7394,15 @@ func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateProjectMetadata) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		x.Abbreviation = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+		x.ProjectId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6994,40 +7416,40 @@ func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectMetadata) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
-		panic(fmt.Errorf("field abbreviation of message regen.ecocredit.v1.EventAddCreditType is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectMetadata is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventAddCreditType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectMetadata) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+	case "regen.ecocredit.v1.EventUpdateProjectMetadata.project_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectMetadata"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectMetadata does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateProjectMetadata) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAddCreditType", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectMetadata", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7035,7 +7457,7 @@ func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateProjectMetadata) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7046,7 +7468,7 @@ func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateProjectMetadata) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7058,7 +7480,7 @@ func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventAddCreditType) IsValid() bool {
+func (x *fastReflection_EventUpdateProjectMetadata) IsValid() bool {
 	return x != nil
 }
 
@@ -7068,9 +7490,9 @@ func (x *fastReflection_EventAddCreditType) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateProjectMetadata) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventUpdateProjectMetadata)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7082,7 +7504,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Abbreviation)
+		l = len(x.ProjectId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7096,7 +7518,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventUpdateProjectMetadata)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7115,10 +7537,10 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Abbreviation) > 0 {
-			i -= len(x.Abbreviation)
-			copy(dAtA[i:], x.Abbreviation)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abbreviation)))
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7133,7 +7555,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventAddCreditType)
+		x := input.Message.Interface().(*EventUpdateProjectMetadata)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7165,15 +7587,15 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectMetadata: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7201,7 +7623,7 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Abbreviation = string(dAtA[iNdEx:postIndex])
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7239,31 +7661,25 @@ func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventBridge           protoreflect.MessageDescriptor
-	fd_EventBridge_target    protoreflect.FieldDescriptor
-	fd_EventBridge_recipient protoreflect.FieldDescriptor
-	fd_EventBridge_contract  protoreflect.FieldDescriptor
-	fd_EventBridge_amount    protoreflect.FieldDescriptor
+	md_EventUpdateClassVerifier          protoreflect.MessageDescriptor
+	fd_EventUpdateClassVerifier_class_id protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventBridge = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridge")
-	fd_EventBridge_target = md_EventBridge.Fields().ByName("target")
-	fd_EventBridge_recipient = md_EventBridge.Fields().ByName("recipient")
-	fd_EventBridge_contract = md_EventBridge.Fields().ByName("contract")
-	fd_EventBridge_amount = md_EventBridge.Fields().ByName("amount")
+	md_EventUpdateClassVerifier = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateClassVerifier")
+	fd_EventUpdateClassVerifier_class_id = md_EventUpdateClassVerifier.Fields().ByName("class_id")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventBridge)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateClassVerifier)(nil)
 
-type fastReflection_EventBridge EventBridge
+type fastReflection_EventUpdateClassVerifier EventUpdateClassVerifier
 
-func (x *EventBridge) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventBridge)(x)
+func (x *EventUpdateClassVerifier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassVerifier)(x)
 }
 
-func (x *EventBridge) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateClassVerifier) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7275,43 +7691,43 @@ func (x *EventBridge) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventBridge_messageType fastReflection_EventBridge_messageType
-var _ protoreflect.MessageType = fastReflection_EventBridge_messageType{}
+var _fastReflection_EventUpdateClassVerifier_messageType fastReflection_EventUpdateClassVerifier_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateClassVerifier_messageType{}
 
-type fastReflection_EventBridge_messageType struct{}
+type fastReflection_EventUpdateClassVerifier_messageType struct{}
 
-func (x fastReflection_EventBridge_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventBridge)(nil)
+func (x fastReflection_EventUpdateClassVerifier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateClassVerifier)(nil)
 }
-func (x fastReflection_EventBridge_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventBridge)
+func (x fastReflection_EventUpdateClassVerifier_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassVerifier)
 }
-func (x fastReflection_EventBridge_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridge
+func (x fastReflection_EventUpdateClassVerifier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassVerifier
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventBridge) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridge
+func (x *fastReflection_EventUpdateClassVerifier) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateClassVerifier
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventBridge) Type() protoreflect.MessageType {
-	return _fastReflection_EventBridge_messageType
+func (x *fastReflection_EventUpdateClassVerifier) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateClassVerifier_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventBridge) New() protoreflect.Message {
-	return new(fastReflection_EventBridge)
+func (x *fastReflection_EventUpdateClassVerifier) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateClassVerifier)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
-	return (*EventBridge)(x)
+func (x *fastReflection_EventUpdateClassVerifier) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateClassVerifier)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7319,28 +7735,10 @@ func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Target != "" {
-		value := protoreflect.ValueOfString(x.Target)
-		if !f(fd_EventBridge_target, value) {
-			return
-		}
-	}
-	if x.Recipient != "" {
-		value := protoreflect.ValueOfString(x.Recipient)
-		if !f(fd_EventBridge_recipient, value) {
-			return
-		}
-	}
-	if x.Contract != "" {
-		value := protoreflect.ValueOfString(x.Contract)
-		if !f(fd_EventBridge_contract, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_EventBridge_amount, value) {
+func (x *fastReflection_EventUpdateClassVerifier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventUpdateClassVerifier_class_id, value) {
 			return
 		}
 	}
@@ -7357,21 +7755,15 @@ func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateClassVerifier) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		return x.Target != ""
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		return x.Recipient != ""
-	case "regen.ecocredit.v1.EventBridge.contract":
-		return x.Contract != ""
-	case "regen.ecocredit.v1.EventBridge.amount":
-		return x.Amount != ""
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
+		return x.ClassId != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7381,21 +7773,15 @@ func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateClassVerifier) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		x.Target = ""
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		x.Recipient = ""
-	case "regen.ecocredit.v1.EventBridge.contract":
-		x.Contract = ""
-	case "regen.ecocredit.v1.EventBridge.amount":
-		x.Amount = ""
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
+		x.ClassId = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7405,25 +7791,16 @@ func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassVerifier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		value := x.Target
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		value := x.Recipient
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.contract":
-		value := x.Contract
-		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridge.amount":
-		value := x.Amount
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
+		value := x.ClassId
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7437,21 +7814,15 @@ func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateClassVerifier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		x.Target = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		x.Recipient = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.contract":
-		x.Contract = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridge.amount":
-		x.Amount = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
+		x.ClassId = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7465,52 +7836,40 @@ func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassVerifier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		panic(fmt.Errorf("field target of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		panic(fmt.Errorf("field recipient of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.contract":
-		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.EventBridge is not mutable"))
-	case "regen.ecocredit.v1.EventBridge.amount":
-		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventUpdateClassVerifier is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventBridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateClassVerifier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridge.target":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.recipient":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.contract":
-		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridge.amount":
+	case "regen.ecocredit.v1.EventUpdateClassVerifier.class_id":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateClassVerifier"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateClassVerifier does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateClassVerifier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridge", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateClassVerifier", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7518,7 +7877,7 @@ func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateClassVerifier) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7529,7 +7888,7 @@ func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateClassVerifier) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7541,7 +7900,7 @@ func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventBridge) IsValid() bool {
+func (x *fastReflection_EventUpdateClassVerifier) IsValid() bool {
 	return x != nil
 }
 
@@ -7551,9 +7910,9 @@ func (x *fastReflection_EventBridge) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateClassVerifier) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventUpdateClassVerifier)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7565,19 +7924,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Target)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Recipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Contract)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
+		l = len(x.ClassId)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7591,7 +7938,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventUpdateClassVerifier)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7610,31 +7957,10 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Contract) > 0 {
-			i -= len(x.Contract)
-			copy(dAtA[i:], x.Contract)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Recipient) > 0 {
-			i -= len(x.Recipient)
-			copy(dAtA[i:], x.Recipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Target) > 0 {
-			i -= len(x.Target)
-			copy(dAtA[i:], x.Target)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7649,7 +7975,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridge)
+		x := input.Message.Interface().(*EventUpdateClassVerifier)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7681,111 +8007,15 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassVerifier: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateClassVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Target = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Recipient = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Contract = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7813,7 +8043,7 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.ClassId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7851,27 +8081,29 @@ func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_EventBridgeReceive             protoreflect.MessageDescriptor
-	fd_EventBridgeReceive_project_id  protoreflect.FieldDescriptor
-	fd_EventBridgeReceive_batch_denom protoreflect.FieldDescriptor
+	md_EventUpdateProjectStatus            protoreflect.MessageDescriptor
+	fd_EventUpdateProjectStatus_project_id protoreflect.FieldDescriptor
+	fd_EventUpdateProjectStatus_status     protoreflect.FieldDescriptor
+	fd_EventUpdateProjectStatus_reason     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_regen_ecocredit_v1_events_proto_init()
-	md_EventBridgeReceive = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeReceive")
-	fd_EventBridgeReceive_project_id = md_EventBridgeReceive.Fields().ByName("project_id")
-	fd_EventBridgeReceive_batch_denom = md_EventBridgeReceive.Fields().ByName("batch_denom")
+	md_EventUpdateProjectStatus = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectStatus")
+	fd_EventUpdateProjectStatus_project_id = md_EventUpdateProjectStatus.Fields().ByName("project_id")
+	fd_EventUpdateProjectStatus_status = md_EventUpdateProjectStatus.Fields().ByName("status")
+	fd_EventUpdateProjectStatus_reason = md_EventUpdateProjectStatus.Fields().ByName("reason")
 }
 
-var _ protoreflect.Message = (*fastReflection_EventBridgeReceive)(nil)
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectStatus)(nil)
 
-type fastReflection_EventBridgeReceive EventBridgeReceive
+type fastReflection_EventUpdateProjectStatus EventUpdateProjectStatus
 
-func (x *EventBridgeReceive) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_EventBridgeReceive)(x)
+func (x *EventUpdateProjectStatus) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectStatus)(x)
 }
 
-func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
+func (x *EventUpdateProjectStatus) slowProtoReflect() protoreflect.Message {
 	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7883,43 +8115,43 @@ func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_EventBridgeReceive_messageType fastReflection_EventBridgeReceive_messageType
-var _ protoreflect.MessageType = fastReflection_EventBridgeReceive_messageType{}
+var _fastReflection_EventUpdateProjectStatus_messageType fastReflection_EventUpdateProjectStatus_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectStatus_messageType{}
 
-type fastReflection_EventBridgeReceive_messageType struct{}
+type fastReflection_EventUpdateProjectStatus_messageType struct{}
 
-func (x fastReflection_EventBridgeReceive_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_EventBridgeReceive)(nil)
+func (x fastReflection_EventUpdateProjectStatus_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectStatus)(nil)
 }
-func (x fastReflection_EventBridgeReceive_messageType) New() protoreflect.Message {
-	return new(fastReflection_EventBridgeReceive)
+func (x fastReflection_EventUpdateProjectStatus_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectStatus)
 }
-func (x fastReflection_EventBridgeReceive_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridgeReceive
+func (x fastReflection_EventUpdateProjectStatus_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectStatus
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_EventBridgeReceive) Descriptor() protoreflect.MessageDescriptor {
-	return md_EventBridgeReceive
+func (x *fastReflection_EventUpdateProjectStatus) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectStatus
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_EventBridgeReceive) Type() protoreflect.MessageType {
-	return _fastReflection_EventBridgeReceive_messageType
+func (x *fastReflection_EventUpdateProjectStatus) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectStatus_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_EventBridgeReceive) New() protoreflect.Message {
-	return new(fastReflection_EventBridgeReceive)
+func (x *fastReflection_EventUpdateProjectStatus) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectStatus)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessage {
-	return (*EventBridgeReceive)(x)
+func (x *fastReflection_EventUpdateProjectStatus) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectStatus)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7927,16 +8159,22 @@ func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessag
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_EventUpdateProjectStatus) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.ProjectId != "" {
 		value := protoreflect.ValueOfString(x.ProjectId)
-		if !f(fd_EventBridgeReceive_project_id, value) {
+		if !f(fd_EventUpdateProjectStatus_project_id, value) {
 			return
 		}
 	}
-	if x.BatchDenom != "" {
-		value := protoreflect.ValueOfString(x.BatchDenom)
-		if !f(fd_EventBridgeReceive_batch_denom, value) {
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_EventUpdateProjectStatus_status, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_EventUpdateProjectStatus_reason, value) {
 			return
 		}
 	}
@@ -7953,17 +8191,19 @@ func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_EventUpdateProjectStatus) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
 		return x.ProjectId != ""
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		return x.Status != 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7973,17 +8213,19 @@ func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_EventUpdateProjectStatus) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
 		x.ProjectId = ""
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		x.Status = 0
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7993,19 +8235,22 @@ func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescripto
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectStatus) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
 		value := x.ProjectId
 		return protoreflect.ValueOfString(value)
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		value := x.BatchDenom
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		value := x.Reason
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8019,17 +8264,19 @@ func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDes
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_EventUpdateProjectStatus) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
 		x.ProjectId = value.Interface().(string)
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		x.Status = (ProjectStatus)(value.Enum())
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8043,44 +8290,48 @@ func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectStatus) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
-		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
-		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		panic(fmt.Errorf("field status of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
+		panic(fmt.Errorf("field reason of message regen.ecocredit.v1.EventUpdateProjectStatus is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_EventBridgeReceive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_EventUpdateProjectStatus) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.project_id":
 		return protoreflect.ValueOfString("")
-	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.status":
+		return protoreflect.ValueOfEnum(0)
+	case "regen.ecocredit.v1.EventUpdateProjectStatus.reason":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectStatus"))
 		}
-		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectStatus does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_EventUpdateProjectStatus) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeReceive", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectStatus", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8088,7 +8339,7 @@ func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_EventUpdateProjectStatus) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8099,7 +8350,7 @@ func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_EventUpdateProjectStatus) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8111,7 +8362,7 @@ func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFi
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_EventBridgeReceive) IsValid() bool {
+func (x *fastReflection_EventUpdateProjectStatus) IsValid() bool {
 	return x != nil
 }
 
@@ -8121,9 +8372,9 @@ func (x *fastReflection_EventBridgeReceive) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_EventUpdateProjectStatus) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8139,7 +8390,10 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BatchDenom)
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Reason)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8153,7 +8407,7 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8172,12 +8426,17 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BatchDenom) > 0 {
-			i -= len(x.BatchDenom)
-			copy(dAtA[i:], x.BatchDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x10
 		}
 		if len(x.ProjectId) > 0 {
 			i -= len(x.ProjectId)
@@ -8197,7 +8456,7 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*EventBridgeReceive)
+		x := input.Message.Interface().(*EventUpdateProjectStatus)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8229,10 +8488,10 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectStatus: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8268,8 +8527,27 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 				x.ProjectId = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= ProjectStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8297,7 +8575,7 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				x.Reason = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8334,689 +8612,6095 @@ func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: regen/ecocredit/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_EventUpdateProjectIssuers            protoreflect.MessageDescriptor
+	fd_EventUpdateProjectIssuers_project_id protoreflect.FieldDescriptor
 )
 
-// EventCreateClass is an event emitted when a credit class is created.
-type EventCreateClass struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// class_id is the unique identifier of the credit class.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-}
-
-func (x *EventCreateClass) Reset() {
-	*x = EventCreateClass{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUpdateProjectIssuers = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectIssuers")
+	fd_EventUpdateProjectIssuers_project_id = md_EventUpdateProjectIssuers.Fields().ByName("project_id")
 }
 
-func (x *EventCreateClass) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectIssuers)(nil)
 
-func (*EventCreateClass) ProtoMessage() {}
+type fastReflection_EventUpdateProjectIssuers EventUpdateProjectIssuers
 
-// Deprecated: Use EventCreateClass.ProtoReflect.Descriptor instead.
-func (*EventCreateClass) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{0}
+func (x *EventUpdateProjectIssuers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectIssuers)(x)
 }
 
-func (x *EventCreateClass) GetClassId() string {
-	if x != nil {
-		return x.ClassId
+func (x *EventUpdateProjectIssuers) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-// EventCreateProject is an event emitted when a project is created.
-type EventCreateProject struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+var _fastReflection_EventUpdateProjectIssuers_messageType fastReflection_EventUpdateProjectIssuers_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectIssuers_messageType{}
 
-	// project_id is the unique identifier of the project.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
-}
+type fastReflection_EventUpdateProjectIssuers_messageType struct{}
 
-func (x *EventCreateProject) Reset() {
-	*x = EventCreateProject{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_EventUpdateProjectIssuers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectIssuers)(nil)
 }
-
-func (x *EventCreateProject) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_EventUpdateProjectIssuers_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectIssuers)
 }
-
-func (*EventCreateProject) ProtoMessage() {}
-
-// Deprecated: Use EventCreateProject.ProtoReflect.Descriptor instead.
-func (*EventCreateProject) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_EventUpdateProjectIssuers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectIssuers
 }
 
-func (x *EventCreateProject) GetProjectId() string {
-	if x != nil {
-		return x.ProjectId
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateProjectIssuers) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectIssuers
 }
 
-// EventCreateBatch is an event emitted when a credit batch is created.
-type EventCreateBatch struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// batch_denom is the unique identifier of the credit batch.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// origin_tx is the transaction from another chain or registry that triggered
-	// the creation of the credit batch.
-	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateProjectIssuers) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectIssuers_messageType
 }
 
-func (x *EventCreateBatch) Reset() {
-	*x = EventCreateBatch{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateProjectIssuers) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectIssuers)
 }
 
-func (x *EventCreateBatch) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateProjectIssuers) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectIssuers)(x)
 }
 
-func (*EventCreateBatch) ProtoMessage() {}
-
-// Deprecated: Use EventCreateBatch.ProtoReflect.Descriptor instead.
-func (*EventCreateBatch) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{2}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateProjectIssuers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventUpdateProjectIssuers_project_id, value) {
+			return
+		}
+	}
 }
 
-func (x *EventCreateBatch) GetBatchDenom() string {
-	if x != nil {
-		return x.BatchDenom
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateProjectIssuers) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		return x.ProjectId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *EventCreateBatch) GetOriginTx() *OriginTx {
-	if x != nil {
-		return x.OriginTx
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectIssuers) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		x.ProjectId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", fd.FullName()))
 	}
-	return nil
 }
 
-// EventMint is an event emitted when credits are minted either when creating a
-// credit batch or when bridging assets from another chain or registry.
-type EventMint struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateProjectIssuers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", descriptor.FullName()))
+	}
+}
 
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectIssuers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		x.ProjectId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectIssuers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectIssuers is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateProjectIssuers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectIssuers.project_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectIssuers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectIssuers does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateProjectIssuers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectIssuers", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateProjectIssuers) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectIssuers) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateProjectIssuers) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateProjectIssuers) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateProjectIssuers)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectIssuers)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectIssuers)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectIssuers: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectIssuers: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventUpdateProjectVerifiers            protoreflect.MessageDescriptor
+	fd_EventUpdateProjectVerifiers_project_id protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventUpdateProjectVerifiers = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventUpdateProjectVerifiers")
+	fd_EventUpdateProjectVerifiers_project_id = md_EventUpdateProjectVerifiers.Fields().ByName("project_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventUpdateProjectVerifiers)(nil)
+
+type fastReflection_EventUpdateProjectVerifiers EventUpdateProjectVerifiers
+
+func (x *EventUpdateProjectVerifiers) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectVerifiers)(x)
+}
+
+func (x *EventUpdateProjectVerifiers) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventUpdateProjectVerifiers_messageType fastReflection_EventUpdateProjectVerifiers_messageType
+var _ protoreflect.MessageType = fastReflection_EventUpdateProjectVerifiers_messageType{}
+
+type fastReflection_EventUpdateProjectVerifiers_messageType struct{}
+
+func (x fastReflection_EventUpdateProjectVerifiers_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventUpdateProjectVerifiers)(nil)
+}
+func (x fastReflection_EventUpdateProjectVerifiers_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectVerifiers)
+}
+func (x fastReflection_EventUpdateProjectVerifiers_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectVerifiers
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventUpdateProjectVerifiers) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventUpdateProjectVerifiers
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventUpdateProjectVerifiers) Type() protoreflect.MessageType {
+	return _fastReflection_EventUpdateProjectVerifiers_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventUpdateProjectVerifiers) New() protoreflect.Message {
+	return new(fastReflection_EventUpdateProjectVerifiers)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventUpdateProjectVerifiers) Interface() protoreflect.ProtoMessage {
+	return (*EventUpdateProjectVerifiers)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventUpdateProjectVerifiers) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventUpdateProjectVerifiers_project_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventUpdateProjectVerifiers) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		return x.ProjectId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectVerifiers) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		x.ProjectId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventUpdateProjectVerifiers) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectVerifiers) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		x.ProjectId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectVerifiers) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventUpdateProjectVerifiers is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventUpdateProjectVerifiers) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventUpdateProjectVerifiers.project_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventUpdateProjectVerifiers"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventUpdateProjectVerifiers does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventUpdateProjectVerifiers) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventUpdateProjectVerifiers", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventUpdateProjectVerifiers) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventUpdateProjectVerifiers) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventUpdateProjectVerifiers) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventUpdateProjectVerifiers) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventUpdateProjectVerifiers)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectVerifiers)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventUpdateProjectVerifiers)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectVerifiers: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventUpdateProjectVerifiers: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventProposeProjectTransfer            protoreflect.MessageDescriptor
+	fd_EventProposeProjectTransfer_project_id protoreflect.FieldDescriptor
+	fd_EventProposeProjectTransfer_class_id   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventProposeProjectTransfer = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventProposeProjectTransfer")
+	fd_EventProposeProjectTransfer_project_id = md_EventProposeProjectTransfer.Fields().ByName("project_id")
+	fd_EventProposeProjectTransfer_class_id = md_EventProposeProjectTransfer.Fields().ByName("class_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventProposeProjectTransfer)(nil)
+
+type fastReflection_EventProposeProjectTransfer EventProposeProjectTransfer
+
+func (x *EventProposeProjectTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventProposeProjectTransfer)(x)
+}
+
+func (x *EventProposeProjectTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventProposeProjectTransfer_messageType fastReflection_EventProposeProjectTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_EventProposeProjectTransfer_messageType{}
+
+type fastReflection_EventProposeProjectTransfer_messageType struct{}
+
+func (x fastReflection_EventProposeProjectTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventProposeProjectTransfer)(nil)
+}
+func (x fastReflection_EventProposeProjectTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventProposeProjectTransfer)
+}
+func (x fastReflection_EventProposeProjectTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposeProjectTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventProposeProjectTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventProposeProjectTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventProposeProjectTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_EventProposeProjectTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventProposeProjectTransfer) New() protoreflect.Message {
+	return new(fastReflection_EventProposeProjectTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventProposeProjectTransfer) Interface() protoreflect.ProtoMessage {
+	return (*EventProposeProjectTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventProposeProjectTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventProposeProjectTransfer_project_id, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventProposeProjectTransfer_class_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventProposeProjectTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		return x.ClassId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposeProjectTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		x.ClassId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventProposeProjectTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposeProjectTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		x.ClassId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposeProjectTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventProposeProjectTransfer is not mutable"))
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventProposeProjectTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventProposeProjectTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventProposeProjectTransfer.class_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventProposeProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventProposeProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventProposeProjectTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventProposeProjectTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventProposeProjectTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventProposeProjectTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventProposeProjectTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventProposeProjectTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventProposeProjectTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventProposeProjectTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventProposeProjectTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposeProjectTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventProposeProjectTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventAcceptProjectTransfer                protoreflect.MessageDescriptor
+	fd_EventAcceptProjectTransfer_old_project_id protoreflect.FieldDescriptor
+	fd_EventAcceptProjectTransfer_new_project_id protoreflect.FieldDescriptor
+	fd_EventAcceptProjectTransfer_class_id       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventAcceptProjectTransfer = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAcceptProjectTransfer")
+	fd_EventAcceptProjectTransfer_old_project_id = md_EventAcceptProjectTransfer.Fields().ByName("old_project_id")
+	fd_EventAcceptProjectTransfer_new_project_id = md_EventAcceptProjectTransfer.Fields().ByName("new_project_id")
+	fd_EventAcceptProjectTransfer_class_id = md_EventAcceptProjectTransfer.Fields().ByName("class_id")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAcceptProjectTransfer)(nil)
+
+type fastReflection_EventAcceptProjectTransfer EventAcceptProjectTransfer
+
+func (x *EventAcceptProjectTransfer) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAcceptProjectTransfer)(x)
+}
+
+func (x *EventAcceptProjectTransfer) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAcceptProjectTransfer_messageType fastReflection_EventAcceptProjectTransfer_messageType
+var _ protoreflect.MessageType = fastReflection_EventAcceptProjectTransfer_messageType{}
+
+type fastReflection_EventAcceptProjectTransfer_messageType struct{}
+
+func (x fastReflection_EventAcceptProjectTransfer_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAcceptProjectTransfer)(nil)
+}
+func (x fastReflection_EventAcceptProjectTransfer_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAcceptProjectTransfer)
+}
+func (x fastReflection_EventAcceptProjectTransfer_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAcceptProjectTransfer
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAcceptProjectTransfer) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAcceptProjectTransfer
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAcceptProjectTransfer) Type() protoreflect.MessageType {
+	return _fastReflection_EventAcceptProjectTransfer_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAcceptProjectTransfer) New() protoreflect.Message {
+	return new(fastReflection_EventAcceptProjectTransfer)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAcceptProjectTransfer) Interface() protoreflect.ProtoMessage {
+	return (*EventAcceptProjectTransfer)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAcceptProjectTransfer) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.OldProjectId != "" {
+		value := protoreflect.ValueOfString(x.OldProjectId)
+		if !f(fd_EventAcceptProjectTransfer_old_project_id, value) {
+			return
+		}
+	}
+	if x.NewProjectId != "" {
+		value := protoreflect.ValueOfString(x.NewProjectId)
+		if !f(fd_EventAcceptProjectTransfer_new_project_id, value) {
+			return
+		}
+	}
+	if x.ClassId != "" {
+		value := protoreflect.ValueOfString(x.ClassId)
+		if !f(fd_EventAcceptProjectTransfer_class_id, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAcceptProjectTransfer) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		return x.OldProjectId != ""
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		return x.NewProjectId != ""
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		return x.ClassId != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAcceptProjectTransfer) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		x.OldProjectId = ""
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		x.NewProjectId = ""
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		x.ClassId = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAcceptProjectTransfer) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		value := x.OldProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		value := x.NewProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		value := x.ClassId
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAcceptProjectTransfer) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		x.OldProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		x.NewProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		x.ClassId = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAcceptProjectTransfer) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		panic(fmt.Errorf("field old_project_id of message regen.ecocredit.v1.EventAcceptProjectTransfer is not mutable"))
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		panic(fmt.Errorf("field new_project_id of message regen.ecocredit.v1.EventAcceptProjectTransfer is not mutable"))
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		panic(fmt.Errorf("field class_id of message regen.ecocredit.v1.EventAcceptProjectTransfer is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAcceptProjectTransfer) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.old_project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.new_project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventAcceptProjectTransfer.class_id":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAcceptProjectTransfer"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAcceptProjectTransfer does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAcceptProjectTransfer) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAcceptProjectTransfer", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAcceptProjectTransfer) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAcceptProjectTransfer) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAcceptProjectTransfer) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAcceptProjectTransfer) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAcceptProjectTransfer)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.OldProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ClassId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAcceptProjectTransfer)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ClassId) > 0 {
+			i -= len(x.ClassId)
+			copy(dAtA[i:], x.ClassId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ClassId)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.NewProjectId) > 0 {
+			i -= len(x.NewProjectId)
+			copy(dAtA[i:], x.NewProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewProjectId)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.OldProjectId) > 0 {
+			i -= len(x.OldProjectId)
+			copy(dAtA[i:], x.OldProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OldProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAcceptProjectTransfer)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAcceptProjectTransfer: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAcceptProjectTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OldProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OldProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClassId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventSealBatch             protoreflect.MessageDescriptor
+	fd_EventSealBatch_batch_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventSealBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventSealBatch")
+	fd_EventSealBatch_batch_denom = md_EventSealBatch.Fields().ByName("batch_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSealBatch)(nil)
+
+type fastReflection_EventSealBatch EventSealBatch
+
+func (x *EventSealBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSealBatch)(x)
+}
+
+func (x *EventSealBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSealBatch_messageType fastReflection_EventSealBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventSealBatch_messageType{}
+
+type fastReflection_EventSealBatch_messageType struct{}
+
+func (x fastReflection_EventSealBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSealBatch)(nil)
+}
+func (x fastReflection_EventSealBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSealBatch)
+}
+func (x fastReflection_EventSealBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSealBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSealBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSealBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSealBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventSealBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSealBatch) New() protoreflect.Message {
+	return new(fastReflection_EventSealBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSealBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventSealBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSealBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventSealBatch_batch_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSealBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		return x.BatchDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSealBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		x.BatchDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSealBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSealBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSealBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventSealBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSealBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSealBatch.batch_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSealBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSealBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSealBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventSealBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSealBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSealBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSealBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSealBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSealBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSealBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSealBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSealBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventSplitBatch_2_list)(nil)
+
+type _EventSplitBatch_2_list struct {
+	list *[]string
+}
+
+func (x *_EventSplitBatch_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventSplitBatch_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventSplitBatch_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventSplitBatch_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventSplitBatch_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventSplitBatch at list field NewBatchDenoms as it is not of Message kind"))
+}
+
+func (x *_EventSplitBatch_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventSplitBatch_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventSplitBatch_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventSplitBatch                  protoreflect.MessageDescriptor
+	fd_EventSplitBatch_batch_denom      protoreflect.FieldDescriptor
+	fd_EventSplitBatch_new_batch_denoms protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventSplitBatch = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventSplitBatch")
+	fd_EventSplitBatch_batch_denom = md_EventSplitBatch.Fields().ByName("batch_denom")
+	fd_EventSplitBatch_new_batch_denoms = md_EventSplitBatch.Fields().ByName("new_batch_denoms")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventSplitBatch)(nil)
+
+type fastReflection_EventSplitBatch EventSplitBatch
+
+func (x *EventSplitBatch) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventSplitBatch)(x)
+}
+
+func (x *EventSplitBatch) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventSplitBatch_messageType fastReflection_EventSplitBatch_messageType
+var _ protoreflect.MessageType = fastReflection_EventSplitBatch_messageType{}
+
+type fastReflection_EventSplitBatch_messageType struct{}
+
+func (x fastReflection_EventSplitBatch_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventSplitBatch)(nil)
+}
+func (x fastReflection_EventSplitBatch_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventSplitBatch)
+}
+func (x fastReflection_EventSplitBatch_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSplitBatch
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventSplitBatch) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventSplitBatch
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventSplitBatch) Type() protoreflect.MessageType {
+	return _fastReflection_EventSplitBatch_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventSplitBatch) New() protoreflect.Message {
+	return new(fastReflection_EventSplitBatch)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventSplitBatch) Interface() protoreflect.ProtoMessage {
+	return (*EventSplitBatch)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventSplitBatch) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventSplitBatch_batch_denom, value) {
+			return
+		}
+	}
+	if len(x.NewBatchDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_EventSplitBatch_2_list{list: &x.NewBatchDenoms})
+		if !f(fd_EventSplitBatch_new_batch_denoms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventSplitBatch) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		return x.BatchDenom != ""
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		return len(x.NewBatchDenoms) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSplitBatch) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		x.BatchDenom = ""
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		x.NewBatchDenoms = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventSplitBatch) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		if len(x.NewBatchDenoms) == 0 {
+			return protoreflect.ValueOfList(&_EventSplitBatch_2_list{})
+		}
+		listValue := &_EventSplitBatch_2_list{list: &x.NewBatchDenoms}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSplitBatch) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		lv := value.List()
+		clv := lv.(*_EventSplitBatch_2_list)
+		x.NewBatchDenoms = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSplitBatch) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		if x.NewBatchDenoms == nil {
+			x.NewBatchDenoms = []string{}
+		}
+		value := &_EventSplitBatch_2_list{list: &x.NewBatchDenoms}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventSplitBatch is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventSplitBatch) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventSplitBatch.batch_denom":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventSplitBatch.new_batch_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventSplitBatch_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventSplitBatch"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventSplitBatch does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventSplitBatch) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventSplitBatch", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventSplitBatch) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventSplitBatch) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventSplitBatch) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventSplitBatch) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventSplitBatch)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.NewBatchDenoms) > 0 {
+			for _, s := range x.NewBatchDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventSplitBatch)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewBatchDenoms) > 0 {
+			for iNdEx := len(x.NewBatchDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.NewBatchDenoms[iNdEx])
+				copy(dAtA[i:], x.NewBatchDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewBatchDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventSplitBatch)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSplitBatch: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventSplitBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBatchDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewBatchDenoms = append(x.NewBatchDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EventMergeBatches_1_list)(nil)
+
+type _EventMergeBatches_1_list struct {
+	list *[]string
+}
+
+func (x *_EventMergeBatches_1_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EventMergeBatches_1_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EventMergeBatches_1_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EventMergeBatches_1_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EventMergeBatches_1_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EventMergeBatches at list field BatchDenoms as it is not of Message kind"))
+}
+
+func (x *_EventMergeBatches_1_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EventMergeBatches_1_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EventMergeBatches_1_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EventMergeBatches                 protoreflect.MessageDescriptor
+	fd_EventMergeBatches_batch_denoms    protoreflect.FieldDescriptor
+	fd_EventMergeBatches_new_batch_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventMergeBatches = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventMergeBatches")
+	fd_EventMergeBatches_batch_denoms = md_EventMergeBatches.Fields().ByName("batch_denoms")
+	fd_EventMergeBatches_new_batch_denom = md_EventMergeBatches.Fields().ByName("new_batch_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventMergeBatches)(nil)
+
+type fastReflection_EventMergeBatches EventMergeBatches
+
+func (x *EventMergeBatches) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventMergeBatches)(x)
+}
+
+func (x *EventMergeBatches) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventMergeBatches_messageType fastReflection_EventMergeBatches_messageType
+var _ protoreflect.MessageType = fastReflection_EventMergeBatches_messageType{}
+
+type fastReflection_EventMergeBatches_messageType struct{}
+
+func (x fastReflection_EventMergeBatches_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventMergeBatches)(nil)
+}
+func (x fastReflection_EventMergeBatches_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventMergeBatches)
+}
+func (x fastReflection_EventMergeBatches_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMergeBatches
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventMergeBatches) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventMergeBatches
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventMergeBatches) Type() protoreflect.MessageType {
+	return _fastReflection_EventMergeBatches_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventMergeBatches) New() protoreflect.Message {
+	return new(fastReflection_EventMergeBatches)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventMergeBatches) Interface() protoreflect.ProtoMessage {
+	return (*EventMergeBatches)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventMergeBatches) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.BatchDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_EventMergeBatches_1_list{list: &x.BatchDenoms})
+		if !f(fd_EventMergeBatches_batch_denoms, value) {
+			return
+		}
+	}
+	if x.NewBatchDenom != "" {
+		value := protoreflect.ValueOfString(x.NewBatchDenom)
+		if !f(fd_EventMergeBatches_new_batch_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventMergeBatches) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		return len(x.BatchDenoms) != 0
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		return x.NewBatchDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMergeBatches) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		x.BatchDenoms = nil
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		x.NewBatchDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventMergeBatches) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		if len(x.BatchDenoms) == 0 {
+			return protoreflect.ValueOfList(&_EventMergeBatches_1_list{})
+		}
+		listValue := &_EventMergeBatches_1_list{list: &x.BatchDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		value := x.NewBatchDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMergeBatches) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		lv := value.List()
+		clv := lv.(*_EventMergeBatches_1_list)
+		x.BatchDenoms = *clv.list
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		x.NewBatchDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMergeBatches) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		if x.BatchDenoms == nil {
+			x.BatchDenoms = []string{}
+		}
+		value := &_EventMergeBatches_1_list{list: &x.BatchDenoms}
+		return protoreflect.ValueOfList(value)
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		panic(fmt.Errorf("field new_batch_denom of message regen.ecocredit.v1.EventMergeBatches is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventMergeBatches) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventMergeBatches.batch_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EventMergeBatches_1_list{list: &list})
+	case "regen.ecocredit.v1.EventMergeBatches.new_batch_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventMergeBatches"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventMergeBatches does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventMergeBatches) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventMergeBatches", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventMergeBatches) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventMergeBatches) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventMergeBatches) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventMergeBatches) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventMergeBatches)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if len(x.BatchDenoms) > 0 {
+			for _, s := range x.BatchDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.NewBatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventMergeBatches)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.NewBatchDenom) > 0 {
+			i -= len(x.NewBatchDenom)
+			copy(dAtA[i:], x.NewBatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewBatchDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.BatchDenoms) > 0 {
+			for iNdEx := len(x.BatchDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BatchDenoms[iNdEx])
+				copy(dAtA[i:], x.BatchDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0xa
+			}
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventMergeBatches)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMergeBatches: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventMergeBatches: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenoms = append(x.BatchDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewBatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewBatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventAddCreditType              protoreflect.MessageDescriptor
+	fd_EventAddCreditType_abbreviation protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventAddCreditType = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventAddCreditType")
+	fd_EventAddCreditType_abbreviation = md_EventAddCreditType.Fields().ByName("abbreviation")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventAddCreditType)(nil)
+
+type fastReflection_EventAddCreditType EventAddCreditType
+
+func (x *EventAddCreditType) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventAddCreditType)(x)
+}
+
+func (x *EventAddCreditType) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventAddCreditType_messageType fastReflection_EventAddCreditType_messageType
+var _ protoreflect.MessageType = fastReflection_EventAddCreditType_messageType{}
+
+type fastReflection_EventAddCreditType_messageType struct{}
+
+func (x fastReflection_EventAddCreditType_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventAddCreditType)(nil)
+}
+func (x fastReflection_EventAddCreditType_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventAddCreditType)
+}
+func (x fastReflection_EventAddCreditType_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddCreditType
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventAddCreditType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventAddCreditType
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventAddCreditType) Type() protoreflect.MessageType {
+	return _fastReflection_EventAddCreditType_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventAddCreditType) New() protoreflect.Message {
+	return new(fastReflection_EventAddCreditType)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventAddCreditType) Interface() protoreflect.ProtoMessage {
+	return (*EventAddCreditType)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventAddCreditType) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Abbreviation != "" {
+		value := protoreflect.ValueOfString(x.Abbreviation)
+		if !f(fd_EventAddCreditType_abbreviation, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventAddCreditType) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		return x.Abbreviation != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddCreditType) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		x.Abbreviation = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventAddCreditType) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		value := x.Abbreviation
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddCreditType) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		x.Abbreviation = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddCreditType) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		panic(fmt.Errorf("field abbreviation of message regen.ecocredit.v1.EventAddCreditType is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventAddCreditType) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventAddCreditType.abbreviation":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventAddCreditType"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventAddCreditType does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventAddCreditType) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventAddCreditType", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventAddCreditType) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventAddCreditType) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventAddCreditType) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventAddCreditType) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventAddCreditType)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Abbreviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventAddCreditType)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Abbreviation) > 0 {
+			i -= len(x.Abbreviation)
+			copy(dAtA[i:], x.Abbreviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Abbreviation)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventAddCreditType)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventAddCreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Abbreviation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridge           protoreflect.MessageDescriptor
+	fd_EventBridge_target    protoreflect.FieldDescriptor
+	fd_EventBridge_recipient protoreflect.FieldDescriptor
+	fd_EventBridge_contract  protoreflect.FieldDescriptor
+	fd_EventBridge_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridge = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridge")
+	fd_EventBridge_target = md_EventBridge.Fields().ByName("target")
+	fd_EventBridge_recipient = md_EventBridge.Fields().ByName("recipient")
+	fd_EventBridge_contract = md_EventBridge.Fields().ByName("contract")
+	fd_EventBridge_amount = md_EventBridge.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridge)(nil)
+
+type fastReflection_EventBridge EventBridge
+
+func (x *EventBridge) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridge)(x)
+}
+
+func (x *EventBridge) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridge_messageType fastReflection_EventBridge_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridge_messageType{}
+
+type fastReflection_EventBridge_messageType struct{}
+
+func (x fastReflection_EventBridge_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridge)(nil)
+}
+func (x fastReflection_EventBridge_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridge)
+}
+func (x fastReflection_EventBridge_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridge
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridge) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridge
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridge) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridge_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridge) New() protoreflect.Message {
+	return new(fastReflection_EventBridge)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridge) Interface() protoreflect.ProtoMessage {
+	return (*EventBridge)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridge) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Target != "" {
+		value := protoreflect.ValueOfString(x.Target)
+		if !f(fd_EventBridge_target, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_EventBridge_recipient, value) {
+			return
+		}
+	}
+	if x.Contract != "" {
+		value := protoreflect.ValueOfString(x.Contract)
+		if !f(fd_EventBridge_contract, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_EventBridge_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridge) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		return x.Target != ""
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		return x.Recipient != ""
+	case "regen.ecocredit.v1.EventBridge.contract":
+		return x.Contract != ""
+	case "regen.ecocredit.v1.EventBridge.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		x.Target = ""
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		x.Recipient = ""
+	case "regen.ecocredit.v1.EventBridge.contract":
+		x.Contract = ""
+	case "regen.ecocredit.v1.EventBridge.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridge) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		value := x.Target
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.contract":
+		value := x.Contract
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridge.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		x.Target = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		x.Recipient = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.contract":
+		x.Contract = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridge.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		panic(fmt.Errorf("field target of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		panic(fmt.Errorf("field recipient of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.contract":
+		panic(fmt.Errorf("field contract of message regen.ecocredit.v1.EventBridge is not mutable"))
+	case "regen.ecocredit.v1.EventBridge.amount":
+		panic(fmt.Errorf("field amount of message regen.ecocredit.v1.EventBridge is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridge) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridge.target":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.recipient":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.contract":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridge.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridge"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridge does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridge) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridge", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridge) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridge) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridge) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridge) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Target)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Contract)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Contract) > 0 {
+			i -= len(x.Contract)
+			copy(dAtA[i:], x.Contract)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Contract)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Target) > 0 {
+			i -= len(x.Target)
+			copy(dAtA[i:], x.Target)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Target)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridge)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridge: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Target = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Contract = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_EventBridgeReceive             protoreflect.MessageDescriptor
+	fd_EventBridgeReceive_project_id  protoreflect.FieldDescriptor
+	fd_EventBridgeReceive_batch_denom protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_regen_ecocredit_v1_events_proto_init()
+	md_EventBridgeReceive = File_regen_ecocredit_v1_events_proto.Messages().ByName("EventBridgeReceive")
+	fd_EventBridgeReceive_project_id = md_EventBridgeReceive.Fields().ByName("project_id")
+	fd_EventBridgeReceive_batch_denom = md_EventBridgeReceive.Fields().ByName("batch_denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_EventBridgeReceive)(nil)
+
+type fastReflection_EventBridgeReceive EventBridgeReceive
+
+func (x *EventBridgeReceive) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EventBridgeReceive)(x)
+}
+
+func (x *EventBridgeReceive) slowProtoReflect() protoreflect.Message {
+	mi := &file_regen_ecocredit_v1_events_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EventBridgeReceive_messageType fastReflection_EventBridgeReceive_messageType
+var _ protoreflect.MessageType = fastReflection_EventBridgeReceive_messageType{}
+
+type fastReflection_EventBridgeReceive_messageType struct{}
+
+func (x fastReflection_EventBridgeReceive_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EventBridgeReceive)(nil)
+}
+func (x fastReflection_EventBridgeReceive_messageType) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeReceive)
+}
+func (x fastReflection_EventBridgeReceive_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeReceive
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EventBridgeReceive) Descriptor() protoreflect.MessageDescriptor {
+	return md_EventBridgeReceive
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EventBridgeReceive) Type() protoreflect.MessageType {
+	return _fastReflection_EventBridgeReceive_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EventBridgeReceive) New() protoreflect.Message {
+	return new(fastReflection_EventBridgeReceive)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EventBridgeReceive) Interface() protoreflect.ProtoMessage {
+	return (*EventBridgeReceive)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EventBridgeReceive) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ProjectId != "" {
+		value := protoreflect.ValueOfString(x.ProjectId)
+		if !f(fd_EventBridgeReceive_project_id, value) {
+			return
+		}
+	}
+	if x.BatchDenom != "" {
+		value := protoreflect.ValueOfString(x.BatchDenom)
+		if !f(fd_EventBridgeReceive_batch_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EventBridgeReceive) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		return x.ProjectId != ""
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		return x.BatchDenom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		x.ProjectId = ""
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		x.BatchDenom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EventBridgeReceive) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		value := x.ProjectId
+		return protoreflect.ValueOfString(value)
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		value := x.BatchDenom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		x.ProjectId = value.Interface().(string)
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		x.BatchDenom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		panic(fmt.Errorf("field project_id of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		panic(fmt.Errorf("field batch_denom of message regen.ecocredit.v1.EventBridgeReceive is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EventBridgeReceive) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "regen.ecocredit.v1.EventBridgeReceive.project_id":
+		return protoreflect.ValueOfString("")
+	case "regen.ecocredit.v1.EventBridgeReceive.batch_denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: regen.ecocredit.v1.EventBridgeReceive"))
+		}
+		panic(fmt.Errorf("message regen.ecocredit.v1.EventBridgeReceive does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EventBridgeReceive) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in regen.ecocredit.v1.EventBridgeReceive", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EventBridgeReceive) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EventBridgeReceive) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EventBridgeReceive) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EventBridgeReceive) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ProjectId)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BatchDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BatchDenom) > 0 {
+			i -= len(x.BatchDenom)
+			copy(dAtA[i:], x.BatchDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BatchDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ProjectId) > 0 {
+			i -= len(x.ProjectId)
+			copy(dAtA[i:], x.ProjectId)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProjectId)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EventBridgeReceive)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EventBridgeReceive: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProjectId", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ProjectId = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BatchDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BatchDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: regen/ecocredit/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// EventCreateClass is an event emitted when a credit class is created.
+type EventCreateClass struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the credit class.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventCreateClass) Reset() {
+	*x = EventCreateClass{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateClass) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateClass) ProtoMessage() {}
+
+// Deprecated: Use EventCreateClass.ProtoReflect.Descriptor instead.
+func (*EventCreateClass) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EventCreateClass) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventCreateProject is an event emitted when a project is created.
+type EventCreateProject struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+}
+
+func (x *EventCreateProject) Reset() {
+	*x = EventCreateProject{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateProject) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateProject) ProtoMessage() {}
+
+// Deprecated: Use EventCreateProject.ProtoReflect.Descriptor instead.
+func (*EventCreateProject) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventCreateProject) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+// EventCreateBatch is an event emitted when a credit batch is created.
+type EventCreateBatch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// origin_tx is the transaction from another chain or registry that triggered
+	// the creation of the credit batch.
+	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+}
+
+func (x *EventCreateBatch) Reset() {
+	*x = EventCreateBatch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCreateBatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCreateBatch) ProtoMessage() {}
+
+// Deprecated: Use EventCreateBatch.ProtoReflect.Descriptor instead.
+func (*EventCreateBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EventCreateBatch) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventCreateBatch) GetOriginTx() *OriginTx {
+	if x != nil {
+		return x.OriginTx
+	}
+	return nil
+}
+
+// EventMint is an event emitted when credits are minted either when creating a
+// credit batch or when bridging assets from another chain or registry.
+type EventMint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were minted.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// tradable_amount is the amount of tradable credits minted.
+	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the amount of retired credits minted.
+	RetiredAmount string `protobuf:"bytes,3,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+}
+
+func (x *EventMint) Reset() {
+	*x = EventMint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMint) ProtoMessage() {}
+
+// Deprecated: Use EventMint.ProtoReflect.Descriptor instead.
+func (*EventMint) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EventMint) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventMint) GetTradableAmount() string {
+	if x != nil {
+		return x.TradableAmount
+	}
+	return ""
+}
+
+func (x *EventMint) GetRetiredAmount() string {
+	if x != nil {
+		return x.RetiredAmount
+	}
+	return ""
+}
+
+// EventMintBatchCredits is an event emitted when credits are minted to an
+// existing open credit batch.
+type EventMintBatchCredits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were minted.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// origin_tx is the transaction from another chain or registry that triggered
+	// the minting of credits within the credit batch.
+	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+}
+
+func (x *EventMintBatchCredits) Reset() {
+	*x = EventMintBatchCredits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventMintBatchCredits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventMintBatchCredits) ProtoMessage() {}
+
+// Deprecated: Use EventMintBatchCredits.ProtoReflect.Descriptor instead.
+func (*EventMintBatchCredits) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *EventMintBatchCredits) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventMintBatchCredits) GetOriginTx() *OriginTx {
+	if x != nil {
+		return x.OriginTx
+	}
+	return nil
+}
+
+// EventTransfer is an event emitted when credits are transferred from one
+// account to another including transfers to or from a module account.
+type EventTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// sender is the sender of the credits. In the case that the credits were
+	// transferred from a base account, this will be the account address. In the
+	// case that the credits were transferred from a module, this will be the
+	// module address (i.e. either the ecocredit module or basket submodule).
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient is the recipient of the credits. In the case that the credits
+	// were transferred to a base account, this will be the account address. In
+	// the case that the credits were transferred to a module, this will be the
+	// module address (i.e. either the ecocredit module or basket submodule).
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// batch_denom is the unique identifier of the credit batch.
+	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// tradable_amount is the decimal number of tradable credits received.
+	TradableAmount string `protobuf:"bytes,4,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
+	// retired_amount is the decimal number of retired credits received.
+	RetiredAmount string `protobuf:"bytes,5,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+}
+
+func (x *EventTransfer) Reset() {
+	*x = EventTransfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventTransfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventTransfer) ProtoMessage() {}
+
+// Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
+func (*EventTransfer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *EventTransfer) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetTradableAmount() string {
+	if x != nil {
+		return x.TradableAmount
+	}
+	return ""
+}
+
+func (x *EventTransfer) GetRetiredAmount() string {
+	if x != nil {
+		return x.RetiredAmount
+	}
+	return ""
+}
+
+// EventRetire is an event emitted when credits are retired. When credits are
+// retired from multiple batches in the same transaction, a separate event is
+// emitted for each batch_denom. This allows for easier indexing.
+type EventRetire struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the account that owns the retired credits. This
+	// will be the account receiving credits in the case that credits were retired
+	// upon issuance using Msg/CreateBatch, retired upon transfer using Msg/Send,
+	// retired upon taking from a basket using basket.Msg/Take, or retired upon
+	// purchase using marketplace.Msg/BuyDirect.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were retired.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of credits that have been retired.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// jurisdiction is the jurisdiction of the beneficiary or buyer of the retired
+	// credits. It is a string of the form
+	// <country-code>[-<sub-national-code>[ <postal-code>]], with the first two
+	// fields conforming to ISO 3166-2, and postal-code being up to 64
+	// alphanumeric characters.
+	Jurisdiction string `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// retirement_id is the unique identifier of the retirement recorded in
+	// state.
+	RetirementId uint64 `protobuf:"varint,5,opt,name=retirement_id,json=retirementId,proto3" json:"retirement_id,omitempty"`
+	// beneficiary is the name or address of the person or organization on whose
+	// behalf the credits were retired. An empty beneficiary indicates that the
+	// credits were retired on behalf of the owner.
+	Beneficiary string `protobuf:"bytes,6,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// reason is the reason for retiring the credits.
+	Reason string `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventRetire) Reset() {
+	*x = EventRetire{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventRetire) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventRetire) ProtoMessage() {}
+
+// Deprecated: Use EventRetire.ProtoReflect.Descriptor instead.
+func (*EventRetire) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *EventRetire) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventRetire) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventRetire) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventRetire) GetJurisdiction() string {
+	if x != nil {
+		return x.Jurisdiction
+	}
+	return ""
+}
+
+func (x *EventRetire) GetRetirementId() uint64 {
+	if x != nil {
+		return x.RetirementId
+	}
+	return 0
+}
+
+func (x *EventRetire) GetBeneficiary() string {
+	if x != nil {
+		return x.Beneficiary
+	}
+	return ""
+}
+
+func (x *EventRetire) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventCancel is an event emitted when credits are cancelled. When credits are
+// cancelled from multiple batches in the same transaction, a separate event is
+// emitted for each batch_denom. This allows for easier indexing.
+type EventCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the account that cancelled the credits.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were minted.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// tradable_amount is the amount of tradable credits minted.
-	TradableAmount string `protobuf:"bytes,2,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
-	// retired_amount is the amount of retired credits minted.
-	RetiredAmount string `protobuf:"bytes,3,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// credits were cancelled.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of credits that have been cancelled.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// reason is the reason the credits were cancelled.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventCancel) Reset() {
+	*x = EventCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventCancel) ProtoMessage() {}
+
+// Deprecated: Use EventCancel.ProtoReflect.Descriptor instead.
+func (*EventCancel) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *EventCancel) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventCancel) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventCancel) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventCancel) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventReverse is an event emitted when credits are reversed.
+type EventReverse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// owner is the address of the account from which the credits were reversed.
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// batch_denom is the unique identifier of the credit batch within which the
+	// credits were reversed.
+	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// amount is the decimal number of credits that have been reversed.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// buffer is whether the credits were reversed from the buffer pool of the
+	// credit class.
+	Buffer bool `protobuf:"varint,4,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	// reason is a description of the reversal event.
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *EventReverse) Reset() {
+	*x = EventReverse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventReverse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventReverse) ProtoMessage() {}
+
+// Deprecated: Use EventReverse.ProtoReflect.Descriptor instead.
+func (*EventReverse) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *EventReverse) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *EventReverse) GetBatchDenom() string {
+	if x != nil {
+		return x.BatchDenom
+	}
+	return ""
+}
+
+func (x *EventReverse) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *EventReverse) GetBuffer() bool {
+	if x != nil {
+		return x.Buffer
+	}
+	return false
+}
+
+func (x *EventReverse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// EventUpdateClassAdmin is emitted when the admin address of a credit class is
+// changed.
+type EventUpdateClassAdmin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassAdmin) Reset() {
+	*x = EventUpdateClassAdmin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassAdmin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassAdmin) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassAdmin.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassAdmin) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *EventUpdateClassAdmin) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventUpdateClassIssuers is emitted when the issuer list for a credit class
+// is updated.
+type EventUpdateClassIssuers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassIssuers) Reset() {
+	*x = EventUpdateClassIssuers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventUpdateClassIssuers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassIssuers) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassIssuers.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassIssuers) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *EventUpdateClassIssuers) GetClassId() string {
+	if x != nil {
+		return x.ClassId
+	}
+	return ""
+}
+
+// EventUpdateClassMetadata is emitted when the credit class metadata is
+// changed.
+type EventUpdateClassMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (x *EventMint) Reset() {
-	*x = EventMint{}
+func (x *EventUpdateClassMetadata) Reset() {
+	*x = EventUpdateClassMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[3]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventMint) String() string {
+func (x *EventUpdateClassMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventMint) ProtoMessage() {}
+func (*EventUpdateClassMetadata) ProtoMessage() {}
 
-// Deprecated: Use EventMint.ProtoReflect.Descriptor instead.
-func (*EventMint) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use EventUpdateClassMetadata.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *EventMint) GetBatchDenom() string {
+func (x *EventUpdateClassMetadata) GetClassId() string {
 	if x != nil {
-		return x.BatchDenom
+		return x.ClassId
 	}
 	return ""
 }
 
-func (x *EventMint) GetTradableAmount() string {
-	if x != nil {
-		return x.TradableAmount
+// EventUpdateClassBuffer is emitted when the buffer percentage of a credit
+// class is changed.
+type EventUpdateClassBuffer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (x *EventUpdateClassBuffer) Reset() {
+	*x = EventUpdateClassBuffer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *EventMint) GetRetiredAmount() string {
+func (x *EventUpdateClassBuffer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventUpdateClassBuffer) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassBuffer.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassBuffer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EventUpdateClassBuffer) GetClassId() string {
 	if x != nil {
-		return x.RetiredAmount
+		return x.ClassId
 	}
 	return ""
 }
 
-// EventMintBatchCredits is an event emitted when credits are minted to an
-// existing open credit batch.
-type EventMintBatchCredits struct {
+// EventUpdateProjectAdmin is emitted when the project admin is changed.
+type EventUpdateProjectAdmin struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were minted.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// origin_tx is the transaction from another chain or registry that triggered
-	// the minting of credits within the credit batch.
-	OriginTx *OriginTx `protobuf:"bytes,2,opt,name=origin_tx,json=originTx,proto3" json:"origin_tx,omitempty"`
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *EventMintBatchCredits) Reset() {
-	*x = EventMintBatchCredits{}
+func (x *EventUpdateProjectAdmin) Reset() {
+	*x = EventUpdateProjectAdmin{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[4]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventMintBatchCredits) String() string {
+func (x *EventUpdateProjectAdmin) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventMintBatchCredits) ProtoMessage() {}
+func (*EventUpdateProjectAdmin) ProtoMessage() {}
 
-// Deprecated: Use EventMintBatchCredits.ProtoReflect.Descriptor instead.
-func (*EventMintBatchCredits) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use EventUpdateProjectAdmin.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectAdmin) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *EventMintBatchCredits) GetBatchDenom() string {
+func (x *EventUpdateProjectAdmin) GetProjectId() string {
 	if x != nil {
-		return x.BatchDenom
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *EventMintBatchCredits) GetOriginTx() *OriginTx {
-	if x != nil {
-		return x.OriginTx
-	}
-	return nil
-}
-
-// EventTransfer is an event emitted when credits are transferred from one
-// account to another including transfers to or from a module account.
-type EventTransfer struct {
+// EventUpdateProjectMetadata is emitted when the project metadata is changed.
+type EventUpdateProjectMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// sender is the sender of the credits. In the case that the credits were
-	// transferred from a base account, this will be the account address. In the
-	// case that the credits were transferred from a module, this will be the
-	// module address (i.e. either the ecocredit module or basket submodule).
-	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	// recipient is the recipient of the credits. In the case that the credits
-	// were transferred to a base account, this will be the account address. In
-	// the case that the credits were transferred to a module, this will be the
-	// module address (i.e. either the ecocredit module or basket submodule).
-	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
-	// batch_denom is the unique identifier of the credit batch.
-	BatchDenom string `protobuf:"bytes,3,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// tradable_amount is the decimal number of tradable credits received.
-	TradableAmount string `protobuf:"bytes,4,opt,name=tradable_amount,json=tradableAmount,proto3" json:"tradable_amount,omitempty"`
-	// retired_amount is the decimal number of retired credits received.
-	RetiredAmount string `protobuf:"bytes,5,opt,name=retired_amount,json=retiredAmount,proto3" json:"retired_amount,omitempty"`
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *EventTransfer) Reset() {
-	*x = EventTransfer{}
+func (x *EventUpdateProjectMetadata) Reset() {
+	*x = EventUpdateProjectMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[5]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventTransfer) String() string {
+func (x *EventUpdateProjectMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventTransfer) ProtoMessage() {}
+func (*EventUpdateProjectMetadata) ProtoMessage() {}
 
-// Deprecated: Use EventTransfer.ProtoReflect.Descriptor instead.
-func (*EventTransfer) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use EventUpdateProjectMetadata.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectMetadata) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EventTransfer) GetSender() string {
+func (x *EventUpdateProjectMetadata) GetProjectId() string {
 	if x != nil {
-		return x.Sender
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *EventTransfer) GetRecipient() string {
-	if x != nil {
-		return x.Recipient
-	}
-	return ""
+// EventUpdateClassVerifier is emitted when the verifier of a credit class is
+// changed.
+type EventUpdateClassVerifier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// class_id is the unique identifier of the class that was updated.
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (x *EventTransfer) GetBatchDenom() string {
-	if x != nil {
-		return x.BatchDenom
+func (x *EventUpdateClassVerifier) Reset() {
+	*x = EventUpdateClassVerifier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *EventTransfer) GetTradableAmount() string {
-	if x != nil {
-		return x.TradableAmount
-	}
-	return ""
+func (x *EventUpdateClassVerifier) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *EventTransfer) GetRetiredAmount() string {
+func (*EventUpdateClassVerifier) ProtoMessage() {}
+
+// Deprecated: Use EventUpdateClassVerifier.ProtoReflect.Descriptor instead.
+func (*EventUpdateClassVerifier) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *EventUpdateClassVerifier) GetClassId() string {
 	if x != nil {
-		return x.RetiredAmount
+		return x.ClassId
 	}
 	return ""
 }
 
-// EventRetire is an event emitted when credits are retired. When credits are
-// retired from multiple batches in the same transaction, a separate event is
-// emitted for each batch_denom. This allows for easier indexing.
-type EventRetire struct {
+// EventUpdateProjectStatus is emitted when the status of a project is changed.
+type EventUpdateProjectStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the address of the account that owns the retired credits. This
-	// will be the account receiving credits in the case that credits were retired
-	// upon issuance using Msg/CreateBatch, retired upon transfer using Msg/Send,
-	// retired upon taking from a basket using basket.Msg/Take, or retired upon
-	// purchase using marketplace.Msg/BuyDirect.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were retired.
-	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// amount is the decimal number of credits that have been retired.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// jurisdiction is the jurisdiction of the beneficiary or buyer of the retired
-	// credits. It is a string of the form
-	// <country-code>[-<sub-national-code>[ <postal-code>]], with the first two
-	// fields conforming to ISO 3166-2, and postal-code being up to 64
-	// alphanumeric characters.
-	Jurisdiction string `protobuf:"bytes,4,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// status is the new status of the project.
+	Status ProjectStatus `protobuf:"varint,2,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
+	// reason is the reason for updating the status of the project.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *EventRetire) Reset() {
-	*x = EventRetire{}
+func (x *EventUpdateProjectStatus) Reset() {
+	*x = EventUpdateProjectStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[6]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventRetire) String() string {
+func (x *EventUpdateProjectStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventRetire) ProtoMessage() {}
-
-// Deprecated: Use EventRetire.ProtoReflect.Descriptor instead.
-func (*EventRetire) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{6}
-}
+func (*EventUpdateProjectStatus) ProtoMessage() {}
 
-func (x *EventRetire) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
+// Deprecated: Use EventUpdateProjectStatus.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectStatus) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *EventRetire) GetBatchDenom() string {
+func (x *EventUpdateProjectStatus) GetProjectId() string {
 	if x != nil {
-		return x.BatchDenom
+		return x.ProjectId
 	}
 	return ""
 }
 
-func (x *EventRetire) GetAmount() string {
+func (x *EventUpdateProjectStatus) GetStatus() ProjectStatus {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
-	return ""
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
 }
 
-func (x *EventRetire) GetJurisdiction() string {
+func (x *EventUpdateProjectStatus) GetReason() string {
 	if x != nil {
-		return x.Jurisdiction
+		return x.Reason
 	}
 	return ""
 }
 
-// EventCancel is an event emitted when credits are cancelled. When credits are
-// cancelled from multiple batches in the same transaction, a separate event is
-// emitted for each batch_denom. This allows for easier indexing.
-type EventCancel struct {
+// EventUpdateProjectIssuers is emitted when the issuer list for a project is
+// updated.
+type EventUpdateProjectIssuers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// owner is the address of the account that cancelled the credits.
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
-	// batch_denom is the unique identifier of the credit batch within which the
-	// credits were cancelled.
-	BatchDenom string `protobuf:"bytes,2,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
-	// amount is the decimal number of credits that have been cancelled.
-	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	// reason is the reason the credits were cancelled.
-	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *EventCancel) Reset() {
-	*x = EventCancel{}
+func (x *EventUpdateProjectIssuers) Reset() {
+	*x = EventUpdateProjectIssuers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[7]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventCancel) String() string {
+func (x *EventUpdateProjectIssuers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventCancel) ProtoMessage() {}
-
-// Deprecated: Use EventCancel.ProtoReflect.Descriptor instead.
-func (*EventCancel) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *EventCancel) GetOwner() string {
-	if x != nil {
-		return x.Owner
-	}
-	return ""
-}
-
-func (x *EventCancel) GetBatchDenom() string {
-	if x != nil {
-		return x.BatchDenom
-	}
-	return ""
-}
+func (*EventUpdateProjectIssuers) ProtoMessage() {}
 
-func (x *EventCancel) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use EventUpdateProjectIssuers.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectIssuers) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *EventCancel) GetReason() string {
+func (x *EventUpdateProjectIssuers) GetProjectId() string {
 	if x != nil {
-		return x.Reason
+		return x.ProjectId
 	}
 	return ""
 }
 
-// EventUpdateClassAdmin is emitted when the admin address of a credit class is
-// changed.
-type EventUpdateClassAdmin struct {
+// EventUpdateProjectVerifiers is emitted when the verifiers or monitoring
+// bodies of a project are updated.
+type EventUpdateProjectVerifiers struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// class_id is the unique identifier of the class that was updated.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// project_id is the unique identifier of the project that was updated.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
 }
 
-func (x *EventUpdateClassAdmin) Reset() {
-	*x = EventUpdateClassAdmin{}
+func (x *EventUpdateProjectVerifiers) Reset() {
+	*x = EventUpdateProjectVerifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[8]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateClassAdmin) String() string {
+func (x *EventUpdateProjectVerifiers) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateClassAdmin) ProtoMessage() {}
+func (*EventUpdateProjectVerifiers) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateClassAdmin.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassAdmin) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use EventUpdateProjectVerifiers.ProtoReflect.Descriptor instead.
+func (*EventUpdateProjectVerifiers) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *EventUpdateClassAdmin) GetClassId() string {
+func (x *EventUpdateProjectVerifiers) GetProjectId() string {
 	if x != nil {
-		return x.ClassId
+		return x.ProjectId
 	}
 	return ""
 }
 
-// EventUpdateClassIssuers is emitted when the issuer list for a credit class
-// is updated.
-type EventUpdateClassIssuers struct {
+// EventProposeProjectTransfer is emitted when a project transfer is proposed.
+type EventProposeProjectTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// class_id is the unique identifier of the class that was updated.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// project_id is the unique identifier of the project.
+	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// class_id is the unique identifier of the credit class that the project
+	// will be transferred to.
+	ClassId string `protobuf:"bytes,2,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (x *EventUpdateClassIssuers) Reset() {
-	*x = EventUpdateClassIssuers{}
+func (x *EventProposeProjectTransfer) Reset() {
+	*x = EventProposeProjectTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[9]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateClassIssuers) String() string {
+func (x *EventProposeProjectTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateClassIssuers) ProtoMessage() {}
+func (*EventProposeProjectTransfer) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateClassIssuers.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassIssuers) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use EventProposeProjectTransfer.ProtoReflect.Descriptor instead.
+func (*EventProposeProjectTransfer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *EventUpdateClassIssuers) GetClassId() string {
+func (x *EventProposeProjectTransfer) GetProjectId() string {
+	if x != nil {
+		return x.ProjectId
+	}
+	return ""
+}
+
+func (x *EventProposeProjectTransfer) GetClassId() string {
 	if x != nil {
 		return x.ClassId
 	}
 	return ""
 }
 
-// EventUpdateClassMetadata is emitted when the credit class metadata is
-// changed.
-type EventUpdateClassMetadata struct {
+// EventAcceptProjectTransfer is emitted when a project transfer is accepted.
+type EventAcceptProjectTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// class_id is the unique identifier of the class that was updated.
-	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	// old_project_id is the previous unique identifier of the project, which
+	// remains an alias of the project.
+	OldProjectId string `protobuf:"bytes,1,opt,name=old_project_id,json=oldProjectId,proto3" json:"old_project_id,omitempty"`
+	// new_project_id is the new unique identifier of the project.
+	NewProjectId string `protobuf:"bytes,2,opt,name=new_project_id,json=newProjectId,proto3" json:"new_project_id,omitempty"`
+	// class_id is the unique identifier of the credit class that the project
+	// was transferred to.
+	ClassId string `protobuf:"bytes,3,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 }
 
-func (x *EventUpdateClassMetadata) Reset() {
-	*x = EventUpdateClassMetadata{}
+func (x *EventAcceptProjectTransfer) Reset() {
+	*x = EventAcceptProjectTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[10]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateClassMetadata) String() string {
+func (x *EventAcceptProjectTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateClassMetadata) ProtoMessage() {}
+func (*EventAcceptProjectTransfer) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateClassMetadata.ProtoReflect.Descriptor instead.
-func (*EventUpdateClassMetadata) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use EventAcceptProjectTransfer.ProtoReflect.Descriptor instead.
+func (*EventAcceptProjectTransfer) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *EventUpdateClassMetadata) GetClassId() string {
+func (x *EventAcceptProjectTransfer) GetOldProjectId() string {
+	if x != nil {
+		return x.OldProjectId
+	}
+	return ""
+}
+
+func (x *EventAcceptProjectTransfer) GetNewProjectId() string {
+	if x != nil {
+		return x.NewProjectId
+	}
+	return ""
+}
+
+func (x *EventAcceptProjectTransfer) GetClassId() string {
 	if x != nil {
 		return x.ClassId
 	}
 	return ""
 }
 
-// EventUpdateProjectAdmin is emitted when the project admin is changed.
-type EventUpdateProjectAdmin struct {
+// EventSealBatch is emitted when a batch is sealed.
+type EventSealBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// project_id is the unique identifier of the project that was updated.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// batch_denom is the denom of the batch that was sealed.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
 }
 
-func (x *EventUpdateProjectAdmin) Reset() {
-	*x = EventUpdateProjectAdmin{}
+func (x *EventSealBatch) Reset() {
+	*x = EventSealBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[11]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateProjectAdmin) String() string {
+func (x *EventSealBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateProjectAdmin) ProtoMessage() {}
+func (*EventSealBatch) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateProjectAdmin.ProtoReflect.Descriptor instead.
-func (*EventUpdateProjectAdmin) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use EventSealBatch.ProtoReflect.Descriptor instead.
+func (*EventSealBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *EventUpdateProjectAdmin) GetProjectId() string {
+func (x *EventSealBatch) GetBatchDenom() string {
 	if x != nil {
-		return x.ProjectId
+		return x.BatchDenom
 	}
 	return ""
 }
 
-// EventUpdateProjectMetadata is emitted when the project metadata is changed.
-type EventUpdateProjectMetadata struct {
+// EventSplitBatch is emitted when a credit batch is split into sub-vintage
+// credit batches.
+type EventSplitBatch struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// project_id is the unique identifier of the project that was updated.
-	ProjectId string `protobuf:"bytes,1,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	// batch_denom is the denom of the credit batch that was split.
+	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// new_batch_denoms are the denoms of the credit batches that were created.
+	NewBatchDenoms []string `protobuf:"bytes,2,rep,name=new_batch_denoms,json=newBatchDenoms,proto3" json:"new_batch_denoms,omitempty"`
 }
 
-func (x *EventUpdateProjectMetadata) Reset() {
-	*x = EventUpdateProjectMetadata{}
+func (x *EventSplitBatch) Reset() {
+	*x = EventSplitBatch{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[12]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventUpdateProjectMetadata) String() string {
+func (x *EventSplitBatch) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventUpdateProjectMetadata) ProtoMessage() {}
+func (*EventSplitBatch) ProtoMessage() {}
 
-// Deprecated: Use EventUpdateProjectMetadata.ProtoReflect.Descriptor instead.
-func (*EventUpdateProjectMetadata) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use EventSplitBatch.ProtoReflect.Descriptor instead.
+func (*EventSplitBatch) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EventUpdateProjectMetadata) GetProjectId() string {
+func (x *EventSplitBatch) GetBatchDenom() string {
 	if x != nil {
-		return x.ProjectId
+		return x.BatchDenom
 	}
 	return ""
 }
 
-// EventSealBatch is emitted when a batch is sealed.
-type EventSealBatch struct {
+func (x *EventSplitBatch) GetNewBatchDenoms() []string {
+	if x != nil {
+		return x.NewBatchDenoms
+	}
+	return nil
+}
+
+// EventMergeBatches is emitted when credit batches are merged into a single
+// credit batch.
+type EventMergeBatches struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// batch_denom is the denom of the batch that was sealed.
-	BatchDenom string `protobuf:"bytes,1,opt,name=batch_denom,json=batchDenom,proto3" json:"batch_denom,omitempty"`
+	// batch_denoms are the denoms of the credit batches that were merged.
+	BatchDenoms []string `protobuf:"bytes,1,rep,name=batch_denoms,json=batchDenoms,proto3" json:"batch_denoms,omitempty"`
+	// new_batch_denom is the denom of the credit batch that was created.
+	NewBatchDenom string `protobuf:"bytes,2,opt,name=new_batch_denom,json=newBatchDenom,proto3" json:"new_batch_denom,omitempty"`
 }
 
-func (x *EventSealBatch) Reset() {
-	*x = EventSealBatch{}
+func (x *EventMergeBatches) Reset() {
+	*x = EventMergeBatches{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[13]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EventSealBatch) String() string {
+func (x *EventMergeBatches) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventSealBatch) ProtoMessage() {}
+func (*EventMergeBatches) ProtoMessage() {}
 
-// Deprecated: Use EventSealBatch.ProtoReflect.Descriptor instead.
-func (*EventSealBatch) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use EventMergeBatches.ProtoReflect.Descriptor instead.
+func (*EventMergeBatches) Descriptor() ([]byte, []int) {
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *EventSealBatch) GetBatchDenom() string {
+func (x *EventMergeBatches) GetBatchDenoms() []string {
 	if x != nil {
-		return x.BatchDenom
+		return x.BatchDenoms
+	}
+	return nil
+}
+
+func (x *EventMergeBatches) GetNewBatchDenom() string {
+	if x != nil {
+		return x.NewBatchDenom
 	}
 	return ""
 }
@@ -9034,7 +14718,7 @@ type EventAddCreditType struct {
 func (x *EventAddCreditType) Reset() {
 	*x = EventAddCreditType{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[14]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9048,7 +14732,7 @@ func (*EventAddCreditType) ProtoMessage() {}
 
 // Deprecated: Use EventAddCreditType.ProtoReflect.Descriptor instead.
 func (*EventAddCreditType) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{14}
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *EventAddCreditType) GetAbbreviation() string {
@@ -9077,7 +14761,7 @@ type EventBridge struct {
 func (x *EventBridge) Reset() {
 	*x = EventBridge{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[15]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9091,7 +14775,7 @@ func (*EventBridge) ProtoMessage() {}
 
 // Deprecated: Use EventBridge.ProtoReflect.Descriptor instead.
 func (*EventBridge) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{15}
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *EventBridge) GetTarget() string {
@@ -9139,7 +14823,7 @@ type EventBridgeReceive struct {
 func (x *EventBridgeReceive) Reset() {
 	*x = EventBridgeReceive{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[16]
+		mi := &file_regen_ecocredit_v1_events_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -9153,7 +14837,7 @@ func (*EventBridgeReceive) ProtoMessage() {}
 
 // Deprecated: Use EventBridgeReceive.ProtoReflect.Descriptor instead.
 func (*EventBridgeReceive) Descriptor() ([]byte, []int) {
-	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{16}
+	return file_regen_ecocredit_v1_events_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *EventBridgeReceive) GetProjectId() string {
@@ -9177,6 +14861,8 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x74, 0x2f, 0x76, 0x31, 0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x12, 0x12, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64,
 	0x69, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x1e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
+	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63, 0x6f,
 	0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x10, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61,
@@ -9218,7 +14904,7 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x74, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x25,
 	0x0a, 0x0e, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
 	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x64, 0x41,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xdf, 0x01, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x74, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
 	0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
@@ -9226,66 +14912,130 @@ var file_regen_ecocredit_v1_events_proto_rawDesc = []byte{
 	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
 	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6a, 0x75, 0x72, 0x69, 0x73, 0x64, 0x69, 0x63,
 	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6a, 0x75, 0x72, 0x69,
-	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a,
-	0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32,
-	0x0a, 0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61,
-	0x73, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73,
-	0x49, 0x64, 0x22, 0x34, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a,
-	0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22,
-	0x38, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72,
-	0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x1a, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65,
-	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
-	0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53,
-	0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63,
-	0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62,
-	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x38, 0x0a, 0x12, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x22, 0x0a, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64,
-	0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x12,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69,
-	0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49,
-	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x42, 0xd9, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65, 0x6e,
-	0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65, 0x64,
-	0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x63,
-	0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63, 0x72,
-	0x65, 0x64, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12, 0x52,
-	0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65,
-	0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45,
-	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x3a,
-	0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x64, 0x69, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x74, 0x69,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0c, 0x72, 0x65, 0x74, 0x69, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a,
+	0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x62, 0x65, 0x6e, 0x65, 0x66, 0x69, 0x63, 0x69, 0x61, 0x72, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x74, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8d, 0x01,
+	0x0a, 0x0c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x32, 0x0a,
+	0x15, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73,
+	0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x22, 0x34, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x33,
+	0x0a, 0x16, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61,
+	0x73, 0x73, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x17, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3b, 0x0a,
+	0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x35, 0x0a, 0x18, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x61, 0x73, 0x73, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49,
+	0x64, 0x22, 0x8c, 0x01, 0x0a, 0x18, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x39, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x72, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x22, 0x3a, 0x0a, 0x19, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a,
+	0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x3c, 0x0a, 0x1b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65,
+	0x63, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1b, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6c, 0x61, 0x73,
+	0x73, 0x49, 0x64, 0x22, 0x83, 0x01, 0x0a, 0x1a, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x12, 0x24, 0x0a, 0x0e, 0x6f, 0x6c, 0x64, 0x5f, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x6c, 0x64, 0x50,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x6e, 0x65, 0x77, 0x5f,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x49, 0x64, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6c, 0x61, 0x73, 0x73, 0x49, 0x64, 0x22, 0x31, 0x0a, 0x0e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x62,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x5c, 0x0a, 0x0f,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x42, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x28, 0x0a, 0x10, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6e, 0x65, 0x77, 0x42,
+	0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x5e, 0x0a, 0x11, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x65, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x77, 0x5f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77,
+	0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x38, 0x0a, 0x12, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x41, 0x64, 0x64, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x62, 0x62, 0x72, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x77, 0x0a, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x54, 0x0a,
+	0x12, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65,
+	0x69, 0x76, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74,
+	0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x42, 0xd9, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x72, 0x65, 0x67, 0x65,
+	0x6e, 0x2e, 0x65, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x48, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2d, 0x6c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x72, 0x65, 0x67, 0x65, 0x6e, 0x2f, 0x65,
+	0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x65, 0x63, 0x6f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x52, 0x45, 0x58, 0xaa, 0x02, 0x12,
+	0x52, 0x65, 0x67, 0x65, 0x6e, 0x2e, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x2e,
+	0x56, 0x31, 0xca, 0x02, 0x12, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c, 0x45, 0x63, 0x6f, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1e, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x5c,
+	0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x14, 0x52, 0x65, 0x67, 0x65, 0x6e,
+	0x3a, 0x3a, 0x45, 0x63, 0x6f, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -9300,35 +15050,47 @@ func file_regen_ecocredit_v1_events_proto_rawDescGZIP() []byte {
 	return file_regen_ecocredit_v1_events_proto_rawDescData
 }
 
-var file_regen_ecocredit_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_regen_ecocredit_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_regen_ecocredit_v1_events_proto_goTypes = []interface{}{
-	(*EventCreateClass)(nil),           // 0: regen.ecocredit.v1.EventCreateClass
-	(*EventCreateProject)(nil),         // 1: regen.ecocredit.v1.EventCreateProject
-	(*EventCreateBatch)(nil),           // 2: regen.ecocredit.v1.EventCreateBatch
-	(*EventMint)(nil),                  // 3: regen.ecocredit.v1.EventMint
-	(*EventMintBatchCredits)(nil),      // 4: regen.ecocredit.v1.EventMintBatchCredits
-	(*EventTransfer)(nil),              // 5: regen.ecocredit.v1.EventTransfer
-	(*EventRetire)(nil),                // 6: regen.ecocredit.v1.EventRetire
-	(*EventCancel)(nil),                // 7: regen.ecocredit.v1.EventCancel
-	(*EventUpdateClassAdmin)(nil),      // 8: regen.ecocredit.v1.EventUpdateClassAdmin
-	(*EventUpdateClassIssuers)(nil),    // 9: regen.ecocredit.v1.EventUpdateClassIssuers
-	(*EventUpdateClassMetadata)(nil),   // 10: regen.ecocredit.v1.EventUpdateClassMetadata
-	(*EventUpdateProjectAdmin)(nil),    // 11: regen.ecocredit.v1.EventUpdateProjectAdmin
-	(*EventUpdateProjectMetadata)(nil), // 12: regen.ecocredit.v1.EventUpdateProjectMetadata
-	(*EventSealBatch)(nil),             // 13: regen.ecocredit.v1.EventSealBatch
-	(*EventAddCreditType)(nil),         // 14: regen.ecocredit.v1.EventAddCreditType
-	(*EventBridge)(nil),                // 15: regen.ecocredit.v1.EventBridge
-	(*EventBridgeReceive)(nil),         // 16: regen.ecocredit.v1.EventBridgeReceive
-	(*OriginTx)(nil),                   // 17: regen.ecocredit.v1.OriginTx
+	(*EventCreateClass)(nil),            // 0: regen.ecocredit.v1.EventCreateClass
+	(*EventCreateProject)(nil),          // 1: regen.ecocredit.v1.EventCreateProject
+	(*EventCreateBatch)(nil),            // 2: regen.ecocredit.v1.EventCreateBatch
+	(*EventMint)(nil),                   // 3: regen.ecocredit.v1.EventMint
+	(*EventMintBatchCredits)(nil),       // 4: regen.ecocredit.v1.EventMintBatchCredits
+	(*EventTransfer)(nil),               // 5: regen.ecocredit.v1.EventTransfer
+	(*EventRetire)(nil),                 // 6: regen.ecocredit.v1.EventRetire
+	(*EventCancel)(nil),                 // 7: regen.ecocredit.v1.EventCancel
+	(*EventReverse)(nil),                // 8: regen.ecocredit.v1.EventReverse
+	(*EventUpdateClassAdmin)(nil),       // 9: regen.ecocredit.v1.EventUpdateClassAdmin
+	(*EventUpdateClassIssuers)(nil),     // 10: regen.ecocredit.v1.EventUpdateClassIssuers
+	(*EventUpdateClassMetadata)(nil),    // 11: regen.ecocredit.v1.EventUpdateClassMetadata
+	(*EventUpdateClassBuffer)(nil),      // 12: regen.ecocredit.v1.EventUpdateClassBuffer
+	(*EventUpdateProjectAdmin)(nil),     // 13: regen.ecocredit.v1.EventUpdateProjectAdmin
+	(*EventUpdateProjectMetadata)(nil),  // 14: regen.ecocredit.v1.EventUpdateProjectMetadata
+	(*EventUpdateClassVerifier)(nil),    // 15: regen.ecocredit.v1.EventUpdateClassVerifier
+	(*EventUpdateProjectStatus)(nil),    // 16: regen.ecocredit.v1.EventUpdateProjectStatus
+	(*EventUpdateProjectIssuers)(nil),   // 17: regen.ecocredit.v1.EventUpdateProjectIssuers
+	(*EventUpdateProjectVerifiers)(nil), // 18: regen.ecocredit.v1.EventUpdateProjectVerifiers
+	(*EventProposeProjectTransfer)(nil), // 19: regen.ecocredit.v1.EventProposeProjectTransfer
+	(*EventAcceptProjectTransfer)(nil),  // 20: regen.ecocredit.v1.EventAcceptProjectTransfer
+	(*EventSealBatch)(nil),              // 21: regen.ecocredit.v1.EventSealBatch
+	(*EventSplitBatch)(nil),             // 22: regen.ecocredit.v1.EventSplitBatch
+	(*EventMergeBatches)(nil),           // 23: regen.ecocredit.v1.EventMergeBatches
+	(*EventAddCreditType)(nil),          // 24: regen.ecocredit.v1.EventAddCreditType
+	(*EventBridge)(nil),                 // 25: regen.ecocredit.v1.EventBridge
+	(*EventBridgeReceive)(nil),          // 26: regen.ecocredit.v1.EventBridgeReceive
+	(*OriginTx)(nil),                    // 27: regen.ecocredit.v1.OriginTx
+	(ProjectStatus)(0),                  // 28: regen.ecocredit.v1.ProjectStatus
 }
 var file_regen_ecocredit_v1_events_proto_depIdxs = []int32{
-	17, // 0: regen.ecocredit.v1.EventCreateBatch.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
-	17, // 1: regen.ecocredit.v1.EventMintBatchCredits.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
-	2,  // [2:2] is the sub-list for method output_type
-	2,  // [2:2] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	27, // 0: regen.ecocredit.v1.EventCreateBatch.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
+	27, // 1: regen.ecocredit.v1.EventMintBatchCredits.origin_tx:type_name -> regen.ecocredit.v1.OriginTx
+	28, // 2: regen.ecocredit.v1.EventUpdateProjectStatus.status:type_name -> regen.ecocredit.v1.ProjectStatus
+	3,  // [3:3] is the sub-list for method output_type
+	3,  // [3:3] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_regen_ecocredit_v1_events_proto_init() }
@@ -9336,6 +15098,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 	if File_regen_ecocredit_v1_events_proto != nil {
 		return
 	}
+	file_regen_ecocredit_v1_state_proto_init()
 	file_regen_ecocredit_v1_types_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_regen_ecocredit_v1_events_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -9435,7 +15198,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateClassAdmin); i {
+			switch v := v.(*EventReverse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9447,7 +15210,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateClassIssuers); i {
+			switch v := v.(*EventUpdateClassAdmin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9459,7 +15222,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateClassMetadata); i {
+			switch v := v.(*EventUpdateClassIssuers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9471,7 +15234,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateProjectAdmin); i {
+			switch v := v.(*EventUpdateClassMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9483,7 +15246,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventUpdateProjectMetadata); i {
+			switch v := v.(*EventUpdateClassBuffer); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9495,7 +15258,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventSealBatch); i {
+			switch v := v.(*EventUpdateProjectAdmin); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9507,7 +15270,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventAddCreditType); i {
+			switch v := v.(*EventUpdateProjectMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9519,7 +15282,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EventBridge); i {
+			switch v := v.(*EventUpdateClassVerifier); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -9531,6 +15294,126 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			}
 		}
 		file_regen_ecocredit_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateProjectStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateProjectIssuers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventUpdateProjectVerifiers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventProposeProjectTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAcceptProjectTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSealBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventSplitBatch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventMergeBatches); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventAddCreditType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventBridge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_regen_ecocredit_v1_events_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EventBridgeReceive); i {
 			case 0:
 				return &v.state
@@ -9549,7 +15432,7 @@ func file_regen_ecocredit_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_regen_ecocredit_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -41,8 +41,16 @@ type QueryClient interface {
 	// ProjectsByAdmin queries for all projects by admin with
 	// pagination.
 	ProjectsByAdmin(ctx context.Context, in *QueryProjectsByAdminRequest, opts ...grpc.CallOption) (*QueryProjectsByAdminResponse, error)
-	// Project queries for information on a project.
+	// Project queries for information on a project. The project can be queried
+	// by the project ID or a previous project ID of a transferred project.
 	Project(ctx context.Context, in *QueryProjectRequest, opts ...grpc.CallOption) (*QueryProjectResponse, error)
+	// ProjectIssuers queries for the addresses of the issuers for a project.
+	ProjectIssuers(ctx context.Context, in *QueryProjectIssuersRequest, opts ...grpc.CallOption) (*QueryProjectIssuersResponse, error)
+	// ProjectVerifiers queries for the verifiers and monitoring bodies of a
+	// project.
+	ProjectVerifiers(ctx context.Context, in *QueryProjectVerifiersRequest, opts ...grpc.CallOption) (*QueryProjectVerifiersResponse, error)
+	// ProjectTransfer queries for the proposed transfer of a project.
+	ProjectTransfer(ctx context.Context, in *QueryProjectTransferRequest, opts ...grpc.CallOption) (*QueryProjectTransferResponse, error)
 	// Batches queries for all batches with pagination.
 	Batches(ctx context.Context, in *QueryBatchesRequest, opts ...grpc.CallOption) (*QueryBatchesResponse, error)
 	// BatchesByIssuer queries all batches issued from a given issuer address.
@@ -54,6 +62,9 @@ type QueryClient interface {
 	BatchesByProject(ctx context.Context, in *QueryBatchesByProjectRequest, opts ...grpc.CallOption) (*QueryBatchesByProjectResponse, error)
 	// Batch queries for information on a credit batch.
 	Batch(ctx context.Context, in *QueryBatchRequest, opts ...grpc.CallOption) (*QueryBatchResponse, error)
+	// BatchLineage queries for the credit batches that a credit batch was split
+	// or merged from and the credit batches that were created from it.
+	BatchLineage(ctx context.Context, in *QueryBatchLineageRequest, opts ...grpc.CallOption) (*QueryBatchLineageResponse, error)
 	// Balance queries the balance (both tradable and retired) of a given credit
 	// batch for a given account address.
 	Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error)
@@ -68,6 +79,14 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// CreditType queries credit type information by abbreviation.
 	CreditType(ctx context.Context, in *QueryCreditTypeRequest, opts ...grpc.CallOption) (*QueryCreditTypeResponse, error)
+	// Retirement queries a retirement of credits by its unique identifier.
+	Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirements of credits by the account that
+	// retired the credits with pagination.
+	RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirements of credits within a credit
+	// batch with pagination.
+	RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error)
 }
 
 type queryClient struct {
@@ -159,6 +178,33 @@ func (c *queryClient) Project(ctx context.Context, in *QueryProjectRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ProjectIssuers(ctx context.Context, in *QueryProjectIssuersRequest, opts ...grpc.CallOption) (*QueryProjectIssuersResponse, error) {
+	out := new(QueryProjectIssuersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectIssuers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectVerifiers(ctx context.Context, in *QueryProjectVerifiersRequest, opts ...grpc.CallOption) (*QueryProjectVerifiersResponse, error) {
+	out := new(QueryProjectVerifiersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectVerifiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectTransfer(ctx context.Context, in *QueryProjectTransferRequest, opts ...grpc.CallOption) (*QueryProjectTransferResponse, error) {
+	out := new(QueryProjectTransferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/ProjectTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Batches(ctx context.Context, in *QueryBatchesRequest, opts ...grpc.CallOption) (*QueryBatchesResponse, error) {
 	out := new(QueryBatchesResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Batches", in, out, opts...)
@@ -204,6 +250,15 @@ func (c *queryClient) Batch(ctx context.Context, in *QueryBatchRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) BatchLineage(ctx context.Context, in *QueryBatchLineageRequest, opts ...grpc.CallOption) (*QueryBatchLineageResponse, error) {
+	out := new(QueryBatchLineageResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/BatchLineage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Balance(ctx context.Context, in *QueryBalanceRequest, opts ...grpc.CallOption) (*QueryBalanceResponse, error) {
 	out := new(QueryBalanceResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Balance", in, out, opts...)
@@ -258,6 +313,33 @@ func (c *queryClient) CreditType(ctx context.Context, in *QueryCreditTypeRequest
 	return out, nil
 }
 
+func (c *queryClient) Retirement(ctx context.Context, in *QueryRetirementRequest, opts ...grpc.CallOption) (*QueryRetirementResponse, error) {
+	out := new(QueryRetirementResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/Retirement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByOwner(ctx context.Context, in *QueryRetirementsByOwnerRequest, opts ...grpc.CallOption) (*QueryRetirementsByOwnerResponse, error) {
+	out := new(QueryRetirementsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RetirementsByBatch(ctx context.Context, in *QueryRetirementsByBatchRequest, opts ...grpc.CallOption) (*QueryRetirementsByBatchResponse, error) {
+	out := new(QueryRetirementsByBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Query/RetirementsByBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -281,8 +363,16 @@ type QueryServer interface {
 	// ProjectsByAdmin queries for all projects by admin with
 	// pagination.
 	ProjectsByAdmin(context.Context, *QueryProjectsByAdminRequest) (*QueryProjectsByAdminResponse, error)
-	// Project queries for information on a project.
+	// Project queries for information on a project. The project can be queried
+	// by the project ID or a previous project ID of a transferred project.
 	Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error)
+	// ProjectIssuers queries for the addresses of the issuers for a project.
+	ProjectIssuers(context.Context, *QueryProjectIssuersRequest) (*QueryProjectIssuersResponse, error)
+	// ProjectVerifiers queries for the verifiers and monitoring bodies of a
+	// project.
+	ProjectVerifiers(context.Context, *QueryProjectVerifiersRequest) (*QueryProjectVerifiersResponse, error)
+	// ProjectTransfer queries for the proposed transfer of a project.
+	ProjectTransfer(context.Context, *QueryProjectTransferRequest) (*QueryProjectTransferResponse, error)
 	// Batches queries for all batches with pagination.
 	Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error)
 	// BatchesByIssuer queries all batches issued from a given issuer address.
@@ -294,6 +384,9 @@ type QueryServer interface {
 	BatchesByProject(context.Context, *QueryBatchesByProjectRequest) (*QueryBatchesByProjectResponse, error)
 	// Batch queries for information on a credit batch.
 	Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error)
+	// BatchLineage queries for the credit batches that a credit batch was split
+	// or merged from and the credit batches that were created from it.
+	BatchLineage(context.Context, *QueryBatchLineageRequest) (*QueryBatchLineageResponse, error)
 	// Balance queries the balance (both tradable and retired) of a given credit
 	// batch for a given account address.
 	Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error)
@@ -308,6 +401,14 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// CreditType queries credit type information by abbreviation.
 	CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error)
+	// Retirement queries a retirement of credits by its unique identifier.
+	Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error)
+	// RetirementsByOwner queries all retirements of credits by the account that
+	// retired the credits with pagination.
+	RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error)
+	// RetirementsByBatch queries all retirements of credits within a credit
+	// batch with pagination.
+	RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -342,6 +443,15 @@ func (UnimplementedQueryServer) ProjectsByAdmin(context.Context, *QueryProjectsB
 func (UnimplementedQueryServer) Project(context.Context, *QueryProjectRequest) (*QueryProjectResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Project not implemented")
 }
+func (UnimplementedQueryServer) ProjectIssuers(context.Context, *QueryProjectIssuersRequest) (*QueryProjectIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectIssuers not implemented")
+}
+func (UnimplementedQueryServer) ProjectVerifiers(context.Context, *QueryProjectVerifiersRequest) (*QueryProjectVerifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectVerifiers not implemented")
+}
+func (UnimplementedQueryServer) ProjectTransfer(context.Context, *QueryProjectTransferRequest) (*QueryProjectTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectTransfer not implemented")
+}
 func (UnimplementedQueryServer) Batches(context.Context, *QueryBatchesRequest) (*QueryBatchesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Batches not implemented")
 }
@@ -357,6 +467,9 @@ func (UnimplementedQueryServer) BatchesByProject(context.Context, *QueryBatchesB
 func (UnimplementedQueryServer) Batch(context.Context, *QueryBatchRequest) (*QueryBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Batch not implemented")
 }
+func (UnimplementedQueryServer) BatchLineage(context.Context, *QueryBatchLineageRequest) (*QueryBatchLineageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchLineage not implemented")
+}
 func (UnimplementedQueryServer) Balance(context.Context, *QueryBalanceRequest) (*QueryBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Balance not implemented")
 }
@@ -375,6 +488,15 @@ func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*Q
 func (UnimplementedQueryServer) CreditType(context.Context, *QueryCreditTypeRequest) (*QueryCreditTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreditType not implemented")
 }
+func (UnimplementedQueryServer) Retirement(context.Context, *QueryRetirementRequest) (*QueryRetirementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Retirement not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByOwner(context.Context, *QueryRetirementsByOwnerRequest) (*QueryRetirementsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByOwner not implemented")
+}
+func (UnimplementedQueryServer) RetirementsByBatch(context.Context, *QueryRetirementsByBatchRequest) (*QueryRetirementsByBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetirementsByBatch not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -550,6 +672,60 @@ func _Query_Project_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProjectIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectIssuersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectIssuers(ctx, req.(*QueryProjectIssuersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectVerifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectVerifiersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectVerifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectVerifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectVerifiers(ctx, req.(*QueryProjectVerifiersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/ProjectTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectTransfer(ctx, req.(*QueryProjectTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Batches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBatchesRequest)
 	if err := dec(in); err != nil {
@@ -640,6 +816,24 @@ func _Query_Batch_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BatchLineage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchLineageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchLineage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/BatchLineage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchLineage(ctx, req.(*QueryBatchLineageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Balance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryBalanceRequest)
 	if err := dec(in); err != nil {
@@ -748,6 +942,60 @@ func _Query_CreditType_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Retirement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Retirement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/Retirement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Retirement(ctx, req.(*QueryRetirementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByOwner(ctx, req.(*QueryRetirementsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RetirementsByBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRetirementsByBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RetirementsByBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Query/RetirementsByBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RetirementsByBatch(ctx, req.(*QueryRetirementsByBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -791,6 +1039,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Project",
 			Handler:    _Query_Project_Handler,
 		},
+		{
+			MethodName: "ProjectIssuers",
+			Handler:    _Query_ProjectIssuers_Handler,
+		},
+		{
+			MethodName: "ProjectVerifiers",
+			Handler:    _Query_ProjectVerifiers_Handler,
+		},
+		{
+			MethodName: "ProjectTransfer",
+			Handler:    _Query_ProjectTransfer_Handler,
+		},
 		{
 			MethodName: "Batches",
 			Handler:    _Query_Batches_Handler,
@@ -811,6 +1071,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Batch",
 			Handler:    _Query_Batch_Handler,
 		},
+		{
+			MethodName: "BatchLineage",
+			Handler:    _Query_BatchLineage_Handler,
+		},
 		{
 			MethodName: "Balance",
 			Handler:    _Query_Balance_Handler,
@@ -835,6 +1099,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreditType",
 			Handler:    _Query_CreditType_Handler,
 		},
+		{
+			MethodName: "Retirement",
+			Handler:    _Query_Retirement_Handler,
+		},
+		{
+			MethodName: "RetirementsByOwner",
+			Handler:    _Query_RetirementsByOwner_Handler,
+		},
+		{
+			MethodName: "RetirementsByBatch",
+			Handler:    _Query_RetirementsByBatch_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "regen/ecocredit/v1/query.proto",
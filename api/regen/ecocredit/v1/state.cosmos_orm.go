@@ -1770,6 +1770,775 @@ func NewBatchContractTable(db ormtable.Schema) (BatchContractTable, error) {
 	return batchContractTable{table}, nil
 }
 
+type RetirementTable interface {
+	Insert(ctx context.Context, retirement *Retirement) error
+	InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error)
+	Update(ctx context.Context, retirement *Retirement) error
+	Save(ctx context.Context, retirement *Retirement) error
+	Delete(ctx context.Context, retirement *Retirement) error
+	Has(ctx context.Context, id uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, id uint64) (*Retirement, error)
+	List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error)
+	DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error
+	DeleteRange(ctx context.Context, from, to RetirementIndexKey) error
+
+	doNotImplement()
+}
+
+type RetirementIterator struct {
+	ormtable.Iterator
+}
+
+func (i RetirementIterator) Value() (*Retirement, error) {
+	var retirement Retirement
+	err := i.UnmarshalMessage(&retirement)
+	return &retirement, err
+}
+
+type RetirementIndexKey interface {
+	id() uint32
+	values() []interface{}
+	retirementIndexKey()
+}
+
+// primary key starting index..
+type RetirementPrimaryKey = RetirementIdIndexKey
+
+type RetirementIdIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementIdIndexKey) id() uint32            { return 0 }
+func (x RetirementIdIndexKey) values() []interface{} { return x.vs }
+func (x RetirementIdIndexKey) retirementIndexKey()   {}
+
+func (this RetirementIdIndexKey) WithId(id uint64) RetirementIdIndexKey {
+	this.vs = []interface{}{id}
+	return this
+}
+
+type RetirementOwnerIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementOwnerIndexKey) id() uint32            { return 1 }
+func (x RetirementOwnerIndexKey) values() []interface{} { return x.vs }
+func (x RetirementOwnerIndexKey) retirementIndexKey()   {}
+
+func (this RetirementOwnerIndexKey) WithOwner(owner []byte) RetirementOwnerIndexKey {
+	this.vs = []interface{}{owner}
+	return this
+}
+
+type RetirementBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x RetirementBatchKeyIndexKey) id() uint32            { return 2 }
+func (x RetirementBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x RetirementBatchKeyIndexKey) retirementIndexKey()   {}
+
+func (this RetirementBatchKeyIndexKey) WithBatchKey(batch_key uint64) RetirementBatchKeyIndexKey {
+	this.vs = []interface{}{batch_key}
+	return this
+}
+
+type retirementTable struct {
+	table ormtable.AutoIncrementTable
+}
+
+func (this retirementTable) Insert(ctx context.Context, retirement *Retirement) error {
+	return this.table.Insert(ctx, retirement)
+}
+
+func (this retirementTable) Update(ctx context.Context, retirement *Retirement) error {
+	return this.table.Update(ctx, retirement)
+}
+
+func (this retirementTable) Save(ctx context.Context, retirement *Retirement) error {
+	return this.table.Save(ctx, retirement)
+}
+
+func (this retirementTable) Delete(ctx context.Context, retirement *Retirement) error {
+	return this.table.Delete(ctx, retirement)
+}
+
+func (this retirementTable) InsertReturningID(ctx context.Context, retirement *Retirement) (uint64, error) {
+	return this.table.InsertReturningID(ctx, retirement)
+}
+
+func (this retirementTable) Has(ctx context.Context, id uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, id)
+}
+
+func (this retirementTable) Get(ctx context.Context, id uint64) (*Retirement, error) {
+	var retirement Retirement
+	found, err := this.table.PrimaryKey().Get(ctx, &retirement, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &retirement, nil
+}
+
+func (this retirementTable) List(ctx context.Context, prefixKey RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) ListRange(ctx context.Context, from, to RetirementIndexKey, opts ...ormlist.Option) (RetirementIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return RetirementIterator{it}, err
+}
+
+func (this retirementTable) DeleteBy(ctx context.Context, prefixKey RetirementIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this retirementTable) DeleteRange(ctx context.Context, from, to RetirementIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this retirementTable) doNotImplement() {}
+
+var _ RetirementTable = retirementTable{}
+
+func NewRetirementTable(db ormtable.Schema) (RetirementTable, error) {
+	table := db.GetTable(&Retirement{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&Retirement{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return retirementTable{table.(ormtable.AutoIncrementTable)}, nil
+}
+
+type ProjectIssuerTable interface {
+	Insert(ctx context.Context, projectIssuer *ProjectIssuer) error
+	Update(ctx context.Context, projectIssuer *ProjectIssuer) error
+	Save(ctx context.Context, projectIssuer *ProjectIssuer) error
+	Delete(ctx context.Context, projectIssuer *ProjectIssuer) error
+	Has(ctx context.Context, project_key uint64, issuer []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64, issuer []byte) (*ProjectIssuer, error)
+	List(ctx context.Context, prefixKey ProjectIssuerIndexKey, opts ...ormlist.Option) (ProjectIssuerIterator, error)
+	ListRange(ctx context.Context, from, to ProjectIssuerIndexKey, opts ...ormlist.Option) (ProjectIssuerIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectIssuerIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectIssuerIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectIssuerIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectIssuerIterator) Value() (*ProjectIssuer, error) {
+	var projectIssuer ProjectIssuer
+	err := i.UnmarshalMessage(&projectIssuer)
+	return &projectIssuer, err
+}
+
+type ProjectIssuerIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectIssuerIndexKey()
+}
+
+// primary key starting index..
+type ProjectIssuerPrimaryKey = ProjectIssuerProjectKeyIssuerIndexKey
+
+type ProjectIssuerProjectKeyIssuerIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectIssuerProjectKeyIssuerIndexKey) id() uint32             { return 0 }
+func (x ProjectIssuerProjectKeyIssuerIndexKey) values() []interface{}  { return x.vs }
+func (x ProjectIssuerProjectKeyIssuerIndexKey) projectIssuerIndexKey() {}
+
+func (this ProjectIssuerProjectKeyIssuerIndexKey) WithProjectKey(project_key uint64) ProjectIssuerProjectKeyIssuerIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+func (this ProjectIssuerProjectKeyIssuerIndexKey) WithProjectKeyIssuer(project_key uint64, issuer []byte) ProjectIssuerProjectKeyIssuerIndexKey {
+	this.vs = []interface{}{project_key, issuer}
+	return this
+}
+
+type projectIssuerTable struct {
+	table ormtable.Table
+}
+
+func (this projectIssuerTable) Insert(ctx context.Context, projectIssuer *ProjectIssuer) error {
+	return this.table.Insert(ctx, projectIssuer)
+}
+
+func (this projectIssuerTable) Update(ctx context.Context, projectIssuer *ProjectIssuer) error {
+	return this.table.Update(ctx, projectIssuer)
+}
+
+func (this projectIssuerTable) Save(ctx context.Context, projectIssuer *ProjectIssuer) error {
+	return this.table.Save(ctx, projectIssuer)
+}
+
+func (this projectIssuerTable) Delete(ctx context.Context, projectIssuer *ProjectIssuer) error {
+	return this.table.Delete(ctx, projectIssuer)
+}
+
+func (this projectIssuerTable) Has(ctx context.Context, project_key uint64, issuer []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key, issuer)
+}
+
+func (this projectIssuerTable) Get(ctx context.Context, project_key uint64, issuer []byte) (*ProjectIssuer, error) {
+	var projectIssuer ProjectIssuer
+	found, err := this.table.PrimaryKey().Get(ctx, &projectIssuer, project_key, issuer)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectIssuer, nil
+}
+
+func (this projectIssuerTable) List(ctx context.Context, prefixKey ProjectIssuerIndexKey, opts ...ormlist.Option) (ProjectIssuerIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectIssuerIterator{it}, err
+}
+
+func (this projectIssuerTable) ListRange(ctx context.Context, from, to ProjectIssuerIndexKey, opts ...ormlist.Option) (ProjectIssuerIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectIssuerIterator{it}, err
+}
+
+func (this projectIssuerTable) DeleteBy(ctx context.Context, prefixKey ProjectIssuerIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectIssuerTable) DeleteRange(ctx context.Context, from, to ProjectIssuerIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectIssuerTable) doNotImplement() {}
+
+var _ ProjectIssuerTable = projectIssuerTable{}
+
+func NewProjectIssuerTable(db ormtable.Schema) (ProjectIssuerTable, error) {
+	table := db.GetTable(&ProjectIssuer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectIssuer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectIssuerTable{table}, nil
+}
+
+type ProjectVerifierTable interface {
+	Insert(ctx context.Context, projectVerifier *ProjectVerifier) error
+	Update(ctx context.Context, projectVerifier *ProjectVerifier) error
+	Save(ctx context.Context, projectVerifier *ProjectVerifier) error
+	Delete(ctx context.Context, projectVerifier *ProjectVerifier) error
+	Has(ctx context.Context, project_key uint64, address []byte) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64, address []byte) (*ProjectVerifier, error)
+	List(ctx context.Context, prefixKey ProjectVerifierIndexKey, opts ...ormlist.Option) (ProjectVerifierIterator, error)
+	ListRange(ctx context.Context, from, to ProjectVerifierIndexKey, opts ...ormlist.Option) (ProjectVerifierIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectVerifierIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectVerifierIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectVerifierIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectVerifierIterator) Value() (*ProjectVerifier, error) {
+	var projectVerifier ProjectVerifier
+	err := i.UnmarshalMessage(&projectVerifier)
+	return &projectVerifier, err
+}
+
+type ProjectVerifierIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectVerifierIndexKey()
+}
+
+// primary key starting index..
+type ProjectVerifierPrimaryKey = ProjectVerifierProjectKeyAddressIndexKey
+
+type ProjectVerifierProjectKeyAddressIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectVerifierProjectKeyAddressIndexKey) id() uint32               { return 0 }
+func (x ProjectVerifierProjectKeyAddressIndexKey) values() []interface{}    { return x.vs }
+func (x ProjectVerifierProjectKeyAddressIndexKey) projectVerifierIndexKey() {}
+
+func (this ProjectVerifierProjectKeyAddressIndexKey) WithProjectKey(project_key uint64) ProjectVerifierProjectKeyAddressIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+func (this ProjectVerifierProjectKeyAddressIndexKey) WithProjectKeyAddress(project_key uint64, address []byte) ProjectVerifierProjectKeyAddressIndexKey {
+	this.vs = []interface{}{project_key, address}
+	return this
+}
+
+type projectVerifierTable struct {
+	table ormtable.Table
+}
+
+func (this projectVerifierTable) Insert(ctx context.Context, projectVerifier *ProjectVerifier) error {
+	return this.table.Insert(ctx, projectVerifier)
+}
+
+func (this projectVerifierTable) Update(ctx context.Context, projectVerifier *ProjectVerifier) error {
+	return this.table.Update(ctx, projectVerifier)
+}
+
+func (this projectVerifierTable) Save(ctx context.Context, projectVerifier *ProjectVerifier) error {
+	return this.table.Save(ctx, projectVerifier)
+}
+
+func (this projectVerifierTable) Delete(ctx context.Context, projectVerifier *ProjectVerifier) error {
+	return this.table.Delete(ctx, projectVerifier)
+}
+
+func (this projectVerifierTable) Has(ctx context.Context, project_key uint64, address []byte) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key, address)
+}
+
+func (this projectVerifierTable) Get(ctx context.Context, project_key uint64, address []byte) (*ProjectVerifier, error) {
+	var projectVerifier ProjectVerifier
+	found, err := this.table.PrimaryKey().Get(ctx, &projectVerifier, project_key, address)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectVerifier, nil
+}
+
+func (this projectVerifierTable) List(ctx context.Context, prefixKey ProjectVerifierIndexKey, opts ...ormlist.Option) (ProjectVerifierIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectVerifierIterator{it}, err
+}
+
+func (this projectVerifierTable) ListRange(ctx context.Context, from, to ProjectVerifierIndexKey, opts ...ormlist.Option) (ProjectVerifierIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectVerifierIterator{it}, err
+}
+
+func (this projectVerifierTable) DeleteBy(ctx context.Context, prefixKey ProjectVerifierIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectVerifierTable) DeleteRange(ctx context.Context, from, to ProjectVerifierIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectVerifierTable) doNotImplement() {}
+
+var _ ProjectVerifierTable = projectVerifierTable{}
+
+func NewProjectVerifierTable(db ormtable.Schema) (ProjectVerifierTable, error) {
+	table := db.GetTable(&ProjectVerifier{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectVerifier{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectVerifierTable{table}, nil
+}
+
+type ProjectTransferTable interface {
+	Insert(ctx context.Context, projectTransfer *ProjectTransfer) error
+	Update(ctx context.Context, projectTransfer *ProjectTransfer) error
+	Save(ctx context.Context, projectTransfer *ProjectTransfer) error
+	Delete(ctx context.Context, projectTransfer *ProjectTransfer) error
+	Has(ctx context.Context, project_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, project_key uint64) (*ProjectTransfer, error)
+	List(ctx context.Context, prefixKey ProjectTransferIndexKey, opts ...ormlist.Option) (ProjectTransferIterator, error)
+	ListRange(ctx context.Context, from, to ProjectTransferIndexKey, opts ...ormlist.Option) (ProjectTransferIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectTransferIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectTransferIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectTransferIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectTransferIterator) Value() (*ProjectTransfer, error) {
+	var projectTransfer ProjectTransfer
+	err := i.UnmarshalMessage(&projectTransfer)
+	return &projectTransfer, err
+}
+
+type ProjectTransferIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectTransferIndexKey()
+}
+
+// primary key starting index..
+type ProjectTransferPrimaryKey = ProjectTransferProjectKeyIndexKey
+
+type ProjectTransferProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectTransferProjectKeyIndexKey) id() uint32               { return 0 }
+func (x ProjectTransferProjectKeyIndexKey) values() []interface{}    { return x.vs }
+func (x ProjectTransferProjectKeyIndexKey) projectTransferIndexKey() {}
+
+func (this ProjectTransferProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectTransferProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type ProjectTransferClassKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectTransferClassKeyIndexKey) id() uint32               { return 1 }
+func (x ProjectTransferClassKeyIndexKey) values() []interface{}    { return x.vs }
+func (x ProjectTransferClassKeyIndexKey) projectTransferIndexKey() {}
+
+func (this ProjectTransferClassKeyIndexKey) WithClassKey(class_key uint64) ProjectTransferClassKeyIndexKey {
+	this.vs = []interface{}{class_key}
+	return this
+}
+
+type projectTransferTable struct {
+	table ormtable.Table
+}
+
+func (this projectTransferTable) Insert(ctx context.Context, projectTransfer *ProjectTransfer) error {
+	return this.table.Insert(ctx, projectTransfer)
+}
+
+func (this projectTransferTable) Update(ctx context.Context, projectTransfer *ProjectTransfer) error {
+	return this.table.Update(ctx, projectTransfer)
+}
+
+func (this projectTransferTable) Save(ctx context.Context, projectTransfer *ProjectTransfer) error {
+	return this.table.Save(ctx, projectTransfer)
+}
+
+func (this projectTransferTable) Delete(ctx context.Context, projectTransfer *ProjectTransfer) error {
+	return this.table.Delete(ctx, projectTransfer)
+}
+
+func (this projectTransferTable) Has(ctx context.Context, project_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, project_key)
+}
+
+func (this projectTransferTable) Get(ctx context.Context, project_key uint64) (*ProjectTransfer, error) {
+	var projectTransfer ProjectTransfer
+	found, err := this.table.PrimaryKey().Get(ctx, &projectTransfer, project_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectTransfer, nil
+}
+
+func (this projectTransferTable) List(ctx context.Context, prefixKey ProjectTransferIndexKey, opts ...ormlist.Option) (ProjectTransferIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectTransferIterator{it}, err
+}
+
+func (this projectTransferTable) ListRange(ctx context.Context, from, to ProjectTransferIndexKey, opts ...ormlist.Option) (ProjectTransferIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectTransferIterator{it}, err
+}
+
+func (this projectTransferTable) DeleteBy(ctx context.Context, prefixKey ProjectTransferIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectTransferTable) DeleteRange(ctx context.Context, from, to ProjectTransferIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectTransferTable) doNotImplement() {}
+
+var _ ProjectTransferTable = projectTransferTable{}
+
+func NewProjectTransferTable(db ormtable.Schema) (ProjectTransferTable, error) {
+	table := db.GetTable(&ProjectTransfer{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectTransfer{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectTransferTable{table}, nil
+}
+
+type ProjectAliasTable interface {
+	Insert(ctx context.Context, projectAlias *ProjectAlias) error
+	Update(ctx context.Context, projectAlias *ProjectAlias) error
+	Save(ctx context.Context, projectAlias *ProjectAlias) error
+	Delete(ctx context.Context, projectAlias *ProjectAlias) error
+	Has(ctx context.Context, alias string) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, alias string) (*ProjectAlias, error)
+	List(ctx context.Context, prefixKey ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error)
+	ListRange(ctx context.Context, from, to ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error)
+	DeleteBy(ctx context.Context, prefixKey ProjectAliasIndexKey) error
+	DeleteRange(ctx context.Context, from, to ProjectAliasIndexKey) error
+
+	doNotImplement()
+}
+
+type ProjectAliasIterator struct {
+	ormtable.Iterator
+}
+
+func (i ProjectAliasIterator) Value() (*ProjectAlias, error) {
+	var projectAlias ProjectAlias
+	err := i.UnmarshalMessage(&projectAlias)
+	return &projectAlias, err
+}
+
+type ProjectAliasIndexKey interface {
+	id() uint32
+	values() []interface{}
+	projectAliasIndexKey()
+}
+
+// primary key starting index..
+type ProjectAliasPrimaryKey = ProjectAliasAliasIndexKey
+
+type ProjectAliasAliasIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectAliasAliasIndexKey) id() uint32            { return 0 }
+func (x ProjectAliasAliasIndexKey) values() []interface{} { return x.vs }
+func (x ProjectAliasAliasIndexKey) projectAliasIndexKey() {}
+
+func (this ProjectAliasAliasIndexKey) WithAlias(alias string) ProjectAliasAliasIndexKey {
+	this.vs = []interface{}{alias}
+	return this
+}
+
+type ProjectAliasProjectKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x ProjectAliasProjectKeyIndexKey) id() uint32            { return 1 }
+func (x ProjectAliasProjectKeyIndexKey) values() []interface{} { return x.vs }
+func (x ProjectAliasProjectKeyIndexKey) projectAliasIndexKey() {}
+
+func (this ProjectAliasProjectKeyIndexKey) WithProjectKey(project_key uint64) ProjectAliasProjectKeyIndexKey {
+	this.vs = []interface{}{project_key}
+	return this
+}
+
+type projectAliasTable struct {
+	table ormtable.Table
+}
+
+func (this projectAliasTable) Insert(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Insert(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Update(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Update(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Save(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Save(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Delete(ctx context.Context, projectAlias *ProjectAlias) error {
+	return this.table.Delete(ctx, projectAlias)
+}
+
+func (this projectAliasTable) Has(ctx context.Context, alias string) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, alias)
+}
+
+func (this projectAliasTable) Get(ctx context.Context, alias string) (*ProjectAlias, error) {
+	var projectAlias ProjectAlias
+	found, err := this.table.PrimaryKey().Get(ctx, &projectAlias, alias)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &projectAlias, nil
+}
+
+func (this projectAliasTable) List(ctx context.Context, prefixKey ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return ProjectAliasIterator{it}, err
+}
+
+func (this projectAliasTable) ListRange(ctx context.Context, from, to ProjectAliasIndexKey, opts ...ormlist.Option) (ProjectAliasIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return ProjectAliasIterator{it}, err
+}
+
+func (this projectAliasTable) DeleteBy(ctx context.Context, prefixKey ProjectAliasIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this projectAliasTable) DeleteRange(ctx context.Context, from, to ProjectAliasIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this projectAliasTable) doNotImplement() {}
+
+var _ ProjectAliasTable = projectAliasTable{}
+
+func NewProjectAliasTable(db ormtable.Schema) (ProjectAliasTable, error) {
+	table := db.GetTable(&ProjectAlias{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&ProjectAlias{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return projectAliasTable{table}, nil
+}
+
+type BatchLineageTable interface {
+	Insert(ctx context.Context, batchLineage *BatchLineage) error
+	Update(ctx context.Context, batchLineage *BatchLineage) error
+	Save(ctx context.Context, batchLineage *BatchLineage) error
+	Delete(ctx context.Context, batchLineage *BatchLineage) error
+	Has(ctx context.Context, parent_batch_key uint64, child_batch_key uint64) (found bool, err error)
+	// Get returns nil and an error which responds true to ormerrors.IsNotFound() if the record was not found.
+	Get(ctx context.Context, parent_batch_key uint64, child_batch_key uint64) (*BatchLineage, error)
+	List(ctx context.Context, prefixKey BatchLineageIndexKey, opts ...ormlist.Option) (BatchLineageIterator, error)
+	ListRange(ctx context.Context, from, to BatchLineageIndexKey, opts ...ormlist.Option) (BatchLineageIterator, error)
+	DeleteBy(ctx context.Context, prefixKey BatchLineageIndexKey) error
+	DeleteRange(ctx context.Context, from, to BatchLineageIndexKey) error
+
+	doNotImplement()
+}
+
+type BatchLineageIterator struct {
+	ormtable.Iterator
+}
+
+func (i BatchLineageIterator) Value() (*BatchLineage, error) {
+	var batchLineage BatchLineage
+	err := i.UnmarshalMessage(&batchLineage)
+	return &batchLineage, err
+}
+
+type BatchLineageIndexKey interface {
+	id() uint32
+	values() []interface{}
+	batchLineageIndexKey()
+}
+
+// primary key starting index..
+type BatchLineagePrimaryKey = BatchLineageParentBatchKeyChildBatchKeyIndexKey
+
+type BatchLineageParentBatchKeyChildBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLineageParentBatchKeyChildBatchKeyIndexKey) id() uint32            { return 0 }
+func (x BatchLineageParentBatchKeyChildBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchLineageParentBatchKeyChildBatchKeyIndexKey) batchLineageIndexKey() {}
+
+func (this BatchLineageParentBatchKeyChildBatchKeyIndexKey) WithParentBatchKey(parent_batch_key uint64) BatchLineageParentBatchKeyChildBatchKeyIndexKey {
+	this.vs = []interface{}{parent_batch_key}
+	return this
+}
+
+func (this BatchLineageParentBatchKeyChildBatchKeyIndexKey) WithParentBatchKeyChildBatchKey(parent_batch_key uint64, child_batch_key uint64) BatchLineageParentBatchKeyChildBatchKeyIndexKey {
+	this.vs = []interface{}{parent_batch_key, child_batch_key}
+	return this
+}
+
+type BatchLineageChildBatchKeyIndexKey struct {
+	vs []interface{}
+}
+
+func (x BatchLineageChildBatchKeyIndexKey) id() uint32            { return 1 }
+func (x BatchLineageChildBatchKeyIndexKey) values() []interface{} { return x.vs }
+func (x BatchLineageChildBatchKeyIndexKey) batchLineageIndexKey() {}
+
+func (this BatchLineageChildBatchKeyIndexKey) WithChildBatchKey(child_batch_key uint64) BatchLineageChildBatchKeyIndexKey {
+	this.vs = []interface{}{child_batch_key}
+	return this
+}
+
+type batchLineageTable struct {
+	table ormtable.Table
+}
+
+func (this batchLineageTable) Insert(ctx context.Context, batchLineage *BatchLineage) error {
+	return this.table.Insert(ctx, batchLineage)
+}
+
+func (this batchLineageTable) Update(ctx context.Context, batchLineage *BatchLineage) error {
+	return this.table.Update(ctx, batchLineage)
+}
+
+func (this batchLineageTable) Save(ctx context.Context, batchLineage *BatchLineage) error {
+	return this.table.Save(ctx, batchLineage)
+}
+
+func (this batchLineageTable) Delete(ctx context.Context, batchLineage *BatchLineage) error {
+	return this.table.Delete(ctx, batchLineage)
+}
+
+func (this batchLineageTable) Has(ctx context.Context, parent_batch_key uint64, child_batch_key uint64) (found bool, err error) {
+	return this.table.PrimaryKey().Has(ctx, parent_batch_key, child_batch_key)
+}
+
+func (this batchLineageTable) Get(ctx context.Context, parent_batch_key uint64, child_batch_key uint64) (*BatchLineage, error) {
+	var batchLineage BatchLineage
+	found, err := this.table.PrimaryKey().Get(ctx, &batchLineage, parent_batch_key, child_batch_key)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ormerrors.NotFound
+	}
+	return &batchLineage, nil
+}
+
+func (this batchLineageTable) List(ctx context.Context, prefixKey BatchLineageIndexKey, opts ...ormlist.Option) (BatchLineageIterator, error) {
+	it, err := this.table.GetIndexByID(prefixKey.id()).List(ctx, prefixKey.values(), opts...)
+	return BatchLineageIterator{it}, err
+}
+
+func (this batchLineageTable) ListRange(ctx context.Context, from, to BatchLineageIndexKey, opts ...ormlist.Option) (BatchLineageIterator, error) {
+	it, err := this.table.GetIndexByID(from.id()).ListRange(ctx, from.values(), to.values(), opts...)
+	return BatchLineageIterator{it}, err
+}
+
+func (this batchLineageTable) DeleteBy(ctx context.Context, prefixKey BatchLineageIndexKey) error {
+	return this.table.GetIndexByID(prefixKey.id()).DeleteBy(ctx, prefixKey.values()...)
+}
+
+func (this batchLineageTable) DeleteRange(ctx context.Context, from, to BatchLineageIndexKey) error {
+	return this.table.GetIndexByID(from.id()).DeleteRange(ctx, from.values(), to.values())
+}
+
+func (this batchLineageTable) doNotImplement() {}
+
+var _ BatchLineageTable = batchLineageTable{}
+
+func NewBatchLineageTable(db ormtable.Schema) (BatchLineageTable, error) {
+	table := db.GetTable(&BatchLineage{})
+	if table == nil {
+		return nil, ormerrors.TableNotFound.Wrap(string((&BatchLineage{}).ProtoReflect().Descriptor().FullName()))
+	}
+	return batchLineageTable{table}, nil
+}
+
 type StateStore interface {
 	CreditTypeTable() CreditTypeTable
 	ClassTable() ClassTable
@@ -1783,6 +2552,12 @@ type StateStore interface {
 	BatchSupplyTable() BatchSupplyTable
 	OriginTxIndexTable() OriginTxIndexTable
 	BatchContractTable() BatchContractTable
+	RetirementTable() RetirementTable
+	ProjectIssuerTable() ProjectIssuerTable
+	ProjectVerifierTable() ProjectVerifierTable
+	ProjectTransferTable() ProjectTransferTable
+	ProjectAliasTable() ProjectAliasTable
+	BatchLineageTable() BatchLineageTable
 
 	doNotImplement()
 }
@@ -1800,6 +2575,12 @@ type stateStore struct {
 	batchSupply     BatchSupplyTable
 	originTxIndex   OriginTxIndexTable
 	batchContract   BatchContractTable
+	retirement      RetirementTable
+	projectIssuer   ProjectIssuerTable
+	projectVerifier ProjectVerifierTable
+	projectTransfer ProjectTransferTable
+	projectAlias    ProjectAliasTable
+	batchLineage    BatchLineageTable
 }
 
 func (x stateStore) CreditTypeTable() CreditTypeTable {
@@ -1850,6 +2631,30 @@ func (x stateStore) BatchContractTable() BatchContractTable {
 	return x.batchContract
 }
 
+func (x stateStore) RetirementTable() RetirementTable {
+	return x.retirement
+}
+
+func (x stateStore) ProjectIssuerTable() ProjectIssuerTable {
+	return x.projectIssuer
+}
+
+func (x stateStore) ProjectVerifierTable() ProjectVerifierTable {
+	return x.projectVerifier
+}
+
+func (x stateStore) ProjectTransferTable() ProjectTransferTable {
+	return x.projectTransfer
+}
+
+func (x stateStore) ProjectAliasTable() ProjectAliasTable {
+	return x.projectAlias
+}
+
+func (x stateStore) BatchLineageTable() BatchLineageTable {
+	return x.batchLineage
+}
+
 func (stateStore) doNotImplement() {}
 
 var _ StateStore = stateStore{}
@@ -1915,6 +2720,36 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		return nil, err
 	}
 
+	retirementTable, err := NewRetirementTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectIssuerTable, err := NewProjectIssuerTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectVerifierTable, err := NewProjectVerifierTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectTransferTable, err := NewProjectTransferTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	projectAliasTable, err := NewProjectAliasTable(db)
+	if err != nil {
+		return nil, err
+	}
+
+	batchLineageTable, err := NewBatchLineageTable(db)
+	if err != nil {
+		return nil, err
+	}
+
 	return stateStore{
 		creditTypeTable,
 		classTable,
@@ -1928,5 +2763,11 @@ func NewStateStore(db ormtable.Schema) (StateStore, error) {
 		batchSupplyTable,
 		originTxIndexTable,
 		batchContractTable,
+		retirementTable,
+		projectIssuerTable,
+		projectVerifierTable,
+		projectTransferTable,
+		projectAliasTable,
+		batchLineageTable,
 	}, nil
 }
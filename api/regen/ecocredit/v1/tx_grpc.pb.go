@@ -60,6 +60,20 @@ type MsgClient interface {
 	// minted to the credit batch. A sealed credit batch cannot be unsealed and
 	// only the credit batch issuer can seal a credit batch.
 	SealBatch(ctx context.Context, in *MsgSealBatch, opts ...grpc.CallOption) (*MsgSealBatchResponse, error)
+	// SplitBatch splits an open credit batch into sub-vintage credit batches
+	// with contiguous dates covering the dates of the credit batch. The balances
+	// and supply of the credit batch are allocated to the new credit batches and
+	// the credit batch is sealed. Only the credit batch issuer can split a credit
+	// batch and all accounts holding credits from the credit batch (other than
+	// the issuer and the buffer pool of the credit class) must sign as holders.
+	SplitBatch(ctx context.Context, in *MsgSplitBatch, opts ...grpc.CallOption) (*MsgSplitBatchResponse, error)
+	// MergeBatches merges open credit batches from the same project with
+	// contiguous dates into a single credit batch. The balances and supply of the
+	// credit batches are combined in the new credit batch and the credit batches
+	// are sealed. Only the issuer of the credit batches can merge credit batches
+	// and all accounts holding credits from the credit batches (other than the
+	// issuer and the buffer pool of the credit class) must sign as holders.
+	MergeBatches(ctx context.Context, in *MsgMergeBatches, opts ...grpc.CallOption) (*MsgMergeBatchesResponse, error)
 	// Send sends a specified amount of tradable credits from the credit owner's
 	// account to another account. Sent credits can either remain tradable or be
 	// retired upon receipt.
@@ -74,6 +88,16 @@ type MsgClient interface {
 	// credit batch's tradable supply. Cancelling credits is permanent and implies
 	// the credits have been moved to another chain or registry.
 	Cancel(ctx context.Context, in *MsgCancel, opts ...grpc.CallOption) (*MsgCancelResponse, error)
+	// Reverse cancels credits in response to a reversal event (e.g. a forest fire)
+	// in a project of the credit class, removing the amount from the tradable
+	// balance of the owner and adding it to the cancelled supply of the credit
+	// batch. Credits are reversed from the buffer pool of the credit class unless
+	// a holder is specified, in which case the buffer pool must no longer hold
+	// tradable credits from the credit batch. Only the admin of the credit class
+	// can reverse credits from the buffer pool and only the governance authority
+	// can reverse credits from a holder, so that holders are given notice of the
+	// reversal through a governance proposal before their credits are cancelled.
+	Reverse(ctx context.Context, in *MsgReverse, opts ...grpc.CallOption) (*MsgReverseResponse, error)
 	// UpdateClassAdmin updates the credit class admin. Only the admin of the
 	// credit class can update the credit class.
 	UpdateClassAdmin(ctx context.Context, in *MsgUpdateClassAdmin, opts ...grpc.CallOption) (*MsgUpdateClassAdminResponse, error)
@@ -83,12 +107,45 @@ type MsgClient interface {
 	// UpdateClassMetadata updates the credit class metadata. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassMetadata(ctx context.Context, in *MsgUpdateClassMetadata, opts ...grpc.CallOption) (*MsgUpdateClassMetadataResponse, error)
+	// UpdateClassBuffer updates the percentage of tradable credits withheld into
+	// the buffer pool of the credit class when credits are issued. Only the admin
+	// of the credit class can update the credit class.
+	UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error)
+	// UpdateClassVerifier updates the verifier of the credit class that is able
+	// to update the status of projects within the credit class. Only the admin
+	// of the credit class can update the credit class.
+	UpdateClassVerifier(ctx context.Context, in *MsgUpdateClassVerifier, opts ...grpc.CallOption) (*MsgUpdateClassVerifierResponse, error)
 	// UpdateProjectAdmin updates the project admin address. Only the admin of the
 	// project can update the project.
 	UpdateProjectAdmin(ctx context.Context, in *MsgUpdateProjectAdmin, opts ...grpc.CallOption) (*MsgUpdateProjectAdminResponse, error)
 	// UpdateProjectMetadata updates the project metadata. Only the admin of the
 	// project can update the project.
 	UpdateProjectMetadata(ctx context.Context, in *MsgUpdateProjectMetadata, opts ...grpc.CallOption) (*MsgUpdateProjectMetadataResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project following
+	// the transitions defined in ProjectStatus. Only the admin or the verifier of
+	// the credit class of the project or a verifier of the project can update the
+	// project status. A monitoring body of the project can suspend the project.
+	UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateProjectIssuers updates the project issuer list. When a project has
+	// project issuers, only the project issuers can issue credits under the
+	// project. Only the admin of the credit class of the project can update the
+	// project issuers.
+	UpdateProjectIssuers(ctx context.Context, in *MsgUpdateProjectIssuers, opts ...grpc.CallOption) (*MsgUpdateProjectIssuersResponse, error)
+	// UpdateProjectVerifiers updates the verifiers or monitoring bodies of a
+	// project. Only the admin of the credit class of the project can update the
+	// project verifiers.
+	UpdateProjectVerifiers(ctx context.Context, in *MsgUpdateProjectVerifiers, opts ...grpc.CallOption) (*MsgUpdateProjectVerifiersResponse, error)
+	// ProposeProjectTransfer proposes to transfer a project to another credit
+	// class with the same credit type. Only the admin of the credit class of the
+	// project can propose a project transfer.
+	ProposeProjectTransfer(ctx context.Context, in *MsgProposeProjectTransfer, opts ...grpc.CallOption) (*MsgProposeProjectTransferResponse, error)
+	// AcceptProjectTransfer accepts a proposed project transfer. The project
+	// receives a new project ID within the credit class and the previous project
+	// ID remains an alias of the project. Only the admin of the credit class that
+	// the project is being transferred to can accept the project transfer. The
+	// project issuers and project verifiers of the project are removed and the
+	// project status is reset to proposed if the credit class has a verifier.
+	AcceptProjectTransfer(ctx context.Context, in *MsgAcceptProjectTransfer, opts ...grpc.CallOption) (*MsgAcceptProjectTransferResponse, error)
 	// Bridge processes credits being sent back to the source chain. When credits
 	// are sent back to the source chain, the credits are cancelled and an event
 	// is emitted to be handled by an external bridge service.
@@ -160,6 +217,24 @@ func (c *msgClient) SealBatch(ctx context.Context, in *MsgSealBatch, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) SplitBatch(ctx context.Context, in *MsgSplitBatch, opts ...grpc.CallOption) (*MsgSplitBatchResponse, error) {
+	out := new(MsgSplitBatchResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/SplitBatch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MergeBatches(ctx context.Context, in *MsgMergeBatches, opts ...grpc.CallOption) (*MsgMergeBatchesResponse, error) {
+	out := new(MsgMergeBatchesResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/MergeBatches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Send(ctx context.Context, in *MsgSend, opts ...grpc.CallOption) (*MsgSendResponse, error) {
 	out := new(MsgSendResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Send", in, out, opts...)
@@ -187,6 +262,15 @@ func (c *msgClient) Cancel(ctx context.Context, in *MsgCancel, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) Reverse(ctx context.Context, in *MsgReverse, opts ...grpc.CallOption) (*MsgReverseResponse, error) {
+	out := new(MsgReverseResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Reverse", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateClassAdmin(ctx context.Context, in *MsgUpdateClassAdmin, opts ...grpc.CallOption) (*MsgUpdateClassAdminResponse, error) {
 	out := new(MsgUpdateClassAdminResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassAdmin", in, out, opts...)
@@ -214,6 +298,24 @@ func (c *msgClient) UpdateClassMetadata(ctx context.Context, in *MsgUpdateClassM
 	return out, nil
 }
 
+func (c *msgClient) UpdateClassBuffer(ctx context.Context, in *MsgUpdateClassBuffer, opts ...grpc.CallOption) (*MsgUpdateClassBufferResponse, error) {
+	out := new(MsgUpdateClassBufferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassBuffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateClassVerifier(ctx context.Context, in *MsgUpdateClassVerifier, opts ...grpc.CallOption) (*MsgUpdateClassVerifierResponse, error) {
+	out := new(MsgUpdateClassVerifierResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateClassVerifier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateProjectAdmin(ctx context.Context, in *MsgUpdateProjectAdmin, opts ...grpc.CallOption) (*MsgUpdateProjectAdminResponse, error) {
 	out := new(MsgUpdateProjectAdminResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectAdmin", in, out, opts...)
@@ -232,6 +334,51 @@ func (c *msgClient) UpdateProjectMetadata(ctx context.Context, in *MsgUpdateProj
 	return out, nil
 }
 
+func (c *msgClient) UpdateProjectStatus(ctx context.Context, in *MsgUpdateProjectStatus, opts ...grpc.CallOption) (*MsgUpdateProjectStatusResponse, error) {
+	out := new(MsgUpdateProjectStatusResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProjectIssuers(ctx context.Context, in *MsgUpdateProjectIssuers, opts ...grpc.CallOption) (*MsgUpdateProjectIssuersResponse, error) {
+	out := new(MsgUpdateProjectIssuersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectIssuers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateProjectVerifiers(ctx context.Context, in *MsgUpdateProjectVerifiers, opts ...grpc.CallOption) (*MsgUpdateProjectVerifiersResponse, error) {
+	out := new(MsgUpdateProjectVerifiersResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/UpdateProjectVerifiers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeProjectTransfer(ctx context.Context, in *MsgProposeProjectTransfer, opts ...grpc.CallOption) (*MsgProposeProjectTransferResponse, error) {
+	out := new(MsgProposeProjectTransferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/ProposeProjectTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptProjectTransfer(ctx context.Context, in *MsgAcceptProjectTransfer, opts ...grpc.CallOption) (*MsgAcceptProjectTransferResponse, error) {
+	out := new(MsgAcceptProjectTransferResponse)
+	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/AcceptProjectTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Bridge(ctx context.Context, in *MsgBridge, opts ...grpc.CallOption) (*MsgBridgeResponse, error) {
 	out := new(MsgBridgeResponse)
 	err := c.cc.Invoke(ctx, "/regen.ecocredit.v1.Msg/Bridge", in, out, opts...)
@@ -301,6 +448,20 @@ type MsgServer interface {
 	// minted to the credit batch. A sealed credit batch cannot be unsealed and
 	// only the credit batch issuer can seal a credit batch.
 	SealBatch(context.Context, *MsgSealBatch) (*MsgSealBatchResponse, error)
+	// SplitBatch splits an open credit batch into sub-vintage credit batches
+	// with contiguous dates covering the dates of the credit batch. The balances
+	// and supply of the credit batch are allocated to the new credit batches and
+	// the credit batch is sealed. Only the credit batch issuer can split a credit
+	// batch and all accounts holding credits from the credit batch (other than
+	// the issuer and the buffer pool of the credit class) must sign as holders.
+	SplitBatch(context.Context, *MsgSplitBatch) (*MsgSplitBatchResponse, error)
+	// MergeBatches merges open credit batches from the same project with
+	// contiguous dates into a single credit batch. The balances and supply of the
+	// credit batches are combined in the new credit batch and the credit batches
+	// are sealed. Only the issuer of the credit batches can merge credit batches
+	// and all accounts holding credits from the credit batches (other than the
+	// issuer and the buffer pool of the credit class) must sign as holders.
+	MergeBatches(context.Context, *MsgMergeBatches) (*MsgMergeBatchesResponse, error)
 	// Send sends a specified amount of tradable credits from the credit owner's
 	// account to another account. Sent credits can either remain tradable or be
 	// retired upon receipt.
@@ -315,6 +476,16 @@ type MsgServer interface {
 	// credit batch's tradable supply. Cancelling credits is permanent and implies
 	// the credits have been moved to another chain or registry.
 	Cancel(context.Context, *MsgCancel) (*MsgCancelResponse, error)
+	// Reverse cancels credits in response to a reversal event (e.g. a forest fire)
+	// in a project of the credit class, removing the amount from the tradable
+	// balance of the owner and adding it to the cancelled supply of the credit
+	// batch. Credits are reversed from the buffer pool of the credit class unless
+	// a holder is specified, in which case the buffer pool must no longer hold
+	// tradable credits from the credit batch. Only the admin of the credit class
+	// can reverse credits from the buffer pool and only the governance authority
+	// can reverse credits from a holder, so that holders are given notice of the
+	// reversal through a governance proposal before their credits are cancelled.
+	Reverse(context.Context, *MsgReverse) (*MsgReverseResponse, error)
 	// UpdateClassAdmin updates the credit class admin. Only the admin of the
 	// credit class can update the credit class.
 	UpdateClassAdmin(context.Context, *MsgUpdateClassAdmin) (*MsgUpdateClassAdminResponse, error)
@@ -324,12 +495,45 @@ type MsgServer interface {
 	// UpdateClassMetadata updates the credit class metadata. Only the admin of
 	// the credit class can update the credit class.
 	UpdateClassMetadata(context.Context, *MsgUpdateClassMetadata) (*MsgUpdateClassMetadataResponse, error)
+	// UpdateClassBuffer updates the percentage of tradable credits withheld into
+	// the buffer pool of the credit class when credits are issued. Only the admin
+	// of the credit class can update the credit class.
+	UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error)
+	// UpdateClassVerifier updates the verifier of the credit class that is able
+	// to update the status of projects within the credit class. Only the admin
+	// of the credit class can update the credit class.
+	UpdateClassVerifier(context.Context, *MsgUpdateClassVerifier) (*MsgUpdateClassVerifierResponse, error)
 	// UpdateProjectAdmin updates the project admin address. Only the admin of the
 	// project can update the project.
 	UpdateProjectAdmin(context.Context, *MsgUpdateProjectAdmin) (*MsgUpdateProjectAdminResponse, error)
 	// UpdateProjectMetadata updates the project metadata. Only the admin of the
 	// project can update the project.
 	UpdateProjectMetadata(context.Context, *MsgUpdateProjectMetadata) (*MsgUpdateProjectMetadataResponse, error)
+	// UpdateProjectStatus updates the lifecycle status of a project following
+	// the transitions defined in ProjectStatus. Only the admin or the verifier of
+	// the credit class of the project or a verifier of the project can update the
+	// project status. A monitoring body of the project can suspend the project.
+	UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error)
+	// UpdateProjectIssuers updates the project issuer list. When a project has
+	// project issuers, only the project issuers can issue credits under the
+	// project. Only the admin of the credit class of the project can update the
+	// project issuers.
+	UpdateProjectIssuers(context.Context, *MsgUpdateProjectIssuers) (*MsgUpdateProjectIssuersResponse, error)
+	// UpdateProjectVerifiers updates the verifiers or monitoring bodies of a
+	// project. Only the admin of the credit class of the project can update the
+	// project verifiers.
+	UpdateProjectVerifiers(context.Context, *MsgUpdateProjectVerifiers) (*MsgUpdateProjectVerifiersResponse, error)
+	// ProposeProjectTransfer proposes to transfer a project to another credit
+	// class with the same credit type. Only the admin of the credit class of the
+	// project can propose a project transfer.
+	ProposeProjectTransfer(context.Context, *MsgProposeProjectTransfer) (*MsgProposeProjectTransferResponse, error)
+	// AcceptProjectTransfer accepts a proposed project transfer. The project
+	// receives a new project ID within the credit class and the previous project
+	// ID remains an alias of the project. Only the admin of the credit class that
+	// the project is being transferred to can accept the project transfer. The
+	// project issuers and project verifiers of the project are removed and the
+	// project status is reset to proposed if the credit class has a verifier.
+	AcceptProjectTransfer(context.Context, *MsgAcceptProjectTransfer) (*MsgAcceptProjectTransferResponse, error)
 	// Bridge processes credits being sent back to the source chain. When credits
 	// are sent back to the source chain, the credits are cancelled and an event
 	// is emitted to be handled by an external bridge service.
@@ -368,6 +572,12 @@ func (UnimplementedMsgServer) MintBatchCredits(context.Context, *MsgMintBatchCre
 func (UnimplementedMsgServer) SealBatch(context.Context, *MsgSealBatch) (*MsgSealBatchResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SealBatch not implemented")
 }
+func (UnimplementedMsgServer) SplitBatch(context.Context, *MsgSplitBatch) (*MsgSplitBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitBatch not implemented")
+}
+func (UnimplementedMsgServer) MergeBatches(context.Context, *MsgMergeBatches) (*MsgMergeBatchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeBatches not implemented")
+}
 func (UnimplementedMsgServer) Send(context.Context, *MsgSend) (*MsgSendResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Send not implemented")
 }
@@ -377,6 +587,9 @@ func (UnimplementedMsgServer) Retire(context.Context, *MsgRetire) (*MsgRetireRes
 func (UnimplementedMsgServer) Cancel(context.Context, *MsgCancel) (*MsgCancelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Cancel not implemented")
 }
+func (UnimplementedMsgServer) Reverse(context.Context, *MsgReverse) (*MsgReverseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reverse not implemented")
+}
 func (UnimplementedMsgServer) UpdateClassAdmin(context.Context, *MsgUpdateClassAdmin) (*MsgUpdateClassAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassAdmin not implemented")
 }
@@ -386,12 +599,33 @@ func (UnimplementedMsgServer) UpdateClassIssuers(context.Context, *MsgUpdateClas
 func (UnimplementedMsgServer) UpdateClassMetadata(context.Context, *MsgUpdateClassMetadata) (*MsgUpdateClassMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateClassBuffer(context.Context, *MsgUpdateClassBuffer) (*MsgUpdateClassBufferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassBuffer not implemented")
+}
+func (UnimplementedMsgServer) UpdateClassVerifier(context.Context, *MsgUpdateClassVerifier) (*MsgUpdateClassVerifierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClassVerifier not implemented")
+}
 func (UnimplementedMsgServer) UpdateProjectAdmin(context.Context, *MsgUpdateProjectAdmin) (*MsgUpdateProjectAdminResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectAdmin not implemented")
 }
 func (UnimplementedMsgServer) UpdateProjectMetadata(context.Context, *MsgUpdateProjectMetadata) (*MsgUpdateProjectMetadataResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectMetadata not implemented")
 }
+func (UnimplementedMsgServer) UpdateProjectStatus(context.Context, *MsgUpdateProjectStatus) (*MsgUpdateProjectStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectStatus not implemented")
+}
+func (UnimplementedMsgServer) UpdateProjectIssuers(context.Context, *MsgUpdateProjectIssuers) (*MsgUpdateProjectIssuersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectIssuers not implemented")
+}
+func (UnimplementedMsgServer) UpdateProjectVerifiers(context.Context, *MsgUpdateProjectVerifiers) (*MsgUpdateProjectVerifiersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectVerifiers not implemented")
+}
+func (UnimplementedMsgServer) ProposeProjectTransfer(context.Context, *MsgProposeProjectTransfer) (*MsgProposeProjectTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeProjectTransfer not implemented")
+}
+func (UnimplementedMsgServer) AcceptProjectTransfer(context.Context, *MsgAcceptProjectTransfer) (*MsgAcceptProjectTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptProjectTransfer not implemented")
+}
 func (UnimplementedMsgServer) Bridge(context.Context, *MsgBridge) (*MsgBridgeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Bridge not implemented")
 }
@@ -504,6 +738,42 @@ func _Msg_SealBatch_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SplitBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSplitBatch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SplitBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/SplitBatch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SplitBatch(ctx, req.(*MsgSplitBatch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MergeBatches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMergeBatches)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MergeBatches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/MergeBatches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MergeBatches(ctx, req.(*MsgMergeBatches))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Send_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgSend)
 	if err := dec(in); err != nil {
@@ -558,6 +828,24 @@ func _Msg_Cancel_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Reverse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReverse)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Reverse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/Reverse",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Reverse(ctx, req.(*MsgReverse))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateClassAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateClassAdmin)
 	if err := dec(in); err != nil {
@@ -612,6 +900,42 @@ func _Msg_UpdateClassMetadata_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateClassBuffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassBuffer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassBuffer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassBuffer(ctx, req.(*MsgUpdateClassBuffer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateClassVerifier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateClassVerifier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateClassVerifier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateClassVerifier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateClassVerifier(ctx, req.(*MsgUpdateClassVerifier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateProjectAdmin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateProjectAdmin)
 	if err := dec(in); err != nil {
@@ -648,6 +972,96 @@ func _Msg_UpdateProjectMetadata_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateProjectStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectStatus(ctx, req.(*MsgUpdateProjectStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectIssuers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectIssuers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectIssuers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectIssuers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectIssuers(ctx, req.(*MsgUpdateProjectIssuers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProjectVerifiers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProjectVerifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProjectVerifiers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/UpdateProjectVerifiers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProjectVerifiers(ctx, req.(*MsgUpdateProjectVerifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeProjectTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeProjectTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeProjectTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/ProposeProjectTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeProjectTransfer(ctx, req.(*MsgProposeProjectTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptProjectTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptProjectTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptProjectTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/regen.ecocredit.v1.Msg/AcceptProjectTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptProjectTransfer(ctx, req.(*MsgAcceptProjectTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Bridge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBridge)
 	if err := dec(in); err != nil {
@@ -729,6 +1143,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SealBatch",
 			Handler:    _Msg_SealBatch_Handler,
 		},
+		{
+			MethodName: "SplitBatch",
+			Handler:    _Msg_SplitBatch_Handler,
+		},
+		{
+			MethodName: "MergeBatches",
+			Handler:    _Msg_MergeBatches_Handler,
+		},
 		{
 			MethodName: "Send",
 			Handler:    _Msg_Send_Handler,
@@ -741,6 +1163,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Cancel",
 			Handler:    _Msg_Cancel_Handler,
 		},
+		{
+			MethodName: "Reverse",
+			Handler:    _Msg_Reverse_Handler,
+		},
 		{
 			MethodName: "UpdateClassAdmin",
 			Handler:    _Msg_UpdateClassAdmin_Handler,
@@ -753,6 +1179,14 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateClassMetadata",
 			Handler:    _Msg_UpdateClassMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateClassBuffer",
+			Handler:    _Msg_UpdateClassBuffer_Handler,
+		},
+		{
+			MethodName: "UpdateClassVerifier",
+			Handler:    _Msg_UpdateClassVerifier_Handler,
+		},
 		{
 			MethodName: "UpdateProjectAdmin",
 			Handler:    _Msg_UpdateProjectAdmin_Handler,
@@ -761,6 +1195,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProjectMetadata",
 			Handler:    _Msg_UpdateProjectMetadata_Handler,
 		},
+		{
+			MethodName: "UpdateProjectStatus",
+			Handler:    _Msg_UpdateProjectStatus_Handler,
+		},
+		{
+			MethodName: "UpdateProjectIssuers",
+			Handler:    _Msg_UpdateProjectIssuers_Handler,
+		},
+		{
+			MethodName: "UpdateProjectVerifiers",
+			Handler:    _Msg_UpdateProjectVerifiers_Handler,
+		},
+		{
+			MethodName: "ProposeProjectTransfer",
+			Handler:    _Msg_ProposeProjectTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptProjectTransfer",
+			Handler:    _Msg_AcceptProjectTransfer_Handler,
+		},
 		{
 			MethodName: "Bridge",
 			Handler:    _Msg_Bridge_Handler,
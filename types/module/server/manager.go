@@ -132,6 +132,9 @@ func (mm *Manager) RegisterModules(modules []module.Module) error {
 		}
 
 		serverMod.RegisterServices(cfg)
+		if cfg.memKey != nil {
+			mm.baseApp.MountStore(cfg.memKey, storetypes.StoreTypeMemory)
+		}
 		mm.registerInvariantsHandler[name] = cfg.registerInvariantsHandler
 		mm.initGenesisHandlers[name] = cfg.initGenesisHandler
 		mm.exportGenesisHandlers[name] = cfg.exportGenesisHandler
@@ -317,6 +320,7 @@ type configurator struct {
 	msgServer                 gogogrpc.Server
 	queryServer               gogogrpc.Server
 	key                       *rootModuleKey
+	memKey                    *storetypes.MemoryStoreKey
 	cdc                       codec.Codec
 	requiredServices          map[reflect.Type]bool
 	initGenesisHandler        module.InitGenesisHandler
@@ -357,6 +361,13 @@ func (c *configurator) ModuleKey() RootModuleKey {
 	return c.key
 }
 
+func (c *configurator) MemoryStoreKey() *storetypes.MemoryStoreKey {
+	if c.memKey == nil {
+		c.memKey = storetypes.NewMemoryStoreKey(fmt.Sprintf("mem_%s", c.key.moduleName))
+	}
+	return c.memKey
+}
+
 func (c *configurator) Marshaler() codec.Codec {
 	return c.cdc
 }
@@ -2,6 +2,7 @@ package server
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -30,6 +31,12 @@ type Configurator interface {
 	sdkmodule.Configurator
 
 	ModuleKey() RootModuleKey
+
+	// MemoryStoreKey returns a memory store key for the module. The memory
+	// store is only mounted if this method is called while registering services
+	// and its contents are lost when the node restarts.
+	MemoryStoreKey() *storetypes.MemoryStoreKey
+
 	Marshaler() codec.Codec
 	RequireServer(interface{})
 	RegisterInvariantsHandler(registry RegisterInvariantsHandler)
@@ -43,6 +43,17 @@ func GogoToProtobufDuration(d *gogotypes.Duration) *durationpb.Duration {
 	}
 }
 
+// ProtobufToGogoDuration converts a protobuf duration to a gogo duration.
+func ProtobufToGogoDuration(d *durationpb.Duration) *gogotypes.Duration {
+	if d == nil {
+		return nil
+	}
+	return &gogotypes.Duration{
+		Seconds: d.Seconds,
+		Nanos:   d.Nanos,
+	}
+}
+
 // ParseDate parses a date using the format yyyy-mm-dd.
 func ParseDate(field string, date string) (time.Time, error) {
 	t, err := time.Parse("2006-01-02", date)
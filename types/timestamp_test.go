@@ -42,6 +42,35 @@ func TestGogoToProtobufDuration(t *testing.T) {
 	}
 }
 
+func TestProtobufToGogoDuration(t *testing.T) {
+	type args struct {
+		d *durationpb.Duration
+	}
+	tests := []struct {
+		name string
+		args args
+		want *gogotypes.Duration
+	}{
+		{
+			name: "valid",
+			args: args{d: &durationpb.Duration{Seconds: 45, Nanos: 4}},
+			want: &gogotypes.Duration{Seconds: 45, Nanos: 4},
+		},
+		{
+			name: "nil case",
+			args: args{d: nil},
+			want: nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := types.ProtobufToGogoDuration(tt.args.d); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ProtobufToGogoDuration() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestGogoToProtobufTimestamp(t *testing.T) {
 	type args struct {
 		ts *gogotypes.Timestamp
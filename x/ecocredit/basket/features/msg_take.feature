@@ -140,3 +140,49 @@ Feature: MsgTake
     """
     When the message is validated
     Then expect no error
+
+  Scenario: a valid message with retirement beneficiary and reason
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "retirement_jurisdiction": "US-WA",
+      "retire_on_take": true,
+      "retirement_beneficiary": "Acme Corporation",
+      "retirement_reason": "offsetting emissions"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if retirement beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "retirement_jurisdiction": "US-WA",
+      "retire_on_take": true
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the message is validated
+    Then expect the error "retirement beneficiary: max length 256: limit exceeded"
+
+  Scenario: an error is returned if retirement reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "owner": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "basket_denom": "eco.uC.NCT",
+      "amount": "100",
+      "retirement_jurisdiction": "US-WA",
+      "retire_on_take": true
+    }
+    """
+    And a retirement reason with length "513"
+    When the message is validated
+    Then expect the error "retirement reason: max length 512: limit exceeded"
@@ -69,6 +69,14 @@ func (m MsgTake) ValidateBasic() error {
 		}
 	}
 
+	if len(m.RetirementBeneficiary) > core.MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", core.MaxBeneficiaryLength)
+	}
+
+	if len(m.RetirementReason) > core.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", core.MaxNoteLength)
+	}
+
 	return nil
 }
 
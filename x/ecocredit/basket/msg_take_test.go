@@ -1,6 +1,8 @@
 package basket
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -24,6 +26,20 @@ func (s *msgTakeSuite) TheMessage(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *msgTakeSuite) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementBeneficiary = strings.Repeat("x", int(length))
+}
+
+func (s *msgTakeSuite) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementReason = strings.Repeat("x", int(length))
+}
+
 func (s *msgTakeSuite) TheMessageIsValidated() {
 	s.err = s.msg.ValidateBasic()
 }
@@ -359,6 +359,13 @@ type MsgTake struct {
 	//
 	// Since Revision 1
 	RetirementJurisdiction string `protobuf:"bytes,6,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// retirement_beneficiary is the optional name or address of the person or
+	// organization on whose behalf the credits are retired, which will be used
+	// only if retire_on_take is true for this basket.
+	RetirementBeneficiary string `protobuf:"bytes,7,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits, which
+	// will be used only if retire_on_take is true for this basket.
+	RetirementReason string `protobuf:"bytes,8,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
 }
 
 func (m *MsgTake) Reset()         { *m = MsgTake{} }
@@ -437,6 +444,20 @@ func (m *MsgTake) GetRetirementJurisdiction() string {
 	return ""
 }
 
+func (m *MsgTake) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (m *MsgTake) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
 // MsgTakeFromBasketResponse is the Msg/TakeFromBasket response type.
 type MsgTakeResponse struct {
 	// credits are the credits taken out of the basket.
@@ -497,52 +518,54 @@ func init() {
 }
 
 var fileDescriptor_a60f962a3c61f018 = []byte{
-	// 715 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x63, 0x48, 0xc8, 0x04, 0x42, 0x19, 0x10, 0x35, 0x39, 0x98, 0x60, 0x51, 0x91, 0x56,
-	0x60, 0x37, 0x20, 0xf5, 0xcf, 0x91, 0x84, 0x53, 0x45, 0x54, 0xe4, 0xa2, 0x1e, 0xaa, 0x56, 0xd6,
-	0xc4, 0x7e, 0x75, 0xdd, 0x24, 0x9e, 0x68, 0x66, 0x1c, 0xe0, 0xde, 0x0f, 0xc0, 0xe7, 0xe8, 0x65,
-	0xbf, 0x06, 0x47, 0x8e, 0x7b, 0xda, 0x5d, 0xc1, 0x7d, 0x3f, 0xc3, 0xca, 0x33, 0x13, 0x13, 0x09,
-	0x6d, 0x58, 0x71, 0xf2, 0xbc, 0xdf, 0x7b, 0xef, 0xe7, 0xf7, 0xe6, 0xf7, 0xb3, 0x91, 0xc3, 0x20,
-	0x86, 0xd4, 0x83, 0x90, 0x86, 0x0c, 0xa2, 0x44, 0x78, 0x03, 0xc2, 0x87, 0x20, 0xbc, 0x69, 0xc7,
-	0x13, 0xd7, 0xee, 0x84, 0x51, 0x41, 0xf1, 0x8e, 0xac, 0x71, 0x8b, 0x1a, 0x57, 0xd5, 0xb8, 0xd3,
-	0x4e, 0xd3, 0x0e, 0x29, 0x1f, 0x53, 0x9e, 0x77, 0x81, 0x37, 0xed, 0x0c, 0x40, 0x90, 0x8e, 0x17,
-	0xd2, 0x24, 0x55, 0xad, 0xcd, 0xad, 0x98, 0xc6, 0x54, 0x1e, 0xbd, 0xfc, 0xa4, 0xd1, 0x6f, 0x16,
-	0xbc, 0xf4, 0x66, 0x02, 0x5c, 0x95, 0x39, 0x6f, 0x4c, 0x54, 0xeb, 0xf3, 0xb8, 0xc7, 0x80, 0x08,
-	0xc0, 0x16, 0xaa, 0x86, 0x19, 0x23, 0x82, 0x32, 0xcb, 0x68, 0x19, 0xed, 0x9a, 0x3f, 0x0b, 0x31,
-	0x46, 0x4b, 0x29, 0x19, 0x83, 0x55, 0x96, 0xb0, 0x3c, 0xe3, 0x16, 0xaa, 0x47, 0xc0, 0x43, 0x96,
-	0x4c, 0x44, 0x42, 0x53, 0xcb, 0x94, 0xa9, 0x79, 0x08, 0xdb, 0x68, 0x05, 0xae, 0x27, 0x34, 0x85,
-	0x54, 0x58, 0x4b, 0x2d, 0xa3, 0xbd, 0xd6, 0x2d, 0x5b, 0x86, 0x5f, 0x60, 0xd8, 0x45, 0x9b, 0x51,
-	0xc2, 0xc9, 0x60, 0x04, 0x01, 0xc9, 0x04, 0x0d, 0x18, 0x88, 0x84, 0x81, 0xb5, 0xdc, 0x32, 0xda,
-	0x2b, 0xfe, 0x86, 0x4e, 0x9d, 0x66, 0x82, 0xfa, 0x32, 0x81, 0x0f, 0x11, 0x56, 0xdb, 0x04, 0xf9,
-	0x0e, 0x01, 0x19, 0x0c, 0x18, 0x4c, 0xad, 0x8a, 0x7c, 0xf1, 0x57, 0x2a, 0x73, 0x79, 0x33, 0x81,
-	0x53, 0x89, 0xe3, 0x03, 0xb4, 0x4e, 0x46, 0x23, 0x7a, 0x05, 0x51, 0x10, 0x8e, 0x08, 0xe7, 0xc0,
-	0xad, 0x6a, 0xcb, 0x6c, 0xd7, 0xfc, 0x86, 0x86, 0x7b, 0x0a, 0xc5, 0xe7, 0x68, 0x2d, 0x22, 0x02,
-	0x82, 0x90, 0x25, 0x02, 0x58, 0x42, 0xac, 0x95, 0x96, 0xd1, 0xae, 0x1f, 0x1f, 0xb8, 0x9f, 0x15,
-	0xc5, 0x3d, 0x23, 0x02, 0x7a, 0xba, 0xdc, 0x5f, 0x8d, 0xe6, 0x22, 0xfc, 0x17, 0x32, 0xff, 0x06,
-	0xb0, 0x6a, 0x2d, 0xb3, 0x5d, 0x3f, 0xde, 0x71, 0x95, 0x7a, 0x79, 0x2b, 0xb8, 0x5a, 0x3d, 0xb7,
-	0x47, 0x93, 0xb4, 0xfb, 0xfd, 0xdd, 0xbb, 0xdd, 0xd2, 0xff, 0xef, 0x77, 0xdb, 0x71, 0x22, 0xfe,
-	0xc9, 0x06, 0x6e, 0x48, 0xc7, 0x9e, 0x96, 0x5a, 0x3d, 0x8e, 0x78, 0x34, 0xd4, 0x62, 0xe5, 0x0d,
-	0xdc, 0xcf, 0x79, 0x9d, 0x1f, 0xd0, 0x46, 0x21, 0x98, 0x0f, 0x7c, 0x42, 0x53, 0x0e, 0x78, 0x0f,
-	0xad, 0xaa, 0xd9, 0x82, 0x08, 0x52, 0x3a, 0xd6, 0xea, 0xd5, 0x15, 0x76, 0x96, 0x43, 0xce, 0x7f,
-	0x06, 0xaa, 0xf4, 0x79, 0x7c, 0x91, 0x09, 0xbc, 0x85, 0x96, 0xe9, 0x55, 0x0a, 0x33, 0x91, 0x55,
-	0xf0, 0x8c, 0xa3, 0xfc, 0x8c, 0x03, 0x9f, 0xa2, 0xaa, 0xba, 0x09, 0x6e, 0x99, 0x72, 0xbd, 0x45,
-	0x57, 0xd4, 0x95, 0xa7, 0x9e, 0x84, 0xfd, 0x59, 0x9f, 0xf3, 0x33, 0x6a, 0xa8, 0x29, 0x8a, 0xd9,
-	0x73, 0x99, 0xc6, 0x34, 0x4b, 0x45, 0xc0, 0x20, 0x84, 0x64, 0x0a, 0x91, 0x9e, 0xab, 0xa1, 0x60,
-	0x5f, 0xa3, 0xce, 0x47, 0x03, 0x55, 0xfb, 0x3c, 0xbe, 0x24, 0x43, 0x78, 0xfd, 0x0a, 0xdb, 0xa8,
-	0xa2, 0x68, 0xb5, 0x5f, 0x75, 0x84, 0x4f, 0xd0, 0xa6, 0x72, 0xdf, 0x18, 0x52, 0x11, 0x8c, 0x68,
-	0x48, 0xa4, 0xa9, 0x73, 0xd7, 0xd6, 0xa4, 0x6b, 0xf1, 0x53, 0xfa, 0x5c, 0x67, 0xf1, 0x3e, 0x6a,
-	0x28, 0x34, 0xa0, 0x69, 0x20, 0xc8, 0x70, 0x66, 0xdd, 0x55, 0x85, 0xfe, 0x9a, 0xca, 0x59, 0x7f,
-	0x44, 0x5f, 0xcf, 0x51, 0xff, 0x9b, 0xb1, 0x84, 0x47, 0x49, 0x28, 0xe9, 0x95, 0x75, 0xb7, 0x9f,
-	0xd2, 0xbf, 0xcc, 0x65, 0x9d, 0x4b, 0xb4, 0xae, 0xf7, 0x2d, 0x2e, 0x6b, 0x4e, 0x01, 0xe3, 0x75,
-	0x0a, 0x1c, 0xdf, 0x96, 0x91, 0xd9, 0xe7, 0x31, 0xfe, 0x13, 0x55, 0xf4, 0x67, 0xbf, 0xbf, 0x80,
-	0xa3, 0xf0, 0x5a, 0xf3, 0xf0, 0x4b, 0xaa, 0x8a, 0x41, 0x7f, 0x43, 0x66, 0x6e, 0xb5, 0xbd, 0xc5,
-	0x4d, 0x17, 0x99, 0x68, 0x7e, 0xfb, 0x62, 0x49, 0x41, 0xfa, 0x3b, 0x5a, 0x92, 0x37, 0xea, 0x2c,
-	0x6e, 0xc9, 0x6b, 0x9a, 0xdf, 0xbd, 0x5c, 0x33, 0xe3, 0xed, 0xfa, 0x77, 0x0f, 0xb6, 0x71, 0xff,
-	0x60, 0x1b, 0x1f, 0x1e, 0x6c, 0xe3, 0xf6, 0xd1, 0x2e, 0xdd, 0x3f, 0xda, 0xa5, 0xb7, 0x8f, 0x76,
-	0xe9, 0x8f, 0x9f, 0xe6, 0x3e, 0x4e, 0xc9, 0x77, 0x94, 0x82, 0xb8, 0xa2, 0x6c, 0xa8, 0xa3, 0x11,
-	0x44, 0x31, 0x30, 0xef, 0xfa, 0xd9, 0x8f, 0x76, 0x50, 0x91, 0x3f, 0xd8, 0x93, 0x4f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x9a, 0x71, 0x4b, 0x98, 0xfe, 0x05, 0x00, 0x00,
+	// 752 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4f, 0x6f, 0xdb, 0x36,
+	0x1c, 0xb5, 0xac, 0xd4, 0x8e, 0xe9, 0xd4, 0x6d, 0xd8, 0xae, 0x53, 0x7d, 0x50, 0x55, 0xa1, 0x43,
+	0xbd, 0xad, 0x95, 0xe6, 0x14, 0xfb, 0x77, 0x8c, 0xdd, 0xd3, 0x50, 0x63, 0x85, 0x16, 0xec, 0x30,
+	0x6c, 0x10, 0x28, 0xe9, 0x57, 0x4d, 0xb3, 0x4d, 0x1a, 0x24, 0xe5, 0x24, 0xf7, 0x7d, 0x80, 0x7e,
+	0x8e, 0x5d, 0xf6, 0x35, 0x7a, 0x2c, 0x76, 0xda, 0x69, 0x1b, 0x92, 0x2f, 0x32, 0x88, 0xa4, 0x65,
+	0x01, 0xc1, 0x9c, 0x21, 0x27, 0x91, 0xef, 0xfd, 0x7e, 0x4f, 0x3f, 0xf2, 0x3d, 0x10, 0xf9, 0x1c,
+	0x72, 0xa0, 0x21, 0xa4, 0x2c, 0xe5, 0x90, 0x15, 0x32, 0x4c, 0x88, 0x98, 0x83, 0x0c, 0xd7, 0xe3,
+	0x50, 0x9e, 0x05, 0x2b, 0xce, 0x24, 0xc3, 0x0f, 0x55, 0x4d, 0x50, 0xd7, 0x04, 0xba, 0x26, 0x58,
+	0x8f, 0x87, 0x6e, 0xca, 0xc4, 0x92, 0x89, 0xaa, 0x0b, 0xc2, 0xf5, 0x38, 0x01, 0x49, 0xc6, 0x61,
+	0xca, 0x0a, 0xaa, 0x5b, 0x87, 0xf7, 0x73, 0x96, 0x33, 0xb5, 0x0c, 0xab, 0x95, 0x41, 0x3f, 0xda,
+	0xf1, 0xd3, 0xf3, 0x15, 0x08, 0x5d, 0xe6, 0xff, 0x6e, 0xa3, 0xde, 0x4c, 0xe4, 0x53, 0x0e, 0x44,
+	0x02, 0x76, 0x50, 0x37, 0x2d, 0x39, 0x91, 0x8c, 0x3b, 0x96, 0x67, 0x8d, 0x7a, 0xd1, 0x66, 0x8b,
+	0x31, 0xda, 0xa3, 0x64, 0x09, 0x4e, 0x5b, 0xc1, 0x6a, 0x8d, 0x3d, 0xd4, 0xcf, 0x40, 0xa4, 0xbc,
+	0x58, 0xc9, 0x82, 0x51, 0xc7, 0x56, 0x54, 0x13, 0xc2, 0x2e, 0xda, 0x87, 0xb3, 0x15, 0xa3, 0x40,
+	0xa5, 0xb3, 0xe7, 0x59, 0xa3, 0xdb, 0x93, 0xb6, 0x63, 0x45, 0x35, 0x86, 0x03, 0x74, 0x2f, 0x2b,
+	0x04, 0x49, 0x16, 0x10, 0x93, 0x52, 0xb2, 0x98, 0x83, 0x2c, 0x38, 0x38, 0xb7, 0x3c, 0x6b, 0xb4,
+	0x1f, 0x1d, 0x1a, 0xea, 0xb8, 0x94, 0x2c, 0x52, 0x04, 0x7e, 0x86, 0xb0, 0x3e, 0x4d, 0x5c, 0x9d,
+	0x21, 0x26, 0x49, 0xc2, 0x61, 0xed, 0x74, 0xd4, 0x8f, 0xef, 0x6a, 0xe6, 0xe4, 0x7c, 0x05, 0xc7,
+	0x0a, 0xc7, 0x4f, 0xd1, 0x1d, 0xb2, 0x58, 0xb0, 0x53, 0xc8, 0xe2, 0x74, 0x41, 0x84, 0x00, 0xe1,
+	0x74, 0x3d, 0x7b, 0xd4, 0x8b, 0x06, 0x06, 0x9e, 0x6a, 0x14, 0xbf, 0x42, 0xb7, 0x33, 0x22, 0x21,
+	0x4e, 0x79, 0x21, 0x81, 0x17, 0xc4, 0xd9, 0xf7, 0xac, 0x51, 0xff, 0xe8, 0x69, 0xf0, 0x9f, 0xa6,
+	0x04, 0x2f, 0x89, 0x84, 0xa9, 0x29, 0x8f, 0x0e, 0xb2, 0xc6, 0x0e, 0xff, 0x84, 0xec, 0x37, 0x00,
+	0x4e, 0xcf, 0xb3, 0x47, 0xfd, 0xa3, 0x87, 0x81, 0x76, 0xaf, 0x6a, 0x85, 0xc0, 0xb8, 0x17, 0x4c,
+	0x59, 0x41, 0x27, 0x9f, 0xbd, 0xfb, 0xeb, 0x51, 0xeb, 0xb7, 0xbf, 0x1f, 0x8d, 0xf2, 0x42, 0xfe,
+	0x5c, 0x26, 0x41, 0xca, 0x96, 0xa1, 0xb1, 0x5a, 0x7f, 0x9e, 0x8b, 0x6c, 0x6e, 0xcc, 0xaa, 0x1a,
+	0x44, 0x54, 0xe9, 0xfa, 0x5f, 0xa0, 0xc3, 0xda, 0xb0, 0x08, 0xc4, 0x8a, 0x51, 0x01, 0xf8, 0x31,
+	0x3a, 0xd0, 0xb3, 0xc5, 0x19, 0x50, 0xb6, 0x34, 0xee, 0xf5, 0x35, 0xf6, 0xb2, 0x82, 0xfc, 0x5f,
+	0x2d, 0xd4, 0x99, 0x89, 0xfc, 0x75, 0x29, 0xf1, 0x7d, 0x74, 0x8b, 0x9d, 0x52, 0xd8, 0x98, 0xac,
+	0x37, 0x57, 0x34, 0xda, 0x57, 0x34, 0xf0, 0x31, 0xea, 0xea, 0x9b, 0x10, 0x8e, 0xed, 0xd9, 0xd7,
+	0x5c, 0xd1, 0x44, 0xad, 0xa6, 0x0a, 0x8e, 0x36, 0x7d, 0xfe, 0xd7, 0x68, 0xa0, 0xa7, 0xa8, 0x67,
+	0xaf, 0x6c, 0x5a, 0xb2, 0x92, 0xca, 0x98, 0x43, 0x0a, 0xc5, 0x1a, 0x32, 0x33, 0xd7, 0x40, 0xc3,
+	0x91, 0x41, 0xfd, 0x3f, 0xda, 0xa8, 0x3b, 0x13, 0xf9, 0x09, 0x99, 0xc3, 0xcd, 0x8f, 0xf0, 0x00,
+	0x75, 0xb4, 0xac, 0xc9, 0xab, 0xd9, 0xe1, 0x17, 0xe8, 0x9e, 0x4e, 0xdf, 0x12, 0xa8, 0x8c, 0x17,
+	0x2c, 0x25, 0x2a, 0xd4, 0x55, 0x6a, 0x7b, 0x2a, 0xb5, 0x78, 0x4b, 0xbf, 0x32, 0x2c, 0x7e, 0x82,
+	0x06, 0x1a, 0x8d, 0x19, 0x8d, 0x25, 0x99, 0x6f, 0xa2, 0x7b, 0xa0, 0xd1, 0x6f, 0xa9, 0x9a, 0xf5,
+	0x4b, 0xf4, 0x61, 0x43, 0xfa, 0x97, 0x92, 0x17, 0x22, 0x2b, 0x52, 0x25, 0xaf, 0xa3, 0xfb, 0x60,
+	0x4b, 0x7f, 0xd3, 0x60, 0xf1, 0xe7, 0xa8, 0xc1, 0xc4, 0x09, 0x50, 0x78, 0x53, 0xa4, 0x05, 0xe1,
+	0xe7, 0x4e, 0x57, 0xf5, 0x7d, 0xb0, 0x65, 0x27, 0x5b, 0x12, 0x7f, 0x8a, 0x0e, 0x1b, 0x6d, 0x1c,
+	0x88, 0x60, 0x54, 0x45, 0xba, 0x17, 0xdd, 0xdd, 0x12, 0x91, 0xc2, 0xfd, 0x13, 0x74, 0xc7, 0xdc,
+	0x69, 0x6d, 0x48, 0xc3, 0x65, 0xeb, 0x66, 0x2e, 0x1f, 0xbd, 0x6d, 0x23, 0x7b, 0x26, 0x72, 0xfc,
+	0x23, 0xea, 0x98, 0xa7, 0xe5, 0xc9, 0x0e, 0x8d, 0x3a, 0xcf, 0xc3, 0x67, 0xff, 0xa7, 0xaa, 0x1e,
+	0xf4, 0x3b, 0x64, 0x57, 0x71, 0x7e, 0xbc, 0xbb, 0xe9, 0x75, 0x29, 0x87, 0x1f, 0x5f, 0x5b, 0x52,
+	0x8b, 0x7e, 0x8f, 0xf6, 0x94, 0x6b, 0xfe, 0xee, 0x96, 0xaa, 0x66, 0xf8, 0xc9, 0xf5, 0x35, 0x1b,
+	0xdd, 0x49, 0xf4, 0xee, 0xc2, 0xb5, 0xde, 0x5f, 0xb8, 0xd6, 0x3f, 0x17, 0xae, 0xf5, 0xf6, 0xd2,
+	0x6d, 0xbd, 0xbf, 0x74, 0x5b, 0x7f, 0x5e, 0xba, 0xad, 0x1f, 0xbe, 0x6a, 0x3c, 0x00, 0x4a, 0xef,
+	0x39, 0x05, 0x79, 0xca, 0xf8, 0xdc, 0xec, 0x16, 0x90, 0xe5, 0xc0, 0xc3, 0xb3, 0x2b, 0x8f, 0x79,
+	0xd2, 0x51, 0x8f, 0xf8, 0x8b, 0x7f, 0x07, 0x00, 0x1f, 0x80, 0xf7, 0xee, 0x62, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -937,6 +960,20 @@ func (m *MsgTake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.RetirementJurisdiction) > 0 {
 		i -= len(m.RetirementJurisdiction)
 		copy(dAtA[i:], m.RetirementJurisdiction)
@@ -1158,6 +1195,14 @@ func (m *MsgTake) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2022,6 +2067,70 @@ func (m *MsgTake) Unmarshal(dAtA []byte) error {
 			}
 			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -27,6 +27,8 @@ const (
 	FlagDenomDescription       = "description"
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
 	FlagRetireOnTake           = "retire-on-take"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
+	FlagRetirementReason       = "retirement-reason"
 )
 
 func TxCreateBasketCmd() *cobra.Command {
@@ -237,10 +239,15 @@ Flags:
     for the credits which will be used only if --retire-on-take flag is true.
 - retire-on-take: boolean that dictates whether the ecocredits received
     in exchange for the basket tokens will be received as retired or tradable credits.
+- retirement-beneficiary: optional name or address of the person or organization on whose
+    behalf the credits are retired, which will be used only if --retire-on-take flag is true.
+- retirement-reason: optional reason for retiring the credits, which will be used only if
+    --retire-on-take flag is true.
 		
 		`),
 		Example: `regen tx ecocredit take-from-basket eco.uC.NCT 1000
-regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --retirement-jurisdiction "US-WA 98225"`,
+regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --retirement-jurisdiction "US-WA 98225" --retirement-beneficiary "Acme Corporation"`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -258,12 +265,24 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 				return err
 			}
 
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
 			msg := basket.MsgTake{
 				Owner:                  clientCtx.FromAddress.String(),
 				BasketDenom:            args[0],
 				Amount:                 args[1],
 				RetirementJurisdiction: retirementJurisdiction,
 				RetireOnTake:           retireOnTake,
+				RetirementBeneficiary:  retirementBeneficiary,
+				RetirementReason:       retirementReason,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -276,6 +295,8 @@ regen tx ecocredit take-from-basket eco.uC.NCT 1000 --retire-on-take=true --reti
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "jurisdiction for the credits which will be used only if --retire-on-take flag is true")
 	cmd.Flags().Bool(FlagRetireOnTake, false, "dictates whether the ecocredits received in exchange for the basket tokens will be received as retired or tradable credits")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "name or address of the person or organization on whose behalf the credits are retired, used only if --retire-on-take flag is true")
+	cmd.Flags().String(FlagRetirementReason, "", "reason for retiring the credits, used only if --retire-on-take flag is true")
 
 	return txFlags(cmd)
 }
@@ -0,0 +1,517 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	gogotypes "github.com/gogo/protobuf/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	datav1 "github.com/regen-network/regen-ledger/api/regen/data/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+const (
+	CertificateFormatJSON = "json"
+	CertificateFormatHTML = "html"
+	CertificateFormatPDF  = "pdf"
+)
+
+// RetirementCertificate is a self-contained proof of the retirements of credits
+// emitted by a transaction.
+type RetirementCertificate struct {
+	TxHash      string                   `json:"tx_hash"`
+	Height      int64                    `json:"height"`
+	Timestamp   string                   `json:"timestamp"`
+	Retirements []*CertificateRetirement `json:"retirements"`
+}
+
+// CertificateRetirement is a single retirement of credits within a retirement
+// certificate along with the credit batch, project and credit class details.
+type CertificateRetirement struct {
+	RetirementId uint64              `json:"retirement_id,omitempty"`
+	Owner        string              `json:"owner"`
+	Beneficiary  string              `json:"beneficiary,omitempty"`
+	Reason       string              `json:"reason,omitempty"`
+	Jurisdiction string              `json:"jurisdiction"`
+	Amount       string              `json:"amount"`
+	Timestamp    string              `json:"timestamp"`
+	Batch        *CertificateBatch   `json:"batch"`
+	Project      *CertificateProject `json:"project"`
+	Class        *CertificateClass   `json:"class"`
+}
+
+// CertificateBatch is the credit batch of a retirement within a retirement certificate.
+type CertificateBatch struct {
+	Denom        string               `json:"denom"`
+	Issuer       string               `json:"issuer"`
+	StartDate    string               `json:"start_date"`
+	EndDate      string               `json:"end_date"`
+	IssuanceDate string               `json:"issuance_date"`
+	Metadata     *CertificateMetadata `json:"metadata,omitempty"`
+}
+
+// CertificateProject is the project of a retirement within a retirement certificate.
+type CertificateProject struct {
+	Id           string               `json:"id"`
+	Admin        string               `json:"admin"`
+	Jurisdiction string               `json:"jurisdiction"`
+	ReferenceId  string               `json:"reference_id,omitempty"`
+	Metadata     *CertificateMetadata `json:"metadata,omitempty"`
+}
+
+// CertificateClass is the credit class of a retirement within a retirement certificate.
+type CertificateClass struct {
+	Id         string               `json:"id"`
+	Admin      string               `json:"admin"`
+	CreditType string               `json:"credit_type"`
+	Metadata   *CertificateMetadata `json:"metadata,omitempty"`
+}
+
+// CertificateMetadata is a metadata IRI and the data anchor and resolvers registered
+// for the IRI in the data module.
+type CertificateMetadata struct {
+	IRI             string   `json:"iri"`
+	Anchored        bool     `json:"anchored"`
+	AnchorTimestamp string   `json:"anchor_timestamp,omitempty"`
+	Resolvers       []string `json:"resolvers,omitempty"`
+}
+
+// retireEvents returns all EventRetire events emitted by a transaction.
+func retireEvents(txRes *sdk.TxResponse) ([]*core.EventRetire, error) {
+	var events []*core.EventRetire
+	for _, e := range txRes.Events {
+		if e.Type != proto.MessageName(&core.EventRetire{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		if err != nil {
+			return nil, err
+		}
+
+		event, ok := msg.(*core.EventRetire)
+		if !ok {
+			return nil, fmt.Errorf("unexpected event type %T", msg)
+		}
+
+		events = append(events, event)
+	}
+
+	if len(events) == 0 {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no retirements of credits in transaction %s", txRes.TxHash)
+	}
+
+	return events, nil
+}
+
+// buildRetirementCertificate queries the retirements, credit batches, projects and
+// credit classes of the retirements emitted by a transaction and resolves their
+// metadata through the data anchors of the data module.
+func buildRetirementCertificate(ctx context.Context, c core.QueryClient, d datav1.QueryClient, txRes *sdk.TxResponse) (*RetirementCertificate, error) {
+	events, err := retireEvents(txRes)
+	if err != nil {
+		return nil, err
+	}
+
+	cert := &RetirementCertificate{
+		TxHash:    txRes.TxHash,
+		Height:    txRes.Height,
+		Timestamp: txRes.Timestamp,
+	}
+
+	for _, event := range events {
+		retirement := &CertificateRetirement{
+			RetirementId: event.RetirementId,
+			Owner:        event.Owner,
+			Beneficiary:  event.Beneficiary,
+			Reason:       event.Reason,
+			Jurisdiction: event.Jurisdiction,
+			Amount:       event.Amount,
+			Timestamp:    txRes.Timestamp,
+		}
+
+		// retirements emitted before retirements were recorded in state have no id
+		if event.RetirementId != 0 {
+			res, err := c.Retirement(ctx, &core.QueryRetirementRequest{Id: event.RetirementId})
+			if err != nil {
+				return nil, fmt.Errorf("retirement %d: %w", event.RetirementId, err)
+			}
+			retirement.Beneficiary = res.Retirement.Beneficiary
+			retirement.Reason = res.Retirement.Reason
+			retirement.Timestamp = formatTimestamp(res.Retirement.Timestamp)
+		}
+
+		batchRes, err := c.Batch(ctx, &core.QueryBatchRequest{BatchDenom: event.BatchDenom})
+		if err != nil {
+			return nil, fmt.Errorf("batch %s: %w", event.BatchDenom, err)
+		}
+		batch := batchRes.Batch
+
+		projectRes, err := c.Project(ctx, &core.QueryProjectRequest{ProjectId: batch.ProjectId})
+		if err != nil {
+			return nil, fmt.Errorf("project %s: %w", batch.ProjectId, err)
+		}
+		project := projectRes.Project
+
+		classRes, err := c.Class(ctx, &core.QueryClassRequest{ClassId: project.ClassId})
+		if err != nil {
+			return nil, fmt.Errorf("class %s: %w", project.ClassId, err)
+		}
+		class := classRes.Class
+
+		retirement.Batch = &CertificateBatch{
+			Denom:        batch.Denom,
+			Issuer:       batch.Issuer,
+			StartDate:    formatDate(batch.StartDate),
+			EndDate:      formatDate(batch.EndDate),
+			IssuanceDate: formatTimestamp(batch.IssuanceDate),
+			Metadata:     resolveMetadata(ctx, d, batch.Metadata),
+		}
+		retirement.Project = &CertificateProject{
+			Id:           project.Id,
+			Admin:        project.Admin,
+			Jurisdiction: project.Jurisdiction,
+			ReferenceId:  project.ReferenceId,
+			Metadata:     resolveMetadata(ctx, d, project.Metadata),
+		}
+		retirement.Class = &CertificateClass{
+			Id:         class.Id,
+			Admin:      class.Admin,
+			CreditType: class.CreditTypeAbbrev,
+			Metadata:   resolveMetadata(ctx, d, class.Metadata),
+		}
+
+		cert.Retirements = append(cert.Retirements, retirement)
+	}
+
+	return cert, nil
+}
+
+// resolveMetadata looks up the data anchor and the resolvers of a metadata IRI. The
+// metadata is not required to be an anchored IRI, in which case the metadata is
+// returned without an anchor.
+func resolveMetadata(ctx context.Context, d datav1.QueryClient, iri string) *CertificateMetadata {
+	if iri == "" {
+		return nil
+	}
+
+	metadata := &CertificateMetadata{IRI: iri}
+
+	anchorRes, err := d.AnchorByIRI(ctx, &datav1.QueryAnchorByIRIRequest{Iri: iri})
+	if err != nil || anchorRes.Anchor == nil {
+		return metadata
+	}
+
+	metadata.Anchored = true
+	if ts := anchorRes.Anchor.Timestamp; ts != nil {
+		metadata.AnchorTimestamp = ts.AsTime().UTC().Format(time.RFC3339)
+	}
+
+	resolversRes, err := d.ResolversByIRI(ctx, &datav1.QueryResolversByIRIRequest{Iri: iri})
+	if err != nil {
+		return metadata
+	}
+
+	for _, resolver := range resolversRes.Resolvers {
+		metadata.Resolvers = append(metadata.Resolvers, resolver.Url)
+	}
+
+	return metadata
+}
+
+func formatTimestamp(ts *gogotypes.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t, err := gogotypes.TimestampFromProto(ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func formatDate(ts *gogotypes.Timestamp) string {
+	if ts == nil {
+		return ""
+	}
+	t, err := gogotypes.TimestampFromProto(ts)
+	if err != nil {
+		return ""
+	}
+	return t.UTC().Format("2006-01-02")
+}
+
+// certificateSection is a titled group of labeled values used by the html and pdf
+// renderers so that both formats present the same content.
+type certificateSection struct {
+	Title string
+	Rows  []certificateRow
+}
+
+type certificateRow struct {
+	Label string
+	Value string
+}
+
+func (m *CertificateMetadata) String() string {
+	if m == nil {
+		return ""
+	}
+	if !m.Anchored {
+		return fmt.Sprintf("%s (not anchored)", m.IRI)
+	}
+	s := fmt.Sprintf("%s (anchored %s)", m.IRI, m.AnchorTimestamp)
+	if len(m.Resolvers) > 0 {
+		s = fmt.Sprintf("%s resolvers: %s", s, strings.Join(m.Resolvers, ", "))
+	}
+	return s
+}
+
+func (cert *RetirementCertificate) sections() []certificateSection {
+	var sections []certificateSection
+	for _, r := range cert.Retirements {
+		retirement := certificateSection{
+			Title: fmt.Sprintf("Retirement of %s credits from %s", r.Amount, r.Batch.Denom),
+			Rows: []certificateRow{
+				{"Owner", r.Owner},
+				{"Beneficiary", r.Beneficiary},
+				{"Reason", r.Reason},
+				{"Jurisdiction", r.Jurisdiction},
+				{"Amount", r.Amount},
+				{"Retired at", r.Timestamp},
+			},
+		}
+		if r.RetirementId != 0 {
+			retirement.Rows = append([]certificateRow{{"Retirement ID", fmt.Sprint(r.RetirementId)}}, retirement.Rows...)
+		}
+
+		sections = append(sections,
+			retirement,
+			certificateSection{
+				Title: "Credit Batch",
+				Rows: []certificateRow{
+					{"Denom", r.Batch.Denom},
+					{"Issuer", r.Batch.Issuer},
+					{"Vintage", fmt.Sprintf("%s to %s", r.Batch.StartDate, r.Batch.EndDate)},
+					{"Issued at", r.Batch.IssuanceDate},
+					{"Metadata", r.Batch.Metadata.String()},
+				},
+			},
+			certificateSection{
+				Title: "Project",
+				Rows: []certificateRow{
+					{"ID", r.Project.Id},
+					{"Admin", r.Project.Admin},
+					{"Jurisdiction", r.Project.Jurisdiction},
+					{"Reference ID", r.Project.ReferenceId},
+					{"Metadata", r.Project.Metadata.String()},
+				},
+			},
+			certificateSection{
+				Title: "Credit Class",
+				Rows: []certificateRow{
+					{"ID", r.Class.Id},
+					{"Admin", r.Class.Admin},
+					{"Credit Type", r.Class.CreditType},
+					{"Metadata", r.Class.Metadata.String()},
+				},
+			},
+		)
+	}
+	return sections
+}
+
+// renderCertificate writes the retirement certificate in the given format.
+func renderCertificate(w io.Writer, cert *RetirementCertificate, format string) error {
+	switch format {
+	case CertificateFormatJSON:
+		bz, err := json.MarshalIndent(cert, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(append(bz, '\n'))
+		return err
+	case CertificateFormatHTML:
+		return certificateTemplate.Execute(w, struct {
+			*RetirementCertificate
+			Sections []certificateSection
+		}{cert, cert.sections()})
+	case CertificateFormatPDF:
+		_, err := w.Write(certificatePDF(cert))
+		return err
+	default:
+		return fmt.Errorf("invalid format %q: must be one of %s, %s or %s",
+			format, CertificateFormatJSON, CertificateFormatHTML, CertificateFormatPDF)
+	}
+}
+
+var certificateTemplate = template.Must(template.New("certificate").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Retirement Certificate {{.TxHash}}</title>
+<style>
+body { font-family: Helvetica, Arial, sans-serif; color: #1d2b36; margin: 40px auto; max-width: 800px; }
+h1 { border-bottom: 2px solid #4fb573; padding-bottom: 8px; }
+h2 { margin-top: 32px; color: #3d7acf; }
+table { border-collapse: collapse; width: 100%; }
+td { border-bottom: 1px solid #e0e0e0; padding: 6px 8px; vertical-align: top; word-break: break-all; }
+td.label { font-weight: bold; width: 160px; word-break: normal; }
+.tx { color: #545555; }
+</style>
+</head>
+<body>
+<h1>Retirement Certificate</h1>
+<p class="tx">Transaction {{.TxHash}} at height {{.Height}} ({{.Timestamp}})</p>
+{{- range .Sections}}
+<h2>{{.Title}}</h2>
+<table>
+{{- range .Rows}}
+<tr><td class="label">{{.Label}}</td><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+const (
+	pdfPageWidth  = 612
+	pdfPageHeight = 792
+	pdfMargin     = 50
+	pdfLineWidth  = 95
+)
+
+type pdfLine struct {
+	font string
+	size int
+	text string
+}
+
+// certificatePDF renders the retirement certificate as a minimal single-column pdf
+// document using the standard helvetica fonts so that no fonts need to be embedded.
+func certificatePDF(cert *RetirementCertificate) []byte {
+	lines := []pdfLine{
+		{"F2", 20, "Retirement Certificate"},
+		{"F1", 10, fmt.Sprintf("Transaction %s", cert.TxHash)},
+		{"F1", 10, fmt.Sprintf("Height %d (%s)", cert.Height, cert.Timestamp)},
+	}
+	for _, section := range cert.sections() {
+		lines = append(lines, pdfLine{"F1", 10, ""}, pdfLine{"F2", 13, section.Title})
+		for _, row := range section.Rows {
+			for i, text := range wrapText(fmt.Sprintf("%s: %s", row.Label, row.Value), pdfLineWidth) {
+				if i > 0 {
+					text = "    " + text
+				}
+				lines = append(lines, pdfLine{"F1", 10, text})
+			}
+		}
+	}
+
+	// split lines into pages
+	var pages [][]pdfLine
+	var page []pdfLine
+	y := pdfPageHeight - pdfMargin
+	for _, line := range lines {
+		y -= line.size + 4
+		if y < pdfMargin {
+			pages = append(pages, page)
+			page = nil
+			y = pdfPageHeight - pdfMargin - line.size - 4
+		}
+		page = append(page, line)
+	}
+	pages = append(pages, page)
+
+	// objects 1 to 4 are the catalog, the page tree and the fonts, followed by a
+	// page object and a content stream for each page
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>",
+		"<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>",
+	}
+
+	var kids []string
+	for i, page := range pages {
+		pageObj := 5 + 2*i
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObj))
+
+		var content bytes.Buffer
+		y := pdfPageHeight - pdfMargin
+		for _, line := range page {
+			y -= line.size + 4
+			fmt.Fprintf(&content, "BT /%s %d Tf %d %d Td (%s) Tj ET\n",
+				line.font, line.size, pdfMargin, y, pdfEscape(line.text))
+		}
+
+		objects = append(objects,
+			fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %d %d] "+
+				"/Resources << /Font << /F1 3 0 R /F2 4 0 R >> >> /Contents %d 0 R >>",
+				pdfPageWidth, pdfPageHeight, pageObj+1),
+			fmt.Sprintf("<< /Length %d >>\nstream\n%sendstream", content.Len(), content.String()),
+		)
+	}
+	objects[1] = fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages))
+
+	var buf bytes.Buffer
+	buf.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for i, obj := range objects {
+		offsets[i] = buf.Len()
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\nendobj\n", i+1, obj)
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(objects)+1, xref)
+
+	return buf.Bytes()
+}
+
+// pdfEscape escapes a string for use in a pdf literal string and replaces characters
+// that are not printable ascii characters.
+func pdfEscape(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '\\' || r == '(' || r == ')':
+			b.WriteRune('\\')
+			b.WriteRune(r)
+		case r < 32 || r > 126:
+			b.WriteRune('?')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// wrapText splits text into lines of at most width characters, breaking at spaces
+// where possible.
+func wrapText(text string, width int) []string {
+	var lines []string
+	for len(text) > width {
+		i := strings.LastIndex(text[:width], " ")
+		if i <= 0 {
+			i = width
+		}
+		lines = append(lines, text[:i])
+		text = strings.TrimLeft(text[i:], " ")
+	}
+	return append(lines, text)
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	datav1 "github.com/regen-network/regen-ledger/api/regen/data/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type mockCoreQueryClient struct {
+	core.QueryClient
+}
+
+func (mockCoreQueryClient) Retirement(_ context.Context, req *core.QueryRetirementRequest, _ ...grpc.CallOption) (*core.QueryRetirementResponse, error) {
+	return &core.QueryRetirementResponse{Retirement: &core.RetirementInfo{
+		Id:          req.Id,
+		Beneficiary: "Acme Corp",
+		Reason:      "offsetting 2022 emissions",
+		Timestamp:   &gogotypes.Timestamp{Seconds: 1650000000},
+	}}, nil
+}
+
+func (mockCoreQueryClient) Batch(_ context.Context, req *core.QueryBatchRequest, _ ...grpc.CallOption) (*core.QueryBatchResponse, error) {
+	return &core.QueryBatchResponse{Batch: &core.BatchInfo{
+		Issuer:       "regen1issuer",
+		ProjectId:    "C01-001",
+		Denom:        req.BatchDenom,
+		Metadata:     "regen:batch.rdf",
+		StartDate:    &gogotypes.Timestamp{Seconds: 1577836800},
+		EndDate:      &gogotypes.Timestamp{Seconds: 1609459200},
+		IssuanceDate: &gogotypes.Timestamp{Seconds: 1640995200},
+	}}, nil
+}
+
+func (mockCoreQueryClient) Project(_ context.Context, req *core.QueryProjectRequest, _ ...grpc.CallOption) (*core.QueryProjectResponse, error) {
+	return &core.QueryProjectResponse{Project: &core.ProjectInfo{
+		Id:           req.ProjectId,
+		Admin:        "regen1admin",
+		ClassId:      "C01",
+		Jurisdiction: "US-WA",
+		Metadata:     "regen:project.rdf",
+	}}, nil
+}
+
+func (mockCoreQueryClient) Class(_ context.Context, req *core.QueryClassRequest, _ ...grpc.CallOption) (*core.QueryClassResponse, error) {
+	return &core.QueryClassResponse{Class: &core.ClassInfo{
+		Id:               req.ClassId,
+		Admin:            "regen1admin",
+		CreditTypeAbbrev: "C",
+	}}, nil
+}
+
+type mockDataQueryClient struct {
+	datav1.QueryClient
+}
+
+func (mockDataQueryClient) AnchorByIRI(_ context.Context, req *datav1.QueryAnchorByIRIRequest, _ ...grpc.CallOption) (*datav1.QueryAnchorByIRIResponse, error) {
+	if req.Iri != "regen:batch.rdf" {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &datav1.QueryAnchorByIRIResponse{Anchor: &datav1.AnchorInfo{
+		Iri:       req.Iri,
+		Timestamp: timestamppb.New(time.Unix(1640995200, 0)),
+	}}, nil
+}
+
+func (mockDataQueryClient) ResolversByIRI(_ context.Context, _ *datav1.QueryResolversByIRIRequest, _ ...grpc.CallOption) (*datav1.QueryResolversByIRIResponse, error) {
+	return &datav1.QueryResolversByIRIResponse{Resolvers: []*datav1.ResolverInfo{
+		{Id: 1, Url: "https://data.regen.network"},
+	}}, nil
+}
+
+func retireTxResponse(t *testing.T, events ...*core.EventRetire) *sdk.TxResponse {
+	txRes := &sdk.TxResponse{TxHash: "ABCD", Height: 10, Timestamp: "2022-04-15T05:20:00Z"}
+	for _, event := range events {
+		e, err := sdk.TypedEventToEvent(event)
+		require.NoError(t, err)
+		txRes.Events = append(txRes.Events, abci.Event(e))
+	}
+	return txRes
+}
+
+func TestRetireEvents(t *testing.T) {
+	_, err := retireEvents(retireTxResponse(t))
+	require.ErrorContains(t, err, "no retirements of credits in transaction ABCD")
+
+	events, err := retireEvents(retireTxResponse(t,
+		&core.EventRetire{Owner: "regen1owner", BatchDenom: "C01-001-20200101-20210101-001", Amount: "10", RetirementId: 1},
+		&core.EventRetire{Owner: "regen1owner", BatchDenom: "C01-001-20200101-20210101-002", Amount: "5"},
+	))
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	require.Equal(t, uint64(1), events[0].RetirementId)
+	require.Equal(t, "5", events[1].Amount)
+}
+
+func TestBuildRetirementCertificate(t *testing.T) {
+	txRes := retireTxResponse(t,
+		&core.EventRetire{Owner: "regen1owner", BatchDenom: "C01-001-20200101-20210101-001", Amount: "10", Jurisdiction: "US-OR", RetirementId: 1},
+		&core.EventRetire{Owner: "regen1owner", BatchDenom: "C01-001-20200101-20210101-001", Amount: "5", Jurisdiction: "US-OR"},
+	)
+
+	cert, err := buildRetirementCertificate(context.Background(), mockCoreQueryClient{}, mockDataQueryClient{}, txRes)
+	require.NoError(t, err)
+	require.Len(t, cert.Retirements, 2)
+
+	// the retirement recorded in state
+	r := cert.Retirements[0]
+	require.Equal(t, "Acme Corp", r.Beneficiary)
+	require.Equal(t, "offsetting 2022 emissions", r.Reason)
+	require.Equal(t, "2022-04-15T05:20:00Z", r.Timestamp)
+	require.Equal(t, "2020-01-01", r.Batch.StartDate)
+	require.Equal(t, "2021-01-01", r.Batch.EndDate)
+	require.Equal(t, &CertificateMetadata{
+		IRI:             "regen:batch.rdf",
+		Anchored:        true,
+		AnchorTimestamp: "2022-01-01T00:00:00Z",
+		Resolvers:       []string{"https://data.regen.network"},
+	}, r.Batch.Metadata)
+	require.Equal(t, &CertificateMetadata{IRI: "regen:project.rdf"}, r.Project.Metadata)
+	require.Nil(t, r.Class.Metadata)
+	require.Equal(t, "C", r.Class.CreditType)
+
+	// the retirement without a record falls back to the event and the transaction
+	r = cert.Retirements[1]
+	require.Empty(t, r.Beneficiary)
+	require.Equal(t, txRes.Timestamp, r.Timestamp)
+}
+
+func TestRenderCertificate(t *testing.T) {
+	txRes := retireTxResponse(t, &core.EventRetire{
+		Owner:        "regen1owner",
+		BatchDenom:   "C01-001-20200101-20210101-001",
+		Amount:       "10",
+		RetirementId: 1,
+	})
+	cert, err := buildRetirementCertificate(context.Background(), mockCoreQueryClient{}, mockDataQueryClient{}, txRes)
+	require.NoError(t, err)
+	cert.Retirements[0].Reason = "<script>(offset)</script> " + strings.Repeat("x", 200)
+
+	var buf bytes.Buffer
+	require.NoError(t, renderCertificate(&buf, cert, CertificateFormatJSON))
+	var decoded RetirementCertificate
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &decoded))
+	require.Equal(t, *cert, decoded)
+
+	buf.Reset()
+	require.NoError(t, renderCertificate(&buf, cert, CertificateFormatHTML))
+	require.Contains(t, buf.String(), "Acme Corp")
+	require.Contains(t, buf.String(), "&lt;script&gt;")
+	require.NotContains(t, buf.String(), "<script>")
+
+	buf.Reset()
+	require.NoError(t, renderCertificate(&buf, cert, CertificateFormatPDF))
+	pdf := buf.String()
+	require.True(t, strings.HasPrefix(pdf, "%PDF-1.4\n"))
+	require.True(t, strings.HasSuffix(pdf, "%%EOF\n"))
+	require.Contains(t, pdf, `\(offset\)`)
+
+	// the cross-reference table must point to the start of each object
+	i := strings.LastIndex(pdf, "startxref\n")
+	xref, err := strconv.Atoi(strings.Fields(pdf[i+len("startxref\n"):])[0])
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(pdf[xref:], "xref\n"))
+	entries := strings.Split(pdf[xref:], "\n")[3:]
+	for n := 1; strings.HasSuffix(entries[n-1], " n "); n++ {
+		offset, err := strconv.Atoi(entries[n-1][:10])
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(pdf[offset:], fmt.Sprintf("%d 0 obj\n", n)))
+	}
+
+	buf.Reset()
+	require.ErrorContains(t, renderCertificate(&buf, cert, "docx"), `invalid format "docx"`)
+}
+
+func TestWrapText(t *testing.T) {
+	require.Equal(t, []string{"foo bar", "baz"}, wrapText("foo bar baz", 8))
+	require.Equal(t, []string{"foobarba", "z"}, wrapText("foobarbaz", 8))
+	require.Equal(t, []string{""}, wrapText("", 8))
+}
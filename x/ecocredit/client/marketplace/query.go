@@ -1,16 +1,25 @@
 package marketplace
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	sdkclient "github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	gogotypes "github.com/gogo/protobuf/types"
 	"github.com/spf13/cobra"
 
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
 
+const (
+	FlagLevels     = "levels"
+	FlagBatchDenom = "batch-denom"
+	FlagEndTime    = "end-time"
+)
+
 // QuerySellOrderCmd returns a query command that retrieves information for a given sell order.
 func QuerySellOrderCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -189,3 +198,653 @@ regen q ecocredit allowed-denoms --limit 10 --offset 10`,
 
 	return cmd
 }
+
+// QueryMarketsCmd returns a query command that retrieves all markets with pagination.
+func QueryMarketsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "markets",
+		Short: "List all markets",
+		Long:  "List all markets with optional pagination flags.",
+		Example: `regen q ecocredit markets
+regen q ecocredit markets --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.Markets(cmd.Context(), &marketplace.QueryMarketsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "markets")
+
+	return cmd
+}
+
+// QueryMarketDepthCmd returns a query command that retrieves the aggregated depth of a market.
+func QueryMarketDepthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "market-depth [market-id]",
+		Short: "Retrieve the aggregated depth of a market",
+		Long: `Retrieve the aggregated depth of a market, i.e. the total quantity of credits of the
+sell orders (asks) and buy orders (bids) at each price.`,
+		Example: `regen q ecocredit market-depth 1
+regen q ecocredit market-depth 1 --levels 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			levels, err := cmd.Flags().GetUint32(FlagLevels)
+			if err != nil {
+				return err
+			}
+			res, err := client.MarketDepth(cmd.Context(), &marketplace.QueryMarketDepthRequest{
+				MarketId: marketId,
+				Limit:    levels,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint32(FlagLevels, 0, "the maximum number of price levels on each side of the market (all levels if empty)")
+
+	return cmd
+}
+
+// QueryBestAskByBatchCmd returns a query command that retrieves the best ask of a credit batch in each market.
+func QueryBestAskByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "best-ask-by-batch [batch_denom]",
+		Short:   "Retrieve the best ask of a credit batch in each market",
+		Long:    "Retrieve the lowest ask price of the sell orders of a credit batch in each market.",
+		Example: "regen q ecocredit best-ask-by-batch C01-001-20200101-20210101-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			res, err := client.BestAskByBatch(cmd.Context(), &marketplace.QueryBestAskByBatchRequest{
+				BatchDenom: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBestAskByProjectCmd returns a query command that retrieves the best ask of the credit batches of a project in each market.
+func QueryBestAskByProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "best-ask-by-project [project_id]",
+		Short:   "Retrieve the best ask of the credit batches of a project in each market",
+		Long:    "Retrieve the lowest ask price of the sell orders of the credit batches of a project in each market.",
+		Example: "regen q ecocredit best-ask-by-project C01-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			res, err := client.BestAskByProject(cmd.Context(), &marketplace.QueryBestAskByProjectRequest{
+				ProjectId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryBestAskByClassCmd returns a query command that retrieves the best ask of the credit batches of a credit class in each market.
+func QueryBestAskByClassCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "best-ask-by-class [class_id]",
+		Short:   "Retrieve the best ask of the credit batches of a credit class in each market",
+		Long:    "Retrieve the lowest ask price of the sell orders of the credit batches of a credit class in each market.",
+		Example: "regen q ecocredit best-ask-by-class C01",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			res, err := client.BestAskByClass(cmd.Context(), &marketplace.QueryBestAskByClassRequest{
+				ClassId: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryTradesByBatchCmd returns a query command that retrieves all trades by batch denom with pagination.
+func QueryTradesByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-batch [batch_denom]",
+		Short: "List all trades by batch denom",
+		Long:  `List all trades of the credits of a credit batch with optional pagination flags.`,
+		Example: `regen q ecocredit trades-by-batch C01-001-20200101-20210101-001
+regen q ecocredit trades-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByBatch(cmd.Context(), &marketplace.QueryTradesByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-batch")
+
+	return cmd
+}
+
+// QueryTradesByBuyerCmd returns a query command that retrieves all trades by buyer with pagination.
+func QueryTradesByBuyerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-buyer [buyer]",
+		Short: "List all trades by buyer",
+		Long:  `List all trades in which the account bought credits with optional pagination flags.`,
+		Example: `regen q ecocredit trades-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit trades-by-buyer regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByBuyer(cmd.Context(), &marketplace.QueryTradesByBuyerRequest{
+				Buyer:      args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-buyer")
+
+	return cmd
+}
+
+// QueryTradesBySellerCmd returns a query command that retrieves all trades by seller with pagination.
+func QueryTradesBySellerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-seller [seller]",
+		Short: "List all trades by seller",
+		Long:  `List all trades in which the account sold credits with optional pagination flags.`,
+		Example: `regen q ecocredit trades-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf
+regen q ecocredit trades-by-seller regen18xvpj53vaupyfejpws5sktv5lnas5xj2phm3cf --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesBySeller(cmd.Context(), &marketplace.QueryTradesBySellerRequest{
+				Seller:     args[0],
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-seller")
+
+	return cmd
+}
+
+// QueryTradesByTimeRangeCmd returns a query command that retrieves all trades within a time range with pagination.
+func QueryTradesByTimeRangeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades-by-time-range [start_time] [end_time]",
+		Short: "List all trades within a time range",
+		Long: `List all trades completed within an inclusive time range with optional pagination flags.
+
+Parameters:
+  start_time: the start of the time range in RFC3339 format
+  end_time:   the end of the time range in RFC3339 format`,
+		Example: `regen q ecocredit trades-by-time-range 2022-01-01T00:00:00Z 2022-02-01T00:00:00Z
+regen q ecocredit trades-by-time-range 2022-01-01T00:00:00Z 2022-02-01T00:00:00Z --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			startTime, err := parseTimestamp("start time", args[0])
+			if err != nil {
+				return err
+			}
+
+			endTime, err := parseTimestamp("end time", args[1])
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.TradesByTimeRange(cmd.Context(), &marketplace.QueryTradesByTimeRangeRequest{
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "trades-by-time-range")
+
+	return cmd
+}
+
+// QueryAuctionCmd returns a query command that retrieves information for a given auction.
+func QueryAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "auction [auction-id]",
+		Short:   "Retrieve information for a given auction",
+		Long:    "Retrieve information for a given auction, including the current price of a dutch auction.",
+		Example: "regen q ecocredit auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+			res, err := client.Auction(cmd.Context(), &marketplace.QueryAuctionRequest{
+				AuctionId: auctionId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryAuctionsCmd returns a query command that retrieves all auctions with pagination.
+func QueryAuctionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auctions",
+		Short: "List all auctions",
+		Long:  `List all auctions with optional pagination flags.`,
+		Example: `regen q ecocredit auctions
+regen q ecocredit auctions --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.Auctions(cmd.Context(), &marketplace.QueryAuctionsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "auctions")
+
+	return cmd
+}
+
+// QueryQuoteRequestCmd returns a query command that retrieves information for a given
+// quote request.
+func QueryQuoteRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "quote-request [request-id]",
+		Short:   "Retrieve information for a given quote request",
+		Long:    "Retrieve information for a given quote request.",
+		Example: "regen q ecocredit quote-request 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %s", err)
+			}
+			res, err := client.QuoteRequest(cmd.Context(), &marketplace.QueryQuoteRequestRequest{
+				RequestId: requestId,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryQuoteRequestsCmd returns a query command that retrieves all quote requests with
+// pagination.
+func QueryQuoteRequestsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quote-requests",
+		Short: "List all quote requests",
+		Long:  `List all quote requests with optional pagination flags.`,
+		Example: `regen q ecocredit quote-requests
+regen q ecocredit quote-requests --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.QuoteRequests(cmd.Context(), &marketplace.QueryQuoteRequestsRequest{
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quote-requests")
+
+	return cmd
+}
+
+// QueryQuotesCmd returns a query command that retrieves the quotes of a quote request with
+// pagination.
+func QueryQuotesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "quotes [request-id]",
+		Short: "List the quotes of a quote request",
+		Long:  `List the quotes of a quote request with optional pagination flags.`,
+		Example: `regen q ecocredit quotes 1
+regen q ecocredit quotes 1 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %s", err)
+			}
+			pagination, err := sdkclient.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := client.Quotes(cmd.Context(), &marketplace.QueryQuotesRequest{
+				RequestId:  requestId,
+				Pagination: pagination,
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "quotes")
+
+	return cmd
+}
+
+// QueryTwapCmd returns a query command that retrieves the time-weighted average price of a market.
+func QueryTwapCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "twap [market-id] [start-time]",
+		Short: "Retrieve the time-weighted average price of a market",
+		Long: `Retrieve the time-weighted average price of a market, or of a credit batch in a market,
+from the start time to the end time or the current block time.
+
+Parameters:
+  market-id:  the id of the market
+  start-time: the start of the time range in RFC3339 format`,
+		Example: `regen q ecocredit twap 1 2022-01-01T00:00:00Z
+regen q ecocredit twap 1 2022-01-01T00:00:00Z --batch-denom C01-001-20200101-20210101-001
+regen q ecocredit twap 1 2022-01-01T00:00:00Z --end-time 2022-02-01T00:00:00Z`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %s", err)
+			}
+			startTime, err := parseTimestamp("start time", args[1])
+			if err != nil {
+				return err
+			}
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+			req := &marketplace.QueryTwapRequest{
+				MarketId:   marketId,
+				BatchDenom: batchDenom,
+				StartTime:  startTime,
+			}
+			endTimeStr, err := cmd.Flags().GetString(FlagEndTime)
+			if err != nil {
+				return err
+			}
+			if endTimeStr != "" {
+				if req.EndTime, err = parseTimestamp("end time", endTimeStr); err != nil {
+					return err
+				}
+			}
+			res, err := client.Twap(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of a credit batch (all credit batches in the market if empty)")
+	cmd.Flags().String(FlagEndTime, "", "the end of the time range in RFC3339 format (the current block time if empty)")
+
+	return cmd
+}
+
+// QueryCandlesCmd returns a query command that retrieves the candles of a market.
+func QueryCandlesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [market-id] [start-time] [end-time] [interval]",
+		Short: "Retrieve the candles of a market",
+		Long: `Retrieve the open, high, low and close prices and the volume of a market, or of a credit
+batch in a market, in each interval of a time range. Intervals without trades are omitted.
+
+Parameters:
+  market-id:  the id of the market
+  start-time: the inclusive start of the time range in RFC3339 format
+  end-time:   the exclusive end of the time range in RFC3339 format
+  interval:   the duration of each candle, e.g. 1h`,
+		Example: `regen q ecocredit candles 1 2022-01-01T00:00:00Z 2022-01-02T00:00:00Z 1h
+regen q ecocredit candles 1 2022-01-01T00:00:00Z 2022-02-01T00:00:00Z 24h --batch-denom C01-001-20200101-20210101-001`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx, err := sdkclient.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			client := marketplace.NewQueryClient(ctx)
+			marketId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid market id: %s", err)
+			}
+			startTime, err := parseTimestamp("start time", args[1])
+			if err != nil {
+				return err
+			}
+			endTime, err := parseTimestamp("end time", args[2])
+			if err != nil {
+				return err
+			}
+			interval, err := time.ParseDuration(args[3])
+			if err != nil {
+				return fmt.Errorf("invalid interval: %s", err)
+			}
+			batchDenom, err := cmd.Flags().GetString(FlagBatchDenom)
+			if err != nil {
+				return err
+			}
+			res, err := client.Candles(cmd.Context(), &marketplace.QueryCandlesRequest{
+				MarketId:   marketId,
+				BatchDenom: batchDenom,
+				StartTime:  startTime,
+				EndTime:    endTime,
+				Interval:   gogotypes.DurationProto(interval),
+			})
+			if err != nil {
+				return err
+			}
+
+			return ctx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagBatchDenom, "", "the denom of a credit batch (all credit batches in the market if empty)")
+
+	return cmd
+}
+
+// parseTimestamp parses a time in RFC3339 format into a timestamp.
+func parseTimestamp(field, value string) (*gogotypes.Timestamp, error) {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("%s must be in RFC3339 format: %w", field, err)
+	}
+	return gogotypes.TimestampProto(t)
+}
@@ -3,6 +3,7 @@ package marketplace
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -16,6 +17,10 @@ import (
 
 const (
 	FlagRetirementJurisdiction = "retirement-jurisdiction"
+	FlagRetirementBeneficiary  = "retirement-beneficiary"
+	FlagRetirementReason       = "retirement-reason"
+	FlagFillPolicy             = "fill-policy"
+	FlagExpiration             = "expiration"
 )
 
 // TxSellCmd returns a transaction command that creates sell orders.
@@ -29,6 +34,16 @@ Parameters:
 
 - orders-json:  path to JSON file containing orders to create
 
+Time in force (time_in_force) is optional:
+
+- TIME_IN_FORCE_GOOD_TIL_CANCELLED: the order remains open until it is filled or cancelled
+- TIME_IN_FORCE_GOOD_TIL_DATE: the order remains open until the required expiration
+- TIME_IN_FORCE_IMMEDIATE_OR_CANCEL: the order is filled against the matching buy orders
+  and any quantity that is not filled is cancelled
+
+Orders with an expiration can be re-listed automatically when they expire (auto_renew),
+with the ask price reduced by the optional price decay down to the optional floor amount.
+
 Example JSON:
 
 [
@@ -37,19 +52,30 @@ Example JSON:
     "quantity": "5",
     "ask_price": {
       "denom": "uregen",
-      "amount" "100000000"
-	},
-    "disable_auto_retire": "true"
+      "amount": "100000000"
+    },
+    "disable_auto_retire": true,
+    "time_in_force": "TIME_IN_FORCE_GOOD_TIL_CANCELLED"
   },
   {
     "batch_denom": "C01-001-20200101-20210101-002",
     "quantity": "10",
     "ask_price": {
       "denom": "uregen",
-      "amount" "100000000"
-	},
+      "amount": "100000000"
+    },
     "disable_auto_retire": false,
-    "expiration": "2024-01-01T00:00:00Z"
+    "expiration": "2024-01-01T00:00:00Z",
+    "min_fill_quantity": "2",
+    "lot_size": "1",
+    "allowed_buyers": ["regen1tnh2q55v8wyygtt9srz5safamzdengsn4qqe0j"],
+    "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+    "auto_renew": {
+      "renewal_period": "604800s",
+      "price_decay": "0.05",
+      "floor_amount": "50000000",
+      "max_renewals": 4
+    }
   }
 ]`,
 		Args:    cobra.ExactArgs(1),
@@ -110,7 +136,9 @@ Example JSON:
       "amount" "100000000"
 	},
     "disable_auto_retire": false,
-    "new_expiration": "2024-01-01T00:00:00Z"
+    "new_expiration": "2024-01-01T00:00:00Z",
+    "new_min_fill_quantity": "2",
+    "new_lot_size": "0"
   }
 ]`,
 		Args:    cobra.ExactArgs(1),
@@ -151,9 +179,16 @@ DisableAutoRetire can be set to false to retire the credits immediately
 upon purchase. When set to true, credits will be received in a tradable
 state, IF AND ONLY IF the sell order also has auto retire disabled.
 
+The fill policy defines how the order is handled when it cannot be filled in full:
+  - all-or-nothing: the transaction fails (default)
+  - partial: the credits available in the sell order are purchased
+  - skip-on-failure: no credits are purchased and the transaction succeeds
+
 NOTE: The bid price is the price paid PER credit. The total cost will be quantity * bid_price.`,
-		Example: `regen tx ecocredit buy-direct 1 300 10000000uregen true --retirement-jurisdiction "US-WA 98225"`,
-		Args:    cobra.ExactArgs(4),
+		Example: `regen tx ecocredit buy-direct 1 300 10000000uregen true --retirement-jurisdiction "US-WA 98225"
+regen tx ecocredit buy-direct 1 300 10000000uregen false --retirement-jurisdiction "US-WA 98225" --retirement-beneficiary "Acme Corporation"
+regen tx ecocredit buy-direct 1 300 10000000uregen true --fill-policy partial`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -181,6 +216,26 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 				return err
 			}
 
+			retirementBeneficiary, err := cmd.Flags().GetString(FlagRetirementBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			retirementReason, err := cmd.Flags().GetString(FlagRetirementReason)
+			if err != nil {
+				return err
+			}
+
+			fillPolicyStr, err := cmd.Flags().GetString(FlagFillPolicy)
+			if err != nil {
+				return err
+			}
+
+			fillPolicy, err := parseFillPolicy(fillPolicyStr)
+			if err != nil {
+				return err
+			}
+
 			msg := marketplace.MsgBuyDirect{
 				Buyer: clientCtx.GetFromAddress().String(),
 				Orders: []*marketplace.MsgBuyDirect_Order{
@@ -190,6 +245,9 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 						BidPrice:               &bidPrice,
 						DisableAutoRetire:      disableAutoRetire,
 						RetirementJurisdiction: retireJurisdiction,
+						RetirementBeneficiary:  retirementBeneficiary,
+						RetirementReason:       retirementReason,
+						FillPolicy:             fillPolicy,
 					},
 				},
 			}
@@ -199,6 +257,9 @@ NOTE: The bid price is the price paid PER credit. The total cost will be quantit
 	}
 
 	cmd.Flags().String(FlagRetirementJurisdiction, "", "the jurisdiction to use for retirement when auto retire is true.")
+	cmd.Flags().String(FlagRetirementBeneficiary, "", "the name or address of the person or organization on whose behalf the credits are retired when auto retire is true.")
+	cmd.Flags().String(FlagRetirementReason, "", "the reason for retiring the credits when auto retire is true.")
+	cmd.Flags().String(FlagFillPolicy, "all-or-nothing", "the fill policy of the order: all-or-nothing, partial or skip-on-failure.")
 
 	return txFlags(cmd)
 }
@@ -214,8 +275,11 @@ DisableAutoRetire can be set to false to retire the credits immediately
 upon purchase. When set to true, credits will be received in a tradable
 state, IF AND ONLY IF the sell order also has auto retire disabled.
 
+The fill policy defines how each order is handled when it cannot be filled in full
+(see buy-direct). When the --fill-policy flag is set, it applies to all orders.
+
 NOTE: The bid price is the price paid PER credit. The total cost will be quantity * bid_price.`,
-		Example: `regen tx ecocredit buy-direct-bulk orders.json
+		Example: `regen tx ecocredit buy-direct-bulk orders.json --fill-policy skip-on-failure
 
 Example JSON:
 [
@@ -252,6 +316,22 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			if cmd.Flags().Changed(FlagFillPolicy) {
+				fillPolicyStr, err := cmd.Flags().GetString(FlagFillPolicy)
+				if err != nil {
+					return err
+				}
+
+				fillPolicy, err := parseFillPolicy(fillPolicyStr)
+				if err != nil {
+					return err
+				}
+
+				for _, order := range orders {
+					order.FillPolicy = fillPolicy
+				}
+			}
+
 			msg := marketplace.MsgBuyDirect{
 				Buyer:  clientCtx.GetFromAddress().String(),
 				Orders: orders,
@@ -261,6 +341,8 @@ Example JSON:
 		},
 	}
 
+	cmd.Flags().String(FlagFillPolicy, "all-or-nothing", "the fill policy of all orders: all-or-nothing, partial or skip-on-failure.")
+
 	return txFlags(cmd)
 }
 
@@ -294,3 +376,424 @@ func TxCancelSellOrderCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+// TxBuyCmd returns a transaction command that creates buy orders.
+func TxBuyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buy [orders-json]",
+		Short: "Creates new buy orders with transaction author (--from) as buyer",
+		Long: `Creates new buy orders with transaction author (--from) as buyer.
+
+The total bid of each buy order (quantity * bid_price) is held in escrow until
+the buy order is filled, cancelled or expires. Buy orders are matched with sell
+orders at the end of each block and filled at the ask price of the sell orders.
+
+A selection can include either a batch_denom, a project_id or a class_id.
+
+Parameters:
+
+- orders-json:  path to JSON file containing orders to create
+
+Example JSON:
+
+[
+  {
+    "selection": {
+      "batch_denom": "C01-001-20200101-20210101-001"
+    },
+    "quantity": "5",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "disable_auto_retire": true
+  },
+  {
+    "selection": {
+      "class_id": "C01"
+    },
+    "quantity": "10",
+    "bid_price": {
+      "denom": "uregen",
+      "amount": "100000000"
+    },
+    "retirement_jurisdiction": "US-WA",
+    "retirement_beneficiary": "Acme Corporation",
+    "retirement_reason": "offsetting 2021 business travel",
+    "expiration": "2024-01-01T00:00:00Z"
+  }
+]`,
+		Args:    cobra.ExactArgs(1),
+		Example: "regen tx ecocredit buy orders.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			orders, err := parseBuyOrderRequests(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg := marketplace.MsgBuy{
+				Buyer:  clientCtx.GetFromAddress().String(),
+				Orders: orders,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelBuyOrderCmd returns a transaction command that cancels a buy order.
+func TxCancelBuyOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-buy-order [order-id]",
+		Short:   "Cancel an existing buy order with transaction author (--from) as buyer",
+		Long:    "Cancel an existing buy order with transaction author (--from) as buyer and return the escrowed funds",
+		Example: "regen tx ecocredit cancel-buy-order 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid order id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelBuyOrder{
+				Buyer:      clientCtx.GetFromAddress().String(),
+				BuyOrderId: id,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateAuctionCmd returns a transaction command that creates an auction.
+func TxCreateAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [auction-json]",
+		Short: "Creates a dutch or english auction of credits",
+		Long: `Creates a dutch or english auction of credits with transaction author (--from) as seller.
+
+The credits are held in escrow until the auction ends and is settled. The price of a dutch
+auction decays from the start price by the price decrement at the end of each decay interval
+until it reaches the reserve price, and the first bid greater than or equal to the current
+price wins the auction. The highest bid of an english auction placed within the bidding window
+wins the auction if it is greater than or equal to the reserve price.
+
+NOTE: All prices are the price PER credit.
+
+Parameters:
+
+- auction-json:  path to JSON file containing the auction to create
+
+Example JSON:
+
+{
+  "batch_denom": "C01-001-20200101-20210101-001",
+  "quantity": "1000",
+  "auction_type": "AUCTION_TYPE_DUTCH",
+  "start_price": {
+    "denom": "uregen",
+    "amount": "100000000"
+  },
+  "reserve_price": {
+    "denom": "uregen",
+    "amount": "50000000"
+  },
+  "start_time": "2024-01-01T00:00:00Z",
+  "end_time": "2024-01-08T00:00:00Z",
+  "price_decrement": {
+    "denom": "uregen",
+    "amount": "5000000"
+  },
+  "decay_interval": "3600s"
+}`,
+		Example: "regen tx ecocredit create-auction auction.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseAuction(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg.Seller = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxBidCmd returns a transaction command that places a bid in an auction.
+func TxBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid [auction-id] [bid-price]",
+		Short: "Places a bid in an auction",
+		Long: `Places a bid in an auction with transaction author (--from) as bidder.
+
+A bid in a dutch auction wins the auction at the current price if the bid price is greater
+than or equal to the current price. A bid in an english auction must be greater than or
+equal to the start price and greater than the highest bid. The total cost of the bid is
+held in escrow until the auction is settled or the bid is outbid.
+
+NOTE: The bid price is the price paid PER credit.`,
+		Example: "regen tx ecocredit bid 1 100000000uregen",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			bidPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := marketplace.MsgBid{
+				Bidder:    clientCtx.GetFromAddress().String(),
+				AuctionId: auctionId,
+				BidPrice:  &bidPrice,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelAuctionCmd returns a transaction command that cancels an auction.
+func TxCancelAuctionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-auction [auction-id]",
+		Short:   "Cancels an auction that has not received any bids",
+		Long:    "Cancels an auction that has not received any bids with transaction author (--from) as seller.",
+		Example: "regen tx ecocredit cancel-auction 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			auctionId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid auction id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelAuction{
+				Seller:    clientCtx.GetFromAddress().String(),
+				AuctionId: auctionId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCreateQuoteRequestCmd returns a transaction command that creates a quote request.
+func TxCreateQuoteRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-quote-request [quote-request-json]",
+		Short: "Creates a request for quote (RFQ) for credits",
+		Long: `Creates a request for quote (RFQ) for credits with transaction author (--from) as buyer.
+
+Holders of credits matching the selection of the quote request submit quotes for the full
+quantity of the quote request and the buyer accepts one of the quotes. Nothing is held in
+escrow until a quote is accepted.
+
+Parameters:
+
+- quote-request-json:  path to JSON file containing the quote request to create
+
+Example JSON:
+
+{
+  "selection": {
+    "class_id": "C01",
+    "project_jurisdiction": "US-OR",
+    "min_start_date": "2020-01-01T00:00:00Z"
+  },
+  "quantity": "10000",
+  "denom": "uregen",
+  "disable_auto_retire": false,
+  "retirement_jurisdiction": "US-WA",
+  "retirement_beneficiary": "Acme Corporation",
+  "retirement_reason": "offsetting 2021 business travel",
+  "expiration": "2024-01-01T00:00:00Z"
+}`,
+		Example: "regen tx ecocredit create-quote-request quote-request.json",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := parseQuoteRequest(args[0])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			msg.Buyer = clientCtx.GetFromAddress().String()
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxSubmitQuoteCmd returns a transaction command that submits a quote to a quote request.
+func TxSubmitQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-quote [request-id] [batch-denom] [ask-price]",
+		Short: "Submits a quote to a quote request",
+		Long: `Submits a quote to a quote request with transaction author (--from) as seller.
+
+The quote is for the full quantity of the quote request. The quoted credits are held in
+escrow in a private sell order that only the buyer of the quote request can fill, and
+they are returned when the quote request is closed without accepting the quote. The quote
+can be withdrawn by cancelling its sell order.
+
+NOTE: The ask price is the price PER credit.`,
+		Example: `regen tx ecocredit submit-quote 1 C01-001-20200101-20210101-001 10000000uregen
+regen tx ecocredit submit-quote 1 C01-001-20200101-20210101-001 10000000uregen --expiration 2024-01-01T00:00:00Z`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %s", err)
+			}
+
+			askPrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			expirationStr, err := cmd.Flags().GetString(FlagExpiration)
+			if err != nil {
+				return err
+			}
+
+			var expiration *time.Time
+			if expirationStr != "" {
+				t, err := time.Parse(time.RFC3339, expirationStr)
+				if err != nil {
+					return fmt.Errorf("expiration must be in RFC3339 format: %w", err)
+				}
+				expiration = &t
+			}
+
+			msg := marketplace.MsgSubmitQuote{
+				Seller:     clientCtx.GetFromAddress().String(),
+				RequestId:  requestId,
+				BatchDenom: args[1],
+				AskPrice:   &askPrice,
+				Expiration: expiration,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagExpiration, "", "the time in RFC3339 format at which the quote expires.")
+
+	return txFlags(cmd)
+}
+
+// TxAcceptQuoteCmd returns a transaction command that accepts a quote.
+func TxAcceptQuoteCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-quote [quote-id]",
+		Short: "Accepts a quote of a quote request",
+		Long: `Accepts a quote of a quote request with transaction author (--from) as buyer.
+
+The credits of the quote are bought at the quoted price and the quote request is closed,
+returning the escrowed credits of the other quotes to their sellers.`,
+		Example: "regen tx ecocredit accept-quote 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			quoteId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid quote id: %s", err)
+			}
+
+			msg := marketplace.MsgAcceptQuote{
+				Buyer:   clientCtx.GetFromAddress().String(),
+				QuoteId: quoteId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+// TxCancelQuoteRequestCmd returns a transaction command that cancels a quote request.
+func TxCancelQuoteRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cancel-quote-request [request-id]",
+		Short:   "Cancels a quote request",
+		Long:    "Cancels a quote request with transaction author (--from) as buyer and returns the escrowed credits of its quotes to their sellers.",
+		Example: "regen tx ecocredit cancel-quote-request 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			requestId, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid request id: %s", err)
+			}
+
+			msg := marketplace.MsgCancelQuoteRequest{
+				Buyer:     clientCtx.GetFromAddress().String(),
+				RequestId: requestId,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
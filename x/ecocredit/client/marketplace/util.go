@@ -3,7 +3,11 @@ package marketplace
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
+	"strings"
+
+	"github.com/gogo/protobuf/jsonpb"
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/regen-network/regen-ledger/types"
@@ -27,15 +31,16 @@ func parseSellOrders(jsonFile string) ([]*marketplace.MsgSell_Order, error) {
 		return nil, err
 	}
 
-	var orders []*marketplace.MsgSell_Order
-
-	// using json package because array is not a proto message
-	err = json.Unmarshal(bz, &orders)
+	// using jsonpb package with a wrapping message because array is not a proto
+	// message and the json package does not support the enum names and durations
+	// of the time in force and auto-renewal
+	var msg marketplace.MsgSell
+	err = jsonpb.UnmarshalString(fmt.Sprintf(`{"orders":%s}`, bz), &msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return orders, nil
+	return msg.Orders, nil
 }
 
 func parseSellUpdates(jsonFile string) ([]*marketplace.MsgUpdateSellOrders_Update, error) {
@@ -79,3 +84,77 @@ func parseBuyOrders(jsonFile string) ([]*marketplace.MsgBuyDirect_Order, error)
 
 	return orders, nil
 }
+
+func parseBuyOrderRequests(jsonFile string) ([]*marketplace.MsgBuy_Order, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	// using jsonpb package with a wrapping message because array is not a proto
+	// message and the json package does not support the oneof selection
+	var msg marketplace.MsgBuy
+	err = jsonpb.UnmarshalString(fmt.Sprintf(`{"orders":%s}`, bz), &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg.Orders, nil
+}
+
+func parseAuction(jsonFile string) (*marketplace.MsgCreateAuction, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	// using jsonpb package because the json package does not support the enum
+	// names, timestamps and durations of the auction
+	var msg marketplace.MsgCreateAuction
+	err = jsonpb.UnmarshalString(string(bz), &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+func parseQuoteRequest(jsonFile string) (*marketplace.MsgCreateQuoteRequest, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	// using jsonpb package because the json package does not support the oneof
+	// selection and timestamps of the quote request
+	var msg marketplace.MsgCreateQuoteRequest
+	err = jsonpb.UnmarshalString(string(bz), &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &msg, nil
+}
+
+// parseFillPolicy parses the fill policy of a direct buy order, e.g. "partial" for
+// FILL_POLICY_PARTIAL.
+func parseFillPolicy(s string) (marketplace.FillPolicy, error) {
+	name := "FILL_POLICY_" + strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	fillPolicy, ok := marketplace.FillPolicy_value[name]
+	if !ok {
+		return 0, fmt.Errorf("invalid fill policy: %s, expected all-or-nothing, partial or skip-on-failure", s)
+	}
+	return marketplace.FillPolicy(fillPolicy), nil
+}
@@ -33,11 +33,19 @@ func TestParseSellOrders(t *testing.T) {
 				"denom": "regen",
 				"amount": "20"
 			},
-			"expiration": "2022-01-01T00:00:00Z"
+			"expiration": "2022-01-01T00:00:00Z",
+			"time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+			"auto_renew": {
+				"renewal_period": "86400s",
+				"price_decay": "0.1",
+				"floor_amount": "10",
+				"max_renewals": 2
+			}
 		}
 	]`).Name()
 
 	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	renewalPeriod := time.Hour * 24
 
 	testCases := []struct {
 		name      string
@@ -81,10 +89,17 @@ func TestParseSellOrders(t *testing.T) {
 					DisableAutoRetire: true,
 				},
 				{
-					BatchDenom: "C01-001-20200101-20210101-002",
-					Quantity:   "20",
-					AskPrice:   &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(20)},
-					Expiration: &expiration,
+					BatchDenom:  "C01-001-20200101-20210101-002",
+					Quantity:    "20",
+					AskPrice:    &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(20)},
+					Expiration:  &expiration,
+					TimeInForce: marketplace.TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE,
+					AutoRenew: &marketplace.MsgSell_AutoRenew{
+						RenewalPeriod: &renewalPeriod,
+						PriceDecay:    "0.1",
+						FloorAmount:   "10",
+						MaxRenewals:   2,
+					},
 				},
 			},
 		},
@@ -285,3 +300,132 @@ func TestParseBuyOrders(t *testing.T) {
 		})
 	}
 }
+
+func TestParseBuyOrderRequests(t *testing.T) {
+	emptyJson := testutil.WriteToNewTempFile(t, `{}`).Name()
+	invalidJson := testutil.WriteToNewTempFile(t, `{foo:bar}`).Name()
+	duplicateJson := testutil.WriteToNewTempFile(t, `{"foo":"bar","foo":"baz"}`).Name()
+	validJson := testutil.WriteToNewTempFile(t, `[
+		{
+			"selection": {
+				"batch_denom": "C01-001-20200101-20210101-001"
+			},
+			"quantity": "10",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "10"
+			},
+			"disable_auto_retire": true
+		},
+		{
+			"selection": {
+				"class_id": "C01"
+			},
+			"quantity": "20",
+			"bid_price": {
+				"denom": "regen",
+				"amount": "20"
+			},
+			"retirement_jurisdiction": "US-WA",
+			"expiration": "2022-01-01T00:00:00Z"
+		}
+	]`).Name()
+
+	expiration := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name      string
+		file      string
+		expErr    bool
+		expErrMsg string
+		expRes    []*marketplace.MsgBuy_Order
+	}{
+		{
+			name:      "empty file path",
+			file:      "",
+			expErr:    true,
+			expErrMsg: "no such file or directory",
+		},
+		{
+			name:      "empty json object",
+			file:      emptyJson,
+			expErr:    true,
+			expErrMsg: "cannot unmarshal object",
+		},
+		{
+			name:      "invalid json format",
+			file:      invalidJson,
+			expErr:    true,
+			expErrMsg: "invalid character",
+		},
+		{
+			name:      "duplicate json keys",
+			file:      duplicateJson,
+			expErr:    true,
+			expErrMsg: "duplicate key",
+		},
+		{
+			name: "valid",
+			file: validJson,
+			expRes: []*marketplace.MsgBuy_Order{
+				{
+					Selection: &marketplace.MsgBuy_Selection{
+						Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+					},
+					Quantity:          "10",
+					BidPrice:          &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(10)},
+					DisableAutoRetire: true,
+				},
+				{
+					Selection: &marketplace.MsgBuy_Selection{
+						Sum: &marketplace.MsgBuy_Selection_ClassId{ClassId: "C01"},
+					},
+					Quantity:               "20",
+					BidPrice:               &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(20)},
+					RetirementJurisdiction: "US-WA",
+					Expiration:             &expiration,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseBuyOrderRequests(tc.file)
+			if tc.expErr {
+				require.Error(t, err)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
+
+func TestParseFillPolicy(t *testing.T) {
+	testCases := []struct {
+		name      string
+		input     string
+		expRes    marketplace.FillPolicy
+		expErrMsg string
+	}{
+		{name: "all or nothing", input: "all-or-nothing", expRes: marketplace.FillPolicy_FILL_POLICY_ALL_OR_NOTHING},
+		{name: "partial", input: "partial", expRes: marketplace.FillPolicy_FILL_POLICY_PARTIAL},
+		{name: "skip on failure", input: "skip-on-failure", expRes: marketplace.FillPolicy_FILL_POLICY_SKIP_ON_FAILURE},
+		{name: "invalid", input: "foo", expErrMsg: "invalid fill policy: foo"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := parseFillPolicy(tc.input)
+			if len(tc.expErrMsg) != 0 {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expRes, res)
+			}
+		})
+	}
+}
@@ -1,11 +1,16 @@
 package client
 
 import (
+	"os"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 
+	datav1 "github.com/regen-network/regen-ledger/api/regen/data/v1"
 	basketcli "github.com/regen-network/regen-ledger/x/ecocredit/client/basket"
 	marketplacecli "github.com/regen-network/regen-ledger/x/ecocredit/client/marketplace"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -30,6 +35,7 @@ func QueryCmd(name string) *cobra.Command {
 		QueryBatchesByClassCmd(),
 		QueryBatchesByProjectCmd(),
 		QueryBatchCmd(),
+		QueryBatchLineageCmd(),
 		QueryBatchBalanceCmd(),
 		QueryBatchSupplyCmd(),
 		QueryCreditTypesCmd(),
@@ -38,8 +44,15 @@ func QueryCmd(name string) *cobra.Command {
 		QueryProjectsByReferenceIdCmd(),
 		QueryProjectsByAdminCmd(),
 		QueryProjectCmd(),
+		QueryProjectIssuersCmd(),
+		QueryProjectVerifiersCmd(),
+		QueryProjectTransferCmd(),
 		QueryParamsCmd(),
 		QueryCreditTypeCmd(),
+		QueryRetirementCmd(),
+		QueryRetirementsByOwnerCmd(),
+		QueryRetirementsByBatchCmd(),
+		QueryRetirementCertificateCmd(),
 		basketcli.QueryBasketCmd(),
 		basketcli.QueryBasketsCmd(),
 		basketcli.QueryBasketBalanceCmd(),
@@ -49,6 +62,22 @@ func QueryCmd(name string) *cobra.Command {
 		marketplacecli.QuerySellOrdersBySellerCmd(),
 		marketplacecli.QuerySellOrdersByBatchCmd(),
 		marketplacecli.QueryAllowedDenomsCmd(),
+		marketplacecli.QueryMarketsCmd(),
+		marketplacecli.QueryMarketDepthCmd(),
+		marketplacecli.QueryBestAskByBatchCmd(),
+		marketplacecli.QueryBestAskByProjectCmd(),
+		marketplacecli.QueryBestAskByClassCmd(),
+		marketplacecli.QueryTradesByBatchCmd(),
+		marketplacecli.QueryTradesByBuyerCmd(),
+		marketplacecli.QueryTradesBySellerCmd(),
+		marketplacecli.QueryTradesByTimeRangeCmd(),
+		marketplacecli.QueryAuctionCmd(),
+		marketplacecli.QueryAuctionsCmd(),
+		marketplacecli.QueryQuoteRequestCmd(),
+		marketplacecli.QueryQuoteRequestsCmd(),
+		marketplacecli.QueryQuotesCmd(),
+		marketplacecli.QueryTwapCmd(),
+		marketplacecli.QueryCandlesCmd(),
 	)
 	return cmd
 }
@@ -230,6 +259,97 @@ func QueryProjectCmd() *cobra.Command {
 	return qflags(cmd)
 }
 
+// QueryProjectIssuersCmd returns a query command that retrieves addresses of the
+// project issuers.
+func QueryProjectIssuersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-issuers [project-id]",
+		Short: "Retrieve issuer addresses for a project",
+		Long:  "Retrieve issuer addresses for a project with optional pagination flags.",
+		Example: `regen q ecocredit project-issuers C01-001
+regen q ecocredit project-issuers C01-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectIssuers(cmd.Context(), &core.QueryProjectIssuersRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "project-issuers")
+	return qflags(cmd)
+}
+
+// QueryProjectVerifiersCmd returns a query command that retrieves the addresses
+// and roles of the project verifiers and monitoring bodies.
+func QueryProjectVerifiersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-verifiers [project-id]",
+		Short: "Retrieve verifiers and monitoring bodies for a project",
+		Long:  "Retrieve verifiers and monitoring bodies for a project with optional pagination flags.",
+		Example: `regen q ecocredit project-verifiers C01-001
+regen q ecocredit project-verifiers C01-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectVerifiers(cmd.Context(), &core.QueryProjectVerifiersRequest{
+				ProjectId:  args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "project-verifiers")
+	return qflags(cmd)
+}
+
+// QueryProjectTransferCmd returns a query command that retrieves the proposed
+// transfer of a project.
+func QueryProjectTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "project-transfer [project-id]",
+		Short:   "Retrieve the proposed transfer of a project",
+		Long:    "Retrieve the credit class that a project is proposed to be transferred to.",
+		Example: `regen q ecocredit project-transfer C01-001`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ProjectTransfer(cmd.Context(), &core.QueryProjectTransferRequest{
+				ProjectId: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	return qflags(cmd)
+}
+
 // QueryBatchesCmd returns a query command that retrieves credit batches for a
 // given project.
 func QueryBatchesCmd() *cobra.Command {
@@ -391,6 +511,29 @@ func QueryBatchCmd() *cobra.Command {
 	})
 }
 
+// QueryBatchLineageCmd returns a query command that retrieves the credit batches
+// that a given credit batch was split or merged from and into.
+func QueryBatchLineageCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "batch-lineage [batch-denom]",
+		Short:   "Retrieve the lineage of a credit batch",
+		Long:    "Retrieve the credit batches that a credit batch was split or merged from and the credit batches that were created from it.",
+		Example: "regen q ecocredit batch-lineage C01-001-20200101-20220101-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.BatchLineage(cmd.Context(), &core.QueryBatchLineageRequest{
+				BatchDenom: args[0],
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
 // QueryBatchBalanceCmd returns a query command that retrieves the tradable and
 // retired balances for a given credit batch and account address.
 func QueryBatchBalanceCmd() *cobra.Command {
@@ -560,3 +703,169 @@ func QueryCreditTypeCmd() *cobra.Command {
 		},
 	})
 }
+
+// QueryRetirementCmd returns a query command that retrieves a retirement of credits
+// by its unique identifier.
+func QueryRetirementCmd() *cobra.Command {
+	return qflags(&cobra.Command{
+		Use:     "retirement [id]",
+		Short:   "Retrieve a retirement of credits",
+		Long:    "Retrieve a retirement of credits including the beneficiary and the reason for the retirement.",
+		Example: "regen q ecocredit retirement 1",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.Retirement(cmd.Context(), &core.QueryRetirementRequest{
+				Id: id,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	})
+}
+
+// QueryRetirementsByOwnerCmd returns a query command that retrieves all retirements of
+// credits by an owner.
+func QueryRetirementsByOwnerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-owner [owner]",
+		Short: "List all retirements of credits by owner",
+		Long:  "List all retirements of credits by the account that retired the credits with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38
+regen q ecocredit retirements-by-owner regen1r9pl9gvr56kmclgkpjg3ynh4rm5am66f2a6y38 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByOwner(cmd.Context(), &core.QueryRetirementsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-owner")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementsByBatchCmd returns a query command that retrieves all retirements of
+// credits within a credit batch.
+func QueryRetirementsByBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirements-by-batch [batch-denom]",
+		Short: "List all retirements of credits by credit batch",
+		Long:  "List all retirements of credits within a credit batch with optional pagination flags.",
+		Example: `regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001
+regen q ecocredit retirements-by-batch C01-001-20200101-20210101-001 --limit 10 --offset 10`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			pagination, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := c.RetirementsByBatch(cmd.Context(), &core.QueryRetirementsByBatchRequest{
+				BatchDenom: args[0],
+				Pagination: pagination,
+			})
+			return printQueryResponse(ctx, res, err)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "retirements-by-batch")
+
+	return qflags(cmd)
+}
+
+// QueryRetirementCertificateCmd returns a query command that renders a certificate of
+// the retirements of credits emitted by a transaction.
+func QueryRetirementCertificateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retirement-certificate [tx-hash]",
+		Short: "Render a certificate of the retirements of credits in a transaction",
+		Long: `Render a certificate of the retirements of credits in a transaction.
+
+The certificate includes each retirement of credits emitted by the transaction, whether
+from retiring credits directly, buying credits with auto-retire enabled in the marketplace,
+or taking credits from a basket with retire on take enabled. Each retirement includes the
+details of the credit batch, project and credit class, and the metadata of each is resolved
+through the data anchors and resolvers registered in the data module.
+
+The certificate can be rendered as json, html or pdf. The html and pdf certificates are
+self-contained and suitable for printing.`,
+		Example: `regen q ecocredit retirement-certificate 5A0B4C3D...
+regen q ecocredit retirement-certificate 5A0B4C3D... --format html --file certificate.html
+regen q ecocredit retirement-certificate 5A0B4C3D... --format pdf --file certificate.pdf`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, ctx, err := mkQueryClient(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
+			}
+
+			txRes, err := authtx.QueryTx(ctx, args[0])
+			if err != nil {
+				return err
+			}
+
+			cert, err := buildRetirementCertificate(cmd.Context(), c, datav1.NewQueryClient(ctx), txRes)
+			if err != nil {
+				return err
+			}
+
+			if file == "" {
+				return renderCertificate(cmd.OutOrStdout(), cert, format)
+			}
+
+			f, err := os.Create(file)
+			if err != nil {
+				return err
+			}
+
+			if err := renderCertificate(f, cert, format); err != nil {
+				f.Close()
+				return err
+			}
+
+			return f.Close()
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, CertificateFormatJSON, "the format of the certificate: json, html or pdf")
+	cmd.Flags().String(FlagFile, "", "the file to write the certificate to (defaults to stdout)")
+
+	return qflags(cmd)
+}
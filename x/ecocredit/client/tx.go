@@ -23,8 +23,16 @@ import (
 const (
 	FlagAddIssuers             string = "add-issuers"
 	FlagRemoveIssuers          string = "remove-issuers"
+	FlagAddVerifiers           string = "add-verifiers"
+	FlagRemoveVerifiers        string = "remove-verifiers"
 	FlagReferenceId            string = "reference-id"
 	FlagRetirementJurisdiction string = "retirement-jurisdiction"
+	FlagBeneficiary            string = "beneficiary"
+	FlagReason                 string = "reason"
+	FlagFormat                 string = "format"
+	FlagHolder                 string = "holder"
+	FlagFile                   string = "file"
+	FlagHolders                string = "holders"
 )
 
 // TxCmd returns a root CLI command handler for all x/ecocredit transaction commands.
@@ -46,11 +54,21 @@ func TxCmd(name string) *cobra.Command {
 		TxSendBulkCmd(),
 		TxRetireCmd(),
 		TxCancelCmd(),
+		TxReverseCmd(),
 		TxUpdateClassMetadataCmd(),
+		TxUpdateClassBufferCmd(),
+		TxUpdateClassVerifierCmd(),
 		TxUpdateClassIssuersCmd(),
 		TxUpdateClassAdminCmd(),
 		TxUpdateProjectAdminCmd(),
 		TxUpdateProjectMetadataCmd(),
+		TxUpdateProjectStatusCmd(),
+		TxUpdateProjectIssuersCmd(),
+		TxUpdateProjectVerifiersCmd(),
+		TxProposeProjectTransferCmd(),
+		TxAcceptProjectTransferCmd(),
+		TxSplitBatchCmd(),
+		TxMergeBatchesCmd(),
 		basketcli.TxCreateBasketCmd(),
 		basketcli.TxPutInBasketCmd(),
 		basketcli.TxTakeFromBasketCmd(),
@@ -59,6 +77,15 @@ func TxCmd(name string) *cobra.Command {
 		marketplacecli.TxBuyDirectCmd(),
 		marketplacecli.TxBuyDirectBulkCmd(),
 		marketplacecli.TxCancelSellOrderCmd(),
+		marketplacecli.TxBuyCmd(),
+		marketplacecli.TxCancelBuyOrderCmd(),
+		marketplacecli.TxCreateAuctionCmd(),
+		marketplacecli.TxBidCmd(),
+		marketplacecli.TxCancelAuctionCmd(),
+		marketplacecli.TxCreateQuoteRequestCmd(),
+		marketplacecli.TxSubmitQuoteCmd(),
+		marketplacecli.TxAcceptQuoteCmd(),
+		marketplacecli.TxCancelQuoteRequestCmd(),
 	)
 	return cmd
 }
@@ -414,9 +441,16 @@ func TxRetireCmd() *cobra.Command {
 Parameters:
 
 - credits:                  path to JSON file containing credits to retire
-- retirement-jurisdiction:  the jurisdiction in which the credit will be retired`,
+- retirement-jurisdiction:  the jurisdiction in which the credit will be retired
+
+Flags:
+
+- beneficiary:  the name or address of the person or organization on whose behalf
+                the credits are retired (defaults to the owner)
+- reason:       the reason for retiring the credits`,
 		Example: `
 regen tx ecocredit retire credits.json "US-WA 98225"
+regen tx ecocredit retire credits.json "US-WA 98225" --beneficiary "Acme Corporation" --reason "offsetting 2021 business travel"
 
 Example JSON:
 [
@@ -442,16 +476,31 @@ Example JSON:
 				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
 			}
 
+			beneficiary, err := cmd.Flags().GetString(FlagBeneficiary)
+			if err != nil {
+				return err
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
 			msg := core.MsgRetire{
 				Owner:        clientCtx.GetFromAddress().String(),
 				Credits:      credits,
 				Jurisdiction: args[1],
+				Beneficiary:  beneficiary,
+				Reason:       reason,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 		},
 	}
 
+	cmd.Flags().String(FlagBeneficiary, "", "the name or address of the person or organization on whose behalf the credits are retired")
+	cmd.Flags().String(FlagReason, "", "the reason for retiring the credits")
+
 	return txFlags(cmd)
 }
 
@@ -506,6 +555,56 @@ Example JSON:
 	return txFlags(cmd)
 }
 
+func TxReverseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reverse [batch-denom] [amount] [reason]",
+		Short: "Reverses credits from the buffer pool of a credit class or from a holder of the credits",
+		Long: `Reverses credits from the buffer pool of a credit class or from a holder of the credits
+in response to a reversal event such as a forest fire. Reversed credits are cancelled and
+added to the cancelled supply of the credit batch.
+
+The '--from' flag must equal the credit class admin.
+
+Credits are reversed from the buffer pool of the credit class unless the --holder flag is
+provided. Holder credits can only be reversed once the buffer pool no longer holds tradable
+credits from the credit batch.
+
+Parameters:
+
+- batch-denom:  the denom of the credit batch from which credits are reversed
+- amount:       the amount of credits to reverse
+- reason:       a description of the reversal event`,
+		Example: `regen tx ecocredit reverse C01-001-20200101-20210101-001 100 "forest fire in project area"
+regen tx ecocredit reverse C01-001-20200101-20210101-001 100 "forest fire in project area" --holder regen1...`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			holder, err := cmd.Flags().GetString(FlagHolder)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgReverse{
+				Admin:      clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Amount:     args[1],
+				Holder:     holder,
+				Reason:     args[2],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagHolder, "", "the address of the account from which credits are reversed (defaults to the buffer pool)")
+
+	return txFlags(cmd)
+}
+
 func TxUpdateClassMetadataCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-class-metadata [class-id] [new-metadata]",
@@ -539,6 +638,76 @@ Parameters:
 	return txFlags(cmd)
 }
 
+func TxUpdateClassBufferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-buffer [class-id] [buffer-percentage]",
+		Short: "Updates the buffer percentage for a specific credit class",
+		Long: `Updates the percentage of tradable credits withheld into the buffer pool of a specific
+credit class when credits are issued.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:           the class id that corresponds with the credit class you want to update
+- buffer-percentage:  the decimal fraction of tradable credits withheld (e.g. 0.1 for ten percent)`,
+		Args:    cobra.ExactArgs(2),
+		Example: `regen tx ecocredit update-class-buffer C01 0.1`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateClassBuffer{
+				Admin:            clientCtx.GetFromAddress().String(),
+				ClassId:          args[0],
+				BufferPercentage: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxUpdateClassVerifierCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-verifier [class-id] [verifier]",
+		Short: "Updates the verifier for a specific credit class",
+		Long: `Updates the verifier for a specific credit class. The verifier is able to update the
+status of projects within the credit class. When the credit class has a verifier, new
+projects are proposed and must be registered before credits can be issued.
+
+The '--from' flag must equal the credit class admin.
+
+Parameters:
+
+- class-id:  the class id that corresponds with the credit class you want to update
+- verifier:  the address of the new verifier (an empty string removes the verifier)`,
+		Args: cobra.ExactArgs(2),
+		Example: `regen tx ecocredit update-class-verifier C01 regen1...
+regen tx ecocredit update-class-verifier C01 ""`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateClassVerifier{
+				Admin:    clientCtx.GetFromAddress().String(),
+				ClassId:  args[0],
+				Verifier: args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
 func TxUpdateClassAdminCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-class-admin [class-id] [new-admin]",
@@ -695,3 +864,378 @@ func TxUpdateProjectMetadataCmd() *cobra.Command {
 
 	return txFlags(cmd)
 }
+
+func TxUpdateProjectStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-status [project-id] [status]",
+		Short: "Updates the status of a project",
+		Long: `Updates the lifecycle status of a project. Credits can only be issued under registered
+or validated projects.
+
+The '--from' flag must equal the admin or the verifier of the credit class of the project,
+or a verifier or monitoring body of the project. A monitoring body can only suspend the project.
+
+Parameters:
+
+- project-id:  the id of the project to update
+- status:      the new status of the project (registered, validated, suspended or completed)
+
+Flags:
+
+- reason:  the reason for updating the status of the project`,
+		Args: cobra.ExactArgs(2),
+		Example: `regen tx ecocredit update-project-status C01-001 validated
+regen tx ecocredit update-project-status C01-001 suspended --reason "under investigation"`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			status, ok := core.ProjectStatus_value["PROJECT_STATUS_"+strings.ToUpper(args[1])]
+			if !ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid status: %s", args[1])
+			}
+
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateProjectStatus{
+				Signer:    clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				Status:    core.ProjectStatus(status),
+				Reason:    reason,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagReason, "", "the reason for updating the status of the project")
+
+	return txFlags(cmd)
+}
+
+func TxUpdateProjectIssuersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-issuers [project-id]",
+		Short: "Update the list of issuers for a specific project",
+		Long: `Update the list of issuers for a specific project. When a project has issuers,
+only the project issuers can issue credits under the project, otherwise the issuers
+of the credit class can issue credits under the project.
+
+The '--from' flag must equal the admin of the credit class of the project.
+
+Parameters:
+
+- project-id:  the ID of the project to update
+
+Flags:
+
+- add-issuers:     the new list of issuers to add to the project issuers list
+- remove-issuers:  the new list of issuers to remove from the project issuers list`,
+		Example: `regen tx ecocredit update-project-issuers C01-001 --add-issuers addr1,addr2,addr3
+regen tx ecocredit update-project-issuers C01-001 --add-issuers addr1,addr2 --remove-issuers addr3,addr4`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			// parse add issuers
+			addIssuers, err := cmd.Flags().GetStringSlice(FlagAddIssuers)
+			if err != nil {
+				return err
+			}
+			for i := range addIssuers {
+				addIssuers[i] = strings.TrimSpace(addIssuers[i])
+			}
+
+			// parse remove issuers
+			removeIssuers, err := cmd.Flags().GetStringSlice(FlagRemoveIssuers)
+			if err != nil {
+				return err
+			}
+			for i := range removeIssuers {
+				removeIssuers[i] = strings.TrimSpace(removeIssuers[i])
+			}
+
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateProjectIssuers{
+				Admin:         clientCtx.GetFromAddress().String(),
+				ProjectId:     args[0],
+				AddIssuers:    addIssuers,
+				RemoveIssuers: removeIssuers,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddIssuers, []string{}, "comma separated (no spaces) list of addresses")
+	cmd.Flags().StringSlice(FlagRemoveIssuers, []string{}, "comma separated (no spaces) list of addresses")
+
+	return txFlags(cmd)
+}
+
+func TxUpdateProjectVerifiersCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-project-verifiers [project-id] [role]",
+		Short: "Update the list of verifiers or monitoring bodies for a specific project",
+		Long: `Update the list of verifiers or monitoring bodies for a specific project. An address
+can only have one role within a project.
+
+The '--from' flag must equal the admin of the credit class of the project.
+
+Parameters:
+
+- project-id:  the ID of the project to update
+- role:        the role of the addresses (verifier or monitoring_body)
+
+Flags:
+
+- add-verifiers:     the list of addresses to add with the role
+- remove-verifiers:  the list of addresses to remove with the role`,
+		Example: `regen tx ecocredit update-project-verifiers C01-001 verifier --add-verifiers addr1,addr2
+regen tx ecocredit update-project-verifiers C01-001 monitoring_body --add-verifiers addr1 --remove-verifiers addr2`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			role, ok := core.ProjectVerifierRole_value["PROJECT_VERIFIER_ROLE_"+strings.ToUpper(args[1])]
+			if !ok {
+				return sdkerrors.ErrInvalidRequest.Wrapf("invalid role: %s", args[1])
+			}
+
+			// parse add verifiers
+			addVerifiers, err := cmd.Flags().GetStringSlice(FlagAddVerifiers)
+			if err != nil {
+				return err
+			}
+			for i := range addVerifiers {
+				addVerifiers[i] = strings.TrimSpace(addVerifiers[i])
+			}
+
+			// parse remove verifiers
+			removeVerifiers, err := cmd.Flags().GetStringSlice(FlagRemoveVerifiers)
+			if err != nil {
+				return err
+			}
+			for i := range removeVerifiers {
+				removeVerifiers[i] = strings.TrimSpace(removeVerifiers[i])
+			}
+
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgUpdateProjectVerifiers{
+				Admin:           clientCtx.GetFromAddress().String(),
+				ProjectId:       args[0],
+				Role:            core.ProjectVerifierRole(role),
+				AddVerifiers:    addVerifiers,
+				RemoveVerifiers: removeVerifiers,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagAddVerifiers, []string{}, "comma separated (no spaces) list of addresses")
+	cmd.Flags().StringSlice(FlagRemoveVerifiers, []string{}, "comma separated (no spaces) list of addresses")
+
+	return txFlags(cmd)
+}
+
+func TxProposeProjectTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-project-transfer [project-id] [class-id]",
+		Short: "Propose to transfer a project to another credit class",
+		Long: `Propose to transfer a project to another credit class with the same credit type. The
+transfer must be accepted by the admin of the credit class that the project will be
+transferred to.
+
+The '--from' flag must equal the admin of the credit class of the project.
+
+Parameters:
+
+- project-id:  the ID of the project to transfer
+- class-id:    the ID of the credit class that the project will be transferred to`,
+		Example: "regen tx ecocredit propose-project-transfer C01-001 C02",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgProposeProjectTransfer{
+				Admin:     clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+				ClassId:   args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxAcceptProjectTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-project-transfer [project-id]",
+		Short: "Accept a proposed project transfer",
+		Long: `Accept a proposed project transfer. The project receives a new project ID within the
+credit class and the previous project ID remains an alias of the project.
+
+The '--from' flag must equal the admin of the credit class that the project is being
+transferred to.
+
+Parameters:
+
+- project-id:  the ID of the project being transferred`,
+		Example: "regen tx ecocredit accept-project-transfer C01-001",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgAcceptProjectTransfer{
+				Admin:     clientCtx.GetFromAddress().String(),
+				ProjectId: args[0],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	return txFlags(cmd)
+}
+
+func TxSplitBatchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-batch [batch-denom] [sub-batches-json]",
+		Short: "Split an open credit batch into sub-vintage credit batches",
+		Long: `Split an open credit batch into sub-vintage credit batches. The balances and cancelled
+supply of the credit batch must be fully allocated to the sub-batches and the credit batch is
+sealed with no remaining balances or supply.
+
+The '--from' flag must equal the issuer of the credit batch. Accounts other than the issuer
+that hold credits from the credit batch must be included with the '--holders' flag and must
+also sign the transaction (e.g. using '--generate-only' and a multi-signature workflow).
+
+Parameters:
+
+- batch-denom:       the denom of the credit batch to split
+- sub-batches-json:  path to JSON file containing the sub-batches`,
+		Example: `regen tx ecocredit split-batch C01-001-20200101-20220101-001 sub-batches.json
+
+Example JSON:
+
+[
+  {
+    "start_date": "2020-01-01T00:00:00Z",
+    "end_date": "2021-01-01T00:00:00Z",
+    "balances": [
+      {
+        "address": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "tradable_amount": "500",
+        "retired_amount": "100"
+      }
+    ],
+    "cancelled_amount": "10"
+  },
+  {
+    "start_date": "2021-01-01T00:00:00Z",
+    "end_date": "2022-01-01T00:00:00Z",
+    "balances": [
+      {
+        "address": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+        "tradable_amount": "400"
+      }
+    ]
+  }
+]`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			// Parse the JSON file representing the sub-batches
+			subBatches, err := parseSubBatches(args[1])
+			if err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("failed to parse json: %s", err)
+			}
+
+			holders, err := cmd.Flags().GetStringSlice(FlagHolders)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgSplitBatch{
+				Issuer:     clientCtx.GetFromAddress().String(),
+				BatchDenom: args[0],
+				Holders:    holders,
+				SubBatches: subBatches,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagHolders, []string{}, "comma separated (no spaces) list of addresses that hold credits and consent to the split")
+
+	return txFlags(cmd)
+}
+
+func TxMergeBatchesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "merge-batches [batch-denoms] [metadata]",
+		Short: "Merge open credit batches with contiguous dates into a single credit batch",
+		Long: `Merge open credit batches from the same project with contiguous dates into a single credit
+batch. The balances and supply of the credit batches are combined in the new credit batch and
+the credit batches are sealed with no remaining balances or supply.
+
+The '--from' flag must equal the issuer of the credit batches. Accounts other than the issuer
+that hold credits from the credit batches must be included with the '--holders' flag and must
+also sign the transaction (e.g. using '--generate-only' and a multi-signature workflow).
+
+Parameters:
+
+- batch-denoms:  comma separated (no spaces) list of credit batch denoms ordered by date
+- metadata:      the metadata of the new credit batch`,
+		Example: "regen tx ecocredit merge-batches C01-001-20200101-20210101-001,C01-001-20210101-20220101-002 regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := sdkclient.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			holders, err := cmd.Flags().GetStringSlice(FlagHolders)
+			if err != nil {
+				return err
+			}
+
+			msg := core.MsgMergeBatches{
+				Issuer:      clientCtx.GetFromAddress().String(),
+				BatchDenoms: strings.Split(args[0], ","),
+				Holders:     holders,
+				Metadata:    args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(FlagHolders, []string{}, "comma separated (no spaces) list of addresses that hold credits and consent to the merge")
+
+	return txFlags(cmd)
+}
@@ -101,3 +101,24 @@ func parseSendCredits(jsonFile string) ([]*core.MsgSend_SendCredits, error) {
 
 	return sendCredits, nil
 }
+
+func parseSubBatches(jsonFile string) ([]*core.MsgSplitBatch_SubBatch, error) {
+	bz, err := ioutil.ReadFile(jsonFile)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := types.CheckDuplicateKey(json.NewDecoder(bytes.NewReader(bz)), nil); err != nil {
+		return nil, err
+	}
+
+	var subBatches []*core.MsgSplitBatch_SubBatch
+
+	// using json package because array is not a proto message
+	err = json.Unmarshal(bz, &subBatches)
+	if err != nil {
+		return nil, err
+	}
+
+	return subBatches, nil
+}
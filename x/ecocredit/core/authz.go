@@ -0,0 +1,292 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var (
+	_ authz.Authorization = &CreditSendAuthorization{}
+	_ authz.Authorization = &CreditRetireAuthorization{}
+)
+
+// Validate checks if CreditLimit is valid.
+func (l *CreditLimit) Validate() error {
+	switch {
+	case l.BatchDenom == "" && l.ClassId == "":
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denom or class id must be set")
+	case l.BatchDenom != "" && l.ClassId != "":
+		return sdkerrors.ErrInvalidRequest.Wrap("only one of batch denom or class id can be set")
+	case l.BatchDenom != "":
+		if err := ValidateBatchDenom(l.BatchDenom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	default:
+		if err := ValidateClassId(l.ClassId); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	if l.Amount == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("amount cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(l.Amount); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("amount: %s", err)
+	}
+
+	return nil
+}
+
+// ValidateCreditLimits checks that the credit limits of an authorization are valid
+// and that each credit batch or credit class has at most one limit.
+func ValidateCreditLimits(limits []*CreditLimit) error {
+	if len(limits) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("limits cannot be empty")
+	}
+
+	seen := make(map[string]bool)
+	for i, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "limits[%d]", i)
+		}
+
+		key := limit.BatchDenom + limit.ClassId
+		if seen[key] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("limits[%d]: duplicate limit for %s", i, key)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+// BatchClassIdResolver returns the ID of the credit class of the project that a credit
+// batch belongs to.
+type BatchClassIdResolver func(ctx sdk.Context, batchDenom string) (string, error)
+
+var batchClassIdResolver BatchClassIdResolver
+
+// SetBatchClassIdResolver sets the resolver used by authorizations to look up the credit
+// class of a credit batch. Authorizations do not have access to the ecocredit state, so
+// the resolver is set by the ecocredit server when it is created.
+func SetBatchClassIdResolver(resolver BatchClassIdResolver) {
+	batchClassIdResolver = resolver
+}
+
+// GetBatchClassId returns the ID of the credit class of the project that a credit batch
+// belongs to. The class ID in the batch denom is only used when no resolver is set because
+// projects can be transferred to another credit class.
+func GetBatchClassId(ctx sdk.Context, batchDenom string) (string, error) {
+	if batchClassIdResolver == nil {
+		return GetClassIdFromBatchDenom(batchDenom), nil
+	}
+	return batchClassIdResolver(ctx, batchDenom)
+}
+
+// SpendCreditLimits returns a copy of the credit limits with the amount deducted from
+// the limit that applies to the credit batch. A limit on the credit batch takes
+// precedence over a limit on the credit class of the credit batch.
+func SpendCreditLimits(limits []*CreditLimit, batchDenom, classId string, amount math.Dec) ([]*CreditLimit, error) {
+	index := -1
+	for i, limit := range limits {
+		if limit.BatchDenom == batchDenom {
+			index = i
+			break
+		}
+		if limit.ClassId == classId && index == -1 {
+			index = i
+		}
+	}
+
+	if index == -1 {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("no limit for credits from %s", batchDenom)
+	}
+
+	remaining, err := math.NewDecFromString(limits[index].Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	remaining, err = math.SafeSubBalance(remaining, amount)
+	if err != nil {
+		return nil, ecocredit.ErrMaxLimit.Wrapf(
+			"amount %s of %s exceeds remaining limit %s", amount, batchDenom, limits[index].Amount,
+		)
+	}
+
+	updated := make([]*CreditLimit, len(limits))
+	for i, limit := range limits {
+		updated[i] = &CreditLimit{
+			BatchDenom: limit.BatchDenom,
+			ClassId:    limit.ClassId,
+			Amount:     limit.Amount,
+		}
+	}
+	updated[index].Amount = remaining.String()
+
+	return updated, nil
+}
+
+// CreditLimitsSpent returns true if no credits remain within any of the credit limits.
+func CreditLimitsSpent(limits []*CreditLimit) bool {
+	for _, limit := range limits {
+		amount, err := math.NewDecFromString(limit.Amount)
+		if err != nil || amount.IsPositive() {
+			return false
+		}
+	}
+	return true
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreditSendAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSend{})
+}
+
+// Accept implements Authorization.Accept.
+func (a CreditSendAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSend, ok := msg.(*MsgSend)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiration != nil && !ctx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization expired at %s", a.Expiration)
+	}
+
+	if len(a.AllowedRecipients) > 0 {
+		allowed := false
+		for _, recipient := range a.AllowedRecipients {
+			if recipient == msgSend.Recipient {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not an allowed recipient", msgSend.Recipient,
+			)
+		}
+	}
+
+	limits := a.SpendLimits
+	for i, credits := range msgSend.Credits {
+		amount, err := sumDecimals(credits.TradableAmount, credits.RetiredAmount)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+
+		classId, err := GetBatchClassId(ctx, credits.BatchDenom)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+
+		limits, err = SpendCreditLimits(limits, credits.BatchDenom, classId, amount)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+	}
+
+	if CreditLimitsSpent(limits) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CreditSendAuthorization{
+		SpendLimits:       limits,
+		AllowedRecipients: a.AllowedRecipients,
+		Expiration:        a.Expiration,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreditSendAuthorization) ValidateBasic() error {
+	if err := ValidateCreditLimits(a.SpendLimits); err != nil {
+		return sdkerrors.Wrap(err, "spend")
+	}
+
+	for i, recipient := range a.AllowedRecipients {
+		if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("allowed recipients[%d]: %s", i, err)
+		}
+	}
+
+	return nil
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a CreditRetireAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgRetire{})
+}
+
+// Accept implements Authorization.Accept.
+func (a CreditRetireAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgRetire, ok := msg.(*MsgRetire)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiration != nil && !ctx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization expired at %s", a.Expiration)
+	}
+
+	limits := a.RetireLimits
+	for i, credits := range msgRetire.Credits {
+		amount, err := math.NewPositiveDecFromString(credits.Amount)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("credits[%d]: %s", i, err)
+		}
+
+		classId, err := GetBatchClassId(ctx, credits.BatchDenom)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+
+		limits, err = SpendCreditLimits(limits, credits.BatchDenom, classId, amount)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "credits[%d]", i)
+		}
+	}
+
+	if CreditLimitsSpent(limits) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &CreditRetireAuthorization{
+		RetireLimits: limits,
+		Expiration:   a.Expiration,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a CreditRetireAuthorization) ValidateBasic() error {
+	if err := ValidateCreditLimits(a.RetireLimits); err != nil {
+		return sdkerrors.Wrap(err, "retire")
+	}
+
+	return nil
+}
+
+// sumDecimals returns the sum of the non-negative decimal strings, treating empty
+// strings as zero.
+func sumDecimals(amounts ...string) (math.Dec, error) {
+	sum := math.NewDecFromInt64(0)
+	for _, amount := range amounts {
+		if amount == "" {
+			continue
+		}
+		dec, err := math.NewNonNegativeDecFromString(amount)
+		if err != nil {
+			return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+		sum, err = sum.Add(dec)
+		if err != nil {
+			return math.Dec{}, err
+		}
+	}
+	return sum, nil
+}
@@ -0,0 +1,175 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type creditSendAuthorization struct {
+	t             gocuke.TestingT
+	ctx           sdk.Context
+	authorization *CreditSendAuthorization
+	res           authz.AcceptResponse
+	err           error
+	batchClassIds map[string]string
+}
+
+func TestCreditSendAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &creditSendAuthorization{}).Path("./features/authz_credit_send_authorization.feature").Run()
+}
+
+func (s *creditSendAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+	s.ctx = sdk.Context{}.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.batchClassIds = make(map[string]string)
+	SetBatchClassIdResolver(s.resolveBatchClassId)
+}
+
+func (s *creditSendAuthorization) TheCreditBatchBelongsToTheCreditClass(a, b string) {
+	s.batchClassIds[a] = b
+}
+
+func (s *creditSendAuthorization) resolveBatchClassId(_ sdk.Context, batchDenom string) (string, error) {
+	if classId, ok := s.batchClassIds[batchDenom]; ok {
+		return classId, nil
+	}
+	return GetClassIdFromBatchDenom(batchDenom), nil
+}
+
+func (s *creditSendAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.authorization = &CreditSendAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.authorization)
+	require.NoError(s.t, err)
+}
+
+func (s *creditSendAuthorization) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+}
+
+func (s *creditSendAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.authorization.ValidateBasic()
+}
+
+func (s *creditSendAuthorization) TheAuthorizationAcceptsTheMessage(a gocuke.DocString) {
+	msg := &MsgSend{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.authorization.Accept(s.ctx, msg)
+}
+
+func (s *creditSendAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &CreditSendAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+	require.Equal(s.t, expected, s.res.Updated)
+}
+
+func (s *creditSendAuthorization) ExpectTheAuthorizationIsDeleted() {
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+}
+
+func (s *creditSendAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *creditSendAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+type creditRetireAuthorization struct {
+	t             gocuke.TestingT
+	ctx           sdk.Context
+	authorization *CreditRetireAuthorization
+	res           authz.AcceptResponse
+	err           error
+	batchClassIds map[string]string
+}
+
+func TestCreditRetireAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &creditRetireAuthorization{}).Path("./features/authz_credit_retire_authorization.feature").Run()
+}
+
+func (s *creditRetireAuthorization) Before(t gocuke.TestingT) {
+	s.t = t
+	s.ctx = sdk.Context{}.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+	s.batchClassIds = make(map[string]string)
+	SetBatchClassIdResolver(s.resolveBatchClassId)
+}
+
+func (s *creditRetireAuthorization) TheCreditBatchBelongsToTheCreditClass(a, b string) {
+	s.batchClassIds[a] = b
+}
+
+func (s *creditRetireAuthorization) resolveBatchClassId(_ sdk.Context, batchDenom string) (string, error) {
+	if classId, ok := s.batchClassIds[batchDenom]; ok {
+		return classId, nil
+	}
+	return GetClassIdFromBatchDenom(batchDenom), nil
+}
+
+func (s *creditRetireAuthorization) TheAuthorization(a gocuke.DocString) {
+	s.authorization = &CreditRetireAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.authorization)
+	require.NoError(s.t, err)
+}
+
+func (s *creditRetireAuthorization) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+}
+
+func (s *creditRetireAuthorization) TheAuthorizationIsValidated() {
+	s.err = s.authorization.ValidateBasic()
+}
+
+func (s *creditRetireAuthorization) TheAuthorizationAcceptsTheMessage(a gocuke.DocString) {
+	msg := &MsgRetire{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.authorization.Accept(s.ctx, msg)
+}
+
+func (s *creditRetireAuthorization) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &CreditRetireAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+	require.Equal(s.t, expected, s.res.Updated)
+}
+
+func (s *creditRetireAuthorization) ExpectTheAuthorizationIsDeleted() {
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+}
+
+func (s *creditRetireAuthorization) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *creditRetireAuthorization) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -7,12 +7,17 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*govv1beta1.Content)(nil), &CreditTypeProposal{})
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&CreditSendAuthorization{},
+		&CreditRetireAuthorization{},
+	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
 
@@ -20,19 +25,31 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateClass{}, "regen.core/MsgCreateClass", nil)
 	cdc.RegisterConcrete(&MsgCreateProject{}, "regen.core/MsgCreateProject", nil)
 	cdc.RegisterConcrete(&MsgCancel{}, "regen.core/MsgCancel", nil)
+	cdc.RegisterConcrete(&MsgReverse{}, "regen.core/MsgReverse", nil)
 	cdc.RegisterConcrete(&MsgCreateBatch{}, "regen.core/MsgCreateBatch", nil)
 	cdc.RegisterConcrete(&MsgMintBatchCredits{}, "regen.core/MsgMintBatchCredits", nil)
 	cdc.RegisterConcrete(&MsgSealBatch{}, "regen.core/MsgSealBatch", nil)
+	cdc.RegisterConcrete(&MsgSplitBatch{}, "regen.core/MsgSplitBatch", nil)
+	cdc.RegisterConcrete(&MsgMergeBatches{}, "regen.core/MsgMergeBatches", nil)
 	cdc.RegisterConcrete(&MsgRetire{}, "regen.core/MsgRetire", nil)
 	cdc.RegisterConcrete(&MsgSend{}, "regen.core/MsgSend", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassAdmin{}, "regen.core/MsgUpdateClassAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassMetadata{}, "regen.core/MsgUpdateClassMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassBuffer{}, "regen.core/MsgUpdateClassBuffer", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassVerifier{}, "regen.core/MsgUpdateClassVerifier", nil)
 	cdc.RegisterConcrete(&MsgUpdateClassIssuers{}, "regen.core/MsgUpdateClassIssuers", nil)
 	cdc.RegisterConcrete(&MsgUpdateProjectAdmin{}, "regen.core/MsgUpdateProjectAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateProjectMetadata{}, "regen.core/MsgUpdateProjectMetadata", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectStatus{}, "regen.core/MsgUpdateProjectStatus", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectIssuers{}, "regen.core/MsgUpdateProjectIssuers", nil)
+	cdc.RegisterConcrete(&MsgUpdateProjectVerifiers{}, "regen.core/MsgUpdateProjectVerifiers", nil)
+	cdc.RegisterConcrete(&MsgProposeProjectTransfer{}, "regen.core/MsgProposeProjectTransfer", nil)
+	cdc.RegisterConcrete(&MsgAcceptProjectTransfer{}, "regen.core/MsgAcceptProjectTransfer", nil)
 	cdc.RegisterConcrete(&CreditTypeProposal{}, "regen.core/CreditTypeProposal", nil)
 	cdc.RegisterConcrete(&MsgBridgeReceive{}, "regen.core/MsgBridgeReceive", nil)
 	cdc.RegisterConcrete(&MsgAddCreditType{}, "regen.core/MsgAddCreditType", nil)
+	cdc.RegisterConcrete(&CreditSendAuthorization{}, "regen.core/CreditSendAuthorization", nil)
+	cdc.RegisterConcrete(&CreditRetireAuthorization{}, "regen.core/CreditRetireAuthorization", nil)
 }
 
 var (
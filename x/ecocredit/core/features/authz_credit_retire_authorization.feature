@@ -0,0 +1,226 @@
+Feature: CreditRetireAuthorization
+
+  Scenario: a valid authorization
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the authorization is validated
+    Then expect no error
+
+  Scenario: an error is returned if retire limits is empty
+    Given the authorization
+    """
+    {}
+    """
+    When the authorization is validated
+    Then expect the error "retire: limits cannot be empty: invalid request"
+
+  Scenario: an error is returned if a retire limit class id is not formatted
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "foo",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "retire: limits[0]: class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: the retire limit of the credit class is decremented
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25.5"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "74.5"
+        }
+      ]
+    }
+    """
+
+  Scenario: the retire limit of the credit class of the project is decremented
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C02",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    And the credit batch "C01-001-20200101-20210101-001" belongs to the credit class "C02"
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25.5"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C02",
+          "amount": "74.5"
+        }
+      ]
+    }
+    """
+
+  Scenario: the retire limit of the credit class in the batch denom does not apply after a project transfer
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    And the credit batch "C01-001-20200101-20210101-001" belongs to the credit class "C02"
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25.5"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the error "credits[0]: no limit for credits from C01-001-20200101-20210101-001: unauthorized"
+
+  Scenario: the authorization is deleted when the retire limits are spent
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the authorization is deleted
+
+  Scenario: an error is returned if the amount exceeds the retire limit
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "25"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "30"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the error "credits[0]: amount 30 of C01-001-20200101-20210101-001 exceeds remaining limit 25: limit exceeded"
+
+  Scenario: an error is returned if the authorization is expired
+    Given the authorization
+    """
+    {
+      "retire_limits": [
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ],
+      "expiration": "2022-01-01T00:00:00Z"
+    }
+    """
+    And the block time "2022-01-02T00:00:00Z"
+    When the authorization accepts the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "10"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    Then expect the error "authorization expired at 2022-01-01 00:00:00 +0000 UTC: unauthorized"
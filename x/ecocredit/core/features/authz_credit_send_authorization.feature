@@ -0,0 +1,344 @@
+Feature: CreditSendAuthorization
+
+  Scenario: a valid authorization
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        },
+        {
+          "class_id": "C02",
+          "amount": "50"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ],
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the authorization is validated
+    Then expect no error
+
+  Scenario: an error is returned if spend limits is empty
+    Given the authorization
+    """
+    {}
+    """
+    When the authorization is validated
+    Then expect the error "spend: limits cannot be empty: invalid request"
+
+  Scenario: an error is returned if a spend limit has neither batch denom nor class id
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend: limits[0]: batch denom or class id must be set: invalid request"
+
+  Scenario: an error is returned if a spend limit has both batch denom and class id
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend: limits[0]: only one of batch denom or class id can be set: invalid request"
+
+  Scenario: an error is returned if a spend limit amount is not positive
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend: limits[0]: amount: expected a positive decimal, got 0: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if spend limits are duplicated
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        },
+        {
+          "class_id": "C01",
+          "amount": "50"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "spend: limits[1]: duplicate limit for C01: invalid request"
+
+  Scenario: an error is returned if an allowed recipient is not a bech32 address
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_recipients": [
+        "foo"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "allowed recipients[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: the spend limit of the credit batch is decremented
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        },
+        {
+          "class_id": "C01",
+          "amount": "50"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "20",
+          "retired_amount": "10",
+          "retirement_jurisdiction": "US-WA"
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-002",
+          "tradable_amount": "5"
+        }
+      ]
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "70"
+        },
+        {
+          "class_id": "C01",
+          "amount": "45"
+        }
+      ]
+    }
+    """
+
+  Scenario: the authorization is deleted when the spend limits are spent
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "30"
+        }
+      ]
+    }
+    """
+    Then expect the authorization is deleted
+
+  Scenario: an error is returned if the amount exceeds the spend limit
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "20",
+          "retired_amount": "20"
+        }
+      ]
+    }
+    """
+    Then expect the error "credits[0]: amount 40 of C01-001-20200101-20210101-001 exceeds remaining limit 30: limit exceeded"
+
+  Scenario: an error is returned if there is no spend limit for the credit batch
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C02",
+          "amount": "30"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    Then expect the error "credits[0]: no limit for credits from C01-001-20200101-20210101-001: unauthorized"
+
+  Scenario: the spend limit of the credit class of the project is used after a project transfer
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C02",
+          "amount": "30"
+        }
+      ]
+    }
+    """
+    And the credit batch "C01-001-20200101-20210101-001" belongs to the credit class "C02"
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C02",
+          "amount": "20"
+        }
+      ]
+    }
+    """
+
+  Scenario: an error is returned if the recipient is not allowed
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ],
+      "allowed_recipients": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    Then expect the error "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4 is not an allowed recipient: unauthorized"
+
+  Scenario: an error is returned if the authorization is expired
+    Given the authorization
+    """
+    {
+      "spend_limits": [
+        {
+          "class_id": "C01",
+          "amount": "30"
+        }
+      ],
+      "expiration": "2022-01-01T00:00:00Z"
+    }
+    """
+    And the block time "2022-01-01T00:00:00Z"
+    When the authorization accepts the message
+    """
+    {
+      "sender": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "recipient": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "tradable_amount": "10"
+        }
+      ]
+    }
+    """
+    Then expect the error "authorization expired at 2022-01-01 00:00:00 +0000 UTC: unauthorized"
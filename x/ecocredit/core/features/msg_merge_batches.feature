@@ -0,0 +1,79 @@
+Feature: MsgMergeBatches
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001", "C01-001-20210101-20220101-002"],
+      "holders": ["regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"],
+      "metadata": "regen:13toVgf5UjYBz6J29x28pLQyjKz5FpcW3f4bT5uRKGxGREWGKjEdXYG.rdf"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denoms has less than two credit batches
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001"]
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denoms must include at least two credit batches: invalid request"
+
+  Scenario: an error is returned if a batch denom is not formatted
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001", "foo"]
+    }
+    """
+    When the message is validated
+    Then expect the error "batch_denoms[1]: invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if a batch denom is duplicated
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001", "C01-001-20200101-20210101-001"]
+    }
+    """
+    When the message is validated
+    Then expect the error "batch_denoms[1]: duplicate batch denom: invalid request"
+
+  Scenario: an error is returned if a holder is the issuer
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001", "C01-001-20210101-20220101-002"],
+      "holders": ["regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"]
+    }
+    """
+    When the message is validated
+    Then expect the error "holders[0]: issuer cannot be a holder: invalid request"
+
+  Scenario: an error is returned if metadata exceeds 256 characters
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denoms": ["C01-001-20200101-20210101-001", "C01-001-20210101-20220101-002"]
+    }
+    """
+    And metadata with length "257"
+    When the message is validated
+    Then expect the error "metadata: max length 256: limit exceeded"
@@ -38,6 +38,25 @@ Feature: MsgRetire
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with beneficiary and reason
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA",
+      "beneficiary": "Acme Corporation",
+      "reason": "offsetting 2021 business travel emissions"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: an error is returned if owner is empty
     Given the message
     """
@@ -128,3 +147,39 @@ Feature: MsgRetire
     """
     When the message is validated
     Then expect the error "invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a beneficiary with length "257"
+    When the message is validated
+    Then expect the error "beneficiary: max length 256: limit exceeded"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "owner": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "credits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "jurisdiction": "US-WA"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
@@ -0,0 +1,117 @@
+Feature: MsgReverse
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "reason": "forest fire"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with holder
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "holder": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+      "reason": "forest fire"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if amount is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if amount is not positive
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "amount: expected a positive decimal, got -100: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if holder is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100",
+      "holder": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "holder: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if reason is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "reason cannot be empty: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "amount": "100"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
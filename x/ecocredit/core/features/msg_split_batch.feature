@@ -0,0 +1,261 @@
+Feature: MsgSplitBatch
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "holders": ["regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"],
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "tradable_amount": "10", "retired_amount": "5"}],
+          "cancelled_amount": "1"
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "tradable_amount": "10"}]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without balances
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if issuer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "issuer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if a holder is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "holders": ["foo"]
+    }
+    """
+    When the message is validated
+    Then expect the error "holders[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if a holder is the issuer
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "holders": ["regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"]
+    }
+    """
+    When the message is validated
+    Then expect the error "holders[0]: issuer cannot be a holder: invalid request"
+
+  Scenario: an error is returned if a holder is duplicated
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "holders": ["regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"]
+    }
+    """
+    When the message is validated
+    Then expect the error "holders[1]: duplicate holder: invalid request"
+
+  Scenario: an error is returned if sub batches has less than two sub batches
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub batches must include at least two sub batches: invalid request"
+
+  Scenario: an error is returned if sub batch start date is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [{}, {}]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: start date cannot be empty: invalid request"
+
+  Scenario: an error is returned if sub batch end date is empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [{"start_date": "2020-01-01T00:00:00Z"}, {}]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: end date cannot be empty: invalid request"
+
+  Scenario: an error is returned if sub batch start date is not before end date
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: start date must be before end date: invalid request"
+
+  Scenario: an error is returned if sub batch dates are not contiguous
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-02-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[1]: start date must equal the end date of the previous sub batch: invalid request"
+
+  Scenario: an error is returned if sub batch balance address is not a bech32 address
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "foo"}]
+        },
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: balances[0]: address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if sub batch balance amounts are empty
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"}]
+        },
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: balances[0]: tradable amount or retired amount required: invalid request"
+
+  Scenario: an error is returned if sub batch balance tradable amount is negative
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "tradable_amount": "-10"}]
+        },
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: balances[0]: tradable amount: expected a non-negative decimal, got -10: invalid decimal string"
+
+  Scenario: an error is returned if sub batch balance address is duplicated
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [
+            {"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "tradable_amount": "10"},
+            {"address": "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4", "retired_amount": "10"}
+          ]
+        },
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: balances[1]: duplicate address: invalid request"
+
+  Scenario: an error is returned if sub batch cancelled amount is negative
+    Given the message
+    """
+    {
+      "issuer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "batch_denom": "C01-001-20200101-20220101-001",
+      "sub_batches": [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "cancelled_amount": "-1"
+        },
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "sub_batches[0]: cancelled amount: expected a non-negative decimal, got -1: invalid decimal string"
@@ -0,0 +1,100 @@
+Feature: MsgUpdateClassBuffer
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "0.1"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message disabling the buffer pool
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "0"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if class id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "class id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if buffer percentage is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage cannot be empty: invalid request"
+
+  Scenario: an error is returned if buffer percentage is not a decimal
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: parse mantissa: foo: invalid decimal string: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buffer percentage is negative
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "-0.1"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage: expected a non-negative decimal, got -0.1: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buffer percentage is one
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "class_id": "C01",
+      "buffer_percentage": "1"
+    }
+    """
+    When the message is validated
+    Then expect the error "buffer percentage must be less than one: invalid request"
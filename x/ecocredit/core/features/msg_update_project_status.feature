@@ -0,0 +1,90 @@
+Feature: MsgUpdateProjectStatus
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": "PROJECT_STATUS_SUSPENDED",
+      "reason": "under investigation"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without reason
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": "PROJECT_STATUS_VALIDATED"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if signer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "signer: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if signer is not a bech32 address
+    Given the message
+    """
+    {
+      "signer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "signer: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if status is unspecified
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "status cannot be unspecified: invalid request"
+
+  Scenario: an error is returned if status is invalid
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": 9
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid status: 9: invalid request"
+
+  Scenario: an error is returned if reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "signer": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "status": "PROJECT_STATUS_SUSPENDED"
+    }
+    """
+    And a reason with length "513"
+    When the message is validated
+    Then expect the error "reason: max length 512: limit exceeded"
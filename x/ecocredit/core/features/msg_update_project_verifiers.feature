@@ -0,0 +1,206 @@
+Feature: MsgUpdateProjectVerifiers
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ],
+      "remove_verifiers": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without add verifiers
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "remove_verifiers": [
+        "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without remove verifiers
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with monitoring body role
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_MONITORING_BODY",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if admin is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "admin: empty address string is not allowed: invalid address"
+
+  Scenario: an error is returned if admin is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "admin: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if project id is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+    }
+    """
+    When the message is validated
+    Then expect the error "project id cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if project id is not formatted
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid project id: foo: parse error: invalid request"
+
+  Scenario: an error is returned if add verifiers and remove verifiers is empty
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER"
+    }
+    """
+    When the message is validated
+    Then expect the error "must specify at least one of add_verifiers or remove_verifiers: invalid request"
+
+  Scenario: an error is returned if add verifier is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "add_verifiers": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "add_verifiers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if add verifier is a duplicate
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "add_verifiers[1]: duplicate verifier: invalid request"
+
+  Scenario: an error is returned if remove verifier is not a bech32 address
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "remove_verifiers": [
+        "foo"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "remove_verifiers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if remove verifier is a duplicate
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": "PROJECT_VERIFIER_ROLE_VERIFIER",
+      "remove_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4",
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "remove_verifiers[1]: duplicate verifier: invalid request"
+
+  Scenario: an error is returned if role is unspecified
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "role cannot be unspecified: invalid request"
+
+  Scenario: an error is returned if role is invalid
+    Given the message
+    """
+    {
+      "admin": "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6",
+      "project_id": "C01-001",
+      "role": 9,
+      "add_verifiers": [
+        "regen1tnh2q55v8wyygtt9srz5safamzdengsnlm0yy4"
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid role: 9: invalid request"
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAcceptProjectTransfer struct {
+	t   gocuke.TestingT
+	msg *MsgAcceptProjectTransfer
+	err error
+}
+
+func TestMsgAcceptProjectTransfer(t *testing.T) {
+	gocuke.NewRunner(t, &msgAcceptProjectTransfer{}).Path("./features/msg_accept_project_transfer.feature").Run()
+}
+
+func (s *msgAcceptProjectTransfer) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAcceptProjectTransfer) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAcceptProjectTransfer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAcceptProjectTransfer) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAcceptProjectTransfer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAcceptProjectTransfer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,60 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgMergeBatches{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgMergeBatches) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgMergeBatches) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgMergeBatches) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgMergeBatches) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if len(m.BatchDenoms) < 2 {
+		return sdkerrors.ErrInvalidRequest.Wrap("batch denoms must include at least two credit batches")
+	}
+
+	seen := make(map[string]bool)
+	for i, denom := range m.BatchDenoms {
+		if err := ValidateBatchDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("batch_denoms[%d]: %s", i, err)
+		}
+
+		if seen[denom] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("batch_denoms[%d]: duplicate batch denom", i)
+		}
+		seen[denom] = true
+	}
+
+	if err := validateHolders(m.Issuer, m.Holders); err != nil {
+		return err
+	}
+
+	if len(m.Metadata) > MaxMetadataLength {
+		return ecocredit.ErrMaxLimit.Wrapf("metadata: max length %d", MaxMetadataLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMergeBatches.
+func (m *MsgMergeBatches) GetSigners() []sdk.AccAddress {
+	return getIssuerAndHolders(m.Issuer, m.Holders)
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgMergeBatches struct {
+	t   gocuke.TestingT
+	msg *MsgMergeBatches
+	err error
+}
+
+func TestMsgMergeBatches(t *testing.T) {
+	gocuke.NewRunner(t, &msgMergeBatches{}).Path("./features/msg_merge_batches.feature").Run()
+}
+
+func (s *msgMergeBatches) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgMergeBatches) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgMergeBatches{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgMergeBatches) MetadataWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Metadata = strings.Repeat("x", int(length))
+}
+
+func (s *msgMergeBatches) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgMergeBatches) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgMergeBatches) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
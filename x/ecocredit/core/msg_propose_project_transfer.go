@@ -0,0 +1,40 @@
+package core
+
+import (
+	"github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgProposeProjectTransfer{}
+
+func (m MsgProposeProjectTransfer) Route() string { return types.MsgTypeURL(&m) }
+
+func (m MsgProposeProjectTransfer) Type() string { return types.MsgTypeURL(&m) }
+
+func (m MsgProposeProjectTransfer) GetSignBytes() []byte {
+	return types.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgProposeProjectTransfer) ValidateBasic() error {
+	if _, err := types.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	return nil
+}
+
+func (m MsgProposeProjectTransfer) GetSigners() []types.AccAddress {
+	addr, _ := types.AccAddressFromBech32(m.Admin)
+	return []types.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgProposeProjectTransfer struct {
+	t   gocuke.TestingT
+	msg *MsgProposeProjectTransfer
+	err error
+}
+
+func TestMsgProposeProjectTransfer(t *testing.T) {
+	gocuke.NewRunner(t, &msgProposeProjectTransfer{}).Path("./features/msg_propose_project_transfer.feature").Run()
+}
+
+func (s *msgProposeProjectTransfer) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgProposeProjectTransfer) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgProposeProjectTransfer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgProposeProjectTransfer) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgProposeProjectTransfer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgProposeProjectTransfer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
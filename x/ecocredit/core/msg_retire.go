@@ -41,6 +41,14 @@ func (m *MsgRetire) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
 	}
 
+	if len(m.Beneficiary) > MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("beneficiary: max length %d", MaxBeneficiaryLength)
+	}
+
+	if len(m.Reason) > MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", MaxNoteLength)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,50 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgReverse struct {
+	t   gocuke.TestingT
+	msg *MsgReverse
+	err error
+}
+
+func TestMsgReverse(t *testing.T) {
+	gocuke.NewRunner(t, &msgReverse{}).Path("./features/msg_reverse.feature").Run()
+}
+
+func (s *msgReverse) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgReverse) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgReverse{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgReverse) AReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.Reason = strings.Repeat("x", int(length))
+}
+
+func (s *msgReverse) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgReverse) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgReverse) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
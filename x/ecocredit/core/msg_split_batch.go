@@ -0,0 +1,155 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgSplitBatch{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSplitBatch) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSplitBatch) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSplitBatch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSplitBatch) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Issuer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("issuer: %s", err)
+	}
+
+	if err := ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if err := validateHolders(m.Issuer, m.Holders); err != nil {
+		return err
+	}
+
+	if len(m.SubBatches) < 2 {
+		return sdkerrors.ErrInvalidRequest.Wrap("sub batches must include at least two sub batches")
+	}
+
+	for i, subBatch := range m.SubBatches {
+		if err := subBatch.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "sub_batches[%d]", i)
+		}
+
+		if i > 0 && !subBatch.StartDate.Equal(*m.SubBatches[i-1].EndDate) {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"sub_batches[%d]: start date must equal the end date of the previous sub batch", i,
+			)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSplitBatch.
+func (m *MsgSplitBatch) GetSigners() []sdk.AccAddress {
+	return getIssuerAndHolders(m.Issuer, m.Holders)
+}
+
+// Validate checks if a SubBatch is valid.
+func (s *MsgSplitBatch_SubBatch) Validate() error {
+	if s.StartDate == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("start date cannot be empty")
+	}
+
+	if s.EndDate == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("end date cannot be empty")
+	}
+
+	if !s.StartDate.Before(*s.EndDate) {
+		return sdkerrors.ErrInvalidRequest.Wrap("start date must be before end date")
+	}
+
+	seen := make(map[string]bool)
+	for i, balance := range s.Balances {
+		if err := balance.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "balances[%d]", i)
+		}
+
+		if seen[balance.Address] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("balances[%d]: duplicate address", i)
+		}
+		seen[balance.Address] = true
+	}
+
+	if s.CancelledAmount != "" {
+		if _, err := math.NewNonNegativeDecFromString(s.CancelledAmount); err != nil {
+			return sdkerrors.Wrap(err, "cancelled amount")
+		}
+	}
+
+	return nil
+}
+
+// Validate checks if a Balance is valid.
+func (b *MsgSplitBatch_Balance) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(b.Address); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("address: %s", err)
+	}
+
+	if b.TradableAmount == "" && b.RetiredAmount == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("tradable amount or retired amount required")
+	}
+
+	if b.TradableAmount != "" {
+		if _, err := math.NewNonNegativeDecFromString(b.TradableAmount); err != nil {
+			return sdkerrors.Wrap(err, "tradable amount")
+		}
+	}
+
+	if b.RetiredAmount != "" {
+		if _, err := math.NewNonNegativeDecFromString(b.RetiredAmount); err != nil {
+			return sdkerrors.Wrap(err, "retired amount")
+		}
+	}
+
+	return nil
+}
+
+// validateHolders checks that the holders consenting to a split or merge of credit
+// batches are valid addresses that are unique and not the issuer.
+func validateHolders(issuer string, holders []string) error {
+	seen := make(map[string]bool)
+	for i, holder := range holders {
+		if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("holders[%d]: %s", i, err)
+		}
+
+		if holder == issuer {
+			return sdkerrors.ErrInvalidRequest.Wrapf("holders[%d]: issuer cannot be a holder", i)
+		}
+
+		if seen[holder] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("holders[%d]: duplicate holder", i)
+		}
+		seen[holder] = true
+	}
+
+	return nil
+}
+
+// getIssuerAndHolders returns the issuer and the holders as the signers of a split or
+// merge of credit batches.
+func getIssuerAndHolders(issuer string, holders []string) []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(issuer)
+	signers := []sdk.AccAddress{addr}
+	for _, holder := range holders {
+		holderAddr, _ := sdk.AccAddressFromBech32(holder)
+		signers = append(signers, holderAddr)
+	}
+	return signers
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSplitBatch struct {
+	t   gocuke.TestingT
+	msg *MsgSplitBatch
+	err error
+}
+
+func TestMsgSplitBatch(t *testing.T) {
+	gocuke.NewRunner(t, &msgSplitBatch{}).Path("./features/msg_split_batch.feature").Run()
+}
+
+func (s *msgSplitBatch) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSplitBatch) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSplitBatch{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSplitBatch) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSplitBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSplitBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,50 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassBuffer{}
+
+func (m MsgUpdateClassBuffer) Route() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateClassBuffer) Type() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateClassBuffer) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgUpdateClassBuffer) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.BufferPercentage == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("buffer percentage cannot be empty")
+	}
+
+	percentage, err := math.NewNonNegativeDecFromString(m.BufferPercentage)
+	if err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("buffer percentage: %s", err)
+	}
+
+	if percentage.Cmp(math.NewDecFromInt64(1)) >= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buffer percentage must be less than one")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateClassBuffer) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
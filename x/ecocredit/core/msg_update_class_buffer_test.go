@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateClassBuffer struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateClassBuffer
+	err error
+}
+
+func TestMsgUpdateClassBuffer(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateClassBuffer{}).Path("./features/msg_update_class_buffer.feature").Run()
+}
+
+func (s *msgUpdateClassBuffer) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateClassBuffer) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateClassBuffer{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateClassBuffer) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateClassBuffer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateClassBuffer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,42 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateClassVerifier{}
+
+func (m MsgUpdateClassVerifier) Route() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateClassVerifier) Type() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateClassVerifier) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgUpdateClassVerifier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateClassId(m.ClassId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.Verifier != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Verifier); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("verifier: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateClassVerifier) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
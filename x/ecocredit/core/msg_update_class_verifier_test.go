@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateClassVerifier struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateClassVerifier
+	err error
+}
+
+func TestMsgUpdateClassVerifier(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateClassVerifier{}).Path("./features/msg_update_class_verifier.feature").Run()
+}
+
+func (s *msgUpdateClassVerifier) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateClassVerifier) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateClassVerifier{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateClassVerifier) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateClassVerifier) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateClassVerifier) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateProjectIssuers struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateProjectIssuers
+	err error
+}
+
+func TestMsgUpdateProjectIssuers(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateProjectIssuers{}).Path("./features/msg_update_project_issuers.feature").Run()
+}
+
+func (s *msgUpdateProjectIssuers) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateProjectIssuers) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateProjectIssuers{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateProjectIssuers) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateProjectIssuers) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateProjectIssuers) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
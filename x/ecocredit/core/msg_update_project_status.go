@@ -0,0 +1,48 @@
+package core
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateProjectStatus{}
+
+func (m MsgUpdateProjectStatus) Route() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateProjectStatus) Type() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateProjectStatus) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgUpdateProjectStatus) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Signer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("signer: %s", err)
+	}
+
+	if err := ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.Status == ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrap("status cannot be unspecified")
+	}
+
+	if _, ok := ProjectStatus_name[int32(m.Status)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid status: %d", m.Status)
+	}
+
+	if len(m.Reason) > MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("reason: max length %d", MaxNoteLength)
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateProjectStatus) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Signer)
+	return []sdk.AccAddress{addr}
+}
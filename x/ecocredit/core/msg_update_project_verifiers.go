@@ -0,0 +1,80 @@
+package core
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgUpdateProjectVerifiers{}
+
+func (m MsgUpdateProjectVerifiers) Route() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateProjectVerifiers) Type() string { return sdk.MsgTypeURL(&m) }
+
+func (m MsgUpdateProjectVerifiers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m *MsgUpdateProjectVerifiers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("admin: %s", err)
+	}
+
+	if err := ValidateProjectId(m.ProjectId); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if m.Role == ProjectVerifierRole_PROJECT_VERIFIER_ROLE_UNSPECIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrap("role cannot be unspecified")
+	}
+
+	if _, ok := ProjectVerifierRole_name[int32(m.Role)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("invalid role: %d", m.Role)
+	}
+
+	if len(m.AddVerifiers) == 0 && len(m.RemoveVerifiers) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("must specify at least one of add_verifiers or remove_verifiers")
+	}
+
+	duplicateAddMap := make(map[string]bool)
+	for i, verifier := range m.AddVerifiers {
+		addVerifierIndex := fmt.Sprintf("add_verifiers[%d]", i)
+
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: %s", addVerifierIndex, err)
+		}
+
+		if _, ok := duplicateAddMap[verifier]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate verifier", addVerifierIndex)
+		}
+
+		duplicateAddMap[verifier] = true
+	}
+
+	duplicateRemoveMap := make(map[string]bool)
+	for i, verifier := range m.RemoveVerifiers {
+		removeVerifierIndex := fmt.Sprintf("remove_verifiers[%d]", i)
+
+		if _, err := sdk.AccAddressFromBech32(verifier); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("%s: %s", removeVerifierIndex, err)
+		}
+
+		if _, ok := duplicateRemoveMap[verifier]; ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate verifier", removeVerifierIndex)
+		}
+
+		duplicateRemoveMap[verifier] = true
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateProjectVerifiers) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{addr}
+}
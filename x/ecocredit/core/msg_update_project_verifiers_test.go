@@ -0,0 +1,41 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgUpdateProjectVerifiers struct {
+	t   gocuke.TestingT
+	msg *MsgUpdateProjectVerifiers
+	err error
+}
+
+func TestMsgUpdateProjectVerifiers(t *testing.T) {
+	gocuke.NewRunner(t, &msgUpdateProjectVerifiers{}).Path("./features/msg_update_project_verifiers.feature").Run()
+}
+
+func (s *msgUpdateProjectVerifiers) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgUpdateProjectVerifiers) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgUpdateProjectVerifiers{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgUpdateProjectVerifiers) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgUpdateProjectVerifiers) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgUpdateProjectVerifiers) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -24,6 +24,104 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProjectStatus defines the lifecycle status of a project. The status of a
+// project can be updated by the admin of the credit class, the verifier of the
+// credit class or a verifier of the project following the transitions below:
+//
+//	proposed -> registered
+//	registered -> validated | suspended | completed
+//	validated -> suspended | completed
+//	suspended -> registered | validated | completed
+//
+// A monitoring body of the project can only suspend the project. Credits can
+// only be issued under registered or validated projects and a completed project
+// cannot be updated.
+type ProjectStatus int32
+
+const (
+	// PROJECT_STATUS_UNSPECIFIED is the status of projects created before the
+	// introduction of project status and is treated as registered.
+	ProjectStatus_PROJECT_STATUS_UNSPECIFIED ProjectStatus = 0
+	// PROJECT_STATUS_PROPOSED is the status of a project that has been proposed
+	// within a credit class with a verifier and has not yet been registered.
+	ProjectStatus_PROJECT_STATUS_PROPOSED ProjectStatus = 1
+	// PROJECT_STATUS_REGISTERED is the status of a project that has been
+	// registered within the credit class.
+	ProjectStatus_PROJECT_STATUS_REGISTERED ProjectStatus = 2
+	// PROJECT_STATUS_VALIDATED is the status of a registered project that has
+	// been validated.
+	ProjectStatus_PROJECT_STATUS_VALIDATED ProjectStatus = 3
+	// PROJECT_STATUS_SUSPENDED is the status of a project that has been
+	// suspended (e.g. while under investigation). Credits cannot be issued under
+	// a suspended project.
+	ProjectStatus_PROJECT_STATUS_SUSPENDED ProjectStatus = 4
+	// PROJECT_STATUS_COMPLETED is the status of a project that has ended. Credits
+	// cannot be issued under a completed project and the status of a completed
+	// project cannot be updated.
+	ProjectStatus_PROJECT_STATUS_COMPLETED ProjectStatus = 5
+)
+
+var ProjectStatus_name = map[int32]string{
+	0: "PROJECT_STATUS_UNSPECIFIED",
+	1: "PROJECT_STATUS_PROPOSED",
+	2: "PROJECT_STATUS_REGISTERED",
+	3: "PROJECT_STATUS_VALIDATED",
+	4: "PROJECT_STATUS_SUSPENDED",
+	5: "PROJECT_STATUS_COMPLETED",
+}
+
+var ProjectStatus_value = map[string]int32{
+	"PROJECT_STATUS_UNSPECIFIED": 0,
+	"PROJECT_STATUS_PROPOSED":    1,
+	"PROJECT_STATUS_REGISTERED":  2,
+	"PROJECT_STATUS_VALIDATED":   3,
+	"PROJECT_STATUS_SUSPENDED":   4,
+	"PROJECT_STATUS_COMPLETED":   5,
+}
+
+func (x ProjectStatus) String() string {
+	return proto.EnumName(ProjectStatus_name, int32(x))
+}
+
+func (ProjectStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{0}
+}
+
+// ProjectVerifierRole defines the role of a project verifier.
+type ProjectVerifierRole int32
+
+const (
+	// PROJECT_VERIFIER_ROLE_UNSPECIFIED is an invalid role.
+	ProjectVerifierRole_PROJECT_VERIFIER_ROLE_UNSPECIFIED ProjectVerifierRole = 0
+	// PROJECT_VERIFIER_ROLE_VERIFIER is the role of a verifier of the project.
+	// A verifier can update the status of the project following the transitions
+	// defined in ProjectStatus.
+	ProjectVerifierRole_PROJECT_VERIFIER_ROLE_VERIFIER ProjectVerifierRole = 1
+	// PROJECT_VERIFIER_ROLE_MONITORING_BODY is the role of a monitoring body of
+	// the project. A monitoring body can suspend the project.
+	ProjectVerifierRole_PROJECT_VERIFIER_ROLE_MONITORING_BODY ProjectVerifierRole = 2
+)
+
+var ProjectVerifierRole_name = map[int32]string{
+	0: "PROJECT_VERIFIER_ROLE_UNSPECIFIED",
+	1: "PROJECT_VERIFIER_ROLE_VERIFIER",
+	2: "PROJECT_VERIFIER_ROLE_MONITORING_BODY",
+}
+
+var ProjectVerifierRole_value = map[string]int32{
+	"PROJECT_VERIFIER_ROLE_UNSPECIFIED":     0,
+	"PROJECT_VERIFIER_ROLE_VERIFIER":        1,
+	"PROJECT_VERIFIER_ROLE_MONITORING_BODY": 2,
+}
+
+func (x ProjectVerifierRole) String() string {
+	return proto.EnumName(ProjectVerifierRole_name, int32(x))
+}
+
+func (ProjectVerifierRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{1}
+}
+
 // CreditType defines the measurement unit/precision of a certain credit type
 // (e.g. carbon, biodiversity...)
 type CreditType struct {
@@ -113,6 +211,14 @@ type Class struct {
 	Metadata string `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// credit_type_abbrev is the abbreviation of the credit type.
 	CreditTypeAbbrev string `protobuf:"bytes,5,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// buffer_percentage is the decimal fraction of the tradable credits of each
+	// issuance withheld into the buffer pool of the credit class (e.g. "0.1" for
+	// ten percent). An empty value means no credits are withheld.
+	BufferPercentage string `protobuf:"bytes,6,opt,name=buffer_percentage,json=bufferPercentage,proto3" json:"buffer_percentage,omitempty"`
+	// verifier is the address of the account designated by the admin of the
+	// credit class to update the status of projects within the credit class. An
+	// empty value means only the admin can update the status of projects.
+	Verifier []byte `protobuf:"bytes,7,opt,name=verifier,proto3" json:"verifier,omitempty"`
 }
 
 func (m *Class) Reset()         { *m = Class{} }
@@ -183,6 +289,20 @@ func (m *Class) GetCreditTypeAbbrev() string {
 	return ""
 }
 
+func (m *Class) GetBufferPercentage() string {
+	if m != nil {
+		return m.BufferPercentage
+	}
+	return ""
+}
+
+func (m *Class) GetVerifier() []byte {
+	if m != nil {
+		return m.Verifier
+	}
+	return nil
+}
+
 // ClassIssuers is a JOIN table for Class Info that stores the credit class
 // issuers
 type ClassIssuer struct {
@@ -260,6 +380,11 @@ type Project struct {
 	Metadata string `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
 	// reference_id is any arbitrary string used to reference the project.
 	ReferenceId string `protobuf:"bytes,7,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	// status is the lifecycle status of the project. Credits can only be issued
+	// under a project with an issuable status (see ProjectStatus). Projects
+	// created before the introduction of project status have an unspecified
+	// status and are treated as registered.
+	Status ProjectStatus `protobuf:"varint,8,opt,name=status,proto3,enum=regen.ecocredit.v1.ProjectStatus" json:"status,omitempty"`
 }
 
 func (m *Project) Reset()         { *m = Project{} }
@@ -344,6 +469,13 @@ func (m *Project) GetReferenceId() string {
 	return ""
 }
 
+func (m *Project) GetStatus() ProjectStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ProjectStatus_PROJECT_STATUS_UNSPECIFIED
+}
+
 // Batch represents the high-level on-chain information for a credit batch.
 type Batch struct {
 	// key is the table row identifier of the credit batch used internally for
@@ -960,277 +1092,649 @@ func (m *BatchContract) GetContract() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
-	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
-	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
-	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
-	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
-	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
-	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
-	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
-	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
-	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
-	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
-	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
+// Retirement stores the details of a retirement of credits, including the
+// beneficiary on whose behalf the credits were retired and the reason for the
+// retirement. A retirement is recorded each time credits are retired, whether
+// the credits were retired with Msg/Retire, retired upon issuance, transfer or
+// purchase, or retired upon taking from a basket.
+type Retirement struct {
+	// id is the unique identifier of the retirement.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// batch_key is the table row identifier of the credit batch within which the
+	// credits were retired.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// amount is the decimal number of credits that were retired.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// owner is the address of the account that retired the credits or the
+	// account that received the credits in a retired state.
+	Owner []byte `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	// beneficiary is the name or address of the person or organization on whose
+	// behalf the credits were retired. An empty beneficiary indicates that the
+	// credits were retired on behalf of the owner.
+	Beneficiary string `protobuf:"bytes,5,opt,name=beneficiary,proto3" json:"beneficiary,omitempty"`
+	// reason is any arbitrary string that specifies the reason for retiring the
+	// credits.
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// jurisdiction is the jurisdiction of the beneficiary or owner of the
+	// retired credits.
+	Jurisdiction string `protobuf:"bytes,7,opt,name=jurisdiction,proto3" json:"jurisdiction,omitempty"`
+	// timestamp is the block time at which the credits were retired.
+	Timestamp *types.Timestamp `protobuf:"bytes,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (m *Retirement) Reset()         { *m = Retirement{} }
+func (m *Retirement) String() string { return proto.CompactTextString(m) }
+func (*Retirement) ProtoMessage()    {}
+func (*Retirement) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{12}
+}
+func (m *Retirement) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Retirement) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Retirement.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Retirement) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Retirement.Merge(m, src)
+}
+func (m *Retirement) XXX_Size() int {
+	return m.Size()
+}
+func (m *Retirement) XXX_DiscardUnknown() {
+	xxx_messageInfo_Retirement.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+var xxx_messageInfo_Retirement proto.InternalMessageInfo
 
-var fileDescriptor_6cfdca0a4aaabb36 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x6e, 0xe3, 0x54,
-	0x14, 0x9e, 0x9b, 0x9f, 0x26, 0x39, 0xf9, 0x73, 0xef, 0xb4, 0xc5, 0x84, 0x21, 0x2d, 0x06, 0x34,
-	0x1d, 0xa9, 0x24, 0x2a, 0x7f, 0x82, 0x2c, 0x40, 0xd3, 0xc2, 0xa2, 0x9a, 0x05, 0xa3, 0xd0, 0x15,
-	0x9b, 0x70, 0x63, 0x9f, 0x49, 0xdd, 0x49, 0x6c, 0x73, 0x7d, 0xdd, 0x69, 0xb6, 0x3c, 0x00, 0x62,
-	0x85, 0x58, 0x20, 0x5e, 0x80, 0x57, 0xe0, 0x01, 0x60, 0x37, 0x12, 0x1b, 0x96, 0xa8, 0x7d, 0x03,
-	0xc4, 0x03, 0x20, 0x1f, 0x5f, 0x3b, 0x71, 0xc8, 0x74, 0x2a, 0x76, 0xf7, 0x9c, 0x7b, 0x7e, 0xbe,
-	0xef, 0xbb, 0xe7, 0x24, 0x86, 0xae, 0xc4, 0x09, 0x7a, 0x7d, 0xb4, 0x7d, 0x5b, 0xa2, 0xe3, 0xaa,
-	0xfe, 0xc5, 0x61, 0x3f, 0x54, 0x42, 0x61, 0x2f, 0x90, 0xbe, 0xf2, 0x39, 0xa7, 0xfb, 0x5e, 0x76,
-	0xdf, 0xbb, 0x38, 0xec, 0xbc, 0x6e, 0xfb, 0xe1, 0xcc, 0x0f, 0xfb, 0xbe, 0x9c, 0xf5, 0x2f, 0x0e,
-	0xc5, 0x34, 0x38, 0x13, 0x87, 0xb1, 0x91, 0xa4, 0x74, 0x76, 0x27, 0xbe, 0x3f, 0x99, 0x62, 0x9f,
-	0xac, 0x71, 0xf4, 0xa4, 0xaf, 0xdc, 0x19, 0x86, 0x4a, 0xcc, 0x82, 0x24, 0xc0, 0xfa, 0x89, 0x01,
-	0x1c, 0x53, 0xb5, 0xd3, 0x79, 0x80, 0xdc, 0x82, 0x86, 0x18, 0x8f, 0x25, 0x5e, 0xb8, 0x42, 0xb9,
-	0xbe, 0x67, 0xb2, 0x3d, 0xb6, 0x5f, 0x1b, 0xe6, 0x7c, 0x9c, 0x43, 0xc9, 0x13, 0x33, 0x34, 0x0b,
-	0x74, 0x47, 0xe7, 0xd8, 0x17, 0x79, 0xae, 0x32, 0x8b, 0x89, 0x2f, 0x3e, 0xf3, 0x7b, 0x50, 0x0b,
-	0x24, 0xda, 0x6e, 0x18, 0x17, 0x2a, 0xed, 0xb1, 0xfd, 0xe6, 0x70, 0xe1, 0x18, 0xbc, 0xf5, 0xf7,
-	0xcf, 0x7f, 0x7c, 0x57, 0xec, 0x42, 0x2b, 0xdf, 0x91, 0x43, 0x52, 0xdd, 0x60, 0x26, 0x33, 0x99,
-	0xf5, 0x3b, 0x83, 0xf2, 0xf1, 0x54, 0x84, 0x21, 0x37, 0xa0, 0xf8, 0x14, 0xe7, 0x04, 0xa8, 0x34,
-	0x8c, 0x8f, 0xbc, 0x05, 0x05, 0xd7, 0xd1, 0x28, 0x0a, 0xae, 0xc3, 0xb7, 0xa0, 0x2c, 0x9c, 0x99,
-	0xeb, 0x11, 0x88, 0xc6, 0x30, 0x31, 0x78, 0x07, 0xaa, 0x33, 0x54, 0xc2, 0x11, 0x4a, 0x10, 0x88,
-	0xda, 0x30, 0xb3, 0xf9, 0x01, 0xf0, 0x44, 0xc9, 0x91, 0x9a, 0x07, 0x38, 0x4a, 0x70, 0x98, 0x65,
-	0x8a, 0x32, 0xec, 0x4c, 0x95, 0x87, 0xe4, 0x1f, 0x7c, 0x42, 0x88, 0x3f, 0x82, 0x0a, 0x21, 0x31,
-	0x18, 0xaf, 0xc6, 0x00, 0x62, 0xa0, 0xbc, 0xa6, 0x5b, 0x1b, 0x05, 0xbe, 0xb3, 0xae, 0xa6, 0x51,
-	0x34, 0x0b, 0xd6, 0xd7, 0x50, 0x27, 0x2a, 0x27, 0x61, 0x18, 0xa1, 0xe4, 0xaf, 0x41, 0xcd, 0x8e,
-	0xcd, 0xd1, 0x82, 0x56, 0x95, 0x1c, 0x8f, 0x70, 0xce, 0x77, 0x60, 0xc3, 0xa5, 0x30, 0xe2, 0xd7,
-	0x18, 0x6a, 0x6b, 0x70, 0x8f, 0x30, 0xec, 0x00, 0x07, 0x23, 0x4b, 0x3e, 0xd0, 0x91, 0x45, 0xeb,
-	0x97, 0x02, 0x54, 0x1e, 0x4b, 0xff, 0x1c, 0x6d, 0xf5, 0xbf, 0xf5, 0xca, 0xc1, 0x2a, 0xad, 0xc0,
-	0xb2, 0xa0, 0x71, 0x1e, 0x49, 0x37, 0x74, 0x5c, 0x9b, 0xc6, 0x23, 0x91, 0x2a, 0xe7, 0xcb, 0x09,
-	0xbe, 0xb1, 0x22, 0xf8, 0x1b, 0xd0, 0x90, 0xf8, 0x04, 0x25, 0x7a, 0x36, 0x8e, 0x5c, 0xc7, 0xac,
-	0xd0, 0x7d, 0x3d, 0xf3, 0x9d, 0x38, 0x83, 0x33, 0x62, 0x38, 0x5e, 0xa7, 0x32, 0x87, 0xc6, 0x12,
-	0x69, 0xc7, 0x28, 0x2c, 0x2b, 0x5f, 0xe4, 0x46, 0xbe, 0xb8, 0x51, 0xe2, 0x1d, 0xd8, 0x59, 0x24,
-	0xe4, 0xee, 0xca, 0x66, 0xc9, 0xfa, 0xb1, 0x08, 0xe5, 0x23, 0xa1, 0xec, 0xb3, 0x35, 0x5a, 0xbd,
-	0x40, 0x7f, 0xbe, 0x0b, 0xf5, 0x20, 0x11, 0x98, 0xf4, 0x29, 0x52, 0x06, 0x68, 0x57, 0xac, 0xd0,
-	0x16, 0x94, 0x1d, 0xf4, 0xfc, 0x99, 0x9e, 0xb5, 0xc4, 0xc8, 0x69, 0x52, 0x5e, 0xd1, 0xe4, 0x63,
-	0x80, 0x50, 0x09, 0xa9, 0x46, 0x8e, 0x50, 0x48, 0x8a, 0xd5, 0xdf, 0xed, 0xf4, 0x92, 0xbd, 0xed,
-	0xa5, 0x7b, 0xdb, 0x3b, 0x4d, 0xf7, 0x76, 0x58, 0xa3, 0xe8, 0xcf, 0x84, 0x42, 0xfe, 0x01, 0x54,
-	0xd1, 0x73, 0x92, 0xc4, 0xca, 0x4b, 0x13, 0x2b, 0xe8, 0x39, 0x94, 0xf6, 0x29, 0x34, 0x63, 0x3a,
-	0x22, 0xd6, 0x82, 0x72, 0xab, 0x2f, 0xcd, 0x6d, 0xa4, 0x09, 0x54, 0x80, 0x43, 0xc9, 0x0f, 0xd0,
-	0x33, 0x6b, 0x7b, 0x6c, 0xbf, 0x3a, 0xa4, 0xf3, 0xe0, 0x11, 0xbd, 0xdb, 0xe7, 0x8b, 0x77, 0xab,
-	0x6b, 0x25, 0xe8, 0xe9, 0xda, 0x39, 0xdd, 0x8c, 0x02, 0x6f, 0x2d, 0xb3, 0x36, 0x8a, 0x1c, 0x52,
-	0xc1, 0x8d, 0x92, 0x59, 0xb6, 0xbe, 0x65, 0xd0, 0xa4, 0x5d, 0xf9, 0x12, 0xbf, 0x89, 0xe2, 0x37,
-	0x7b, 0xc1, 0xaa, 0xb2, 0xf5, 0xab, 0xca, 0xdf, 0x84, 0xa6, 0x87, 0x97, 0x6a, 0x14, 0xea, 0x74,
-	0x7a, 0xc5, 0xd2, 0xb0, 0x11, 0x3b, 0xd3, 0x92, 0x83, 0x2e, 0x21, 0x36, 0x61, 0x6b, 0x6d, 0xe9,
-	0x0d, 0xeb, 0x1c, 0xda, 0x7a, 0x99, 0x32, 0x14, 0x37, 0xee, 0xec, 0xad, 0x9a, 0x6e, 0x53, 0xd3,
-	0x36, 0xd4, 0x97, 0x2b, 0x55, 0x2c, 0x0f, 0x9a, 0x34, 0x8a, 0x59, 0xa7, 0x95, 0x41, 0x63, 0xff,
-	0x19, 0xb4, 0x5b, 0x75, 0x7b, 0x85, 0xba, 0x6d, 0x42, 0x33, 0x5f, 0xad, 0x6a, 0xfd, 0xc3, 0xa0,
-	0x41, 0x0d, 0x8f, 0xc4, 0x54, 0x68, 0x66, 0xe3, 0xd8, 0x5e, 0x66, 0x46, 0x8e, 0xb8, 0x97, 0x09,
-	0x15, 0xe1, 0x38, 0x12, 0xc3, 0x50, 0xaf, 0x43, 0x6a, 0xf2, 0xfb, 0xd0, 0x56, 0x52, 0x38, 0x62,
-	0x3c, 0xc5, 0x91, 0x98, 0xf9, 0x91, 0x97, 0xfe, 0x05, 0xb4, 0x52, 0xf7, 0x43, 0xf2, 0xf2, 0xb7,
-	0xa1, 0x25, 0x51, 0xb9, 0x12, 0x9d, 0x34, 0x2e, 0x59, 0x90, 0xa6, 0xf6, 0xea, 0xb0, 0xfb, 0xd0,
-	0xc6, 0xd0, 0x96, 0xfe, 0xb3, 0x45, 0x5c, 0xb2, 0x2f, 0xad, 0xd4, 0x9d, 0x04, 0x0e, 0xde, 0x27,
-	0x66, 0x3d, 0xb8, 0x0b, 0x9b, 0x1a, 0xcb, 0x41, 0x86, 0x9f, 0x6f, 0xc3, 0x66, 0x66, 0x1c, 0xe8,
-	0x6b, 0x83, 0x99, 0x35, 0xeb, 0x57, 0x06, 0xf5, 0x44, 0xe7, 0x28, 0x08, 0xa6, 0xf3, 0x9b, 0x59,
-	0xaf, 0xe1, 0x56, 0xb8, 0x25, 0xb7, 0xe2, 0x3a, 0x6e, 0x0f, 0xc0, 0xb0, 0x63, 0xad, 0xa7, 0xd3,
-	0x55, 0x11, 0xda, 0x99, 0x5f, 0xb3, 0x5b, 0x9a, 0x92, 0x05, 0x3e, 0xb0, 0x22, 0x68, 0x7e, 0x21,
-	0xdd, 0x89, 0xeb, 0x9d, 0x5e, 0x9e, 0x78, 0x0e, 0x5e, 0xde, 0x3c, 0x8f, 0xab, 0xbf, 0xf7, 0x3b,
-	0xb0, 0x11, 0xfa, 0x91, 0xb4, 0x51, 0xc3, 0xd3, 0xd6, 0x60, 0x97, 0x9a, 0xbd, 0x0a, 0xdb, 0x70,
-	0x77, 0xf9, 0xe7, 0xf5, 0x40, 0x07, 0xd7, 0xad, 0x1f, 0x98, 0x9e, 0xce, 0x63, 0xdf, 0x53, 0x52,
-	0xd8, 0xea, 0x66, 0xdd, 0x72, 0xa0, 0x0a, 0x2b, 0xa0, 0x3a, 0x50, 0xb5, 0x75, 0x15, 0x0d, 0x23,
-	0xb3, 0x07, 0x7d, 0x02, 0xf2, 0x20, 0xc7, 0x9a, 0x9b, 0xc0, 0x17, 0xa8, 0xd2, 0x50, 0xfa, 0x3a,
-	0x68, 0x1c, 0x3d, 0xfe, 0xed, 0xaa, 0xcb, 0x9e, 0x5f, 0x75, 0xd9, 0x5f, 0x57, 0x5d, 0xf6, 0xfd,
-	0x75, 0xf7, 0xce, 0xf3, 0xeb, 0xee, 0x9d, 0x3f, 0xaf, 0xbb, 0x77, 0xbe, 0xfa, 0x70, 0xe2, 0xaa,
-	0xb3, 0x68, 0xdc, 0xb3, 0xfd, 0x59, 0x9f, 0xbe, 0x9a, 0xde, 0xf1, 0x50, 0x3d, 0xf3, 0xe5, 0x53,
-	0x6d, 0x4d, 0xd1, 0x99, 0xa0, 0xec, 0x5f, 0x2e, 0x7d, 0x6c, 0xd9, 0xbe, 0xc4, 0xf1, 0x06, 0xfd,
-	0xf6, 0xbd, 0xf7, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xe0, 0x0f, 0xb7, 0x8b, 0x09, 0x00,
-	0x00,
+func (m *Retirement) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func (m *CreditType) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Precision != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Precision))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Unit) > 0 {
-		i -= len(m.Unit)
-		copy(dAtA[i:], m.Unit)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Abbreviation) > 0 {
-		i -= len(m.Abbreviation)
-		copy(dAtA[i:], m.Abbreviation)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
-		i--
-		dAtA[i] = 0xa
+func (m *Retirement) GetOwner() []byte {
+	if m != nil {
+		return m.Owner
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Class) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetBeneficiary() string {
+	if m != nil {
+		return m.Beneficiary
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Class) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Retirement) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Admin) > 0 {
-		i -= len(m.Admin)
-		copy(dAtA[i:], m.Admin)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Key != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Key))
-		i--
-		dAtA[i] = 0x8
+func (m *Retirement) GetJurisdiction() string {
+	if m != nil {
+		return m.Jurisdiction
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Retirement) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProjectIssuer is a JOIN table for Project that stores the project issuers.
+// When a project has project issuers, only the project issuers can issue
+// credits under the project, otherwise the credit class issuers can issue
+// credits under the project.
+type ProjectIssuer struct {
+	// project_key is the table row identifier of the project used internally for
+	// efficient lookups. This links a project issuer to a project.
+	ProjectKey uint64 `protobuf:"varint,1,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// issuer is the approved issuer of the project.
+	Issuer []byte `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
 }
 
-func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Issuer) > 0 {
-		i -= len(m.Issuer)
-		copy(dAtA[i:], m.Issuer)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
-		i--
-		dAtA[i] = 0x12
+func (m *ProjectIssuer) Reset()         { *m = ProjectIssuer{} }
+func (m *ProjectIssuer) String() string { return proto.CompactTextString(m) }
+func (*ProjectIssuer) ProtoMessage()    {}
+func (*ProjectIssuer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{13}
+}
+func (m *ProjectIssuer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectIssuer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectIssuer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *ProjectIssuer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectIssuer.Merge(m, src)
+}
+func (m *ProjectIssuer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectIssuer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectIssuer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectIssuer proto.InternalMessageInfo
+
+func (m *ProjectIssuer) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProjectIssuer) GetIssuer() []byte {
+	if m != nil {
+		return m.Issuer
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ProjectVerifier is a JOIN table for Project that stores the verifiers and
+// monitoring bodies of a project.
+type ProjectVerifier struct {
+	// project_key is the table row identifier of the project used internally for
+	// efficient lookups. This links a project verifier to a project.
+	ProjectKey uint64 `protobuf:"varint,1,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// address is the address of the verifier or monitoring body.
+	Address []byte `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// role is the role of the address within the project.
+	Role ProjectVerifierRole `protobuf:"varint,3,opt,name=role,proto3,enum=regen.ecocredit.v1.ProjectVerifierRole" json:"role,omitempty"`
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReferenceId) > 0 {
-		i -= len(m.ReferenceId)
-		copy(dAtA[i:], m.ReferenceId)
-		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+func (m *ProjectVerifier) Reset()         { *m = ProjectVerifier{} }
+func (m *ProjectVerifier) String() string { return proto.CompactTextString(m) }
+func (*ProjectVerifier) ProtoMessage()    {}
+func (*ProjectVerifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{14}
+}
+func (m *ProjectVerifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectVerifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectVerifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectVerifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectVerifier.Merge(m, src)
+}
+func (m *ProjectVerifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectVerifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectVerifier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectVerifier proto.InternalMessageInfo
+
+func (m *ProjectVerifier) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *ProjectVerifier) GetAddress() []byte {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *ProjectVerifier) GetRole() ProjectVerifierRole {
+	if m != nil {
+		return m.Role
+	}
+	return ProjectVerifierRole_PROJECT_VERIFIER_ROLE_UNSPECIFIED
+}
+
+// ProjectTransfer stores a proposal to transfer a project to another credit
+// class with the same credit type. A project transfer is proposed by the admin
+// of the credit class of the project and accepted by the admin of the credit
+// class that the project will be transferred to.
+type ProjectTransfer struct {
+	// project_key is the table row identifier of the project being transferred.
+	ProjectKey uint64 `protobuf:"varint,1,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+	// class_key is the table row identifier of the credit class that the project
+	// will be transferred to.
+	ClassKey uint64 `protobuf:"varint,2,opt,name=class_key,json=classKey,proto3" json:"class_key,omitempty"`
+}
+
+func (m *ProjectTransfer) Reset()         { *m = ProjectTransfer{} }
+func (m *ProjectTransfer) String() string { return proto.CompactTextString(m) }
+func (*ProjectTransfer) ProtoMessage()    {}
+func (*ProjectTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{15}
+}
+func (m *ProjectTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectTransfer.Merge(m, src)
+}
+func (m *ProjectTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectTransfer proto.InternalMessageInfo
+
+func (m *ProjectTransfer) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+func (m *ProjectTransfer) GetClassKey() uint64 {
+	if m != nil {
+		return m.ClassKey
+	}
+	return 0
+}
+
+// ProjectAlias maps a previous project ID to the project. When a project is
+// transferred to another credit class, the project receives a new project ID
+// within the credit class and the previous project ID is stored as an alias so
+// that the project can still be referenced by the previous project ID (e.g.
+// the denoms of the credit batches issued before the transfer).
+type ProjectAlias struct {
+	// alias is the previous project ID.
+	Alias string `protobuf:"bytes,1,opt,name=alias,proto3" json:"alias,omitempty"`
+	// project_key is the table row identifier of the project.
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3" json:"project_key,omitempty"`
+}
+
+func (m *ProjectAlias) Reset()         { *m = ProjectAlias{} }
+func (m *ProjectAlias) String() string { return proto.CompactTextString(m) }
+func (*ProjectAlias) ProtoMessage()    {}
+func (*ProjectAlias) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{16}
+}
+func (m *ProjectAlias) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectAlias) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectAlias.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectAlias) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectAlias.Merge(m, src)
+}
+func (m *ProjectAlias) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectAlias) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectAlias.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectAlias proto.InternalMessageInfo
+
+func (m *ProjectAlias) GetAlias() string {
+	if m != nil {
+		return m.Alias
+	}
+	return ""
+}
+
+func (m *ProjectAlias) GetProjectKey() uint64 {
+	if m != nil {
+		return m.ProjectKey
+	}
+	return 0
+}
+
+// BatchLineage stores the lineage of credit batches that were split into
+// sub-vintage credit batches or merged into a single credit batch. Each entry
+// links a credit batch to a credit batch that was created from it.
+type BatchLineage struct {
+	// parent_batch_key is the table row identifier of the credit batch that was
+	// split or merged.
+	ParentBatchKey uint64 `protobuf:"varint,1,opt,name=parent_batch_key,json=parentBatchKey,proto3" json:"parent_batch_key,omitempty"`
+	// child_batch_key is the table row identifier of the credit batch that was
+	// created from the parent credit batch.
+	ChildBatchKey uint64 `protobuf:"varint,2,opt,name=child_batch_key,json=childBatchKey,proto3" json:"child_batch_key,omitempty"`
+}
+
+func (m *BatchLineage) Reset()         { *m = BatchLineage{} }
+func (m *BatchLineage) String() string { return proto.CompactTextString(m) }
+func (*BatchLineage) ProtoMessage()    {}
+func (*BatchLineage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6cfdca0a4aaabb36, []int{17}
+}
+func (m *BatchLineage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchLineage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchLineage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchLineage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchLineage.Merge(m, src)
+}
+func (m *BatchLineage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchLineage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchLineage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchLineage proto.InternalMessageInfo
+
+func (m *BatchLineage) GetParentBatchKey() uint64 {
+	if m != nil {
+		return m.ParentBatchKey
+	}
+	return 0
+}
+
+func (m *BatchLineage) GetChildBatchKey() uint64 {
+	if m != nil {
+		return m.ChildBatchKey
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.v1.ProjectStatus", ProjectStatus_name, ProjectStatus_value)
+	proto.RegisterEnum("regen.ecocredit.v1.ProjectVerifierRole", ProjectVerifierRole_name, ProjectVerifierRole_value)
+	proto.RegisterType((*CreditType)(nil), "regen.ecocredit.v1.CreditType")
+	proto.RegisterType((*Class)(nil), "regen.ecocredit.v1.Class")
+	proto.RegisterType((*ClassIssuer)(nil), "regen.ecocredit.v1.ClassIssuer")
+	proto.RegisterType((*Project)(nil), "regen.ecocredit.v1.Project")
+	proto.RegisterType((*Batch)(nil), "regen.ecocredit.v1.Batch")
+	proto.RegisterType((*ClassSequence)(nil), "regen.ecocredit.v1.ClassSequence")
+	proto.RegisterType((*ProjectSequence)(nil), "regen.ecocredit.v1.ProjectSequence")
+	proto.RegisterType((*BatchSequence)(nil), "regen.ecocredit.v1.BatchSequence")
+	proto.RegisterType((*BatchBalance)(nil), "regen.ecocredit.v1.BatchBalance")
+	proto.RegisterType((*BatchSupply)(nil), "regen.ecocredit.v1.BatchSupply")
+	proto.RegisterType((*OriginTxIndex)(nil), "regen.ecocredit.v1.OriginTxIndex")
+	proto.RegisterType((*BatchContract)(nil), "regen.ecocredit.v1.BatchContract")
+	proto.RegisterType((*Retirement)(nil), "regen.ecocredit.v1.Retirement")
+	proto.RegisterType((*ProjectIssuer)(nil), "regen.ecocredit.v1.ProjectIssuer")
+	proto.RegisterType((*ProjectVerifier)(nil), "regen.ecocredit.v1.ProjectVerifier")
+	proto.RegisterType((*ProjectTransfer)(nil), "regen.ecocredit.v1.ProjectTransfer")
+	proto.RegisterType((*ProjectAlias)(nil), "regen.ecocredit.v1.ProjectAlias")
+	proto.RegisterType((*BatchLineage)(nil), "regen.ecocredit.v1.BatchLineage")
+}
+
+func init() { proto.RegisterFile("regen/ecocredit/v1/state.proto", fileDescriptor_6cfdca0a4aaabb36) }
+
+var fileDescriptor_6cfdca0a4aaabb36 = []byte{
+	// 1547 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0x4b, 0x6f, 0xdb, 0x46,
+	0x10, 0xf6, 0x52, 0xef, 0xd1, 0x8b, 0x5e, 0x27, 0x0e, 0xe3, 0x38, 0x8a, 0xc3, 0xe6, 0xe1, 0xa4,
+	0xae, 0x04, 0xa7, 0x0f, 0x24, 0x0a, 0xd0, 0xc2, 0xb6, 0xd4, 0x40, 0x8d, 0x63, 0x09, 0x94, 0x12,
+	0xa0, 0xbd, 0xa8, 0x2b, 0x72, 0x6d, 0x33, 0x91, 0x48, 0x75, 0x49, 0xf9, 0x71, 0xed, 0xb5, 0x40,
+	0x51, 0xf4, 0x50, 0xf4, 0x50, 0xf4, 0xd2, 0x5b, 0xfb, 0x17, 0x7a, 0x2d, 0xd0, 0x63, 0x80, 0x5e,
+	0x7a, 0x2c, 0x12, 0xa0, 0x3f, 0xa0, 0xe8, 0x0f, 0x28, 0xb8, 0x5c, 0x52, 0x12, 0x2d, 0x3b, 0x46,
+	0x6f, 0x9c, 0xd9, 0x79, 0x7c, 0xf3, 0xed, 0xec, 0xec, 0x12, 0x4a, 0x8c, 0xee, 0x51, 0xab, 0x42,
+	0x75, 0x5b, 0x67, 0xd4, 0x30, 0xdd, 0xca, 0xc1, 0x7a, 0xc5, 0x71, 0x89, 0x4b, 0xcb, 0x43, 0x66,
+	0xbb, 0x36, 0xc6, 0x7c, 0xbd, 0x1c, 0xae, 0x97, 0x0f, 0xd6, 0x97, 0xae, 0xea, 0xb6, 0x33, 0xb0,
+	0x9d, 0x8a, 0xcd, 0x06, 0x95, 0x83, 0x75, 0xd2, 0x1f, 0xee, 0x93, 0x75, 0x4f, 0xf0, 0x5d, 0x96,
+	0xae, 0xed, 0xd9, 0xf6, 0x5e, 0x9f, 0x56, 0xb8, 0xd4, 0x1b, 0xed, 0x56, 0x5c, 0x73, 0x40, 0x1d,
+	0x97, 0x0c, 0x86, 0xbe, 0x81, 0xfa, 0x03, 0x02, 0xd8, 0xe2, 0xd1, 0x3a, 0xc7, 0x43, 0x8a, 0x55,
+	0xc8, 0x91, 0x5e, 0x8f, 0xd1, 0x03, 0x93, 0xb8, 0xa6, 0x6d, 0x29, 0x68, 0x05, 0xad, 0x66, 0xb4,
+	0x29, 0x1d, 0xc6, 0x10, 0xb7, 0xc8, 0x80, 0x2a, 0x12, 0x5f, 0xe3, 0xdf, 0x9e, 0x6e, 0x64, 0x99,
+	0xae, 0x12, 0xf3, 0x75, 0xde, 0x37, 0x5e, 0x86, 0xcc, 0x90, 0x51, 0xdd, 0x74, 0xbc, 0x40, 0xf1,
+	0x15, 0xb4, 0x9a, 0xd7, 0xc6, 0x8a, 0xea, 0x8d, 0x7f, 0x7e, 0xfc, 0xe3, 0xeb, 0x58, 0x09, 0x0a,
+	0xd3, 0x19, 0x31, 0xf8, 0xd1, 0x65, 0xa4, 0x20, 0x05, 0xa9, 0xdf, 0x4a, 0x90, 0xd8, 0xea, 0x13,
+	0xc7, 0xc1, 0x32, 0xc4, 0x5e, 0xd0, 0x63, 0x0e, 0x28, 0xae, 0x79, 0x9f, 0xb8, 0x00, 0x92, 0x69,
+	0x08, 0x14, 0x92, 0x69, 0xe0, 0x0b, 0x90, 0x20, 0xc6, 0xc0, 0xb4, 0x38, 0x88, 0x9c, 0xe6, 0x0b,
+	0x78, 0x09, 0xd2, 0x03, 0xea, 0x12, 0x83, 0xb8, 0x84, 0x83, 0xc8, 0x68, 0xa1, 0x8c, 0xd7, 0x00,
+	0xfb, 0x4c, 0x76, 0xdd, 0xe3, 0x21, 0xed, 0xfa, 0x38, 0x94, 0x04, 0xb7, 0x92, 0xf5, 0x90, 0x95,
+	0x0d, 0xae, 0xc7, 0x6f, 0xc3, 0x7c, 0x6f, 0xb4, 0xbb, 0x4b, 0x59, 0x77, 0x48, 0x99, 0x4e, 0x2d,
+	0x97, 0xec, 0x51, 0x25, 0xe9, 0x1b, 0xfb, 0x0b, 0xad, 0x50, 0xef, 0xa5, 0x3d, 0xa0, 0xcc, 0xdc,
+	0x35, 0x29, 0x53, 0x52, 0x1c, 0x4f, 0x28, 0x57, 0x3f, 0xe4, 0xa5, 0xdf, 0x87, 0x14, 0x2f, 0x49,
+	0x46, 0x38, 0xed, 0x55, 0xe2, 0x55, 0x8c, 0x33, 0xa2, 0x06, 0x59, 0xc2, 0x8b, 0xb3, 0xc0, 0xc9,
+	0x31, 0x45, 0x52, 0x3f, 0x87, 0x2c, 0xe7, 0xa4, 0xe1, 0x38, 0x23, 0xca, 0xf0, 0x15, 0xc8, 0xe8,
+	0x9e, 0xd8, 0x1d, 0xf3, 0x93, 0xe6, 0x8a, 0xc7, 0xf4, 0x18, 0x2f, 0x42, 0xd2, 0xe4, 0x66, 0x9c,
+	0xa8, 0x9c, 0x26, 0xa4, 0xea, 0x32, 0xc7, 0xb0, 0x08, 0x18, 0xe4, 0xd0, 0x79, 0x4d, 0x58, 0xc6,
+	0xd4, 0xbf, 0x25, 0x48, 0xb5, 0x98, 0xfd, 0x9c, 0xea, 0xee, 0xff, 0x26, 0x7e, 0x0a, 0x56, 0x3c,
+	0x02, 0x4b, 0x85, 0xdc, 0xf3, 0x11, 0x33, 0x1d, 0xc3, 0xd4, 0x79, 0x9f, 0xf9, 0x9c, 0x4f, 0xe9,
+	0xa6, 0x76, 0x2e, 0x19, 0xd9, 0xb9, 0xeb, 0x90, 0x63, 0x74, 0x97, 0x32, 0x6a, 0xe9, 0xb4, 0x6b,
+	0x1a, 0x9c, 0xe2, 0x8c, 0x96, 0x0d, 0x75, 0x0d, 0x03, 0x3f, 0x80, 0xa4, 0x77, 0x78, 0x46, 0x8e,
+	0x92, 0x5e, 0x41, 0xab, 0x85, 0x7b, 0xd7, 0xcb, 0x27, 0x8f, 0x4f, 0x59, 0x14, 0xd9, 0xe6, 0x86,
+	0x9a, 0x70, 0xa8, 0xee, 0x73, 0x72, 0x7a, 0xb3, 0x36, 0x08, 0x43, 0x6e, 0x82, 0x2f, 0x43, 0x96,
+	0x26, 0x37, 0x2d, 0x86, 0xe5, 0x69, 0x5c, 0x72, 0x1c, 0x2f, 0xc1, 0xe2, 0xd8, 0x61, 0x6a, 0x2d,
+	0xa1, 0xc4, 0xd5, 0xef, 0x63, 0x90, 0xd8, 0x24, 0xae, 0xbe, 0x3f, 0x83, 0xe6, 0x53, 0xb6, 0x0e,
+	0x5f, 0x83, 0xec, 0xd0, 0x87, 0xcd, 0xa9, 0x8d, 0x71, 0x0f, 0x10, 0x2a, 0x8f, 0xdc, 0x0b, 0x90,
+	0x30, 0xa8, 0x65, 0x0f, 0x44, 0xbf, 0xfb, 0xc2, 0x14, 0x9d, 0x89, 0x08, 0x9d, 0x0f, 0x00, 0x1c,
+	0x97, 0x30, 0xb7, 0x6b, 0x10, 0xd7, 0xef, 0xe9, 0xec, 0xbd, 0xa5, 0xb2, 0x3f, 0x3b, 0xca, 0xc1,
+	0xec, 0x28, 0x77, 0x82, 0xd9, 0xa1, 0x65, 0xb8, 0x75, 0x8d, 0xb8, 0x14, 0xbf, 0x0f, 0x69, 0x6a,
+	0x19, 0xbe, 0x63, 0xea, 0x8d, 0x8e, 0x29, 0x6a, 0x19, 0xdc, 0xed, 0x23, 0xc8, 0x7b, 0xe5, 0x10,
+	0x8f, 0x0b, 0xee, 0x9b, 0x7e, 0xa3, 0x6f, 0x2e, 0x70, 0xe0, 0x01, 0x30, 0xc4, 0xed, 0x21, 0xb5,
+	0x94, 0xcc, 0x0a, 0x5a, 0x4d, 0x6b, 0xfc, 0xbb, 0xfa, 0x98, 0xef, 0x5b, 0x7d, 0xbc, 0x6f, 0x59,
+	0xc1, 0x04, 0xdf, 0xba, 0xe2, 0x14, 0x6f, 0xb2, 0x84, 0x0b, 0x93, 0x55, 0xcb, 0x31, 0x0c, 0x01,
+	0xe1, 0x72, 0x5c, 0x49, 0xa8, 0x5f, 0x22, 0xc8, 0xf3, 0x63, 0xd6, 0xa6, 0x5f, 0x8c, 0xbc, 0x3d,
+	0x3b, 0x65, 0x5c, 0xa0, 0x53, 0xc6, 0xc5, 0x5b, 0x90, 0xb7, 0xe8, 0x91, 0xdb, 0x75, 0x84, 0x3b,
+	0xdf, 0xc5, 0xb8, 0x96, 0xf3, 0x94, 0x41, 0xc8, 0x6a, 0x89, 0x23, 0x56, 0xe0, 0xc2, 0xcc, 0xd0,
+	0x49, 0xf5, 0x39, 0x14, 0x83, 0x16, 0x0d, 0x50, 0x9c, 0x79, 0xdc, 0xcf, 0x95, 0xf4, 0x22, 0x4f,
+	0x5a, 0x84, 0xec, 0x64, 0xa4, 0x94, 0x6a, 0x41, 0x9e, 0xb7, 0x62, 0x98, 0x29, 0xd2, 0x68, 0xe8,
+	0x44, 0xa3, 0x9d, 0x2b, 0xdb, 0x25, 0x9e, 0x6d, 0x1e, 0xf2, 0xd3, 0xd1, 0xd2, 0xea, 0xbf, 0x08,
+	0x72, 0x3c, 0xe1, 0x26, 0xe9, 0x13, 0x51, 0x59, 0xcf, 0x93, 0x27, 0x2b, 0xe3, 0x0a, 0x2f, 0x97,
+	0x02, 0x29, 0x62, 0x18, 0x8c, 0x3a, 0x8e, 0x38, 0x0e, 0x81, 0x88, 0x6f, 0x43, 0xd1, 0x65, 0xc4,
+	0x20, 0xbd, 0x3e, 0xed, 0x92, 0x81, 0x3d, 0xb2, 0x82, 0x6b, 0xa8, 0x10, 0xa8, 0x37, 0xb8, 0x16,
+	0xdf, 0x84, 0x02, 0xa3, 0xae, 0xc9, 0xa8, 0x11, 0xd8, 0xf9, 0x07, 0x24, 0x2f, 0xb4, 0xc2, 0xec,
+	0x36, 0x14, 0xa9, 0xa3, 0x33, 0xfb, 0x70, 0x6c, 0xe7, 0x9f, 0x97, 0x42, 0xa0, 0xf6, 0x0d, 0xab,
+	0xef, 0xf1, 0xca, 0xca, 0xb0, 0x00, 0xf3, 0x02, 0xcb, 0x5a, 0x88, 0x1f, 0x5f, 0x84, 0xf9, 0x50,
+	0x58, 0x13, 0xcb, 0x32, 0x52, 0x32, 0xea, 0xaf, 0x08, 0xb2, 0x3e, 0xcf, 0xa3, 0xe1, 0xb0, 0x7f,
+	0x7c, 0x76, 0xd5, 0x33, 0x6a, 0x93, 0xce, 0x59, 0x5b, 0x6c, 0x56, 0x6d, 0x77, 0x40, 0xd6, 0x3d,
+	0xae, 0xfb, 0xfd, 0x28, 0x09, 0xc5, 0x50, 0x2f, 0xaa, 0x9b, 0xe8, 0x92, 0x31, 0x3e, 0x50, 0x47,
+	0x90, 0x6f, 0x32, 0x73, 0xcf, 0xb4, 0x3a, 0x47, 0x0d, 0xcb, 0xa0, 0x47, 0x67, 0xf7, 0x63, 0xf4,
+	0xaa, 0x58, 0x84, 0xa4, 0x63, 0x8f, 0x98, 0x4e, 0x05, 0x3c, 0x21, 0x55, 0xaf, 0xf1, 0x64, 0x97,
+	0xe1, 0x22, 0x2c, 0x4c, 0x8e, 0xd7, 0x35, 0x61, 0x9c, 0x55, 0xbf, 0x43, 0xa2, 0x3b, 0xb7, 0x6c,
+	0xcb, 0x65, 0x44, 0x77, 0xcf, 0xe6, 0x6d, 0x0a, 0x94, 0x14, 0x01, 0xb5, 0x04, 0x69, 0x5d, 0x44,
+	0x11, 0x30, 0x42, 0xb9, 0x5a, 0xe1, 0x40, 0xee, 0x4c, 0x55, 0x8d, 0x15, 0xc0, 0x63, 0x54, 0x81,
+	0x29, 0x7f, 0xa1, 0xe4, 0xd4, 0x5f, 0x24, 0x00, 0x8d, 0x73, 0x3c, 0xa0, 0x96, 0x2b, 0x0a, 0xf6,
+	0xe1, 0x78, 0x05, 0x4f, 0xa1, 0x94, 0x22, 0x28, 0x17, 0x21, 0x39, 0xb5, 0x59, 0x42, 0xf2, 0x06,
+	0xb8, 0x7d, 0x68, 0x51, 0xc6, 0xb7, 0x26, 0xa7, 0xf9, 0x02, 0x5e, 0x81, 0x6c, 0x8f, 0x5a, 0x74,
+	0xd7, 0xd4, 0x4d, 0xc2, 0x8e, 0x45, 0x4f, 0x4e, 0xaa, 0xbc, 0x78, 0x8c, 0x12, 0xc7, 0xb6, 0xc4,
+	0x7d, 0x29, 0xa4, 0x13, 0xb7, 0x6d, 0x6a, 0xc6, 0x6d, 0x7b, 0x1f, 0x32, 0xe1, 0xdb, 0xf0, 0x1c,
+	0xc3, 0x78, 0x6c, 0x5c, 0xbd, 0xcb, 0x29, 0xbb, 0x01, 0x49, 0xff, 0x92, 0xf4, 0xae, 0x43, 0x0e,
+	0x58, 0x46, 0x38, 0x3f, 0x51, 0xbd, 0x2c, 0x29, 0x79, 0x75, 0x1f, 0xf2, 0x62, 0x9e, 0x35, 0x66,
+	0x5e, 0x66, 0x27, 0x67, 0xcc, 0x69, 0x0f, 0x98, 0x89, 0xc9, 0x39, 0x11, 0x20, 0x78, 0xc2, 0x14,
+	0xd4, 0x9f, 0x51, 0x38, 0x3a, 0x9f, 0x89, 0x87, 0xd7, 0x9b, 0x93, 0x9d, 0x3e, 0x64, 0x1e, 0x42,
+	0x9c, 0xd9, 0x7d, 0xbf, 0x6d, 0x0b, 0xf7, 0x6e, 0x9f, 0xf1, 0x96, 0x08, 0xb2, 0x69, 0x76, 0x9f,
+	0x6a, 0xdc, 0x69, 0xb2, 0xbb, 0x27, 0xb1, 0x06, 0xa9, 0x8a, 0xea, 0x61, 0x88, 0xb5, 0xc3, 0x88,
+	0xe5, 0xec, 0x9e, 0x07, 0xeb, 0x59, 0x2d, 0x5e, 0xbd, 0xc5, 0x33, 0xae, 0x44, 0x86, 0xae, 0xb7,
+	0x1f, 0xa1, 0x8f, 0x8c, 0x14, 0x59, 0x35, 0x21, 0x27, 0x12, 0x6f, 0xf4, 0x4d, 0xe2, 0xf0, 0xa7,
+	0x9c, 0xf7, 0x21, 0x6e, 0x35, 0x5f, 0x88, 0x62, 0x91, 0xa2, 0x58, 0xaa, 0x2a, 0x4f, 0xb7, 0x0c,
+	0x29, 0xe1, 0x1e, 0xbd, 0x6b, 0x91, 0x32, 0xaf, 0xfe, 0x14, 0x8c, 0xfb, 0x6d, 0xd3, 0xa2, 0xde,
+	0x13, 0x79, 0x15, 0xe4, 0x21, 0x61, 0xd4, 0x72, 0xbb, 0xd1, 0x73, 0x5c, 0xf0, 0xf5, 0x9b, 0xc1,
+	0x39, 0xb9, 0x05, 0x45, 0x7d, 0xdf, 0xec, 0x1b, 0xdd, 0xe8, 0x51, 0xca, 0x73, 0x75, 0x60, 0x57,
+	0xdd, 0xe0, 0x30, 0x1e, 0x82, 0x0a, 0x2b, 0xd1, 0xc8, 0x6b, 0x91, 0x00, 0x78, 0xe1, 0x44, 0x4c,
+	0x19, 0x29, 0xf8, 0xee, 0x6f, 0x08, 0xf2, 0x53, 0x8f, 0x42, 0x5c, 0x82, 0xa5, 0x96, 0xd6, 0xfc,
+	0xa4, 0xbe, 0xd5, 0xe9, 0xb6, 0x3b, 0x1b, 0x9d, 0xa7, 0xed, 0xee, 0xd3, 0x9d, 0x76, 0xab, 0xbe,
+	0xd5, 0xf8, 0xb8, 0x51, 0xaf, 0xc9, 0x73, 0xf8, 0x0a, 0x5c, 0x8a, 0xac, 0xb7, 0xb4, 0x66, 0xab,
+	0xd9, 0xae, 0xd7, 0x64, 0x84, 0xaf, 0xc2, 0xe5, 0xc8, 0xa2, 0x56, 0x7f, 0xd4, 0x68, 0x77, 0xea,
+	0x5a, 0xbd, 0x26, 0x4b, 0x78, 0x19, 0x94, 0xc8, 0xf2, 0xb3, 0x8d, 0xed, 0x46, 0x6d, 0xa3, 0x53,
+	0xaf, 0xc9, 0xb1, 0x19, 0xab, 0xed, 0xa7, 0xed, 0x56, 0x7d, 0xa7, 0x56, 0xaf, 0xc9, 0xf1, 0x19,
+	0xab, 0x5b, 0xcd, 0x27, 0xad, 0xed, 0xba, 0xe7, 0x9b, 0xb8, 0xfb, 0x15, 0x82, 0x85, 0x19, 0x0d,
+	0x89, 0x6f, 0xc2, 0xf5, 0xc0, 0xeb, 0x59, 0x5d, 0xf3, 0x6a, 0xd0, 0xba, 0x5a, 0x73, 0xbb, 0x1e,
+	0x29, 0x4a, 0x85, 0xd2, 0x6c, 0xb3, 0x40, 0x92, 0x11, 0xbe, 0x03, 0x37, 0x67, 0xdb, 0x3c, 0x69,
+	0xee, 0x34, 0x3a, 0x4d, 0xad, 0xb1, 0xf3, 0xa8, 0xbb, 0xd9, 0xac, 0x7d, 0x2a, 0x4b, 0x9b, 0xad,
+	0xdf, 0x5f, 0x95, 0xd0, 0xcb, 0x57, 0x25, 0xf4, 0xd7, 0xab, 0x12, 0xfa, 0xe6, 0x75, 0x69, 0xee,
+	0xe5, 0xeb, 0xd2, 0xdc, 0x9f, 0xaf, 0x4b, 0x73, 0x9f, 0x7d, 0xb0, 0x67, 0xba, 0xfb, 0xa3, 0x5e,
+	0x59, 0xb7, 0x07, 0x15, 0x7e, 0xa6, 0xde, 0xb1, 0xa8, 0x7b, 0x68, 0xb3, 0x17, 0x42, 0xea, 0x53,
+	0x63, 0x8f, 0xb2, 0xca, 0xd1, 0xc4, 0x5f, 0xb1, 0x6e, 0x33, 0xda, 0x4b, 0xf2, 0x99, 0xf4, 0xee,
+	0x7f, 0x03, 0x00, 0x5e, 0x30, 0xbb, 0xef, 0x34, 0x0f, 0x00, 0x00,
+}
+
+func (m *CreditType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Unit) > 0 {
+		i -= len(m.Unit)
+		copy(dAtA[i:], m.Unit)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Unit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Abbreviation) > 0 {
+		i -= len(m.Abbreviation)
+		copy(dAtA[i:], m.Abbreviation)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Abbreviation)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Class) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Class) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Class) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verifier) > 0 {
+		i -= len(m.Verifier)
+		copy(dAtA[i:], m.Verifier)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Verifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BufferPercentage) > 0 {
+		i -= len(m.BufferPercentage)
+		copy(dAtA[i:], m.BufferPercentage)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BufferPercentage)))
 		i--
 		dAtA[i] = 0x32
 	}
-	if len(m.Jurisdiction) > 0 {
-		i -= len(m.Jurisdiction)
-		copy(dAtA[i:], m.Jurisdiction)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if m.ClassKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x22
 	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
@@ -1254,7 +1758,7 @@ func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Batch) Marshal() (dAtA []byte, err error) {
+func (m *ClassIssuer) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1264,17 +1768,125 @@ func (m *Batch) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClassIssuer) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClassIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Open {
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Status != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReferenceId) > 0 {
+		i -= len(m.ReferenceId)
+		copy(dAtA[i:], m.ReferenceId)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReferenceId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Key != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Key))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Batch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Batch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Batch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Open {
 		i--
 		if m.Open {
 			dAtA[i] = 1
@@ -1642,101 +2254,365 @@ func (m *BatchContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Retirement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreditType) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *Retirement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Retirement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Abbreviation)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.Unit)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.Precision != 0 {
-		n += 1 + sovState(uint64(m.Precision))
+	if len(m.Jurisdiction) > 0 {
+		i -= len(m.Jurisdiction)
+		copy(dAtA[i:], m.Jurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Jurisdiction)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	return n
-}
-
-func (m *Class) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
 	}
-	var l int
-	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
+	if len(m.Beneficiary) > 0 {
+		i -= len(m.Beneficiary)
+		copy(dAtA[i:], m.Beneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Beneficiary)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ClassIssuer) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProjectIssuer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectIssuer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectIssuer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClassKey != 0 {
-		n += 1 + sovState(uint64(m.ClassKey))
+	if len(m.Issuer) > 0 {
+		i -= len(m.Issuer)
+		copy(dAtA[i:], m.Issuer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Issuer)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Issuer)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Project) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ProjectVerifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectVerifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectVerifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Key != 0 {
-		n += 1 + sovState(uint64(m.Key))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if m.Role != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.Admin)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectAlias) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectAlias) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectAlias) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Alias) > 0 {
+		i -= len(m.Alias)
+		copy(dAtA[i:], m.Alias)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Alias)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchLineage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchLineage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchLineage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChildBatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ChildBatchKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ParentBatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ParentBatchKey))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Abbreviation)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Unit)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Precision != 0 {
+		n += 1 + sovState(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *Class) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BufferPercentage)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Verifier)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *ClassIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Key != 0 {
+		n += 1 + sovState(uint64(m.Key))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
 	}
 	if m.ClassKey != 0 {
 		n += 1 + sovState(uint64(m.ClassKey))
@@ -1753,6 +2629,9 @@ func (m *Project) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovState(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovState(uint64(m.Status))
+	}
 	return n
 }
 
@@ -1935,44 +2814,801 @@ func (m *BatchContract) Size() (n int) {
 	return n
 }
 
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *Retirement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Beneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Jurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
 }
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *ProjectIssuer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Issuer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
 }
-func (m *CreditType) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+
+func (m *ProjectVerifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovState(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *ProjectTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	if m.ClassKey != 0 {
+		n += 1 + sovState(uint64(m.ClassKey))
+	}
+	return n
+}
+
+func (m *ProjectAlias) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.ProjectKey != 0 {
+		n += 1 + sovState(uint64(m.ProjectKey))
+	}
+	return n
+}
+
+func (m *BatchLineage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ParentBatchKey != 0 {
+		n += 1 + sovState(uint64(m.ParentBatchKey))
+	}
+	if m.ChildBatchKey != 0 {
+		n += 1 + sovState(uint64(m.ChildBatchKey))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Class) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Metadata = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BufferPercentage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BufferPercentage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verifier", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verifier = append(m.Verifier[:0], dAtA[iNdEx:postIndex]...)
+			if m.Verifier == nil {
+				m.Verifier = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Project) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			m.Key = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Key |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
+			if m.Admin == nil {
+				m.Admin = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreditType: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreditType: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			m.ClassKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abbreviation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2000,11 +3636,11 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abbreviation = string(dAtA[iNdEx:postIndex])
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2032,11 +3668,11 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Unit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2064,13 +3700,13 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Unit = string(dAtA[iNdEx:postIndex])
+			m.ReferenceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			m.Precision = 0
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2080,7 +3716,7 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Precision |= uint32(b&0x7F) << shift
+				m.Status |= ProjectStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2106,7 +3742,7 @@ func (m *CreditType) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Class) Unmarshal(dAtA []byte) error {
+func (m *Batch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2129,10 +3765,10 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Class: wiretype end group for non-group")
+			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Class: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2156,9 +3792,9 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2168,29 +3804,31 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			var byteLen int
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2200,29 +3838,14 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
-			}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2250,11 +3873,11 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2282,63 +3905,49 @@ func (m *Class) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthState
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthState
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartDate == nil {
+				m.StartDate = &types.Timestamp{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClassIssuer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.ClassKey = 0
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2348,16 +3957,33 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndDate == nil {
+				m.EndDate = &types.Timestamp{}
+			}
+			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2367,26 +3993,48 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
+			if m.IssuanceDate == nil {
+				m.IssuanceDate = &types.Timestamp{}
+			}
+			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
+			m.Open = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2408,7 +4056,7 @@ func (m *ClassIssuer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2431,34 +4079,15 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
-			}
-			m.Key = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2486,13 +4115,13 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
 			}
-			var byteLen int
+			m.NextSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2502,27 +4131,62 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NextSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Admin = append(m.Admin[:0], dAtA[iNdEx:postIndex]...)
-			if m.Admin == nil {
-				m.Admin = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
@@ -2541,75 +4205,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceId", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
 			}
-			var stringLen uint64
+			m.NextSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2619,24 +4219,11 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NextSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ReferenceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2658,7 +4245,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Batch) Unmarshal(dAtA []byte) error {
+func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2681,17 +4268,17 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Batch: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Batch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			m.Key = 0
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2701,16 +4288,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Key |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
 			}
-			var byteLen int
+			m.NextSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2720,31 +4307,66 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NextSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
-			if m.Issuer == nil {
-				m.Issuer = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
 			}
-			iNdEx = postIndex
-		case 3:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			m.ProjectKey = 0
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2754,16 +4376,16 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2773,27 +4395,29 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2821,49 +4445,13 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.StartDate == nil {
-				m.StartDate = &types.Timestamp{}
-			}
-			if err := m.StartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2873,33 +4461,29 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndDate == nil {
-				m.EndDate = &types.Timestamp{}
-			}
-			if err := m.EndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IssuanceDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -2909,48 +4493,24 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.IssuanceDate == nil {
-				m.IssuanceDate = &types.Timestamp{}
-			}
-			if err := m.IssuanceDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Open = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -2972,7 +4532,7 @@ func (m *Batch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClassSequence) Unmarshal(dAtA []byte) error {
+func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2995,15 +4555,34 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClassSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClassSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3031,13 +4610,13 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			m.TradableAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
 			}
-			m.NextSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3047,11 +4626,56 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3073,7 +4697,7 @@ func (m *ClassSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
+func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3096,10 +4720,10 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3122,10 +4746,42 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.NextSequence = 0
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3135,11 +4791,24 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3161,7 +4830,7 @@ func (m *ProjectSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSequence) Unmarshal(dAtA []byte) error {
+func (m *BatchContract) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3184,17 +4853,17 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSequence: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
-			m.ProjectKey = 0
+			m.BatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3204,16 +4873,16 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ProjectKey |= uint64(b&0x7F) << shift
+				m.BatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			m.NextSequence = 0
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3223,11 +4892,43 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3249,7 +4950,7 @@ func (m *BatchSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchBalance) Unmarshal(dAtA []byte) error {
+func (m *Retirement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,13 +4973,32 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchBalance: wiretype end group for non-group")
+			return fmt.Errorf("proto: Retirement: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Retirement: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
 			}
@@ -3297,9 +5017,41 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3326,14 +5078,14 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			m.Owner = append(m.Owner[:0], dAtA[iNdEx:postIndex]...)
+			if m.Owner == nil {
+				m.Owner = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Beneficiary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3361,13 +5113,163 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
+			m.Beneficiary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Jurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectIssuer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectIssuer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectIssuer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3377,29 +5279,16 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EscrowedAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3409,23 +5298,25 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EscrowedAmount = string(dAtA[iNdEx:postIndex])
+			m.Issuer = append(m.Issuer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Issuer == nil {
+				m.Issuer = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3448,7 +5339,7 @@ func (m *BatchBalance) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchSupply) Unmarshal(dAtA []byte) error {
+func (m *ProjectVerifier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3471,17 +5362,17 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectVerifier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectVerifier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			m.BatchKey = 0
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3491,16 +5382,16 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3510,61 +5401,31 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TradableAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RetiredAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
-			m.RetiredAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CancelledAmount", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			var stringLen uint64
+			m.Role = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3574,24 +5435,11 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Role |= ProjectVerifierRole(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CancelledAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3613,7 +5461,7 @@ func (m *BatchSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
+func (m *ProjectTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3636,17 +5484,17 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OriginTxIndex: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OriginTxIndex: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
 			}
-			m.ClassKey = 0
+			m.ProjectKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3656,16 +5504,16 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
+				m.ProjectKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
 			}
-			var stringLen uint64
+			m.ClassKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3675,27 +5523,64 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ClassKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthState
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectAlias) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectAlias: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectAlias: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3723,8 +5608,27 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Source = string(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			m.ProjectKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProjectKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -3746,7 +5650,7 @@ func (m *OriginTxIndex) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BatchContract) Unmarshal(dAtA []byte) error {
+func (m *BatchLineage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3769,17 +5673,17 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BatchContract: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchLineage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BatchContract: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchLineage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParentBatchKey", wireType)
 			}
-			m.BatchKey = 0
+			m.ParentBatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3789,35 +5693,16 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				m.ParentBatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
-			}
-			m.ClassKey = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowState
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ClassKey |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChildBatchKey", wireType)
 			}
-			var stringLen uint64
+			m.ChildBatchKey = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -3827,24 +5712,11 @@ func (m *BatchContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ChildBatchKey |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthState
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthState
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
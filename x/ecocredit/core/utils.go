@@ -8,6 +8,8 @@ import (
 	"time"
 	"unicode"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/regen-network/regen-ledger/x/ecocredit"
@@ -32,6 +34,10 @@ const (
 
 	// MaxNoteLength defines the max length for note fields.
 	MaxNoteLength = 512
+
+	// MaxBeneficiaryLength defines the max length of the beneficiary of a
+	// retirement.
+	MaxBeneficiaryLength = 256
 )
 
 var (
@@ -46,6 +52,14 @@ var (
 	regexJurisdiction = regexp.MustCompile(fmt.Sprintf(`^%s$`, RegexJurisdiction))
 )
 
+// BufferAddress returns the address of the account holding the buffer pool of
+// a credit class. The address is derived from the ecocredit module name and the
+// credit class id, and no private key exists for the account, so credits held by
+// the account can only be removed through Msg/Reverse.
+func BufferAddress(classId string) sdk.AccAddress {
+	return address.Module(ecocredit.ModuleName, []byte(fmt.Sprintf("buffer/%s", classId)))
+}
+
 // FormatClassId formats the unique identifier for a new credit class, based
 // on the credit type abbreviation and the credit class sequence number. This
 // format may evolve over time, but will maintain backwards compatibility.
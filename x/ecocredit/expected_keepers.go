@@ -1,9 +1,12 @@
 package ecocredit
 
 import (
+	"context"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -37,6 +40,21 @@ type BankKeeper interface {
 	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
+// DistributionKeeper defines the expected interface needed to fund the community pool.
+type DistributionKeeper interface {
+	// FundCommunityPool sends coins from the sender account to the community pool.
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// GroupKeeper defines the expected interface needed to retrieve group policies and group members.
+type GroupKeeper interface {
+	// GroupPolicyInfo queries a group policy by its account address.
+	GroupPolicyInfo(ctx context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+
+	// GroupMembers queries the members of a group by group id.
+	GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error)
+}
+
 type ParamKeeper interface {
 
 	// Get fetches a parameter by key from the Subspace's KVStore and sets the provided pointer to the fetched value.
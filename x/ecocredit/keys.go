@@ -5,6 +5,7 @@ import (
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	orderbookApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 )
 
@@ -25,3 +26,17 @@ var ModuleSchema = ormapi.ModuleSchemaDescriptor{
 	},
 	Prefix: []byte{ORMPrefix},
 }
+
+// OrderBookSchema is the schema of the marketplace order book. The order book
+// is kept in a memory store and is rebuilt from the marketplace state when a
+// node starts up.
+var OrderBookSchema = ormapi.ModuleSchemaDescriptor{
+	SchemaFile: []*ormapi.ModuleSchemaDescriptor_FileEntry{
+		{
+			Id:            4,
+			ProtoFileName: orderbookApi.File_regen_ecocredit_orderbook_v1alpha1_memory_proto.Path(),
+			StorageType:   ormapi.StorageType_STORAGE_TYPE_MEMORY,
+		},
+	},
+	Prefix: []byte{ORMPrefix},
+}
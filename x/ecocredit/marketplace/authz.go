@@ -0,0 +1,177 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ authz.Authorization = &SellOrderAuthorization{}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (a SellOrderAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSell{})
+}
+
+// Accept implements Authorization.Accept.
+func (a SellOrderAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSell, ok := msg.(*MsgSell)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	if a.Expiration != nil && !ctx.BlockTime().Before(*a.Expiration) {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf("authorization expired at %s", a.Expiration)
+	}
+
+	limits := a.SellLimits
+	for i, order := range msgSell.Orders {
+		if len(a.AllowedAskDenoms) > 0 && order.AskPrice != nil && !a.isAllowedAskDenom(order.AskPrice.Denom) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrapf(
+				"orders[%d]: %s is not an allowed ask denom", i, order.AskPrice.Denom,
+			)
+		}
+
+		if err := a.checkAllowedBuyers(order); err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "orders[%d]", i)
+		}
+
+		if err := a.checkMinAskPrice(order); err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "orders[%d]", i)
+		}
+
+		quantity, err := math.NewPositiveDecFromString(order.Quantity)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrapf("orders[%d]: %s", i, err)
+		}
+
+		classId, err := core.GetBatchClassId(ctx, order.BatchDenom)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "orders[%d]", i)
+		}
+
+		limits, err = core.SpendCreditLimits(limits, order.BatchDenom, classId, quantity)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "orders[%d]", i)
+		}
+	}
+
+	if core.CreditLimitsSpent(limits) {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Updated: &SellOrderAuthorization{
+		SellLimits:       limits,
+		AllowedAskDenoms: a.AllowedAskDenoms,
+		Expiration:       a.Expiration,
+		AllowedBuyers:    a.AllowedBuyers,
+		MinAskPrices:     a.MinAskPrices,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a SellOrderAuthorization) ValidateBasic() error {
+	if err := core.ValidateCreditLimits(a.SellLimits); err != nil {
+		return sdkerrors.Wrap(err, "sell")
+	}
+
+	for i, denom := range a.AllowedAskDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed ask denoms[%d]: %s", i, err)
+		}
+	}
+
+	seen := make(map[string]bool)
+	for i, buyer := range a.AllowedBuyers {
+		if _, err := sdk.AccAddressFromBech32(buyer); err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("allowed buyers[%d]: %s", i, err)
+		}
+		if seen[buyer] {
+			return sdkerrors.ErrInvalidRequest.Wrapf("allowed buyers[%d]: duplicate address %s", i, buyer)
+		}
+		seen[buyer] = true
+	}
+
+	if err := a.MinAskPrices.Validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("min ask prices: %s", err)
+	}
+
+	return nil
+}
+
+// checkAllowedBuyers checks that the allowed buyers of a private sell order are allowed
+// buyers of the authorization. Private sell orders with an allowed buyer group policy are
+// not authorized because the members of the group can change.
+func (a SellOrderAuthorization) checkAllowedBuyers(order *MsgSell_Order) error {
+	if order.AllowedBuyerGroupPolicy != "" {
+		return sdkerrors.ErrUnauthorized.Wrap("private sell orders with an allowed buyer group policy are not authorized")
+	}
+
+	for _, buyer := range order.AllowedBuyers {
+		allowed := false
+		for _, allowedBuyer := range a.AllowedBuyers {
+			if buyer == allowedBuyer {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return sdkerrors.ErrUnauthorized.Wrapf("%s is not an allowed buyer", buyer)
+		}
+	}
+
+	return nil
+}
+
+// checkMinAskPrice checks that the ask price of the sell order and the floor amount of its
+// auto-renewal price decay are greater than or equal to the minimum ask price in the denom
+// of the ask price.
+func (a SellOrderAuthorization) checkMinAskPrice(order *MsgSell_Order) error {
+	if len(a.MinAskPrices) == 0 {
+		return nil
+	}
+
+	if order.AskPrice == nil {
+		return sdkerrors.ErrUnauthorized.Wrap("ask price cannot be empty")
+	}
+
+	var minAskPrice *sdk.Coin
+	for i := range a.MinAskPrices {
+		if a.MinAskPrices[i].Denom == order.AskPrice.Denom {
+			minAskPrice = &a.MinAskPrices[i]
+			break
+		}
+	}
+	if minAskPrice == nil {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the denom of a minimum ask price", order.AskPrice.Denom)
+	}
+
+	if order.AskPrice.Amount.LT(minAskPrice.Amount) {
+		return sdkerrors.ErrUnauthorized.Wrapf(
+			"ask price %s is less than the minimum ask price %s", order.AskPrice, minAskPrice,
+		)
+	}
+
+	if order.AutoRenew != nil && order.AutoRenew.PriceDecay != "" {
+		floor, ok := sdk.NewIntFromString(order.AutoRenew.FloorAmount)
+		if !ok || floor.LT(minAskPrice.Amount) {
+			return sdkerrors.ErrUnauthorized.Wrapf(
+				"auto-renew floor amount %s is less than the minimum ask price %s", order.AutoRenew.FloorAmount, minAskPrice,
+			)
+		}
+	}
+
+	return nil
+}
+
+func (a SellOrderAuthorization) isAllowedAskDenom(denom string) bool {
+	for _, allowed := range a.AllowedAskDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,606 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: regen/ecocredit/marketplace/v1/authz.proto
+
+package marketplace
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	core "github.com/regen-network/regen-ledger/x/ecocredit/core"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SellOrderAuthorization allows the grantee to create sell orders on behalf of
+// the granter with Msg/Sell. The sell limits are decremented by the quantity of
+// each sell order created.
+type SellOrderAuthorization struct {
+	// sell_limits are the limits on the credits the grantee can sell. Credits
+	// from a credit batch without a limit on the credit batch or its credit class
+	// cannot be sold.
+	SellLimits []*core.CreditLimit `protobuf:"bytes,1,rep,name=sell_limits,json=sellLimits,proto3" json:"sell_limits,omitempty"`
+	// allowed_ask_denoms are the denoms in which the grantee can set the ask
+	// price of a sell order. If empty, any allowed denom can be used.
+	AllowedAskDenoms []string `protobuf:"bytes,2,rep,name=allowed_ask_denoms,json=allowedAskDenoms,proto3" json:"allowed_ask_denoms,omitempty"`
+	// expiration is the time after which the authorization can no longer be
+	// used. If empty, the authorization is only bound by the expiration of the
+	// grant.
+	Expiration *time.Time `protobuf:"bytes,3,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// allowed_buyers are the addresses of the buyers that the grantee can allow
+	// to buy credits from private sell orders. The allowed buyers of each private
+	// sell order must be a subset of allowed_buyers and private sell orders with
+	// an allowed buyer group policy cannot be created. If empty, the grantee
+	// cannot create private sell orders.
+	AllowedBuyers []string `protobuf:"bytes,4,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// min_ask_prices are the optional minimum ask prices of the sell orders. If
+	// set, the ask price of each sell order must be in the denom of one of the
+	// minimum ask prices and its amount must be greater than or equal to the
+	// amount of that minimum ask price. The floor amount of a sell order with a
+	// price decay on auto-renewal must also be greater than or equal to it.
+	MinAskPrices github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=min_ask_prices,json=minAskPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"min_ask_prices"`
+}
+
+func (m *SellOrderAuthorization) Reset()         { *m = SellOrderAuthorization{} }
+func (m *SellOrderAuthorization) String() string { return proto.CompactTextString(m) }
+func (*SellOrderAuthorization) ProtoMessage()    {}
+func (*SellOrderAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f9d7b53c444f951f, []int{0}
+}
+func (m *SellOrderAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SellOrderAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SellOrderAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SellOrderAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrderAuthorization.Merge(m, src)
+}
+func (m *SellOrderAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *SellOrderAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellOrderAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SellOrderAuthorization proto.InternalMessageInfo
+
+func (m *SellOrderAuthorization) GetSellLimits() []*core.CreditLimit {
+	if m != nil {
+		return m.SellLimits
+	}
+	return nil
+}
+
+func (m *SellOrderAuthorization) GetAllowedAskDenoms() []string {
+	if m != nil {
+		return m.AllowedAskDenoms
+	}
+	return nil
+}
+
+func (m *SellOrderAuthorization) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *SellOrderAuthorization) GetAllowedBuyers() []string {
+	if m != nil {
+		return m.AllowedBuyers
+	}
+	return nil
+}
+
+func (m *SellOrderAuthorization) GetMinAskPrices() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MinAskPrices
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SellOrderAuthorization)(nil), "regen.ecocredit.marketplace.v1.SellOrderAuthorization")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/authz.proto", fileDescriptor_f9d7b53c444f951f)
+}
+
+var fileDescriptor_f9d7b53c444f951f = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcf, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0xb6, 0xab, 0xe0, 0xd4, 0x5d, 0x34, 0x88, 0x64, 0x7b, 0x98, 0x14, 0x41, 0x28, 0x62,
+	0x67, 0xec, 0x7a, 0x13, 0x84, 0x6d, 0xd7, 0xa3, 0xa0, 0x54, 0x41, 0xf0, 0x52, 0x26, 0xc9, 0x33,
+	0x1d, 0x32, 0x93, 0x89, 0x33, 0x93, 0xee, 0x8f, 0xbf, 0x62, 0xff, 0x0e, 0xcf, 0xfe, 0x11, 0x8b,
+	0xa7, 0x3d, 0x7a, 0x72, 0xa5, 0xfd, 0x23, 0xbc, 0x4a, 0x26, 0x53, 0xe9, 0x2e, 0x9e, 0x32, 0xef,
+	0x7b, 0xdf, 0xc7, 0xf7, 0xbe, 0xf7, 0x82, 0x9e, 0x69, 0xc8, 0xa1, 0xa4, 0x90, 0xaa, 0x54, 0x43,
+	0xc6, 0x2d, 0x95, 0x4c, 0x17, 0x60, 0x2b, 0xc1, 0x52, 0xa0, 0xcb, 0x31, 0x65, 0xb5, 0x5d, 0x9c,
+	0x93, 0x4a, 0x2b, 0xab, 0x42, 0xec, 0xb8, 0xe4, 0x1f, 0x97, 0x6c, 0x71, 0xc9, 0x72, 0xdc, 0xc7,
+	0xa9, 0x32, 0x52, 0x19, 0x9a, 0x30, 0xd3, 0x68, 0x13, 0xb0, 0x6c, 0x4c, 0x53, 0xc5, 0xcb, 0x56,
+	0xdf, 0x3f, 0x68, 0xfb, 0x73, 0x57, 0xd1, 0xb6, 0xf0, 0xad, 0x47, 0xb9, 0xca, 0x55, 0x8b, 0x37,
+	0x2f, 0x8f, 0xc6, 0xb9, 0x52, 0xb9, 0x00, 0xea, 0xaa, 0xa4, 0xfe, 0x42, 0x2d, 0x97, 0x60, 0x2c,
+	0x93, 0x95, 0x27, 0xe0, 0xdb, 0xd3, 0xdf, 0x9c, 0xf8, 0xc9, 0x9f, 0x1d, 0xf4, 0xf8, 0x03, 0x08,
+	0xf1, 0x4e, 0x67, 0xa0, 0x27, 0xb5, 0x5d, 0x28, 0xcd, 0xcf, 0x99, 0xe5, 0xaa, 0x0c, 0x8f, 0x50,
+	0xcf, 0x80, 0x10, 0x73, 0xc1, 0x25, 0xb7, 0x26, 0x0a, 0x06, 0xdd, 0x61, 0xef, 0x30, 0x26, 0xb7,
+	0x23, 0x2e, 0xc7, 0xe4, 0xd8, 0xbd, 0xde, 0x36, 0xbc, 0x19, 0x6a, 0x34, 0xee, 0x69, 0xc2, 0xe7,
+	0x28, 0x64, 0x42, 0xa8, 0x13, 0xc8, 0xe6, 0xcc, 0x14, 0xf3, 0x0c, 0x4a, 0x25, 0x4d, 0xb4, 0x33,
+	0xe8, 0x0e, 0xef, 0xcd, 0x1e, 0xf8, 0xce, 0xc4, 0x14, 0x6f, 0x1c, 0x1e, 0x1e, 0x21, 0x04, 0xa7,
+	0x15, 0xd7, 0xce, 0x3d, 0xea, 0x0e, 0x82, 0x61, 0xef, 0xb0, 0x4f, 0xda, 0x80, 0x64, 0x13, 0x90,
+	0x7c, 0xdc, 0x04, 0x9c, 0xee, 0x5e, 0x5c, 0xc7, 0xc1, 0x6c, 0x4b, 0x13, 0x3e, 0x45, 0xfb, 0x1b,
+	0xbf, 0xa4, 0x3e, 0x03, 0x6d, 0xa2, 0x5d, 0xe7, 0xb5, 0xe7, 0xd1, 0xa9, 0x03, 0xc3, 0xaf, 0x68,
+	0x5f, 0xf2, 0xd2, 0x8d, 0x54, 0x69, 0x9e, 0x82, 0x89, 0xee, 0xb8, 0x6c, 0x07, 0xc4, 0x6f, 0xbc,
+	0x39, 0x0f, 0xf1, 0xe7, 0x21, 0xc7, 0x8a, 0x97, 0xd3, 0x17, 0x97, 0xbf, 0xe2, 0xce, 0xb7, 0xeb,
+	0x78, 0x98, 0x73, 0xbb, 0xa8, 0x13, 0x92, 0x2a, 0xe9, 0xcf, 0xe3, 0x3f, 0x23, 0x93, 0x15, 0xd4,
+	0x9e, 0x55, 0x60, 0x9c, 0xc0, 0xcc, 0xee, 0x4b, 0x5e, 0x4e, 0x4c, 0xf1, 0xde, 0x19, 0xbc, 0x7a,
+	0xf8, 0xe3, 0xfb, 0x68, 0xef, 0xc6, 0x7a, 0xa7, 0x9f, 0x2e, 0x57, 0x38, 0xb8, 0x5a, 0xe1, 0xe0,
+	0xf7, 0x0a, 0x07, 0x17, 0x6b, 0xdc, 0xb9, 0x5a, 0xe3, 0xce, 0xcf, 0x35, 0xee, 0x7c, 0x7e, 0xbd,
+	0x65, 0xe2, 0xb6, 0x3d, 0x2a, 0xc1, 0x9e, 0x28, 0x5d, 0xf8, 0x4a, 0x40, 0x96, 0x83, 0xa6, 0xa7,
+	0xff, 0xff, 0x27, 0x93, 0xbb, 0x6e, 0x57, 0x2f, 0xff, 0x0e, 0x00, 0xda, 0x3e, 0xa2, 0xc7, 0xb9,
+	0x02, 0x00, 0x00,
+}
+
+func (m *SellOrderAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrderAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrderAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinAskPrices) > 0 {
+		for iNdEx := len(m.MinAskPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAskPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBuyers[iNdEx])
+			copy(dAtA[i:], m.AllowedBuyers[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedBuyers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Expiration != nil {
+		n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintAuthz(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AllowedAskDenoms) > 0 {
+		for iNdEx := len(m.AllowedAskDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAskDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedAskDenoms[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedAskDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SellLimits) > 0 {
+		for iNdEx := len(m.SellLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SellLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SellOrderAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SellLimits) > 0 {
+		for _, e := range m.SellLimits {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.AllowedAskDenoms) > 0 {
+		for _, s := range m.AllowedAskDenoms {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.Expiration != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for _, s := range m.AllowedBuyers {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.MinAskPrices) > 0 {
+		for _, e := range m.MinAskPrices {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SellOrderAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrderAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrderAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellLimits = append(m.SellLimits, &core.CreditLimit{})
+			if err := m.SellLimits[len(m.SellLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAskDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAskDenoms = append(m.AllowedAskDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyers = append(m.AllowedBuyers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAskPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAskPrices = append(m.MinAskPrices, types1.Coin{})
+			if err := m.MinAskPrices[len(m.MinAskPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuthz(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuthz
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuthz
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuthz
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuthz        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuthz          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuthz = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,80 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+type sellOrderAuthorizationSuite struct {
+	t             gocuke.TestingT
+	ctx           sdk.Context
+	authorization *SellOrderAuthorization
+	res           authz.AcceptResponse
+	err           error
+}
+
+func TestSellOrderAuthorization(t *testing.T) {
+	gocuke.NewRunner(t, &sellOrderAuthorizationSuite{}).Path("./features/authz_sell_order_authorization.feature").Run()
+}
+
+func (s *sellOrderAuthorizationSuite) Before(t gocuke.TestingT) {
+	s.t = t
+	s.ctx = sdk.Context{}.WithBlockTime(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC))
+}
+
+func (s *sellOrderAuthorizationSuite) TheAuthorization(a gocuke.DocString) {
+	s.authorization = &SellOrderAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, s.authorization)
+	require.NoError(s.t, err)
+}
+
+func (s *sellOrderAuthorizationSuite) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.ctx = s.ctx.WithBlockTime(blockTime)
+}
+
+func (s *sellOrderAuthorizationSuite) TheAuthorizationIsValidated() {
+	s.err = s.authorization.ValidateBasic()
+}
+
+func (s *sellOrderAuthorizationSuite) TheAuthorizationAcceptsTheMessage(a gocuke.DocString) {
+	msg := &MsgSell{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.authorization.Accept(s.ctx, msg)
+}
+
+func (s *sellOrderAuthorizationSuite) ExpectTheUpdatedAuthorization(a gocuke.DocString) {
+	expected := &SellOrderAuthorization{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.False(s.t, s.res.Delete)
+	require.Equal(s.t, expected, s.res.Updated)
+}
+
+func (s *sellOrderAuthorizationSuite) ExpectTheAuthorizationIsDeleted() {
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.res.Accept)
+	require.True(s.t, s.res.Delete)
+}
+
+func (s *sellOrderAuthorizationSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *sellOrderAuthorizationSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -7,11 +7,13 @@ import (
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	authzcodec "github.com/cosmos/cosmos-sdk/x/authz/codec"
 )
 
 func RegisterTypes(registry codectypes.InterfaceRegistry) {
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+	registry.RegisterImplementations((*authz.Authorization)(nil), &SellOrderAuthorization{})
 }
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
@@ -19,7 +21,20 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSell{}, "regen.marketplace/MsgSell", nil)
 	cdc.RegisterConcrete(&MsgCancelSellOrder{}, "regen.marketplace/MsgCancelSellOrder", nil)
 	cdc.RegisterConcrete(&MsgUpdateSellOrders{}, "regen.marketplace/MsgUpdateSellOrders", nil)
+	cdc.RegisterConcrete(&MsgBuy{}, "regen.marketplace/MsgBuy", nil)
+	cdc.RegisterConcrete(&MsgCancelBuyOrder{}, "regen.marketplace/MsgCancelBuyOrder", nil)
 	cdc.RegisterConcrete(&MsgAddAllowedDenom{}, "regen.marketplace/MsgAddAllowedDenom", nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowedDenom{}, "regen.marketplace/MsgRemoveAllowedDenom", nil)
+	cdc.RegisterConcrete(&MsgSetMarketClearingMode{}, "regen.marketplace/MsgSetMarketClearingMode", nil)
+	cdc.RegisterConcrete(&MsgSetMarketFees{}, "regen.marketplace/MsgSetMarketFees", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "regen.marketplace/MsgCreateAuction", nil)
+	cdc.RegisterConcrete(&MsgBid{}, "regen.marketplace/MsgBid", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "regen.marketplace/MsgCancelAuction", nil)
+	cdc.RegisterConcrete(&MsgCreateQuoteRequest{}, "regen.marketplace/MsgCreateQuoteRequest", nil)
+	cdc.RegisterConcrete(&MsgSubmitQuote{}, "regen.marketplace/MsgSubmitQuote", nil)
+	cdc.RegisterConcrete(&MsgAcceptQuote{}, "regen.marketplace/MsgAcceptQuote", nil)
+	cdc.RegisterConcrete(&MsgCancelQuoteRequest{}, "regen.marketplace/MsgCancelQuoteRequest", nil)
+	cdc.RegisterConcrete(&SellOrderAuthorization{}, "regen.marketplace/SellOrderAuthorization", nil)
 }
 
 var (
@@ -0,0 +1,620 @@
+Feature: SellOrderAuthorization
+
+  Scenario: a valid authorization
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "regen"
+      ],
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the authorization is validated
+    Then expect no error
+
+  Scenario: an error is returned if sell limits is empty
+    Given the authorization
+    """
+    {}
+    """
+    When the authorization is validated
+    Then expect the error "sell: limits cannot be empty: invalid request"
+
+  Scenario: an error is returned if a sell limit batch denom is not formatted
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "batch_denom": "foo",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "sell: limits[0]: invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if an allowed ask denom is not formatted
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "1"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "allowed ask denoms[0]: invalid denom: 1: invalid request"
+
+  Scenario: an error is returned if an allowed buyer is not a valid bech32 address
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_buyers": [
+        "foo"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "allowed buyers[0]: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if allowed buyers contains duplicates
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_buyers": [
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "allowed buyers[1]: duplicate address regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68: invalid request"
+
+  Scenario: an error is returned if a min ask price is not positive
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "0"
+        }
+      ]
+    }
+    """
+    When the authorization is validated
+    Then expect the error "min ask prices: coin 0regen amount is not positive: invalid request"
+
+  Scenario: the sell limit of the credit batch is decremented
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "100"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "regen"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "40",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "amount": "60"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "regen"
+      ]
+    }
+    """
+
+  Scenario: the authorization is deleted when the sell limits are spent
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "60",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        },
+        {
+          "batch_denom": "C01-001-20200101-20210101-002",
+          "quantity": "40",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the authorization is deleted
+
+  Scenario: an error is returned if the ask denom is not allowed
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "regen"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "atom",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: atom is not an allowed ask denom: unauthorized"
+
+  Scenario: an error is returned if the quantity exceeds the sell limit
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "101",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: amount 101 of C01-001-20200101-20210101-001 exceeds remaining limit 100: limit exceeded"
+
+  Scenario: an error is returned if the authorization is expired
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "expiration": "2022-01-01T00:00:00Z"
+    }
+    """
+    And the block time "2022-01-01T00:00:00Z"
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "authorization expired at 2022-01-01 00:00:00 +0000 UTC: unauthorized"
+
+  Scenario: a private sell order to an allowed buyer is accepted
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_buyers": [
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+          ]
+        }
+      ]
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "90"
+        }
+      ],
+      "allowed_buyers": [
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      ]
+    }
+    """
+
+  Scenario: an error is returned if a private sell order buyer is not an allowed buyer
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_buyers": [
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6"
+          ]
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: regen1depk54cuajgkzea6zpgkq36tnjwdzv4ak663u6 is not an allowed buyer: unauthorized"
+
+  Scenario: an error is returned if a private sell order is created without allowed buyers
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_ask_denoms": [
+        "regen"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+          ]
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68 is not an allowed buyer: unauthorized"
+
+  Scenario: an error is returned if a private sell order has an allowed buyer group policy
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "allowed_buyers": [
+        "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyer_group_policy": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: private sell orders with an allowed buyer group policy are not authorized: unauthorized"
+
+  Scenario: a sell order with the minimum ask price is accepted
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the updated authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "90"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+
+  Scenario: an error is returned if the ask price is less than the minimum ask price
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "99"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: ask price 99regen is less than the minimum ask price 100regen: unauthorized"
+
+  Scenario: an error is returned if the ask denom has no minimum ask price
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "atom",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: atom is not the denom of a minimum ask price: unauthorized"
+
+  Scenario: an error is returned if the auto-renew floor amount is less than the minimum ask price
+    Given the authorization
+    """
+    {
+      "sell_limits": [
+        {
+          "class_id": "C01",
+          "amount": "100"
+        }
+      ],
+      "min_ask_prices": [
+        {
+          "denom": "regen",
+          "amount": "100"
+        }
+      ]
+    }
+    """
+    When the authorization accepts the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "10",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "price_decay": "0.05",
+            "floor_amount": "50"
+          }
+        }
+      ]
+    }
+    """
+    Then expect the error "orders[0]: auto-renew floor amount 50 is less than the minimum ask price 100regen: unauthorized"
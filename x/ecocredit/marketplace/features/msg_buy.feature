@@ -0,0 +1,683 @@
+Feature: MsgBuy
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with project selection
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "project_id": "C01-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with class selection
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "class_id": "C01"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with class selection and criteria
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "class_id": "C01",
+            "project_jurisdiction": "US-OR",
+            "min_start_date": "2020-01-01T00:00:00Z",
+            "max_end_date": "2022-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with project selection and dates
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "project_id": "C01-001",
+            "min_start_date": "2020-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with disable auto-retire
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "disable_auto_retire": true
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with expiration
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "expiration": "2030-01-01T00:00:00Z"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with multiple orders
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        },
+        {
+          "selection": {
+            "class_id": "C01"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if orders is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "orders cannot be empty: invalid request"
+
+  Scenario: an error is returned if selection is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {}
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection cannot be empty: invalid request"
+
+  Scenario: an error is returned if selection does not select credits
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {},
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: one of batch denom, project id or class id is required: parse error: invalid request"
+
+  Scenario: an error is returned if selection batch denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "foo"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: invalid batch denom: expected format A00-000-00000000-00000000-000: parse error: invalid request"
+
+  Scenario: an error is returned if selection project id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "project_id": "foo"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: invalid project id: foo: parse error: invalid request"
+
+  Scenario: an error is returned if selection class id is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "class_id": "foo"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: class ID didn't match the format: expected A00, got foo: parse error: invalid request"
+
+  Scenario: an error is returned if selection batch denom has criteria
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001",
+            "min_start_date": "2020-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: criteria are not supported for a batch denom: parse error: invalid request"
+
+  Scenario: an error is returned if selection project id has project jurisdiction
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "project_id": "C01-001",
+            "project_jurisdiction": "US-OR"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: project jurisdiction is not supported for a project id: parse error: invalid request"
+
+  Scenario: an error is returned if selection project jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "class_id": "C01",
+            "project_jurisdiction": "foo"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if selection max end date is before min start date
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "class_id": "C01",
+            "min_start_date": "2021-01-01T00:00:00Z",
+            "max_end_date": "2020-01-01T00:00:00Z"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: selection: max end date cannot be before min start date: parse error: invalid request"
+
+  Scenario: an error is returned if order quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if order quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "-100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if bid price is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {}
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price denom is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "foo#bar"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: invalid denom: foo#bar: invalid request"
+
+  Scenario: an error is returned if bid price amount is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: amount cannot be empty: invalid request"
+
+  Scenario: an error is returned if bid price amount is not a positive integer
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "-100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: bid price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if disable auto-retire is false and retirement jurisdiction is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: jurisdiction cannot be empty, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if disable auto-retire is false and retirement jurisdiction is not formatted
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: a valid message with retirement beneficiary and reason
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "retirement_beneficiary": "Acme Corporation",
+          "retirement_reason": "offsetting emissions"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if retirement beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the message is validated
+    Then expect the error "orders[0]: retirement beneficiary: max length 256: limit exceeded"
+
+  Scenario: an error is returned if retirement reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "selection": {
+            "batch_denom": "C01-001-20200101-20210101-001"
+          },
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement reason with length "513"
+    When the message is validated
+    Then expect the error "orders[0]: retirement reason: max length 512: limit exceeded"
@@ -72,6 +72,38 @@ Feature: MsgBuyDirect
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with fill policies
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": "1",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "fill_policy": "FILL_POLICY_PARTIAL"
+        },
+        {
+          "sell_order_id": "2",
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "fill_policy": "FILL_POLICY_SKIP_ON_FAILURE"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: an error is returned if buyer is empty
     Given the message
     """
@@ -275,3 +307,92 @@ Feature: MsgBuyDirect
     """
     When the message is validated
     Then expect the error "orders[0]: invalid jurisdiction: foo, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: an error is returned if fill policy is unknown
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": 1,
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "fill_policy": 3
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: unknown fill policy: 3: invalid request"
+
+  Scenario: a valid message with retirement beneficiary and reason
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": 1,
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA",
+          "retirement_beneficiary": "Acme Corporation",
+          "retirement_reason": "offsetting emissions"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if retirement beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": 1,
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the message is validated
+    Then expect the error "orders[0]: retirement beneficiary: max length 256: limit exceeded"
+
+  Scenario: an error is returned if retirement reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "sell_order_id": 1,
+          "quantity": "100",
+          "bid_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+    }
+    """
+    And a retirement reason with length "513"
+    When the message is validated
+    Then expect the error "orders[0]: retirement reason: max length 512: limit exceeded"
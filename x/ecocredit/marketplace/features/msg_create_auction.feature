@@ -0,0 +1,406 @@
+Feature: MsgCreateAuction
+
+  Scenario: a valid dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "decay_interval": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid english auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "start_time": "2029-01-01T00:00:00Z",
+      "end_time": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if seller is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "seller cannot be empty: invalid request"
+
+  Scenario: an error is returned if seller is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if batch denom is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "batch denom cannot be empty: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if auction type is unspecified
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "auction type cannot be unspecified: invalid request"
+
+  Scenario: an error is returned if start price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH"
+    }
+    """
+    When the message is validated
+    Then expect the error "start price cannot be empty: invalid request"
+
+  Scenario: an error is returned if start price amount is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "0"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "start price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if reserve price is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "reserve price cannot be empty: invalid request"
+
+  Scenario: an error is returned if reserve price denom does not match start price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "uregen",
+        "amount": "50"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "reserve price denom uregen does not match start price denom regen: invalid request"
+
+  Scenario: an error is returned if end time is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "end time cannot be empty: invalid request"
+
+  Scenario: an error is returned if start time is not before end time
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "start_time": "2030-01-01T00:00:00Z",
+      "end_time": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "start time must be before end time: invalid request"
+
+  Scenario: an error is returned if price decrement is set for an english auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "start_time": "2029-01-01T00:00:00Z",
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "regen",
+        "amount": "10"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "price decrement is only allowed for dutch auctions: invalid request"
+
+  Scenario: an error is returned if decay interval is set for an english auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_ENGLISH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "start_time": "2029-01-01T00:00:00Z",
+      "end_time": "2030-01-01T00:00:00Z",
+      "decay_interval": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect the error "decay interval is only allowed for dutch auctions: invalid request"
+
+  Scenario: an error is returned if reserve price is greater than start price for a dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "200"
+      },
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "decay_interval": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect the error "reserve price cannot be greater than start price: invalid request"
+
+  Scenario: an error is returned if price decrement is empty for a dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect the error "price decrement cannot be empty: invalid request"
+
+  Scenario: an error is returned if price decrement denom does not match start price denom
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "uregen",
+        "amount": "10"
+      },
+      "decay_interval": "3600s"
+    }
+    """
+    When the message is validated
+    Then expect the error "price decrement denom uregen does not match start price denom regen: invalid request"
+
+  Scenario: an error is returned if decay interval is empty for a dutch auction
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "regen",
+        "amount": "10"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "decay interval cannot be empty: invalid request"
+
+  Scenario: an error is returned if decay interval is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "batch_denom": "C01-001-20200101-20210101-001",
+      "quantity": "100",
+      "auction_type": "AUCTION_TYPE_DUTCH",
+      "start_price": {
+        "denom": "regen",
+        "amount": "100"
+      },
+      "reserve_price": {
+        "denom": "regen",
+        "amount": "50"
+      },
+      "end_time": "2030-01-01T00:00:00Z",
+      "price_decrement": {
+        "denom": "regen",
+        "amount": "10"
+      },
+      "decay_interval": "0s"
+    }
+    """
+    When the message is validated
+    Then expect the error "decay interval must be positive: invalid request"
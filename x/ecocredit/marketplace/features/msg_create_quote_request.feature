@@ -0,0 +1,200 @@
+Feature: MsgCreateQuoteRequest
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "class_id": "C01",
+        "project_jurisdiction": "US-OR",
+        "min_start_date": "2020-01-01T00:00:00Z"
+      },
+      "quantity": "1000",
+      "denom": "regen",
+      "retirement_jurisdiction": "US-WA",
+      "expiration": "2030-01-01T00:00:00Z"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with auto-retire disabled
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      },
+      "quantity": "1000",
+      "denom": "regen",
+      "disable_auto_retire": true
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if buyer is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "buyer cannot be empty: invalid request"
+
+  Scenario: an error is returned if buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "buyer": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if selection is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "selection cannot be empty: invalid request"
+
+  Scenario: an error is returned if selection is not valid
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "foo"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "selection: invalid project id: foo: parse error: invalid request"
+
+  Scenario: an error is returned if quantity is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "C01-001"
+      }
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity cannot be empty: invalid request"
+
+  Scenario: an error is returned if quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "C01-001"
+      },
+      "quantity": "-100"
+    }
+    """
+    When the message is validated
+    Then expect the error "quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if denom is empty
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "C01-001"
+      },
+      "quantity": "100"
+    }
+    """
+    When the message is validated
+    Then expect the error "denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if denom is not valid
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "C01-001"
+      },
+      "quantity": "100",
+      "denom": "foo#bar"
+    }
+    """
+    When the message is validated
+    Then expect the error "denom: invalid denom: foo#bar: invalid request"
+
+  Scenario: an error is returned if retirement jurisdiction is empty and auto-retire is enabled
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "project_id": "C01-001"
+      },
+      "quantity": "100",
+      "denom": "regen"
+    }
+    """
+    When the message is validated
+    Then expect the error "jurisdiction cannot be empty, expected format <country-code>[-<region-code>[ <postal-code>]]: parse error: invalid request"
+
+  Scenario: a valid message with retirement beneficiary and reason
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      },
+      "quantity": "1000",
+      "denom": "regen",
+      "retirement_jurisdiction": "US-WA",
+      "retirement_beneficiary": "Acme Corporation",
+      "retirement_reason": "offsetting emissions"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if retirement beneficiary exceeds 256 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      },
+      "quantity": "1000",
+      "denom": "regen",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    And a retirement beneficiary with length "257"
+    When the message is validated
+    Then expect the error "retirement beneficiary: max length 256: limit exceeded"
+
+  Scenario: an error is returned if retirement reason exceeds 512 characters
+    Given the message
+    """
+    {
+      "buyer": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "selection": {
+        "batch_denom": "C01-001-20200101-20210101-001"
+      },
+      "quantity": "1000",
+      "denom": "regen",
+      "retirement_jurisdiction": "US-WA"
+    }
+    """
+    And a retirement reason with length "513"
+    When the message is validated
+    Then expect the error "retirement reason: max length 512: limit exceeded"
@@ -0,0 +1,51 @@
+Feature: MsgRemoveAllowedDenom
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "denom": "uregen"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if authority is not a valid bech32 address
+    Given the message
+    """
+    {
+      "authority": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: decoding bech32 failed: invalid bech32 string length 3"
+
+  Scenario: an error is returned if denom is empty
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid denom: invalid denom: : invalid request"
+
+  Scenario: an error is returned if denom is not formatted
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "denom": "A+B"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid denom: invalid denom: A+B: invalid request"
@@ -62,6 +62,122 @@ Feature: MsgSell
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with min fill quantity and lot size
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "min_fill_quantity": "20",
+          "lot_size": "10"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with allowed buyers and allowed buyer group policy
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "allowed_buyers": [
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+          ],
+          "allowed_buyer_group_policy": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with good-til-cancelled time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_GOOD_TIL_CANCELLED"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with good-til-date time in force and auto-renew
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "price_decay": "0.05",
+            "floor_amount": "50",
+            "max_renewals": 10
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with immediate-or-cancel time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: a valid message with multiple orders
     Given the message
     """
@@ -177,6 +293,96 @@ Feature: MsgSell
     When the message is validated
     Then expect the error "orders[0]: quantity must be a positive decimal: invalid request"
 
+  Scenario: an error is returned if order min fill quantity is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "min_fill_quantity": "0"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: min fill quantity must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if order lot size is not a positive decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "lot_size": "-10"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: lot size must be a positive decimal: invalid request"
+
+  Scenario: an error is returned if order allowed buyer is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "allowed_buyers": [
+            "foo"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyer is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
+  Scenario: an error is returned if order allowed buyers contains duplicates
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "allowed_buyers": [
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: duplicate allowed buyer regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68: invalid request"
+
+  Scenario: an error is returned if order allowed buyer group policy is not a valid bech32 address
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "allowed_buyer_group_policy": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: allowed buyer group policy is not a valid address: decoding bech32 failed: invalid bech32 string length 3: invalid address"
+
   Scenario: an error is returned if ask price is empty
     Given the message
     """
@@ -267,3 +473,284 @@ Feature: MsgSell
     """
     When the message is validated
     Then expect the error "orders[0]: ask price: amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if order expiration is set for good-til-cancelled time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_GOOD_TIL_CANCELLED",
+          "expiration": "2030-01-01T00:00:00Z"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: expiration must be empty for good-til-cancelled sell orders: invalid request"
+
+  Scenario: an error is returned if order expiration is empty for good-til-date time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: expiration is required for good-til-date sell orders: invalid request"
+
+  Scenario: an error is returned if order expiration is set for immediate-or-cancel time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+          "expiration": "2030-01-01T00:00:00Z"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: expiration must be empty for immediate-or-cancel sell orders: invalid request"
+
+  Scenario: an error is returned if order is private with immediate-or-cancel time in force
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+          "allowed_buyers": [
+            "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+          ]
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: immediate-or-cancel sell orders cannot be private: invalid request"
+
+  Scenario: an error is returned if order time in force is invalid
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "time_in_force": 9
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: invalid time in force: 9: invalid request"
+
+  Scenario: an error is returned if order auto-renew is set without expiration
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "auto_renew": {
+            "renewal_period": "86400s"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: expiration is required: invalid request"
+
+  Scenario: an error is returned if order auto-renew renewal period is empty
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "price_decay": "0.05"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: renewal period cannot be empty: invalid request"
+
+  Scenario: an error is returned if order auto-renew renewal period is not positive
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "0s"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: renewal period must be positive: invalid request"
+
+  Scenario: an error is returned if order auto-renew price decay is not a non-negative decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "price_decay": "-0.05"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: price decay must be a non-negative decimal: invalid request"
+
+  Scenario: an error is returned if order auto-renew price decay is not less than one
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "price_decay": "1"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: price decay must be less than one: invalid request"
+
+  Scenario: an error is returned if order auto-renew floor amount is not a positive integer
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "floor_amount": "0"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: floor amount must be a positive integer: invalid request"
+
+  Scenario: an error is returned if order auto-renew floor amount exceeds ask amount
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "orders": [
+        {
+          "batch_denom": "C01-001-20200101-20210101-001",
+          "quantity": "100",
+          "ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "expiration": "2030-01-01T00:00:00Z",
+          "auto_renew": {
+            "renewal_period": "86400s",
+            "floor_amount": "200"
+          }
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "orders[0]: auto-renew: floor amount 200 exceeds ask amount 100: invalid request"
@@ -0,0 +1,115 @@
+Feature: MsgSetMarketClearingMode
+
+  Scenario: a valid message with continuous clearing
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message with batch auction clearing
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "clearing_mode": "CLEARING_MODE_BATCH_AUCTION",
+      "epoch_length": 100
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if authority is not a valid bech32 address
+    Given the message
+    """
+    {
+      "authority": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: decoding bech32 failed: invalid bech32 string length 3"
+
+  Scenario: an error is returned if credit type abbreviation is empty
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation cannot be empty: parse error"
+
+  Scenario: an error is returned if credit type abbreviation is not formatted
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation must be 1-3 uppercase latin letters: got foo: parse error"
+
+  Scenario: an error is returned if bank denom is empty
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bank denom is not formatted
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "foo#bar"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom: invalid denom: foo#bar: invalid request"
+
+  Scenario: an error is returned if epoch length is set for continuous clearing
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "epoch_length": 100
+    }
+    """
+    When the message is validated
+    Then expect the error "epoch length must be empty for continuous clearing: invalid request"
+
+  Scenario: an error is returned if epoch length is empty for batch auction clearing
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "clearing_mode": "CLEARING_MODE_BATCH_AUCTION"
+    }
+    """
+    When the message is validated
+    Then expect the error "epoch length cannot be empty for batch auction clearing: invalid request"
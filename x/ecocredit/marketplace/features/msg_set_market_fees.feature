@@ -0,0 +1,131 @@
+Feature: MsgSetMarketFees
+
+  Scenario: a valid message
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "buyer_fee": "0.01",
+      "seller_fee": "0.02",
+      "fee_destination": "FEE_DESTINATION_COMMUNITY_POOL"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: a valid message without fees
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen"
+    }
+    """
+    When the message is validated
+    Then expect no error
+
+  Scenario: an error is returned if authority is empty
+    Given the message
+    """
+    {}
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: empty address string is not allowed"
+
+  Scenario: an error is returned if authority is not a valid bech32 address
+    Given the message
+    """
+    {
+      "authority": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "invalid authority address: decoding bech32 failed: invalid bech32 string length 3"
+
+  Scenario: an error is returned if credit type abbreviation is empty
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+    }
+    """
+    When the message is validated
+    Then expect the error "credit type abbreviation cannot be empty: parse error"
+
+  Scenario: an error is returned if bank denom is empty
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom cannot be empty: invalid request"
+
+  Scenario: an error is returned if bank denom is not formatted
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "foo#bar"
+    }
+    """
+    When the message is validated
+    Then expect the error "bank denom: invalid denom: foo#bar: invalid request"
+
+  Scenario: an error is returned if buyer fee is not a decimal
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "buyer_fee": "foo"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer fee: parse mantissa: foo: invalid decimal string: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if buyer fee is negative
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "buyer_fee": "-0.01"
+    }
+    """
+    When the message is validated
+    Then expect the error "buyer fee: expected a non-negative decimal, got -0.01: invalid decimal string: invalid request"
+
+  Scenario: an error is returned if seller fee is not less than one
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "seller_fee": "1"
+    }
+    """
+    When the message is validated
+    Then expect the error "seller fee: expected a decimal less than 1, got 1: invalid request"
+
+  Scenario: an error is returned if fee destination is unknown
+    Given the message
+    """
+    {
+      "authority": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "credit_type_abbrev": "C",
+      "bank_denom": "uregen",
+      "fee_destination": 5
+    }
+    """
+    When the message is validated
+    Then expect the error "unknown fee destination: 5: invalid request"
@@ -62,6 +62,28 @@ Feature: MsgUpdateSellOrders
     When the message is validated
     Then expect no error
 
+  Scenario: a valid message with new min fill quantity and new lot size
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_ask_price": {
+            "denom": "regen",
+            "amount": "100"
+          },
+          "new_min_fill_quantity": "0",
+          "new_lot_size": "10"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect no error
+
   Scenario: a valid message with multiple updates
     Given the message
     """
@@ -162,6 +184,40 @@ Feature: MsgUpdateSellOrders
     When the message is validated
     Then expect the error "updates[0]: new quantity must be a positive decimal: invalid request"
 
+  Scenario: an error is returned if update new min fill quantity is not a non-negative decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_min_fill_quantity": "-1"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "updates[0]: new min fill quantity must be a non-negative decimal: invalid request"
+
+  Scenario: an error is returned if update new lot size is not a non-negative decimal
+    Given the message
+    """
+    {
+      "seller": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+      "updates": [
+        {
+          "sell_order_id": 1,
+          "new_quantity": "100",
+          "new_lot_size": "foo"
+        }
+      ]
+    }
+    """
+    When the message is validated
+    Then expect the error "updates[0]: new lot size must be a non-negative decimal: invalid request"
+
   Scenario: an error is returned if ask price is empty
     Given the message
     """
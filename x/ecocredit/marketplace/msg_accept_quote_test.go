@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgAcceptQuoteSuite struct {
+	t   gocuke.TestingT
+	msg *MsgAcceptQuote
+	err error
+}
+
+func TestMsgAcceptQuote(t *testing.T) {
+	gocuke.NewRunner(t, &msgAcceptQuoteSuite{}).Path("./features/msg_accept_quote.feature").Run()
+}
+
+func (s *msgAcceptQuoteSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgAcceptQuoteSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgAcceptQuote{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgAcceptQuoteSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgAcceptQuoteSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgAcceptQuoteSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
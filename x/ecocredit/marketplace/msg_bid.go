@@ -0,0 +1,45 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+var _ legacytx.LegacyMsg = &MsgBid{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBid) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBid) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBid) ValidateBasic() error {
+	if len(m.Bidder) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("bidder cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Bidder); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("bidder is not a valid address: %s", err)
+	}
+
+	if m.AuctionId == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction id cannot be empty")
+	}
+
+	return validatePrice("bid price", m.BidPrice)
+}
+
+// GetSigners returns the expected signers for MsgBid.
+func (m *MsgBid) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Bidder)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBidSuite struct {
+	t   gocuke.TestingT
+	msg *MsgBid
+	err error
+}
+
+func TestMsgBid(t *testing.T) {
+	gocuke.NewRunner(t, &msgBidSuite{}).Path("./features/msg_bid.feature").Run()
+}
+
+func (s *msgBidSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBidSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBid{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBidSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBidSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBidSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,148 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgBuy{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgBuy) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgBuy) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgBuy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgBuy) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if len(m.Orders) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("orders cannot be empty")
+	}
+
+	for i, order := range m.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		if order.Selection == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: selection cannot be empty", orderIndex)
+		}
+
+		if err := order.Selection.validate(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: selection: %s", orderIndex, err)
+		}
+
+		if len(order.Quantity) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity cannot be empty", orderIndex)
+		}
+
+		if _, err := math.NewPositiveDecFromString(order.Quantity); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity must be a positive decimal", orderIndex)
+		}
+
+		// sdk.Coin.Validate panics if coin is nil
+		if order.BidPrice == nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin denom is empty
+		if len(order.BidPrice.Denom) == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: denom cannot be empty", orderIndex)
+		}
+
+		if err := sdk.ValidateDenom(order.BidPrice.Denom); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: %s", orderIndex, err)
+		}
+
+		// sdk.Coin.Validate panics if coin amount is nil
+		if order.BidPrice.Amount.IsNil() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount cannot be empty", orderIndex)
+		}
+
+		// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+		if !order.BidPrice.Amount.IsPositive() {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: bid price: amount must be a positive integer", orderIndex)
+		}
+
+		if !order.DisableAutoRetire {
+			if err := core.ValidateJurisdiction(order.RetirementJurisdiction); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err)
+			}
+		}
+
+		if len(order.RetirementBeneficiary) > core.MaxBeneficiaryLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", orderIndex, core.MaxBeneficiaryLength)
+		}
+
+		if len(order.RetirementReason) > core.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", orderIndex, core.MaxNoteLength)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgBuy.
+func (m *MsgBuy) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
+
+// validate checks that one of credit batch, project or credit class is selected,
+// that the selected identifier is formatted correctly and that the criteria are
+// supported by the selection.
+func (s *MsgBuy_Selection) validate() error {
+	switch sum := s.Sum.(type) {
+	case *MsgBuy_Selection_BatchDenom:
+		if err := core.ValidateBatchDenom(sum.BatchDenom); err != nil {
+			return err
+		}
+		if s.ProjectJurisdiction != "" || s.MinStartDate != nil || s.MaxEndDate != nil {
+			return ecocredit.ErrParseFailure.Wrap("criteria are not supported for a batch denom")
+		}
+	case *MsgBuy_Selection_ProjectId:
+		if err := core.ValidateProjectId(sum.ProjectId); err != nil {
+			return err
+		}
+		if s.ProjectJurisdiction != "" {
+			return ecocredit.ErrParseFailure.Wrap("project jurisdiction is not supported for a project id")
+		}
+	case *MsgBuy_Selection_ClassId:
+		if err := core.ValidateClassId(sum.ClassId); err != nil {
+			return err
+		}
+		if s.ProjectJurisdiction != "" {
+			if err := core.ValidateJurisdiction(s.ProjectJurisdiction); err != nil {
+				return err
+			}
+		}
+	default:
+		return ecocredit.ErrParseFailure.Wrap("one of batch denom, project id or class id is required")
+	}
+
+	if s.MinStartDate != nil && s.MaxEndDate != nil && s.MaxEndDate.Before(*s.MinStartDate) {
+		return ecocredit.ErrParseFailure.Wrap("max end date cannot be before min start date")
+	}
+
+	return nil
+}
@@ -73,6 +73,18 @@ func (m MsgBuyDirect) ValidateBasic() error {
 				return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err)
 			}
 		}
+
+		if len(order.RetirementBeneficiary) > core.MaxBeneficiaryLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement beneficiary: max length %d", orderIndex, core.MaxBeneficiaryLength)
+		}
+
+		if len(order.RetirementReason) > core.MaxNoteLength {
+			return ecocredit.ErrMaxLimit.Wrapf("%s: retirement reason: max length %d", orderIndex, core.MaxNoteLength)
+		}
+
+		if _, ok := FillPolicy_name[int32(order.FillPolicy)]; !ok {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: unknown fill policy: %d", orderIndex, order.FillPolicy)
+		}
 	}
 
 	return nil
@@ -1,6 +1,8 @@
 package marketplace
 
 import (
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/jsonpb"
@@ -28,6 +30,24 @@ func (s *msgBuyDirectSuite) TheMessage(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *msgBuyDirectSuite) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	for _, order := range s.msg.Orders {
+		order.RetirementBeneficiary = strings.Repeat("x", int(length))
+	}
+}
+
+func (s *msgBuyDirectSuite) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	for _, order := range s.msg.Orders {
+		order.RetirementReason = strings.Repeat("x", int(length))
+	}
+}
+
 func (s *msgBuyDirectSuite) TheMessageIsValidated() {
 	s.err = s.msg.ValidateBasic()
 }
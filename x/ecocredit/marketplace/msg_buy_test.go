@@ -0,0 +1,61 @@
+package marketplace
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgBuySuite struct {
+	t   gocuke.TestingT
+	msg *MsgBuy
+	err error
+}
+
+func TestMsgBuy(t *testing.T) {
+	gocuke.NewRunner(t, &msgBuySuite{}).Path("./features/msg_buy.feature").Run()
+}
+
+func (s *msgBuySuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgBuySuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgBuy{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgBuySuite) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	for _, order := range s.msg.Orders {
+		order.RetirementBeneficiary = strings.Repeat("x", int(length))
+	}
+}
+
+func (s *msgBuySuite) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	for _, order := range s.msg.Orders {
+		order.RetirementReason = strings.Repeat("x", int(length))
+	}
+}
+
+func (s *msgBuySuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgBuySuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgBuySuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
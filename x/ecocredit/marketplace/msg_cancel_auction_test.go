@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelAuction
+	err error
+}
+
+func TestMsgCancelAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelAuctionSuite{}).Path("./features/msg_cancel_auction.feature").Run()
+}
+
+func (s *msgCancelAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
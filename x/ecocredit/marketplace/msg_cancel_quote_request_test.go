@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCancelQuoteRequestSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCancelQuoteRequest
+	err error
+}
+
+func TestMsgCancelQuoteRequest(t *testing.T) {
+	gocuke.NewRunner(t, &msgCancelQuoteRequestSuite{}).Path("./features/msg_cancel_quote_request.feature").Run()
+}
+
+func (s *msgCancelQuoteRequestSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCancelQuoteRequestSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCancelQuoteRequest{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCancelQuoteRequestSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCancelQuoteRequestSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCancelQuoteRequestSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
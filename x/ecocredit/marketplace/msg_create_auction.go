@@ -0,0 +1,146 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateAuction{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateAuction) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateAuction) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateAuction) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateAuction) ValidateBasic() error {
+	if len(m.Seller) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("seller cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Seller); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("seller is not a valid address: %s", err)
+	}
+
+	if err := core.ValidateBatchDenom(m.BatchDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if m.AuctionType == AuctionType_AUCTION_TYPE_UNSPECIFIED {
+		return sdkerrors.ErrInvalidRequest.Wrap("auction type cannot be unspecified")
+	}
+
+	if _, ok := AuctionType_name[int32(m.AuctionType)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown auction type: %d", m.AuctionType)
+	}
+
+	if err := validatePrice("start price", m.StartPrice); err != nil {
+		return err
+	}
+
+	if err := validatePrice("reserve price", m.ReservePrice); err != nil {
+		return err
+	}
+
+	if m.ReservePrice.Denom != m.StartPrice.Denom {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"reserve price denom %s does not match start price denom %s", m.ReservePrice.Denom, m.StartPrice.Denom,
+		)
+	}
+
+	if m.EndTime == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("end time cannot be empty")
+	}
+
+	if m.StartTime != nil && !m.StartTime.Before(*m.EndTime) {
+		return sdkerrors.ErrInvalidRequest.Wrap("start time must be before end time")
+	}
+
+	if m.AuctionType == AuctionType_AUCTION_TYPE_ENGLISH {
+		if m.PriceDecrement != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("price decrement is only allowed for dutch auctions")
+		}
+		if m.DecayInterval != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap("decay interval is only allowed for dutch auctions")
+		}
+		return nil
+	}
+
+	if m.ReservePrice.Amount.GT(m.StartPrice.Amount) {
+		return sdkerrors.ErrInvalidRequest.Wrap("reserve price cannot be greater than start price")
+	}
+
+	if err := validatePrice("price decrement", m.PriceDecrement); err != nil {
+		return err
+	}
+
+	if m.PriceDecrement.Denom != m.StartPrice.Denom {
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"price decrement denom %s does not match start price denom %s", m.PriceDecrement.Denom, m.StartPrice.Denom,
+		)
+	}
+
+	if m.DecayInterval == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("decay interval cannot be empty")
+	}
+
+	if *m.DecayInterval <= 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("decay interval must be positive")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateAuction.
+func (m *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Seller)
+	return []sdk.AccAddress{addr}
+}
+
+// validatePrice checks that the price is a coin with a valid denom and a positive amount.
+func validatePrice(name string, price *sdk.Coin) error {
+	// sdk.Coin.Validate panics if coin is nil
+	if price == nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s cannot be empty", name)
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin denom is empty
+	if len(price.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: denom cannot be empty", name)
+	}
+
+	if err := sdk.ValidateDenom(price.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", name, err)
+	}
+
+	// sdk.Coin.Validate panics if coin amount is nil
+	if price.Amount.IsNil() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: amount cannot be empty", name)
+	}
+
+	// sdk.Coin.Validate provides inadequate error if coin amount is not a positive integer
+	if !price.Amount.IsPositive() {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: amount must be a positive integer", name)
+	}
+
+	return nil
+}
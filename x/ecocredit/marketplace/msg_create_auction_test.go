@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateAuctionSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateAuction
+	err error
+}
+
+func TestMsgCreateAuction(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateAuctionSuite{}).Path("./features/msg_create_auction.feature").Run()
+}
+
+func (s *msgCreateAuctionSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateAuctionSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateAuction{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateAuctionSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,81 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgCreateQuoteRequest{}
+
+// Route implements the LegacyMsg interface.
+func (m MsgCreateQuoteRequest) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgCreateQuoteRequest) Type() string { return sdk.MsgTypeURL(&m) }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgCreateQuoteRequest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCreateQuoteRequest) ValidateBasic() error {
+	if len(m.Buyer) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("buyer cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Buyer); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("buyer is not a valid address: %s", err)
+	}
+
+	if m.Selection == nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("selection cannot be empty")
+	}
+
+	if err := m.Selection.validate(); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("selection: %s", err)
+	}
+
+	if len(m.Quantity) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity cannot be empty")
+	}
+
+	if _, err := math.NewPositiveDecFromString(m.Quantity); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be a positive decimal")
+	}
+
+	if len(m.Denom) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("denom: %s", err)
+	}
+
+	if !m.DisableAutoRetire {
+		if err := core.ValidateJurisdiction(m.RetirementJurisdiction); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+		}
+	}
+
+	if len(m.RetirementBeneficiary) > core.MaxBeneficiaryLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement beneficiary: max length %d", core.MaxBeneficiaryLength)
+	}
+
+	if len(m.RetirementReason) > core.MaxNoteLength {
+		return ecocredit.ErrMaxLimit.Wrapf("retirement reason: max length %d", core.MaxNoteLength)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgCreateQuoteRequest.
+func (m *MsgCreateQuoteRequest) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Buyer)
+	return []sdk.AccAddress{addr}
+}
@@ -0,0 +1,57 @@
+package marketplace
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgCreateQuoteRequestSuite struct {
+	t   gocuke.TestingT
+	msg *MsgCreateQuoteRequest
+	err error
+}
+
+func TestMsgCreateQuoteRequest(t *testing.T) {
+	gocuke.NewRunner(t, &msgCreateQuoteRequestSuite{}).Path("./features/msg_create_quote_request.feature").Run()
+}
+
+func (s *msgCreateQuoteRequestSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgCreateQuoteRequestSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgCreateQuoteRequest{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgCreateQuoteRequestSuite) ARetirementBeneficiaryWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementBeneficiary = strings.Repeat("x", int(length))
+}
+
+func (s *msgCreateQuoteRequestSuite) ARetirementReasonWithLength(a string) {
+	length, err := strconv.ParseInt(a, 10, 64)
+	require.NoError(s.t, err)
+
+	s.msg.RetirementReason = strings.Repeat("x", int(length))
+}
+
+func (s *msgCreateQuoteRequestSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgCreateQuoteRequestSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgCreateQuoteRequestSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
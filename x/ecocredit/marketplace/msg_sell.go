@@ -56,6 +56,35 @@ func (m *MsgSell) ValidateBasic() error {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: quantity must be a positive decimal", orderIndex)
 		}
 
+		if len(order.MinFillQuantity) != 0 {
+			if _, err := math.NewPositiveDecFromString(order.MinFillQuantity); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity must be a positive decimal", orderIndex)
+			}
+		}
+
+		if len(order.LotSize) != 0 {
+			if _, err := math.NewPositiveDecFromString(order.LotSize); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: lot size must be a positive decimal", orderIndex)
+			}
+		}
+
+		seen := make(map[string]bool)
+		for _, allowedBuyer := range order.AllowedBuyers {
+			if _, err := sdk.AccAddressFromBech32(allowedBuyer); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("%s: allowed buyer is not a valid address: %s", orderIndex, err)
+			}
+			if seen[allowedBuyer] {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: duplicate allowed buyer %s", orderIndex, allowedBuyer)
+			}
+			seen[allowedBuyer] = true
+		}
+
+		if len(order.AllowedBuyerGroupPolicy) != 0 {
+			if _, err := sdk.AccAddressFromBech32(order.AllowedBuyerGroupPolicy); err != nil {
+				return sdkerrors.ErrInvalidAddress.Wrapf("%s: allowed buyer group policy is not a valid address: %s", orderIndex, err)
+			}
+		}
+
 		// sdk.Coin.Validate panics if coin is nil
 		if order.AskPrice == nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: ask price: cannot be empty", orderIndex)
@@ -79,6 +108,71 @@ func (m *MsgSell) ValidateBasic() error {
 		if !order.AskPrice.Amount.IsPositive() {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: ask price: amount must be a positive integer", orderIndex)
 		}
+
+		switch order.TimeInForce {
+		case TimeInForce_TIME_IN_FORCE_UNSPECIFIED:
+		case TimeInForce_TIME_IN_FORCE_GOOD_TIL_CANCELLED:
+			if order.Expiration != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: expiration must be empty for good-til-cancelled sell orders", orderIndex)
+			}
+		case TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE:
+			if order.Expiration == nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: expiration is required for good-til-date sell orders", orderIndex)
+			}
+		case TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL:
+			if order.Expiration != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: expiration must be empty for immediate-or-cancel sell orders", orderIndex)
+			}
+			if len(order.AllowedBuyers) != 0 || len(order.AllowedBuyerGroupPolicy) != 0 {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: immediate-or-cancel sell orders cannot be private", orderIndex)
+			}
+		default:
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: invalid time in force: %s", orderIndex, order.TimeInForce)
+		}
+
+		if order.AutoRenew != nil {
+			if order.Expiration == nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: auto-renew: expiration is required", orderIndex)
+			}
+			if err := order.AutoRenew.validate(order.AskPrice.Amount); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: auto-renew: %s", orderIndex, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validate checks that the renewal period is positive, that the optional price decay is
+// a decimal fraction less than one and that the optional floor amount is a positive
+// integer less than or equal to the ask amount.
+func (a *MsgSell_AutoRenew) validate(askAmount sdk.Int) error {
+	if a.RenewalPeriod == nil {
+		return fmt.Errorf("renewal period cannot be empty")
+	}
+
+	if *a.RenewalPeriod <= 0 {
+		return fmt.Errorf("renewal period must be positive")
+	}
+
+	if len(a.PriceDecay) != 0 {
+		decay, err := math.NewNonNegativeDecFromString(a.PriceDecay)
+		if err != nil {
+			return fmt.Errorf("price decay must be a non-negative decimal")
+		}
+		if decay.Cmp(math.NewDecFromInt64(1)) != math.LessThan {
+			return fmt.Errorf("price decay must be less than one")
+		}
+	}
+
+	if len(a.FloorAmount) != 0 {
+		floor, ok := sdk.NewIntFromString(a.FloorAmount)
+		if !ok || !floor.IsPositive() {
+			return fmt.Errorf("floor amount must be a positive integer")
+		}
+		if floor.GT(askAmount) {
+			return fmt.Errorf("floor amount %s exceeds ask amount %s", floor, askAmount)
+		}
 	}
 
 	return nil
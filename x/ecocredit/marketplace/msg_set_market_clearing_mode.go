@@ -0,0 +1,63 @@
+package marketplace
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgSetMarketClearingMode{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgSetMarketClearingMode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address")
+	}
+
+	if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return err
+	}
+
+	if m.BankDenom == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("bank denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("bank denom: %s", err.Error())
+	}
+
+	switch m.ClearingMode {
+	case ClearingMode_CLEARING_MODE_CONTINUOUS:
+		if m.EpochLength != 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("epoch length must be empty for continuous clearing")
+		}
+	case ClearingMode_CLEARING_MODE_BATCH_AUCTION:
+		if m.EpochLength == 0 {
+			return sdkerrors.ErrInvalidRequest.Wrap("epoch length cannot be empty for batch auction clearing")
+		}
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown clearing mode: %d", m.ClearingMode)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSetMarketClearingMode.
+func (m MsgSetMarketClearingMode) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetMarketClearingMode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSetMarketClearingMode) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSetMarketClearingMode) Type() string { return sdk.MsgTypeURL(&m) }
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSetMarketClearingModeSuite struct {
+	t   gocuke.TestingT
+	msg *MsgSetMarketClearingMode
+	err error
+}
+
+func TestMsgSetMarketClearingModeSuite(t *testing.T) {
+	gocuke.NewRunner(t, &msgSetMarketClearingModeSuite{}).Path("./features/msg_set_market_clearing_mode.feature").Run()
+}
+
+func (s *msgSetMarketClearingModeSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSetMarketClearingModeSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSetMarketClearingMode{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSetMarketClearingModeSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSetMarketClearingModeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSetMarketClearingModeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
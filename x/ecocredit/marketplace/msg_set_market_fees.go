@@ -0,0 +1,84 @@
+package marketplace
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+var _ legacytx.LegacyMsg = &MsgSetMarketFees{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m MsgSetMarketFees) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address")
+	}
+
+	if err := core.ValidateCreditTypeAbbreviation(m.CreditTypeAbbrev); err != nil {
+		return err
+	}
+
+	if m.BankDenom == "" {
+		return sdkerrors.ErrInvalidRequest.Wrap("bank denom cannot be empty")
+	}
+
+	if err := sdk.ValidateDenom(m.BankDenom); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("bank denom: %s", err.Error())
+	}
+
+	if err := ValidateFee(m.BuyerFee); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("buyer fee: %s", err.Error())
+	}
+
+	if err := ValidateFee(m.SellerFee); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("seller fee: %s", err.Error())
+	}
+
+	if _, ok := FeeDestination_name[int32(m.FeeDestination)]; !ok {
+		return sdkerrors.ErrInvalidRequest.Wrapf("unknown fee destination: %d", m.FeeDestination)
+	}
+
+	return nil
+}
+
+// ValidateFee checks that the fee is either empty or a decimal fraction greater than
+// or equal to zero and less than one.
+func ValidateFee(fee string) error {
+	if fee == "" {
+		return nil
+	}
+
+	dec, err := math.NewNonNegativeDecFromString(fee)
+	if err != nil {
+		return err
+	}
+
+	if dec.Cmp(math.NewDecFromInt64(1)) != math.LessThan {
+		return fmt.Errorf("expected a decimal less than 1, got %s", fee)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSetMarketFees.
+func (m MsgSetMarketFees) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgSetMarketFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ecocredit.ModuleCdc.MustMarshalJSON(&m))
+}
+
+// Route implements the LegacyMsg interface.
+func (m MsgSetMarketFees) Route() string { return sdk.MsgTypeURL(&m) }
+
+// Type implements the LegacyMsg interface.
+func (m MsgSetMarketFees) Type() string { return sdk.MsgTypeURL(&m) }
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSetMarketFeesSuite struct {
+	t   gocuke.TestingT
+	msg *MsgSetMarketFees
+	err error
+}
+
+func TestMsgSetMarketFeesSuite(t *testing.T) {
+	gocuke.NewRunner(t, &msgSetMarketFeesSuite{}).Path("./features/msg_set_market_fees.feature").Run()
+}
+
+func (s *msgSetMarketFeesSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSetMarketFeesSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSetMarketFees{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSetMarketFeesSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSetMarketFeesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSetMarketFeesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -0,0 +1,41 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+)
+
+type msgSubmitQuoteSuite struct {
+	t   gocuke.TestingT
+	msg *MsgSubmitQuote
+	err error
+}
+
+func TestMsgSubmitQuote(t *testing.T) {
+	gocuke.NewRunner(t, &msgSubmitQuoteSuite{}).Path("./features/msg_submit_quote.feature").Run()
+}
+
+func (s *msgSubmitQuoteSuite) Before(t gocuke.TestingT) {
+	s.t = t
+}
+
+func (s *msgSubmitQuoteSuite) TheMessage(a gocuke.DocString) {
+	s.msg = &MsgSubmitQuote{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg)
+	require.NoError(s.t, err)
+}
+
+func (s *msgSubmitQuoteSuite) TheMessageIsValidated() {
+	s.err = s.msg.ValidateBasic()
+}
+
+func (s *msgSubmitQuoteSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *msgSubmitQuoteSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
@@ -53,6 +53,18 @@ func (m *MsgUpdateSellOrders) ValidateBasic() error {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: new quantity must be a positive decimal", updateIndex)
 		}
 
+		if len(update.NewMinFillQuantity) != 0 {
+			if _, err := math.NewNonNegativeDecFromString(update.NewMinFillQuantity); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: new min fill quantity must be a non-negative decimal", updateIndex)
+			}
+		}
+
+		if len(update.NewLotSize) != 0 {
+			if _, err := math.NewNonNegativeDecFromString(update.NewLotSize); err != nil {
+				return sdkerrors.ErrInvalidRequest.Wrapf("%s: new lot size must be a non-negative decimal", updateIndex)
+			}
+		}
+
 		// sdk.Coin.Validate panics if coin is nil
 		if update.NewAskPrice == nil {
 			return sdkerrors.ErrInvalidRequest.Wrapf("%s: new ask price cannot be empty", updateIndex)
@@ -24,6 +24,151 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AuctionType defines how the price of an auction is discovered.
+type AuctionType int32
+
+const (
+	// AUCTION_TYPE_UNSPECIFIED is an invalid auction type.
+	AuctionType_AUCTION_TYPE_UNSPECIFIED AuctionType = 0
+	// AUCTION_TYPE_DUTCH is a descending price auction. The price decays from
+	// the start price by the price decrement at the end of each decay interval
+	// until it reaches the reserve price and the first bid greater than or equal
+	// to the current price wins the auction at the current price.
+	AuctionType_AUCTION_TYPE_DUTCH AuctionType = 1
+	// AUCTION_TYPE_ENGLISH is an ascending price auction. Bids are accepted
+	// within the bidding window of the auction and the highest bid wins the
+	// auction at the price of the bid if it is greater than or equal to the
+	// reserve price.
+	AuctionType_AUCTION_TYPE_ENGLISH AuctionType = 2
+)
+
+var AuctionType_name = map[int32]string{
+	0: "AUCTION_TYPE_UNSPECIFIED",
+	1: "AUCTION_TYPE_DUTCH",
+	2: "AUCTION_TYPE_ENGLISH",
+}
+
+var AuctionType_value = map[string]int32{
+	"AUCTION_TYPE_UNSPECIFIED": 0,
+	"AUCTION_TYPE_DUTCH":       1,
+	"AUCTION_TYPE_ENGLISH":     2,
+}
+
+func (x AuctionType) String() string {
+	return proto.EnumName(AuctionType_name, int32(x))
+}
+
+func (AuctionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{0}
+}
+
+// TimeInForce defines how long a sell order remains open.
+type TimeInForce int32
+
+const (
+	// TIME_IN_FORCE_UNSPECIFIED keeps the sell order open until it is filled,
+	// cancelled or until the optional expiration is reached.
+	TimeInForce_TIME_IN_FORCE_UNSPECIFIED TimeInForce = 0
+	// TIME_IN_FORCE_GOOD_TIL_CANCELLED keeps the sell order open until it is
+	// filled or cancelled. A good-til-cancelled sell order has no expiration.
+	TimeInForce_TIME_IN_FORCE_GOOD_TIL_CANCELLED TimeInForce = 1
+	// TIME_IN_FORCE_GOOD_TIL_DATE keeps the sell order open until it is filled,
+	// cancelled or until the expiration is reached. A good-til-date sell order
+	// requires an expiration.
+	TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE TimeInForce = 2
+	// TIME_IN_FORCE_IMMEDIATE_OR_CANCEL fills the sell order against the
+	// matching buy orders in the order book when the sell order is created and
+	// cancels any quantity that is not filled. Immediate-or-cancel sell orders
+	// are only supported in markets with continuous clearing.
+	TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL TimeInForce = 3
+)
+
+var TimeInForce_name = map[int32]string{
+	0: "TIME_IN_FORCE_UNSPECIFIED",
+	1: "TIME_IN_FORCE_GOOD_TIL_CANCELLED",
+	2: "TIME_IN_FORCE_GOOD_TIL_DATE",
+	3: "TIME_IN_FORCE_IMMEDIATE_OR_CANCEL",
+}
+
+var TimeInForce_value = map[string]int32{
+	"TIME_IN_FORCE_UNSPECIFIED":         0,
+	"TIME_IN_FORCE_GOOD_TIL_CANCELLED":  1,
+	"TIME_IN_FORCE_GOOD_TIL_DATE":       2,
+	"TIME_IN_FORCE_IMMEDIATE_OR_CANCEL": 3,
+}
+
+func (x TimeInForce) String() string {
+	return proto.EnumName(TimeInForce_name, int32(x))
+}
+
+func (TimeInForce) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
+}
+
+// FeeDestination defines where the fees collected in a market are sent.
+type FeeDestination int32
+
+const (
+	// FEE_DESTINATION_BURN burns the fees.
+	FeeDestination_FEE_DESTINATION_BURN FeeDestination = 0
+	// FEE_DESTINATION_COMMUNITY_POOL sends the fees to the community pool.
+	FeeDestination_FEE_DESTINATION_COMMUNITY_POOL FeeDestination = 1
+	// FEE_DESTINATION_CLASS_ADMIN sends the fees to the admin of the credit
+	// class of the credits purchased.
+	FeeDestination_FEE_DESTINATION_CLASS_ADMIN FeeDestination = 2
+)
+
+var FeeDestination_name = map[int32]string{
+	0: "FEE_DESTINATION_BURN",
+	1: "FEE_DESTINATION_COMMUNITY_POOL",
+	2: "FEE_DESTINATION_CLASS_ADMIN",
+}
+
+var FeeDestination_value = map[string]int32{
+	"FEE_DESTINATION_BURN":           0,
+	"FEE_DESTINATION_COMMUNITY_POOL": 1,
+	"FEE_DESTINATION_CLASS_ADMIN":    2,
+}
+
+func (x FeeDestination) String() string {
+	return proto.EnumName(FeeDestination_name, int32(x))
+}
+
+func (FeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+}
+
+// ClearingMode defines how crossing buy orders and sell orders in the order
+// book of a market are cleared.
+type ClearingMode int32
+
+const (
+	// CLEARING_MODE_CONTINUOUS clears crossing orders every block at the ask
+	// price of each sell order in price-time priority.
+	ClearingMode_CLEARING_MODE_CONTINUOUS ClearingMode = 0
+	// CLEARING_MODE_BATCH_AUCTION clears crossing orders once every epoch in a
+	// batch auction at a single uniform price for all orders in the market.
+	ClearingMode_CLEARING_MODE_BATCH_AUCTION ClearingMode = 1
+)
+
+var ClearingMode_name = map[int32]string{
+	0: "CLEARING_MODE_CONTINUOUS",
+	1: "CLEARING_MODE_BATCH_AUCTION",
+}
+
+var ClearingMode_value = map[string]int32{
+	"CLEARING_MODE_CONTINUOUS":    0,
+	"CLEARING_MODE_BATCH_AUCTION": 1,
+}
+
+func (x ClearingMode) String() string {
+	return proto.EnumName(ClearingMode_name, int32(x))
+}
+
+func (ClearingMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{3}
+}
+
 // SellOrder represents the information for a sell order.
 type SellOrder struct {
 	// id is the unique ID of sell order.
@@ -53,6 +198,36 @@ type SellOrder struct {
 	// maker indicates that this is a maker order, meaning that when it hit
 	// the order book, there were no matching buy orders.
 	Maker bool `protobuf:"varint,10,opt,name=maker,proto3" json:"maker,omitempty"`
+	// min_fill_quantity is an optional minimum decimal quantity of credits that
+	// can be bought in a single fill of the sell order. The remaining quantity
+	// of the sell order can always be bought in full even if it is less than
+	// the min_fill_quantity. Buy orders are only matched with the sell order in
+	// the order book if their quantity, rounded down to the lot size, is greater
+	// than or equal to the min_fill_quantity.
+	MinFillQuantity string `protobuf:"bytes,11,opt,name=min_fill_quantity,json=minFillQuantity,proto3" json:"min_fill_quantity,omitempty"`
+	// lot_size is an optional decimal quantity of credits that the quantity of
+	// each fill of the sell order must be a multiple of. The quantity of the
+	// sell order is always a multiple of the lot_size.
+	LotSize string `protobuf:"bytes,12,opt,name=lot_size,json=lotSize,proto3" json:"lot_size,omitempty"`
+	// allowed_buyers is an optional list of the addresses of the buyers that are
+	// allowed to buy credits from the sell order. If allowed_buyers or
+	// allowed_buyer_group_policy is set, the sell order is private and it can
+	// only be filled by the allowed buyers with MsgBuyDirect.
+	AllowedBuyers [][]byte `protobuf:"bytes,13,rep,name=allowed_buyers,json=allowedBuyers,proto3" json:"allowed_buyers,omitempty"`
+	// allowed_buyer_group_policy is an optional address of an x/group group
+	// policy. The members of the group of the group policy are allowed to buy
+	// credits from the sell order in addition to the allowed_buyers.
+	AllowedBuyerGroupPolicy []byte `protobuf:"bytes,14,opt,name=allowed_buyer_group_policy,json=allowedBuyerGroupPolicy,proto3" json:"allowed_buyer_group_policy,omitempty"`
+	// time_in_force is the time in force of the sell order. Immediate-or-cancel
+	// sell orders never rest in state so the time in force of a sell order in
+	// state is either unspecified, good-til-cancelled or good-til-date.
+	TimeInForce TimeInForce `protobuf:"varint,15,opt,name=time_in_force,json=timeInForce,proto3,enum=regen.ecocredit.marketplace.v1.TimeInForce" json:"time_in_force,omitempty"`
+	// auto_renew is the optional auto-renewal of the sell order. If set, the
+	// sell order is re-listed at a decayed ask price when the expiration is
+	// reached rather than being removed from state.
+	AutoRenew *SellOrder_AutoRenew `protobuf:"bytes,16,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	// renewals is the number of times the sell order has been re-listed.
+	Renewals uint32 `protobuf:"varint,17,opt,name=renewals,proto3" json:"renewals,omitempty"`
 }
 
 func (m *SellOrder) Reset()         { *m = SellOrder{} }
@@ -151,31 +326,86 @@ func (m *SellOrder) GetMaker() bool {
 	return false
 }
 
-// AllowedDenom represents the information for an allowed ask/bid denom.
-type AllowedDenom struct {
-	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
-	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// display_denom is the denom to display to the user and is informational.
-	// Because the denom is likely an IBC denom, this should be chosen by
-	// governance to represent the consensus trusted name of the denom.
-	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
-	// exponent is the exponent that relates the denom to the display_denom and is
-	// informational
-	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+func (m *SellOrder) GetMinFillQuantity() string {
+	if m != nil {
+		return m.MinFillQuantity
+	}
+	return ""
 }
 
-func (m *AllowedDenom) Reset()         { *m = AllowedDenom{} }
-func (m *AllowedDenom) String() string { return proto.CompactTextString(m) }
-func (*AllowedDenom) ProtoMessage()    {}
-func (*AllowedDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
+func (m *SellOrder) GetLotSize() string {
+	if m != nil {
+		return m.LotSize
+	}
+	return ""
 }
-func (m *AllowedDenom) XXX_Unmarshal(b []byte) error {
+
+func (m *SellOrder) GetAllowedBuyers() [][]byte {
+	if m != nil {
+		return m.AllowedBuyers
+	}
+	return nil
+}
+
+func (m *SellOrder) GetAllowedBuyerGroupPolicy() []byte {
+	if m != nil {
+		return m.AllowedBuyerGroupPolicy
+	}
+	return nil
+}
+
+func (m *SellOrder) GetTimeInForce() TimeInForce {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return TimeInForce_TIME_IN_FORCE_UNSPECIFIED
+}
+
+func (m *SellOrder) GetAutoRenew() *SellOrder_AutoRenew {
+	if m != nil {
+		return m.AutoRenew
+	}
+	return nil
+}
+
+func (m *SellOrder) GetRenewals() uint32 {
+	if m != nil {
+		return m.Renewals
+	}
+	return 0
+}
+
+// AutoRenew defines how a sell order is re-listed when it expires.
+type SellOrder_AutoRenew struct {
+	// renewal_period is the period by which the expiration of the sell order
+	// is extended each time the sell order is re-listed.
+	RenewalPeriod *types.Duration `protobuf:"bytes,1,opt,name=renewal_period,json=renewalPeriod,proto3" json:"renewal_period,omitempty"`
+	// price_decay is the optional decimal fraction by which the ask amount of
+	// the sell order is reduced each time the sell order is re-listed (e.g.
+	// "0.05" for a reduction of 5%). The ask amount is not reduced if
+	// price_decay is empty.
+	PriceDecay string `protobuf:"bytes,2,opt,name=price_decay,json=priceDecay,proto3" json:"price_decay,omitempty"`
+	// floor_amount is the optional integer amount (encoded as a string) below
+	// which the ask amount of the sell order is not reduced.
+	FloorAmount string `protobuf:"bytes,3,opt,name=floor_amount,json=floorAmount,proto3" json:"floor_amount,omitempty"`
+	// max_renewals is the optional maximum number of times the sell order is
+	// re-listed. The sell order is re-listed until it is filled or cancelled
+	// if max_renewals is zero.
+	MaxRenewals uint32 `protobuf:"varint,4,opt,name=max_renewals,json=maxRenewals,proto3" json:"max_renewals,omitempty"`
+}
+
+func (m *SellOrder_AutoRenew) Reset()         { *m = SellOrder_AutoRenew{} }
+func (m *SellOrder_AutoRenew) String() string { return proto.CompactTextString(m) }
+func (*SellOrder_AutoRenew) ProtoMessage()    {}
+func (*SellOrder_AutoRenew) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{0, 0}
+}
+func (m *SellOrder_AutoRenew) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SellOrder_AutoRenew) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_AllowedDenom.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SellOrder_AutoRenew.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -185,95 +415,110 @@ func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *AllowedDenom) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AllowedDenom.Merge(m, src)
+func (m *SellOrder_AutoRenew) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SellOrder_AutoRenew.Merge(m, src)
 }
-func (m *AllowedDenom) XXX_Size() int {
+func (m *SellOrder_AutoRenew) XXX_Size() int {
 	return m.Size()
 }
-func (m *AllowedDenom) XXX_DiscardUnknown() {
-	xxx_messageInfo_AllowedDenom.DiscardUnknown(m)
+func (m *SellOrder_AutoRenew) XXX_DiscardUnknown() {
+	xxx_messageInfo_SellOrder_AutoRenew.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AllowedDenom proto.InternalMessageInfo
+var xxx_messageInfo_SellOrder_AutoRenew proto.InternalMessageInfo
 
-func (m *AllowedDenom) GetBankDenom() string {
+func (m *SellOrder_AutoRenew) GetRenewalPeriod() *types.Duration {
 	if m != nil {
-		return m.BankDenom
+		return m.RenewalPeriod
+	}
+	return nil
+}
+
+func (m *SellOrder_AutoRenew) GetPriceDecay() string {
+	if m != nil {
+		return m.PriceDecay
 	}
 	return ""
 }
 
-func (m *AllowedDenom) GetDisplayDenom() string {
+func (m *SellOrder_AutoRenew) GetFloorAmount() string {
 	if m != nil {
-		return m.DisplayDenom
+		return m.FloorAmount
 	}
 	return ""
 }
 
-func (m *AllowedDenom) GetExponent() uint32 {
+func (m *SellOrder_AutoRenew) GetMaxRenewals() uint32 {
 	if m != nil {
-		return m.Exponent
+		return m.MaxRenewals
 	}
 	return 0
 }
 
-// Market describes a distinctly processed market between a credit type and
-// allowed bank denom. Each market has its own precision in the order book
-// and is processed independently of other markets. Governance must enable
-// markets one by one. Every additional enabled market potentially adds more
-// processing overhead to the blockchain and potentially weakens liquidity in
-// competing markets. For instance, enabling side by side USD/Carbon and
-// EUR/Carbon markets may have the end result that each market individually has
-// less liquidity and longer settlement times. Such decisions should be taken
-// with care.
-type Market struct {
-	// id is the unique ID of the market.
+// BuyOrder represents the information for a buy order. Buy orders rest in the
+// order book until they are filled by one or more matching sell orders.
+type BuyOrder struct {
+	// id is the unique ID of buy order.
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// credit_type_abbrev is the abbreviation of the credit type.
-	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
-	// bank_denom is an allowed bank denom.
-	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
-	// precision_modifier is an optional modifier used to convert arbitrary
-	// precision integer bank amounts to uint32 values used for sorting in the
-	// order book. Given an arbitrary precision integer x, its uint32 conversion
-	// will be x / 10^precision_modifier using round half away from zero
-	// rounding.
-	//
-	// uint32 values range from 0 to 4,294,967,295.
-	// This allows for a full 9 digits of precision. In most real world markets
-	// this amount of precision is sufficient and most common downside -
-	// that some orders with very miniscule price differences may be ordered
-	// equivalently (because of rounding) - is acceptable.
-	// Note that this rounding will not affect settlement price which will
-	// always be done exactly.
-	//
-	// Given a USD stable coin with 6 decimal digits, a precision_modifier
-	// of 0 is probably acceptable as long as credits are always less than
-	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
-	// in this case), prices can rise up to $4,294,000/unit. Either scenario
-	// is probably quite acceptable given that carbon prices are unlikely to
-	// rise above $1000/ton any time in the near future.
-	//
-	// If credit prices, exceed the maximum range of uint32 with this
-	// precision_modifier, orders with high prices will fail and governance
-	// will need to adjust precision_modifier to allow for higher prices in
-	// exchange for less precision at the lower end.
-	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// buyer is the address of the account that is buying credits.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// selection is the selection of credits the buy order is bidding for.
+	Selection *BuyOrder_Selection `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"`
+	// quantity is the decimal quantity of credits being bought.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// market_id is the market in which this buy order exists and specifies
+	// the bank_denom that bid_amount corresponds to forming the bid_price.
+	MarketId uint64 `protobuf:"varint,5,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// bid_amount is the integer amount (encoded as a string) that the buyer is
+	// willing to pay for each credit unit. The bid_amount corresponds to the
+	// Market.denom to form the bid price.
+	BidAmount string `protobuf:"bytes,6,opt,name=bid_amount,json=bidAmount,proto3" json:"bid_amount,omitempty"`
+	// maker indicates that this is a maker order, meaning that when it hit
+	// the order book, there were no matching sell orders.
+	Maker bool `protobuf:"varint,7,opt,name=maker,proto3" json:"maker,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive the credits in a tradable state. A buy order with
+	// auto-retirement disabled can only be filled by sell orders that also have
+	// auto-retirement disabled.
+	DisableAutoRetire bool `protobuf:"varint,8,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,9,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the buy order expires. When the
+	// expiration time is reached, the buy order is removed from state and the
+	// escrowed funds are returned to the buyer.
+	Expiration *types.Timestamp `protobuf:"bytes,10,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// buyer_fee is the buyer fee of the market at the time the buy order was
+	// created. The fee for the quantity of the buy order at the bid price is
+	// held in escrow along with the bid and it is charged when the buy order
+	// is filled.
+	BuyerFee string `protobuf:"bytes,11,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// retirement_beneficiary is the optional name or address of the person or
+	// organization on whose behalf the credits will be retired, which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,12,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits, which
+	// will be used only if disable_auto_retire is false.
+	RetirementReason string `protobuf:"bytes,13,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+	// failed_sell_order_ids are the IDs of the sell orders from which the buy
+	// order failed to be filled by the order book. The buy order is no longer
+	// matched with these sell orders so that a fill that fails is not retried
+	// in every block.
+	FailedSellOrderIds []uint64 `protobuf:"varint,14,rep,packed,name=failed_sell_order_ids,json=failedSellOrderIds,proto3" json:"failed_sell_order_ids,omitempty"`
 }
 
-func (m *Market) Reset()         { *m = Market{} }
-func (m *Market) String() string { return proto.CompactTextString(m) }
-func (*Market) ProtoMessage()    {}
-func (*Market) Descriptor() ([]byte, []int) {
-	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+func (m *BuyOrder) Reset()         { *m = BuyOrder{} }
+func (m *BuyOrder) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder) ProtoMessage()    {}
+func (*BuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1}
 }
-func (m *Market) XXX_Unmarshal(b []byte) error {
+func (m *BuyOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -283,378 +528,5572 @@ func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Market) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Market.Merge(m, src)
+func (m *BuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder.Merge(m, src)
 }
-func (m *Market) XXX_Size() int {
+func (m *BuyOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *Market) XXX_DiscardUnknown() {
-	xxx_messageInfo_Market.DiscardUnknown(m)
+func (m *BuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Market proto.InternalMessageInfo
+var xxx_messageInfo_BuyOrder proto.InternalMessageInfo
 
-func (m *Market) GetId() uint64 {
+func (m *BuyOrder) GetId() uint64 {
 	if m != nil {
 		return m.Id
 	}
 	return 0
 }
 
-func (m *Market) GetCreditTypeAbbrev() string {
+func (m *BuyOrder) GetBuyer() []byte {
 	if m != nil {
-		return m.CreditTypeAbbrev
+		return m.Buyer
 	}
-	return ""
+	return nil
 }
 
-func (m *Market) GetBankDenom() string {
+func (m *BuyOrder) GetSelection() *BuyOrder_Selection {
 	if m != nil {
-		return m.BankDenom
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *BuyOrder) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
 	}
 	return ""
 }
 
-func (m *Market) GetPrecisionModifier() uint32 {
+func (m *BuyOrder) GetMarketId() uint64 {
 	if m != nil {
-		return m.PrecisionModifier
+		return m.MarketId
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
-	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
-	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+func (m *BuyOrder) GetBidAmount() string {
+	if m != nil {
+		return m.BidAmount
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+func (m *BuyOrder) GetMaker() bool {
+	if m != nil {
+		return m.Maker
+	}
+	return false
 }
 
-var fileDescriptor_718b9cb8f10a9f3c = []byte{
-	// 591 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xee, 0x24, 0x6d, 0xcc, 0x8e, 0x49, 0x49, 0xa7, 0x22, 0x43, 0xa4, 0x6b, 0x68, 0x11, 0x82,
-	0xb6, 0xbb, 0x44, 0xe9, 0x25, 0xe2, 0x21, 0xe2, 0x45, 0xa4, 0x08, 0x6b, 0x41, 0xf0, 0xb2, 0xcc,
-	0xee, 0xbc, 0xa6, 0xc3, 0xce, 0xee, 0xac, 0xb3, 0x93, 0xb4, 0xf9, 0x13, 0xe2, 0x5d, 0xf0, 0xf7,
-	0x08, 0x5e, 0x0a, 0x5e, 0x3c, 0x4a, 0x7b, 0xf0, 0xee, 0x2f, 0x90, 0x9d, 0xdd, 0xc6, 0xd0, 0x7a,
-	0xfc, 0xde, 0xf7, 0xbd, 0x99, 0xf7, 0x7d, 0xf3, 0x06, 0x3f, 0xd6, 0x30, 0x85, 0xcc, 0x87, 0x58,
-	0xc5, 0x1a, 0xb8, 0x30, 0x7e, 0xca, 0x74, 0x02, 0x26, 0x97, 0x2c, 0x06, 0x7f, 0x3e, 0xf2, 0x0b,
-	0xc3, 0x0c, 0x78, 0xb9, 0x56, 0x46, 0x11, 0xd7, 0x6a, 0xbd, 0xa5, 0xd6, 0x5b, 0xd1, 0x7a, 0xf3,
-	0x51, 0x7f, 0x27, 0x56, 0x45, 0xaa, 0x0a, 0x5f, 0xe9, 0xd4, 0x9f, 0x8f, 0x98, 0xcc, 0x4f, 0xd9,
-	0xa8, 0x04, 0x55, 0x7b, 0xff, 0xe1, 0x54, 0xa9, 0xa9, 0x04, 0xdf, 0xa2, 0x68, 0x76, 0xe2, 0x1b,
-	0x91, 0x42, 0x61, 0x58, 0x9a, 0x57, 0x82, 0xdd, 0xdf, 0x0d, 0xec, 0xbc, 0x03, 0x29, 0xdf, 0x6a,
-	0x0e, 0x9a, 0x6c, 0xe2, 0x86, 0xe0, 0x14, 0x0d, 0xd0, 0x70, 0x3d, 0x68, 0x08, 0x4e, 0xee, 0xe3,
-	0x56, 0x01, 0x52, 0x82, 0xa6, 0x8d, 0x01, 0x1a, 0x76, 0x82, 0x1a, 0x91, 0x07, 0xd8, 0x89, 0x98,
-	0x89, 0x4f, 0xc3, 0x04, 0x16, 0xb4, 0x69, 0xe5, 0x6d, 0x5b, 0x78, 0x03, 0x0b, 0xd2, 0xc7, 0xed,
-	0x8f, 0x33, 0x96, 0x19, 0x61, 0x16, 0x74, 0x7d, 0x80, 0x86, 0x4e, 0xb0, 0xc4, 0x65, 0x63, 0x65,
-	0x20, 0x14, 0x9c, 0x6e, 0x54, 0x8d, 0x55, 0xe1, 0x35, 0x27, 0x3b, 0x18, 0xb3, 0x22, 0x09, 0x59,
-	0xaa, 0x66, 0x99, 0xa1, 0x2d, 0xdb, 0xea, 0xb0, 0x22, 0x99, 0xd8, 0x02, 0xf1, 0xf0, 0x36, 0x17,
-	0x05, 0x8b, 0x24, 0x84, 0x6c, 0x66, 0x54, 0xa8, 0xc1, 0x08, 0x0d, 0xf4, 0xce, 0x00, 0x0d, 0xdb,
-	0xc1, 0x56, 0x4d, 0x4d, 0x66, 0x46, 0x05, 0x96, 0x20, 0x63, 0x8c, 0xe1, 0x3c, 0x17, 0x9a, 0x19,
-	0xa1, 0x32, 0xea, 0x0c, 0xd0, 0xf0, 0xee, 0xd3, 0xbe, 0x57, 0x05, 0xe2, 0x5d, 0x07, 0xe2, 0x1d,
-	0x5f, 0x07, 0x12, 0xac, 0xa8, 0xc9, 0x3d, 0xbc, 0x91, 0xb2, 0x04, 0x34, 0xc5, 0xf6, 0xf4, 0x0a,
-	0x8c, 0x9f, 0xff, 0xf9, 0xfa, 0xe3, 0x53, 0xf3, 0x10, 0xb7, 0xca, 0x98, 0x7a, 0x88, 0x74, 0x57,
-	0x62, 0xe8, 0x21, 0x82, 0xaf, 0xd3, 0xea, 0x35, 0xc8, 0xe6, 0xea, 0xe5, 0xbd, 0x26, 0x45, 0xbb,
-	0x5f, 0x10, 0xee, 0x4c, 0xa4, 0x54, 0x67, 0xc0, 0x5f, 0x41, 0xa6, 0xd2, 0xd2, 0x6e, 0xc4, 0xb2,
-	0x24, 0xe4, 0x25, 0xb2, 0xa1, 0x3b, 0x81, 0x53, 0x56, 0x2a, 0x7a, 0x0f, 0x77, 0xb9, 0x28, 0x72,
-	0xc9, 0x16, 0xb5, 0xa2, 0x61, 0x15, 0x9d, 0xba, 0x58, 0x89, 0xfa, 0xb8, 0x0d, 0xe7, 0xb9, 0xca,
-	0x20, 0x33, 0xf6, 0x1d, 0xba, 0xc1, 0x12, 0x8f, 0x9f, 0xd8, 0x69, 0x1f, 0xe1, 0xce, 0xea, 0x3d,
-	0x64, 0xfb, 0xc6, 0xb1, 0x3d, 0x44, 0x11, 0x6d, 0xee, 0x7e, 0x47, 0xb8, 0x75, 0x64, 0x1f, 0xe2,
-	0xd6, 0x12, 0xec, 0x63, 0x52, 0xed, 0x5e, 0x68, 0x16, 0x39, 0x84, 0x2c, 0x8a, 0x34, 0xcc, 0xeb,
-	0x69, 0x7a, 0x15, 0x73, 0xbc, 0xc8, 0x61, 0x62, 0xeb, 0x37, 0x5c, 0x35, 0x6f, 0xba, 0x3a, 0xc0,
-	0x24, 0xd7, 0x10, 0x8b, 0x42, 0xa8, 0x2c, 0x4c, 0x15, 0x17, 0x27, 0x02, 0xb4, 0x5d, 0x93, 0x6e,
-	0xb0, 0xb5, 0x64, 0x8e, 0x6a, 0x62, 0x7c, 0x68, 0x3d, 0xf8, 0xcb, 0xc4, 0xf7, 0xf0, 0xce, 0xed,
-	0x59, 0xf6, 0xff, 0x5d, 0x68, 0xdd, 0xac, 0xbf, 0x7c, 0xff, 0xed, 0xd2, 0x45, 0x17, 0x97, 0x2e,
-	0xfa, 0x75, 0xe9, 0xa2, 0xcf, 0x57, 0xee, 0xda, 0xc5, 0x95, 0xbb, 0xf6, 0xf3, 0xca, 0x5d, 0xfb,
-	0xf0, 0x62, 0x2a, 0xcc, 0xe9, 0x2c, 0xf2, 0x62, 0x95, 0xfa, 0xf6, 0x6b, 0x1d, 0x64, 0x60, 0xce,
-	0x94, 0x4e, 0x6a, 0x24, 0x81, 0x4f, 0x41, 0xfb, 0xe7, 0xff, 0xff, 0x9d, 0x51, 0xcb, 0xee, 0xcd,
-	0xb3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7b, 0x77, 0x4d, 0xa3, 0xc3, 0x03, 0x00, 0x00,
+func (m *BuyOrder) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
 }
 
-func (m *SellOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BuyOrder) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BuyOrder) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
 }
 
-func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Maker {
-		i--
-		if m.Maker {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+func (m *BuyOrder) GetBuyerFee() string {
+	if m != nil {
+		return m.BuyerFee
 	}
-	if m.Expiration != nil {
-		{
-			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintState(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *BuyOrder) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
 	}
-	if m.DisableAutoRetire {
-		i--
-		if m.DisableAutoRetire {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.AskAmount) > 0 {
-		i -= len(m.AskAmount)
-		copy(dAtA[i:], m.AskAmount)
-		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.MarketId != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Quantity) > 0 {
-		i -= len(m.Quantity)
-		copy(dAtA[i:], m.Quantity)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BatchKey != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *BuyOrder) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BuyOrder) GetFailedSellOrderIds() []uint64 {
+	if m != nil {
+		return m.FailedSellOrderIds
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Selection defines the credits a buy order is bidding for.
+type BuyOrder_Selection struct {
+	// sum is the selection type.
+	//
+	// Types that are valid to be assigned to Sum:
+	//	*BuyOrder_Selection_BatchKey
+	//	*BuyOrder_Selection_ProjectKey
+	//	*BuyOrder_Selection_ClassKey
+	Sum isBuyOrder_Selection_Sum `protobuf_oneof:"sum"`
+	// project_jurisdiction optionally restricts a class selection to credits
+	// from projects within the jurisdiction.
+	ProjectJurisdiction string `protobuf:"bytes,4,opt,name=project_jurisdiction,json=projectJurisdiction,proto3" json:"project_jurisdiction,omitempty"`
+	// min_start_date optionally restricts a project or class selection to
+	// credit batches with a start date on or after the min_start_date.
+	MinStartDate *types.Timestamp `protobuf:"bytes,5,opt,name=min_start_date,json=minStartDate,proto3" json:"min_start_date,omitempty"`
+	// max_end_date optionally restricts a project or class selection to
+	// credit batches with an end date on or before the max_end_date.
+	MaxEndDate *types.Timestamp `protobuf:"bytes,6,opt,name=max_end_date,json=maxEndDate,proto3" json:"max_end_date,omitempty"`
 }
 
-func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exponent != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.DisplayDenom) > 0 {
-		i -= len(m.DisplayDenom)
-		copy(dAtA[i:], m.DisplayDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0xa
+func (m *BuyOrder_Selection) Reset()         { *m = BuyOrder_Selection{} }
+func (m *BuyOrder_Selection) String() string { return proto.CompactTextString(m) }
+func (*BuyOrder_Selection) ProtoMessage()    {}
+func (*BuyOrder_Selection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{1, 0}
+}
+func (m *BuyOrder_Selection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BuyOrder_Selection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BuyOrder_Selection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_Selection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyOrder_Selection.Merge(m, src)
+}
+func (m *BuyOrder_Selection) XXX_Size() int {
+	return m.Size()
+}
+func (m *BuyOrder_Selection) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyOrder_Selection.DiscardUnknown(m)
 }
 
-func (m *Market) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_BuyOrder_Selection proto.InternalMessageInfo
+
+type isBuyOrder_Selection_Sum interface {
+	isBuyOrder_Selection_Sum()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (m *Market) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type BuyOrder_Selection_BatchKey struct {
+	BatchKey uint64 `protobuf:"varint,1,opt,name=batch_key,json=batchKey,proto3,oneof" json:"batch_key,omitempty"`
+}
+type BuyOrder_Selection_ProjectKey struct {
+	ProjectKey uint64 `protobuf:"varint,2,opt,name=project_key,json=projectKey,proto3,oneof" json:"project_key,omitempty"`
+}
+type BuyOrder_Selection_ClassKey struct {
+	ClassKey uint64 `protobuf:"varint,3,opt,name=class_key,json=classKey,proto3,oneof" json:"class_key,omitempty"`
 }
 
-func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PrecisionModifier != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
-		i--
-		dAtA[i] = 0x20
+func (*BuyOrder_Selection_BatchKey) isBuyOrder_Selection_Sum()   {}
+func (*BuyOrder_Selection_ProjectKey) isBuyOrder_Selection_Sum() {}
+func (*BuyOrder_Selection_ClassKey) isBuyOrder_Selection_Sum()   {}
+
+func (m *BuyOrder_Selection) GetSum() isBuyOrder_Selection_Sum {
+	if m != nil {
+		return m.Sum
 	}
-	if len(m.BankDenom) > 0 {
-		i -= len(m.BankDenom)
-		copy(dAtA[i:], m.BankDenom)
-		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *BuyOrder_Selection) GetBatchKey() uint64 {
+	if x, ok := m.GetSum().(*BuyOrder_Selection_BatchKey); ok {
+		return x.BatchKey
 	}
-	if len(m.CreditTypeAbbrev) > 0 {
-		i -= len(m.CreditTypeAbbrev)
-		copy(dAtA[i:], m.CreditTypeAbbrev)
-		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *BuyOrder_Selection) GetProjectKey() uint64 {
+	if x, ok := m.GetSum().(*BuyOrder_Selection_ProjectKey); ok {
+		return x.ProjectKey
 	}
-	if m.Id != 0 {
-		i = encodeVarintState(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return 0
+}
+
+func (m *BuyOrder_Selection) GetClassKey() uint64 {
+	if x, ok := m.GetSum().(*BuyOrder_Selection_ClassKey); ok {
+		return x.ClassKey
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintState(dAtA []byte, offset int, v uint64) int {
-	offset -= sovState(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BuyOrder_Selection) GetProjectJurisdiction() string {
+	if m != nil {
+		return m.ProjectJurisdiction
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *SellOrder) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *BuyOrder_Selection) GetMinStartDate() *types.Timestamp {
+	if m != nil {
+		return m.MinStartDate
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
+	return nil
+}
+
+func (m *BuyOrder_Selection) GetMaxEndDate() *types.Timestamp {
+	if m != nil {
+		return m.MaxEndDate
 	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*BuyOrder_Selection) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*BuyOrder_Selection_BatchKey)(nil),
+		(*BuyOrder_Selection_ProjectKey)(nil),
+		(*BuyOrder_Selection_ClassKey)(nil),
 	}
-	if m.BatchKey != 0 {
-		n += 1 + sovState(uint64(m.BatchKey))
+}
+
+// AllowedDenom represents the information for an allowed ask/bid denom.
+type AllowedDenom struct {
+	// denom is the bank denom to allow (ex. ibc/GLKHDSG423SGS)
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// display_denom is the denom to display to the user and is informational.
+	// Because the denom is likely an IBC denom, this should be chosen by
+	// governance to represent the consensus trusted name of the denom.
+	DisplayDenom string `protobuf:"bytes,2,opt,name=display_denom,json=displayDenom,proto3" json:"display_denom,omitempty"`
+	// exponent is the exponent that relates the denom to the display_denom and is
+	// informational
+	Exponent uint32 `protobuf:"varint,3,opt,name=exponent,proto3" json:"exponent,omitempty"`
+}
+
+func (m *AllowedDenom) Reset()         { *m = AllowedDenom{} }
+func (m *AllowedDenom) String() string { return proto.CompactTextString(m) }
+func (*AllowedDenom) ProtoMessage()    {}
+func (*AllowedDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{2}
+}
+func (m *AllowedDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Quantity)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *AllowedDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedDenom.Merge(m, src)
+}
+func (m *AllowedDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedDenom proto.InternalMessageInfo
+
+func (m *AllowedDenom) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
 	}
-	if m.MarketId != 0 {
-		n += 1 + sovState(uint64(m.MarketId))
+	return ""
+}
+
+func (m *AllowedDenom) GetDisplayDenom() string {
+	if m != nil {
+		return m.DisplayDenom
 	}
-	l = len(m.AskAmount)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *AllowedDenom) GetExponent() uint32 {
+	if m != nil {
+		return m.Exponent
 	}
-	if m.DisableAutoRetire {
-		n += 2
+	return 0
+}
+
+// Market describes a distinctly processed market between a credit type and
+// allowed bank denom. Each market has its own precision in the order book
+// and is processed independently of other markets. Governance must enable
+// markets one by one. Every additional enabled market potentially adds more
+// processing overhead to the blockchain and potentially weakens liquidity in
+// competing markets. For instance, enabling side by side USD/Carbon and
+// EUR/Carbon markets may have the end result that each market individually has
+// less liquidity and longer settlement times. Such decisions should be taken
+// with care.
+type Market struct {
+	// id is the unique ID of the market.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// credit_type_abbrev is the abbreviation of the credit type.
+	CreditTypeAbbrev string `protobuf:"bytes,2,opt,name=credit_type_abbrev,json=creditTypeAbbrev,proto3" json:"credit_type_abbrev,omitempty"`
+	// bank_denom is an allowed bank denom, the denom of a basket token or the
+	// denom of a credit batch. Markets with the denom of a basket token or of a
+	// credit batch are only used for sell orders that ask for basket tokens or
+	// for credits of another credit batch. Amounts in a market with the denom
+	// of a credit batch are integer amounts of the smallest unit of the credit
+	// type precision of that batch.
+	BankDenom string `protobuf:"bytes,3,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	// precision_modifier is an optional modifier used to convert arbitrary
+	// precision integer bank amounts to uint32 values used for sorting in the
+	// order book. Given an arbitrary precision integer x, its uint32 conversion
+	// will be x / 10^precision_modifier using round half away from zero
+	// rounding.
+	//
+	// uint32 values range from 0 to 4,294,967,295.
+	// This allows for a full 9 digits of precision. In most real world markets
+	// this amount of precision is sufficient and most common downside -
+	// that some orders with very miniscule price differences may be ordered
+	// equivalently (because of rounding) - is acceptable.
+	// Note that this rounding will not affect settlement price which will
+	// always be done exactly.
+	//
+	// Given a USD stable coin with 6 decimal digits, a precision_modifier
+	// of 0 is probably acceptable as long as credits are always less than
+	// $4,294/unit. With precision down to $0.001 (a precision_modifier of 3
+	// in this case), prices can rise up to $4,294,000/unit. Either scenario
+	// is probably quite acceptable given that carbon prices are unlikely to
+	// rise above $1000/ton any time in the near future.
+	//
+	// If credit prices, exceed the maximum range of uint32 with this
+	// precision_modifier, orders with high prices will fail and governance
+	// will need to adjust precision_modifier to allow for higher prices in
+	// exchange for less precision at the lower end.
+	PrecisionModifier uint32 `protobuf:"varint,4,opt,name=precision_modifier,json=precisionModifier,proto3" json:"precision_modifier,omitempty"`
+	// clearing_mode is the mode in which crossing buy orders and sell orders in
+	// the order book of the market are cleared.
+	ClearingMode ClearingMode `protobuf:"varint,5,opt,name=clearing_mode,json=clearingMode,proto3,enum=regen.ecocredit.marketplace.v1.ClearingMode" json:"clearing_mode,omitempty"`
+	// epoch_length is the number of blocks between the batch auctions of the
+	// market. It is only set when clearing_mode is
+	// CLEARING_MODE_BATCH_AUCTION.
+	EpochLength uint64 `protobuf:"varint,6,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`
+	// buyer_fee is the fee paid by the buyer in addition to the total cost of
+	// the credits purchased, expressed as a decimal fraction of the total cost
+	// (e.g. "0.01" for a fee of 1%). No fee is charged if buyer_fee is empty.
+	BuyerFee string `protobuf:"bytes,7,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+	// seller_fee is the fee deducted from the total cost of the credits sold
+	// before it is paid to the seller, expressed as a decimal fraction of the
+	// total cost. No fee is charged if seller_fee is empty.
+	//
+	// The buyer fee and the seller fee are not charged in a market of the
+	// credits of another credit batch (i.e. swaps of credits for credits)
+	// because the fee destinations only accept bank coins.
+	SellerFee string `protobuf:"bytes,8,opt,name=seller_fee,json=sellerFee,proto3" json:"seller_fee,omitempty"`
+	// fee_destination is the destination of the fees collected in the market.
+	FeeDestination FeeDestination `protobuf:"varint,9,opt,name=fee_destination,json=feeDestination,proto3,enum=regen.ecocredit.marketplace.v1.FeeDestination" json:"fee_destination,omitempty"`
+}
+
+func (m *Market) Reset()         { *m = Market{} }
+func (m *Market) String() string { return proto.CompactTextString(m) }
+func (*Market) ProtoMessage()    {}
+func (*Market) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{3}
+}
+func (m *Market) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Market) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Market.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.Expiration != nil {
-		l = m.Expiration.Size()
-		n += 1 + l + sovState(uint64(l))
+}
+func (m *Market) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Market.Merge(m, src)
+}
+func (m *Market) XXX_Size() int {
+	return m.Size()
+}
+func (m *Market) XXX_DiscardUnknown() {
+	xxx_messageInfo_Market.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Market proto.InternalMessageInfo
+
+func (m *Market) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if m.Maker {
-		n += 2
+	return 0
+}
+
+func (m *Market) GetCreditTypeAbbrev() string {
+	if m != nil {
+		return m.CreditTypeAbbrev
 	}
-	return n
+	return ""
 }
 
-func (m *AllowedDenom) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Market) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
 	}
-	var l int
-	_ = l
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return ""
+}
+
+func (m *Market) GetPrecisionModifier() uint32 {
+	if m != nil {
+		return m.PrecisionModifier
 	}
-	l = len(m.DisplayDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
+	return 0
+}
+
+func (m *Market) GetClearingMode() ClearingMode {
+	if m != nil {
+		return m.ClearingMode
 	}
-	if m.Exponent != 0 {
-		n += 1 + sovState(uint64(m.Exponent))
+	return ClearingMode_CLEARING_MODE_CONTINUOUS
+}
+
+func (m *Market) GetEpochLength() uint64 {
+	if m != nil {
+		return m.EpochLength
+	}
+	return 0
+}
+
+func (m *Market) GetBuyerFee() string {
+	if m != nil {
+		return m.BuyerFee
+	}
+	return ""
+}
+
+func (m *Market) GetSellerFee() string {
+	if m != nil {
+		return m.SellerFee
+	}
+	return ""
+}
+
+func (m *Market) GetFeeDestination() FeeDestination {
+	if m != nil {
+		return m.FeeDestination
+	}
+	return FeeDestination_FEE_DESTINATION_BURN
+}
+
+// Trade represents the information for a completed fill of a sell order. A
+// trade is recorded each time credits of a sell order are bought, either
+// directly or by a buy order in the order book.
+type Trade struct {
+	// id is the unique ID of the trade.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sell_order_id is the ID of the sell order that was filled.
+	SellOrderId uint64 `protobuf:"varint,2,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+	// buyer is the address of the account that bought the credits.
+	Buyer []byte `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// seller is the address of the account that sold the credits.
+	Seller []byte `protobuf:"bytes,4,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the credits
+	// that were traded.
+	BatchKey uint64 `protobuf:"varint,5,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits that were traded.
+	Quantity string `protobuf:"bytes,6,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// price is the integer amount (encoded as a string) paid for each credit
+	// unit. The price is the ask amount of the sell order for direct purchases
+	// and the settlement price of the order book for buy orders.
+	Price string `protobuf:"bytes,7,opt,name=price,proto3" json:"price,omitempty"`
+	// denom is the denom of the price, i.e. the bank denom of the market.
+	Denom string `protobuf:"bytes,8,opt,name=denom,proto3" json:"denom,omitempty"`
+	// retired indicates whether the credits were retired upon purchase.
+	Retired bool `protobuf:"varint,9,opt,name=retired,proto3" json:"retired,omitempty"`
+	// timestamp is the block time at which the trade was completed.
+	Timestamp *types.Timestamp `protobuf:"bytes,10,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// auction_id is the ID of the auction that was settled if the credits were
+	// bought in an auction, in which case sell_order_id is zero.
+	AuctionId uint64 `protobuf:"varint,11,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{4}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Trade) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+func (m *Trade) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *Trade) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *Trade) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *Trade) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *Trade) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+func (m *Trade) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Trade) GetRetired() bool {
+	if m != nil {
+		return m.Retired
+	}
+	return false
+}
+
+func (m *Trade) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *Trade) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
+	}
+	return 0
+}
+
+// Auction represents the information for an auction of credits from a credit
+// batch. The credits are held in escrow until the auction is settled or
+// cancelled.
+type Auction struct {
+	// id is the unique ID of the auction.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// seller is the address of the account that is auctioning credits.
+	Seller []byte `protobuf:"bytes,2,opt,name=seller,proto3" json:"seller,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the credits
+	// being auctioned.
+	BatchKey uint64 `protobuf:"varint,3,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// quantity is the decimal quantity of credits being auctioned.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// auction_type is the type of the auction.
+	AuctionType AuctionType `protobuf:"varint,5,opt,name=auction_type,json=auctionType,proto3,enum=regen.ecocredit.marketplace.v1.AuctionType" json:"auction_type,omitempty"`
+	// denom is the bank denom of the prices of the auction.
+	Denom string `protobuf:"bytes,6,opt,name=denom,proto3" json:"denom,omitempty"`
+	// start_price is the integer amount (encoded as a string) of the price for
+	// each credit unit at the start of the auction. The price of a dutch auction
+	// decays from the start price and the first bid of an english auction must
+	// be greater than or equal to the start price.
+	StartPrice string `protobuf:"bytes,7,opt,name=start_price,json=startPrice,proto3" json:"start_price,omitempty"`
+	// reserve_price is the integer amount (encoded as a string) of the lowest
+	// price for each credit unit at which the credits are sold.
+	ReservePrice string `protobuf:"bytes,8,opt,name=reserve_price,json=reservePrice,proto3" json:"reserve_price,omitempty"`
+	// start_time is the time at which the auction starts accepting bids.
+	StartTime *types.Timestamp `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// end_time is the time at which the auction stops accepting bids and is
+	// settled. The end time of a dutch auction is set to the time of the first
+	// accepted bid.
+	EndTime *types.Timestamp `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// price_decrement is the integer amount (encoded as a string) that the price
+	// of a dutch auction decays by at the end of each decay interval.
+	PriceDecrement string `protobuf:"bytes,11,opt,name=price_decrement,json=priceDecrement,proto3" json:"price_decrement,omitempty"`
+	// decay_interval is the interval at which the price of a dutch auction
+	// decays.
+	DecayInterval *types.Duration `protobuf:"bytes,12,opt,name=decay_interval,json=decayInterval,proto3" json:"decay_interval,omitempty"`
+	// bidder is the address of the account that placed the highest bid, i.e.
+	// the winning bid of the auction if the auction were settled now.
+	Bidder []byte `protobuf:"bytes,13,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// bid_price is the integer amount (encoded as a string) of the price for
+	// each credit unit of the highest bid. The total cost of the highest bid and
+	// the buyer fee are held in escrow until the auction is settled.
+	BidPrice string `protobuf:"bytes,14,opt,name=bid_price,json=bidPrice,proto3" json:"bid_price,omitempty"`
+	// market_id is the market in which the credits are auctioned. The fees of
+	// the market are charged when the auction is settled with a winning bid.
+	MarketId uint64 `protobuf:"varint,15,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// buyer_fee is the buyer fee rate of the market when the highest bid was
+	// placed. The buyer fee is held in escrow with the total cost of the bid so
+	// that the fee paid or refunded does not depend on later fee changes.
+	BuyerFee string `protobuf:"bytes,16,opt,name=buyer_fee,json=buyerFee,proto3" json:"buyer_fee,omitempty"`
+}
+
+func (m *Auction) Reset()         { *m = Auction{} }
+func (m *Auction) String() string { return proto.CompactTextString(m) }
+func (*Auction) ProtoMessage()    {}
+func (*Auction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{5}
+}
+func (m *Auction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Auction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Auction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Auction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Auction.Merge(m, src)
+}
+func (m *Auction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Auction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Auction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Auction proto.InternalMessageInfo
+
+func (m *Auction) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Auction) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *Auction) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *Auction) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *Auction) GetAuctionType() AuctionType {
+	if m != nil {
+		return m.AuctionType
+	}
+	return AuctionType_AUCTION_TYPE_UNSPECIFIED
+}
+
+func (m *Auction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Auction) GetStartPrice() string {
+	if m != nil {
+		return m.StartPrice
+	}
+	return ""
+}
+
+func (m *Auction) GetReservePrice() string {
+	if m != nil {
+		return m.ReservePrice
+	}
+	return ""
+}
+
+func (m *Auction) GetStartTime() *types.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *Auction) GetEndTime() *types.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *Auction) GetPriceDecrement() string {
+	if m != nil {
+		return m.PriceDecrement
+	}
+	return ""
+}
+
+func (m *Auction) GetDecayInterval() *types.Duration {
+	if m != nil {
+		return m.DecayInterval
+	}
+	return nil
+}
+
+func (m *Auction) GetBidder() []byte {
+	if m != nil {
+		return m.Bidder
+	}
+	return nil
+}
+
+func (m *Auction) GetBidPrice() string {
+	if m != nil {
+		return m.BidPrice
+	}
+	return ""
+}
+
+func (m *Auction) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *Auction) GetBuyerFee() string {
+	if m != nil {
+		return m.BuyerFee
+	}
+	return ""
+}
+
+// QuoteRequest represents the information for a request for quote (RFQ). A
+// quote request asks holders of credits matching the selection to submit
+// quotes for the quantity of credits and the buyer accepts one of the quotes.
+type QuoteRequest struct {
+	// id is the unique ID of the quote request.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// buyer is the address of the account that is requesting quotes.
+	Buyer []byte `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// selection is the selection of credits the quotes must be for.
+	Selection *BuyOrder_Selection `protobuf:"bytes,3,opt,name=selection,proto3" json:"selection,omitempty"`
+	// quantity is the decimal quantity of credits being requested.
+	Quantity string `protobuf:"bytes,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	// denom is the bank denom in which the quotes must be priced.
+	Denom string `protobuf:"bytes,5,opt,name=denom,proto3" json:"denom,omitempty"`
+	// disable_auto_retire disables auto-retirement of credits which allows the
+	// buyer to receive the credits in a tradable state when a quote is
+	// accepted.
+	DisableAutoRetire bool `protobuf:"varint,6,opt,name=disable_auto_retire,json=disableAutoRetire,proto3" json:"disable_auto_retire,omitempty"`
+	// retirement_jurisdiction is the optional retirement jurisdiction for the
+	// credits which will be used only if disable_auto_retire is false.
+	RetirementJurisdiction string `protobuf:"bytes,7,opt,name=retirement_jurisdiction,json=retirementJurisdiction,proto3" json:"retirement_jurisdiction,omitempty"`
+	// expiration is an optional timestamp when the quote request expires. When
+	// the expiration time is reached, the quote request and its quotes are
+	// removed from state and the credits of the quotes are returned to the
+	// sellers.
+	Expiration *types.Timestamp `protobuf:"bytes,8,opt,name=expiration,proto3" json:"expiration,omitempty"`
+	// retirement_beneficiary is the optional name or address of the person or
+	// organization on whose behalf the credits will be retired, which will be
+	// used only if disable_auto_retire is false.
+	RetirementBeneficiary string `protobuf:"bytes,9,opt,name=retirement_beneficiary,json=retirementBeneficiary,proto3" json:"retirement_beneficiary,omitempty"`
+	// retirement_reason is the optional reason for retiring the credits, which
+	// will be used only if disable_auto_retire is false.
+	RetirementReason string `protobuf:"bytes,10,opt,name=retirement_reason,json=retirementReason,proto3" json:"retirement_reason,omitempty"`
+}
+
+func (m *QuoteRequest) Reset()         { *m = QuoteRequest{} }
+func (m *QuoteRequest) String() string { return proto.CompactTextString(m) }
+func (*QuoteRequest) ProtoMessage()    {}
+func (*QuoteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{6}
+}
+func (m *QuoteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuoteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuoteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuoteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuoteRequest.Merge(m, src)
+}
+func (m *QuoteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuoteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuoteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuoteRequest proto.InternalMessageInfo
+
+func (m *QuoteRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QuoteRequest) GetBuyer() []byte {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *QuoteRequest) GetSelection() *BuyOrder_Selection {
+	if m != nil {
+		return m.Selection
+	}
+	return nil
+}
+
+func (m *QuoteRequest) GetQuantity() string {
+	if m != nil {
+		return m.Quantity
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetDisableAutoRetire() bool {
+	if m != nil {
+		return m.DisableAutoRetire
+	}
+	return false
+}
+
+func (m *QuoteRequest) GetRetirementJurisdiction() string {
+	if m != nil {
+		return m.RetirementJurisdiction
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetExpiration() *types.Timestamp {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *QuoteRequest) GetRetirementBeneficiary() string {
+	if m != nil {
+		return m.RetirementBeneficiary
+	}
+	return ""
+}
+
+func (m *QuoteRequest) GetRetirementReason() string {
+	if m != nil {
+		return m.RetirementReason
+	}
+	return ""
+}
+
+// Quote represents the information for a quote submitted in response to a
+// quote request. The credits of a quote are held in escrow in a private sell
+// order that only the buyer of the quote request is allowed to fill.
+type Quote struct {
+	// id is the unique ID of the quote.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// quote_request_id is the ID of the quote request the quote responds to.
+	QuoteRequestId uint64 `protobuf:"varint,2,opt,name=quote_request_id,json=quoteRequestId,proto3" json:"quote_request_id,omitempty"`
+	// seller is the address of the account that submitted the quote.
+	Seller []byte `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	// sell_order_id is the ID of the private sell order holding the credits of
+	// the quote in escrow at the quoted price.
+	SellOrderId uint64 `protobuf:"varint,4,opt,name=sell_order_id,json=sellOrderId,proto3" json:"sell_order_id,omitempty"`
+}
+
+func (m *Quote) Reset()         { *m = Quote{} }
+func (m *Quote) String() string { return proto.CompactTextString(m) }
+func (*Quote) ProtoMessage()    {}
+func (*Quote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{7}
+}
+func (m *Quote) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Quote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Quote.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Quote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quote.Merge(m, src)
+}
+func (m *Quote) XXX_Size() int {
+	return m.Size()
+}
+func (m *Quote) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quote.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Quote proto.InternalMessageInfo
+
+func (m *Quote) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Quote) GetQuoteRequestId() uint64 {
+	if m != nil {
+		return m.QuoteRequestId
+	}
+	return 0
+}
+
+func (m *Quote) GetSeller() []byte {
+	if m != nil {
+		return m.Seller
+	}
+	return nil
+}
+
+func (m *Quote) GetSellOrderId() uint64 {
+	if m != nil {
+		return m.SellOrderId
+	}
+	return 0
+}
+
+// PriceObservation represents the price accumulator of a credit batch in a
+// market, or of all credit batches in a market, at the block time of a block
+// in which credits were traded. An observation is recorded for each block in
+// which a sell order is filled and is updated by each fill in the block.
+// Observations are pruned once they are older than the retention window of
+// the price history.
+type PriceObservation struct {
+	// market_id is the ID of the market in which the credits were traded.
+	MarketId uint64 `protobuf:"varint,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// batch_key is the table row identifier of the credit batch of the credits
+	// that were traded. The batch key is zero for the observations of all
+	// credit batches in the market.
+	BatchKey uint64 `protobuf:"varint,2,opt,name=batch_key,json=batchKey,proto3" json:"batch_key,omitempty"`
+	// timestamp is the block time of the observation.
+	Timestamp *types.Timestamp `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// cumulative_price is the decimal sum (encoded as a string) of the value of
+	// each previous observation multiplied by the number of seconds until the
+	// next observation. The price in effect between two observations is the
+	// average price of the trades of the earlier observation, weighted by the
+	// quantity of credits traded.
+	CumulativePrice string `protobuf:"bytes,4,opt,name=cumulative_price,json=cumulativePrice,proto3" json:"cumulative_price,omitempty"`
+	// open is the integer amount (encoded as a string) of the price of the
+	// first trade in the block.
+	Open string `protobuf:"bytes,5,opt,name=open,proto3" json:"open,omitempty"`
+	// high is the integer amount (encoded as a string) of the highest price of
+	// the trades in the block.
+	High string `protobuf:"bytes,6,opt,name=high,proto3" json:"high,omitempty"`
+	// low is the integer amount (encoded as a string) of the lowest price of
+	// the trades in the block.
+	Low string `protobuf:"bytes,7,opt,name=low,proto3" json:"low,omitempty"`
+	// close is the integer amount (encoded as a string) of the price of the
+	// last trade in the block.
+	Close string `protobuf:"bytes,8,opt,name=close,proto3" json:"close,omitempty"`
+	// volume is the decimal quantity of credits traded in the block.
+	Volume string `protobuf:"bytes,9,opt,name=volume,proto3" json:"volume,omitempty"`
+	// value is the decimal sum (encoded as a string) of the price multiplied by
+	// the quantity of each trade in the block.
+	Value string `protobuf:"bytes,10,opt,name=value,proto3" json:"value,omitempty"`
+	// cumulative_volume is the decimal sum (encoded as a string) of the volume
+	// of each previous observation multiplied by the number of seconds until
+	// the next observation. The quantity-weighted average price between two
+	// observations is the change in cumulative_price divided by the change in
+	// cumulative_volume.
+	CumulativeVolume string `protobuf:"bytes,11,opt,name=cumulative_volume,json=cumulativeVolume,proto3" json:"cumulative_volume,omitempty"`
+}
+
+func (m *PriceObservation) Reset()         { *m = PriceObservation{} }
+func (m *PriceObservation) String() string { return proto.CompactTextString(m) }
+func (*PriceObservation) ProtoMessage()    {}
+func (*PriceObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718b9cb8f10a9f3c, []int{8}
+}
+func (m *PriceObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceObservation.Merge(m, src)
+}
+func (m *PriceObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceObservation proto.InternalMessageInfo
+
+func (m *PriceObservation) GetMarketId() uint64 {
+	if m != nil {
+		return m.MarketId
+	}
+	return 0
+}
+
+func (m *PriceObservation) GetBatchKey() uint64 {
+	if m != nil {
+		return m.BatchKey
+	}
+	return 0
+}
+
+func (m *PriceObservation) GetTimestamp() *types.Timestamp {
+	if m != nil {
+		return m.Timestamp
+	}
+	return nil
+}
+
+func (m *PriceObservation) GetCumulativePrice() string {
+	if m != nil {
+		return m.CumulativePrice
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetOpen() string {
+	if m != nil {
+		return m.Open
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetHigh() string {
+	if m != nil {
+		return m.High
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetLow() string {
+	if m != nil {
+		return m.Low
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetClose() string {
+	if m != nil {
+		return m.Close
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetVolume() string {
+	if m != nil {
+		return m.Volume
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *PriceObservation) GetCumulativeVolume() string {
+	if m != nil {
+		return m.CumulativeVolume
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.AuctionType", AuctionType_name, AuctionType_value)
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.TimeInForce", TimeInForce_name, TimeInForce_value)
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.FeeDestination", FeeDestination_name, FeeDestination_value)
+	proto.RegisterEnum("regen.ecocredit.marketplace.v1.ClearingMode", ClearingMode_name, ClearingMode_value)
+	proto.RegisterType((*SellOrder)(nil), "regen.ecocredit.marketplace.v1.SellOrder")
+	proto.RegisterType((*SellOrder_AutoRenew)(nil), "regen.ecocredit.marketplace.v1.SellOrder.AutoRenew")
+	proto.RegisterType((*BuyOrder)(nil), "regen.ecocredit.marketplace.v1.BuyOrder")
+	proto.RegisterType((*BuyOrder_Selection)(nil), "regen.ecocredit.marketplace.v1.BuyOrder.Selection")
+	proto.RegisterType((*AllowedDenom)(nil), "regen.ecocredit.marketplace.v1.AllowedDenom")
+	proto.RegisterType((*Market)(nil), "regen.ecocredit.marketplace.v1.Market")
+	proto.RegisterType((*Trade)(nil), "regen.ecocredit.marketplace.v1.Trade")
+	proto.RegisterType((*Auction)(nil), "regen.ecocredit.marketplace.v1.Auction")
+	proto.RegisterType((*QuoteRequest)(nil), "regen.ecocredit.marketplace.v1.QuoteRequest")
+	proto.RegisterType((*Quote)(nil), "regen.ecocredit.marketplace.v1.Quote")
+	proto.RegisterType((*PriceObservation)(nil), "regen.ecocredit.marketplace.v1.PriceObservation")
+}
+
+func init() {
+	proto.RegisterFile("regen/ecocredit/marketplace/v1/state.proto", fileDescriptor_718b9cb8f10a9f3c)
+}
+
+var fileDescriptor_718b9cb8f10a9f3c = []byte{
+	// 2065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0x5f, 0x6f, 0x1b, 0x59,
+	0x15, 0xcf, 0x78, 0xe2, 0xd8, 0x3e, 0xfe, 0x93, 0xe9, 0x6d, 0x76, 0x77, 0x9a, 0x25, 0x69, 0xea,
+	0x52, 0x11, 0xd2, 0xae, 0xad, 0xa4, 0xaa, 0xd8, 0xcd, 0x82, 0x58, 0xc7, 0x76, 0x5a, 0x43, 0x6c,
+	0xa7, 0x63, 0x87, 0xd5, 0x22, 0xa1, 0xab, 0xf1, 0xcc, 0x4d, 0x72, 0x37, 0xe3, 0x19, 0x77, 0x66,
+	0x9c, 0xc4, 0xfb, 0x09, 0x10, 0x0f, 0x88, 0x27, 0x1e, 0x40, 0xc0, 0x1b, 0x9f, 0x80, 0xcf, 0x80,
+	0x78, 0x5c, 0x09, 0x09, 0xf1, 0x88, 0xda, 0x77, 0x1e, 0xf8, 0x04, 0xe8, 0xfe, 0xf1, 0xcc, 0xd8,
+	0x69, 0x36, 0x0d, 0x08, 0x89, 0x37, 0x9f, 0x7f, 0x77, 0xee, 0x3d, 0xe7, 0xfc, 0x7e, 0xe7, 0x24,
+	0xb0, 0xe5, 0x93, 0x13, 0xe2, 0x56, 0x89, 0xe5, 0x59, 0x3e, 0xb1, 0x69, 0x58, 0x1d, 0x9a, 0xfe,
+	0x19, 0x09, 0x47, 0x8e, 0x69, 0x91, 0xea, 0xf9, 0x76, 0x35, 0x08, 0xcd, 0x90, 0x54, 0x46, 0xbe,
+	0x17, 0x7a, 0x68, 0x9d, 0xfb, 0x56, 0x22, 0xdf, 0x4a, 0xc2, 0xb7, 0x72, 0xbe, 0xbd, 0xba, 0x66,
+	0x79, 0xc1, 0xd0, 0x0b, 0xaa, 0x9e, 0x3f, 0xac, 0x9e, 0x6f, 0x9b, 0xce, 0xe8, 0xd4, 0xdc, 0x66,
+	0x82, 0x08, 0x5f, 0x5d, 0x3f, 0xf1, 0xbc, 0x13, 0x87, 0x54, 0xb9, 0x34, 0x18, 0x1f, 0x57, 0xed,
+	0xb1, 0x6f, 0x86, 0xd4, 0x73, 0xa5, 0xfd, 0xfe, 0xbc, 0x3d, 0xa4, 0x43, 0x12, 0x84, 0xe6, 0x70,
+	0x24, 0x1c, 0xca, 0xbf, 0xc8, 0x40, 0xae, 0x47, 0x1c, 0xa7, 0xeb, 0xdb, 0xc4, 0x47, 0x25, 0x48,
+	0x51, 0x5b, 0x57, 0x36, 0x94, 0xcd, 0x45, 0x23, 0x45, 0x6d, 0xf4, 0x3e, 0x2c, 0x05, 0xc4, 0x71,
+	0x88, 0xaf, 0xa7, 0x36, 0x94, 0xcd, 0x82, 0x21, 0x25, 0xf4, 0x21, 0xe4, 0x06, 0x66, 0x68, 0x9d,
+	0xe2, 0x33, 0x32, 0xd1, 0x55, 0xee, 0x9e, 0xe5, 0x8a, 0x1f, 0x93, 0x09, 0x5a, 0x85, 0xec, 0xab,
+	0xb1, 0xe9, 0x86, 0x34, 0x9c, 0xe8, 0x8b, 0x1b, 0xca, 0x66, 0xce, 0x88, 0x64, 0x16, 0x28, 0x1e,
+	0x88, 0xa9, 0xad, 0xa7, 0x45, 0xa0, 0x50, 0xb4, 0x6c, 0xb4, 0x06, 0x60, 0x06, 0x67, 0xd8, 0x1c,
+	0x7a, 0x63, 0x37, 0xd4, 0x97, 0x78, 0x68, 0xce, 0x0c, 0xce, 0x6a, 0x5c, 0x81, 0x2a, 0x70, 0xd7,
+	0xa6, 0x81, 0x39, 0x70, 0x08, 0x36, 0xc7, 0xa1, 0x87, 0x7d, 0x12, 0x52, 0x9f, 0xe8, 0x99, 0x0d,
+	0x65, 0x33, 0x6b, 0xdc, 0x91, 0xa6, 0xda, 0x38, 0xf4, 0x0c, 0x6e, 0x40, 0xbb, 0x00, 0xe4, 0x72,
+	0x44, 0x45, 0x3e, 0xf4, 0xdc, 0x86, 0xb2, 0x99, 0xdf, 0x59, 0xad, 0x88, 0x84, 0x54, 0xa6, 0x09,
+	0xa9, 0xf4, 0xa7, 0x09, 0x31, 0x12, 0xde, 0x68, 0x05, 0xd2, 0x43, 0xf3, 0x8c, 0xf8, 0x3a, 0xf0,
+	0xd3, 0x85, 0x80, 0xb6, 0xe0, 0xce, 0x90, 0xba, 0xf8, 0x98, 0x3a, 0x0e, 0x8e, 0x9e, 0x98, 0xe7,
+	0xf7, 0x5c, 0x1e, 0x52, 0x77, 0x9f, 0x3a, 0xce, 0xcb, 0xe9, 0x4b, 0xef, 0x41, 0xd6, 0xf1, 0x42,
+	0x1c, 0xd0, 0xaf, 0x88, 0x5e, 0xe0, 0x2e, 0x19, 0xc7, 0x0b, 0x7b, 0xf4, 0x2b, 0x82, 0x1e, 0x41,
+	0xc9, 0x74, 0x1c, 0xef, 0x82, 0xd8, 0x78, 0x30, 0x9e, 0x10, 0x3f, 0xd0, 0x8b, 0x1b, 0xea, 0x66,
+	0xc1, 0x28, 0x4a, 0xed, 0x1e, 0x57, 0xa2, 0x4f, 0x61, 0x75, 0xc6, 0x0d, 0x9f, 0xf8, 0xde, 0x78,
+	0x84, 0x47, 0x9e, 0x43, 0xad, 0x89, 0x5e, 0xe2, 0x05, 0xf9, 0x20, 0x19, 0xf2, 0x9c, 0xd9, 0x0f,
+	0xb9, 0x19, 0x75, 0xa1, 0xc8, 0x4a, 0x8d, 0xd9, 0x75, 0x3d, 0xdf, 0x22, 0xfa, 0xf2, 0x86, 0xb2,
+	0x59, 0xda, 0x79, 0x5c, 0xf9, 0xe6, 0x7e, 0xe3, 0xe9, 0x68, 0xb9, 0xfb, 0x2c, 0xc4, 0xc8, 0x87,
+	0xb1, 0x80, 0x0c, 0x00, 0x99, 0x75, 0x97, 0x5c, 0xe8, 0x1a, 0xcf, 0xe6, 0xd3, 0x9b, 0x4e, 0x8b,
+	0x3a, 0xab, 0x22, 0xea, 0xe2, 0x92, 0x0b, 0x23, 0x67, 0x4e, 0x7f, 0xb2, 0x4e, 0xe1, 0xc7, 0x99,
+	0x4e, 0xa0, 0xdf, 0xd9, 0x50, 0x36, 0x8b, 0x46, 0x24, 0xaf, 0xfe, 0x49, 0x81, 0x5c, 0x14, 0x84,
+	0x3e, 0x83, 0x92, 0xb4, 0xe0, 0x11, 0xf1, 0xa9, 0x27, 0x9a, 0x34, 0xbf, 0x73, 0xef, 0x4a, 0x3d,
+	0x1b, 0x12, 0x00, 0x46, 0x51, 0x06, 0x1c, 0x72, 0x7f, 0x74, 0x1f, 0xf2, 0x23, 0x9f, 0x5a, 0x04,
+	0xdb, 0xc4, 0x32, 0x27, 0xbc, 0x9f, 0x73, 0x06, 0x70, 0x55, 0x83, 0x69, 0xd0, 0x03, 0x28, 0x1c,
+	0x3b, 0x9e, 0xe7, 0x4f, 0xfb, 0x4f, 0xe5, 0x1e, 0x79, 0xae, 0x93, 0x1d, 0xf8, 0x00, 0x0a, 0x43,
+	0xf3, 0x12, 0x47, 0x77, 0x5e, 0xe4, 0x77, 0xce, 0x0f, 0xcd, 0x4b, 0x43, 0xaa, 0x76, 0x3f, 0xfd,
+	0xd7, 0xef, 0xff, 0xfa, 0x4b, 0xf5, 0x19, 0x2c, 0x31, 0x24, 0x69, 0x0a, 0x2a, 0x26, 0x90, 0xa2,
+	0x29, 0x08, 0xa6, 0x80, 0xd2, 0x52, 0xa8, 0x94, 0xec, 0x4f, 0x4d, 0xd5, 0x95, 0xf2, 0x1f, 0x32,
+	0x90, 0xdd, 0x1b, 0x4f, 0xde, 0x8e, 0xc5, 0x15, 0x48, 0xf3, 0x36, 0x90, 0x50, 0x14, 0x02, 0x3a,
+	0x84, 0x5c, 0x40, 0x1c, 0x62, 0xf1, 0x1e, 0x57, 0x79, 0x4e, 0x76, 0x6e, 0xaa, 0xca, 0xf4, 0x13,
+	0x95, 0xde, 0x34, 0xd2, 0x88, 0x0f, 0xf9, 0xaf, 0xe0, 0x3b, 0xa0, 0xf6, 0x1c, 0x7c, 0x07, 0xd4,
+	0x96, 0xc9, 0x8b, 0x20, 0x95, 0x49, 0x42, 0xea, 0x1a, 0x50, 0x67, 0xaf, 0x03, 0xf5, 0xf7, 0xe0,
+	0x03, 0xe1, 0x32, 0x24, 0x6e, 0x88, 0xbf, 0x1c, 0xfb, 0x34, 0xb0, 0xa9, 0x15, 0x21, 0x3c, 0x67,
+	0xbc, 0x1f, 0x9b, 0x7f, 0x94, 0xb0, 0xce, 0xb1, 0x01, 0xdc, 0x8a, 0x0d, 0x18, 0xdd, 0x71, 0x04,
+	0x1e, 0x13, 0x22, 0xf1, 0x9e, 0xe5, 0x8a, 0x7d, 0x42, 0xd0, 0x33, 0x48, 0x7c, 0x12, 0x0f, 0x88,
+	0x4b, 0x8e, 0xa9, 0x45, 0x4d, 0x7f, 0x22, 0x61, 0xff, 0x5e, 0x6c, 0xdd, 0x8b, 0x8d, 0xe8, 0x31,
+	0xdc, 0x49, 0x84, 0xf9, 0xc4, 0x0c, 0x3c, 0x57, 0x2f, 0xf2, 0x08, 0x2d, 0x36, 0x18, 0x5c, 0x8f,
+	0xb6, 0xe1, 0xbd, 0x63, 0x93, 0x3a, 0xc4, 0xc6, 0xac, 0x7b, 0xb0, 0xc7, 0xaa, 0x87, 0xa9, 0x1d,
+	0xe8, 0xa5, 0x0d, 0x75, 0x73, 0xd1, 0x40, 0xc2, 0x18, 0xa1, 0xad, 0x65, 0x07, 0xab, 0x7f, 0x4c,
+	0x71, 0x62, 0x97, 0x45, 0x5d, 0x4b, 0x12, 0x36, 0xef, 0xa9, 0x17, 0x0b, 0x09, 0xca, 0x7e, 0xc0,
+	0xc0, 0xe1, 0x7d, 0x49, 0xac, 0x90, 0x3b, 0xa4, 0xa4, 0x03, 0x48, 0x25, 0x73, 0x59, 0x83, 0x9c,
+	0xe5, 0x98, 0x41, 0x10, 0x53, 0x3e, 0x3b, 0x81, 0xab, 0x98, 0x79, 0x1b, 0x56, 0xa6, 0x27, 0xcc,
+	0x14, 0x45, 0x74, 0xd0, 0x5d, 0x69, 0x9b, 0xa9, 0xc8, 0x67, 0x50, 0x62, 0x6c, 0x1a, 0x84, 0xa6,
+	0x1f, 0x62, 0xdb, 0x0c, 0x89, 0x9e, 0xbe, 0xb1, 0x2a, 0x85, 0x21, 0x75, 0x7b, 0x2c, 0xa0, 0x61,
+	0x86, 0x04, 0x7d, 0x5f, 0xe0, 0x91, 0xb8, 0xb6, 0x88, 0x5f, 0xba, 0xb9, 0xaa, 0x43, 0xf3, 0xb2,
+	0xe9, 0xda, 0x2c, 0x7a, 0x2f, 0x0d, 0x6a, 0x30, 0x1e, 0xee, 0x3e, 0xe6, 0x88, 0x7d, 0x14, 0x21,
+	0x36, 0x27, 0x71, 0xa6, 0x29, 0x73, 0x08, 0x4d, 0xe9, 0xa9, 0xf2, 0x6f, 0x15, 0x28, 0xd4, 0x04,
+	0xe5, 0x36, 0x88, 0xeb, 0x0d, 0x79, 0xd3, 0x9b, 0xee, 0x19, 0xb6, 0x99, 0xa4, 0x2b, 0xb2, 0xe9,
+	0x4d, 0xf7, 0x4c, 0x98, 0x1f, 0x42, 0xd1, 0xa6, 0xc1, 0xc8, 0x31, 0x27, 0xd2, 0x43, 0xf0, 0x4e,
+	0x41, 0x2a, 0x85, 0xd3, 0x2a, 0x64, 0xc9, 0xe5, 0xc8, 0x73, 0x89, 0x64, 0x9d, 0xa2, 0x11, 0xc9,
+	0xd1, 0xed, 0x0a, 0xc9, 0xef, 0xa0, 0xbb, 0x73, 0xc7, 0x6a, 0x8a, 0xae, 0xe8, 0x6a, 0xf9, 0x6f,
+	0x2a, 0x2c, 0xb5, 0x39, 0x1c, 0xaf, 0xb0, 0xc7, 0x13, 0x40, 0x82, 0x0c, 0x70, 0x38, 0x19, 0x11,
+	0x6c, 0x0e, 0x06, 0x3e, 0x39, 0x97, 0xb7, 0xd1, 0x84, 0xa5, 0x3f, 0x19, 0x91, 0x1a, 0xd7, 0xcf,
+	0xbd, 0x4a, 0x9d, 0x7f, 0xd5, 0x47, 0x80, 0x46, 0x3e, 0xb1, 0x68, 0x40, 0x3d, 0x17, 0x0f, 0x3d,
+	0x9b, 0x1e, 0x53, 0xe2, 0x4b, 0x36, 0xbc, 0x13, 0x59, 0xda, 0xd2, 0x80, 0x5e, 0x42, 0xd1, 0x72,
+	0x88, 0xe9, 0x53, 0xf7, 0x84, 0x79, 0x8b, 0x3a, 0x97, 0x76, 0x9e, 0xdc, 0xc4, 0x53, 0x75, 0x19,
+	0xd4, 0xf6, 0x6c, 0x62, 0x14, 0xac, 0x84, 0xc4, 0x98, 0x98, 0x8c, 0x3c, 0xeb, 0x14, 0x3b, 0xc4,
+	0x3d, 0x09, 0x4f, 0x79, 0xe5, 0x17, 0x8d, 0x3c, 0xd7, 0x1d, 0x70, 0xd5, 0x2c, 0x68, 0x33, 0x73,
+	0xa0, 0x5d, 0x03, 0x10, 0x3c, 0xcc, 0xad, 0x59, 0xf1, 0x40, 0xa1, 0x61, 0xe6, 0xcf, 0x61, 0xf9,
+	0x98, 0xb0, 0x51, 0x11, 0x84, 0xd4, 0x8d, 0xf7, 0x87, 0xd2, 0x4e, 0xe5, 0xa6, 0x3b, 0xef, 0x13,
+	0xd2, 0x88, 0xa3, 0x8c, 0xd2, 0xf1, 0x8c, 0xbc, 0xfb, 0x8c, 0x97, 0xb3, 0x1a, 0x35, 0xdb, 0x43,
+	0x58, 0xbb, 0x5a, 0x96, 0x27, 0x71, 0xee, 0x79, 0x61, 0x17, 0xcb, 0x3f, 0x57, 0x21, 0xdd, 0xf7,
+	0x4d, 0x9b, 0x5c, 0xa9, 0x6b, 0x19, 0x8a, 0x33, 0x94, 0x20, 0xb0, 0x6b, 0xe4, 0x83, 0x98, 0x0b,
+	0xe2, 0xc9, 0xa1, 0x26, 0x27, 0x47, 0xbc, 0xdb, 0x2d, 0x5e, 0xbf, 0xdb, 0xa5, 0xbf, 0x61, 0xb7,
+	0x5b, 0x9a, 0x1b, 0x0e, 0x2b, 0x90, 0xe6, 0xe3, 0x54, 0x26, 0x5b, 0x08, 0x4c, 0x2b, 0xba, 0x48,
+	0x24, 0x59, 0x08, 0x48, 0x87, 0x8c, 0x20, 0x39, 0x9b, 0x27, 0x36, 0x6b, 0x4c, 0x45, 0xf4, 0x31,
+	0xe4, 0xa2, 0x1d, 0xf5, 0x1d, 0x68, 0x3a, 0x76, 0xe6, 0xeb, 0xe3, 0x98, 0x53, 0x0b, 0xcb, 0x43,
+	0x9e, 0xdf, 0x3c, 0x27, 0x35, 0x2d, 0x7b, 0xb7, 0xc9, 0x53, 0xff, 0xc3, 0xeb, 0x26, 0x73, 0x04,
+	0xfb, 0x54, 0x62, 0x48, 0xab, 0xcc, 0x2b, 0xfa, 0x82, 0xb6, 0xa8, 0xa7, 0xcb, 0xbf, 0x4b, 0x43,
+	0xa6, 0x26, 0x0e, 0xfd, 0xdf, 0xaf, 0xcb, 0x1d, 0x28, 0x4c, 0x9f, 0xc4, 0xfa, 0x43, 0x4f, 0xbf,
+	0xdb, 0x12, 0x27, 0xef, 0xc7, 0x00, 0x6d, 0xe4, 0xcd, 0x58, 0x88, 0x8b, 0xb1, 0x94, 0x2c, 0xc6,
+	0x7d, 0xc8, 0x0b, 0x12, 0x4e, 0x96, 0x0f, 0xb8, 0xea, 0x90, 0xd7, 0xf0, 0x21, 0x14, 0x7d, 0x12,
+	0x10, 0xff, 0x9c, 0x48, 0x17, 0x51, 0xcb, 0x82, 0x54, 0x0a, 0xa7, 0x4f, 0x40, 0x84, 0x60, 0x96,
+	0xaf, 0x77, 0x58, 0xb7, 0x73, 0xdc, 0x9b, 0xc9, 0xe8, 0x19, 0x64, 0x19, 0x87, 0xf3, 0xc0, 0x9b,
+	0x4b, 0x9e, 0x21, 0xae, 0xcd, 0xc3, 0xbe, 0x03, 0xcb, 0xd1, 0x4a, 0x27, 0xc6, 0xa5, 0x1c, 0xce,
+	0xa5, 0xe9, 0x5a, 0x27, 0xb4, 0x6c, 0xd2, 0xf0, 0xad, 0x0f, 0x53, 0x37, 0x24, 0xfe, 0xb9, 0xe9,
+	0xe8, 0x85, 0x1b, 0xb7, 0x47, 0x1e, 0xd0, 0x92, 0xfe, 0xac, 0xb2, 0x03, 0x6a, 0xdb, 0xc4, 0xe7,
+	0x23, 0xba, 0x60, 0x48, 0x89, 0x57, 0x96, 0xda, 0x32, 0x2b, 0x25, 0x49, 0x32, 0xd4, 0x16, 0x19,
+	0x99, 0xd9, 0x96, 0x96, 0xe7, 0xb6, 0xa5, 0x19, 0x7a, 0xd2, 0x66, 0xe9, 0x69, 0xf7, 0x13, 0xde,
+	0xab, 0x4f, 0xa3, 0x5e, 0x8d, 0x3b, 0x72, 0xae, 0x6f, 0x53, 0xa8, 0x10, 0xe7, 0x4e, 0x53, 0xf5,
+	0xa5, 0xf2, 0x3f, 0x55, 0x28, 0xbc, 0x1c, 0x7b, 0x21, 0x31, 0xc8, 0xab, 0x31, 0x09, 0xc2, 0xff,
+	0xcb, 0x3d, 0x32, 0xea, 0xc3, 0x74, 0xb2, 0x0f, 0xaf, 0xd9, 0x05, 0x97, 0xfe, 0x83, 0x5d, 0x30,
+	0x73, 0x8b, 0x5d, 0x30, 0x7b, 0xab, 0x5d, 0xf0, 0xfa, 0x75, 0x2f, 0x77, 0xeb, 0x75, 0x0f, 0xde,
+	0xbe, 0xee, 0xdd, 0x66, 0x25, 0xc9, 0x94, 0xff, 0xac, 0x40, 0x9a, 0x17, 0xfc, 0x4a, 0xa5, 0x37,
+	0x41, 0x7b, 0xc5, 0x0c, 0xd8, 0x17, 0xad, 0x10, 0x8f, 0x87, 0xd2, 0xab, 0x44, 0x87, 0xb4, 0x92,
+	0xc4, 0xa5, 0xce, 0x10, 0xd7, 0x95, 0xe9, 0xb2, 0x78, 0x65, 0xba, 0xec, 0x3e, 0xe7, 0x97, 0xad,
+	0x45, 0x97, 0x5d, 0xb9, 0xfa, 0xd5, 0xb9, 0x3f, 0x7c, 0xee, 0xce, 0x9d, 0xca, 0xfe, 0xf6, 0xd1,
+	0xb3, 0xe5, 0x5f, 0xab, 0xa0, 0x71, 0xe0, 0x74, 0x07, 0x8c, 0x56, 0xa2, 0xd5, 0x3b, 0xc6, 0x90,
+	0xf2, 0x16, 0x0c, 0x45, 0xbc, 0x9a, 0x9a, 0xe3, 0xd5, 0x99, 0x41, 0xa2, 0xde, 0x66, 0x90, 0x7c,
+	0x17, 0x34, 0x6b, 0x3c, 0x1c, 0x3b, 0x66, 0x48, 0x23, 0xc6, 0x13, 0x1d, 0xbc, 0x1c, 0xeb, 0x05,
+	0xc4, 0x11, 0x2c, 0x7a, 0x23, 0xe2, 0xca, 0x3e, 0xe6, 0xbf, 0x99, 0xee, 0x94, 0x9e, 0x9c, 0x4a,
+	0x8e, 0xe5, 0xbf, 0x91, 0x06, 0xaa, 0xe3, 0x5d, 0xc8, 0xb6, 0x64, 0x3f, 0x19, 0x04, 0x2c, 0xc7,
+	0x0b, 0xa6, 0x5c, 0x2a, 0x04, 0x56, 0x88, 0x73, 0xcf, 0x19, 0x4b, 0x02, 0xcd, 0x19, 0x52, 0x62,
+	0xde, 0xe7, 0xa6, 0x33, 0x26, 0xb2, 0x65, 0x84, 0xc0, 0x9a, 0x2a, 0x71, 0x51, 0x19, 0x98, 0x97,
+	0x3b, 0x5d, 0x64, 0xf8, 0x09, 0xd7, 0xef, 0x7e, 0xcc, 0xeb, 0xb4, 0x03, 0xf7, 0x61, 0x2d, 0xca,
+	0xe8, 0x93, 0x28, 0x7d, 0x4f, 0xe2, 0xe7, 0xcf, 0x8c, 0x3c, 0x45, 0xcf, 0x6d, 0xfd, 0x0c, 0xf2,
+	0x89, 0x89, 0x82, 0xbe, 0x05, 0x7a, 0xed, 0xa8, 0xde, 0x6f, 0x75, 0x3b, 0xb8, 0xff, 0xc5, 0x61,
+	0x13, 0x1f, 0x75, 0x7a, 0x87, 0xcd, 0x7a, 0x6b, 0xbf, 0xd5, 0x6c, 0x68, 0x0b, 0xe8, 0x7d, 0x40,
+	0x33, 0xd6, 0xc6, 0x51, 0xbf, 0xfe, 0x42, 0x53, 0x90, 0x0e, 0x2b, 0x33, 0xfa, 0x66, 0xe7, 0xf9,
+	0x41, 0xab, 0xf7, 0x42, 0x4b, 0x6d, 0xfd, 0x46, 0x81, 0x7c, 0xe2, 0xdf, 0x0e, 0x68, 0x0d, 0xee,
+	0xf5, 0x5b, 0xed, 0x26, 0x6e, 0x75, 0xf0, 0x7e, 0xd7, 0xa8, 0xcf, 0x7f, 0xe0, 0xdb, 0xb0, 0x31,
+	0x6b, 0x7e, 0xde, 0xed, 0x36, 0x70, 0xbf, 0x75, 0x80, 0xeb, 0xb5, 0x4e, 0xbd, 0x79, 0x70, 0xd0,
+	0x6c, 0x68, 0x0a, 0xba, 0x0f, 0x1f, 0x5e, 0xe3, 0xd5, 0xa8, 0xf5, 0x9b, 0x5a, 0x0a, 0x3d, 0x82,
+	0x07, 0xb3, 0x0e, 0xad, 0x76, 0xbb, 0xd9, 0x68, 0xd5, 0xfa, 0x4d, 0xdc, 0x35, 0xe4, 0x51, 0x9a,
+	0xba, 0xe5, 0x41, 0x69, 0x76, 0xa5, 0x63, 0x0f, 0xd9, 0x6f, 0x36, 0x71, 0xa3, 0xd9, 0xeb, 0xb7,
+	0x3a, 0x35, 0xfe, 0xa0, 0xbd, 0x23, 0xa3, 0xa3, 0x2d, 0xa0, 0x32, 0xac, 0xcf, 0x5b, 0xea, 0xdd,
+	0x76, 0xfb, 0xa8, 0xd3, 0xea, 0x7f, 0x81, 0x0f, 0xbb, 0xdd, 0x03, 0x71, 0xaf, 0x2b, 0x3e, 0x07,
+	0xb5, 0x5e, 0x0f, 0xd7, 0x1a, 0xed, 0x56, 0x47, 0x4b, 0x6d, 0xb5, 0xa1, 0x90, 0xdc, 0x7b, 0x59,
+	0xb6, 0xeb, 0x07, 0xcd, 0x9a, 0xd1, 0xea, 0x3c, 0xc7, 0xed, 0x6e, 0xa3, 0x89, 0xeb, 0xdd, 0x4e,
+	0xbf, 0xd5, 0x39, 0xea, 0x1e, 0xf5, 0xb4, 0x05, 0x76, 0xdc, 0xac, 0x75, 0xaf, 0xd6, 0xaf, 0xbf,
+	0xc0, 0x32, 0xd3, 0x9a, 0xb2, 0xf7, 0xf9, 0x5f, 0x5e, 0xaf, 0x2b, 0x5f, 0xbf, 0x5e, 0x57, 0xfe,
+	0xf1, 0x7a, 0x5d, 0xf9, 0xd5, 0x9b, 0xf5, 0x85, 0xaf, 0xdf, 0xac, 0x2f, 0xfc, 0xfd, 0xcd, 0xfa,
+	0xc2, 0x4f, 0x7f, 0x70, 0x42, 0xc3, 0xd3, 0xf1, 0xa0, 0x62, 0x79, 0xc3, 0x2a, 0x27, 0xfa, 0x8f,
+	0x5c, 0x12, 0x5e, 0x78, 0xfe, 0x99, 0x94, 0x1c, 0x62, 0x9f, 0x10, 0xbf, 0x7a, 0xf9, 0xf6, 0xff,
+	0x63, 0x0e, 0x96, 0x38, 0x86, 0x9e, 0xfe, 0x7b, 0x00, 0x74, 0xd4, 0xc9, 0xe5, 0xed, 0x14, 0x00,
+	0x00,
+}
+
+func (m *SellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Renewals != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Renewals))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AutoRenew != nil {
+		{
+			size, err := m.AutoRenew.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.TimeInForce != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.TimeInForce))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.AllowedBuyerGroupPolicy) > 0 {
+		i -= len(m.AllowedBuyerGroupPolicy)
+		copy(dAtA[i:], m.AllowedBuyerGroupPolicy)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AllowedBuyerGroupPolicy)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for iNdEx := len(m.AllowedBuyers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedBuyers[iNdEx])
+			copy(dAtA[i:], m.AllowedBuyers[iNdEx])
+			i = encodeVarintState(dAtA, i, uint64(len(m.AllowedBuyers[iNdEx])))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.LotSize) > 0 {
+		i -= len(m.LotSize)
+		copy(dAtA[i:], m.LotSize)
+		i = encodeVarintState(dAtA, i, uint64(len(m.LotSize)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.MinFillQuantity) > 0 {
+		i -= len(m.MinFillQuantity)
+		copy(dAtA[i:], m.MinFillQuantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.MinFillQuantity)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Maker {
+		i--
+		if m.Maker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AskAmount) > 0 {
+		i -= len(m.AskAmount)
+		copy(dAtA[i:], m.AskAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.AskAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SellOrder_AutoRenew) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SellOrder_AutoRenew) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SellOrder_AutoRenew) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRenewals != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MaxRenewals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FloorAmount) > 0 {
+		i -= len(m.FloorAmount)
+		copy(dAtA[i:], m.FloorAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.FloorAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PriceDecay) > 0 {
+		i -= len(m.PriceDecay)
+		copy(dAtA[i:], m.PriceDecay)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PriceDecay)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.RenewalPeriod != nil {
+		{
+			size, err := m.RenewalPeriod.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedSellOrderIds) > 0 {
+		dAtA5 := make([]byte, len(m.FailedSellOrderIds)*10)
+		var j4 int
+		for _, num := range m.FailedSellOrderIds {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintState(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.BuyerFee) > 0 {
+		i -= len(m.BuyerFee)
+		copy(dAtA[i:], m.BuyerFee)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BuyerFee)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Maker {
+		i--
+		if m.Maker {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.BidAmount) > 0 {
+		i -= len(m.BidAmount)
+		copy(dAtA[i:], m.BidAmount)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Selection != nil {
+		{
+			size, err := m.Selection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_Selection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyOrder_Selection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Selection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxEndDate != nil {
+		{
+			size, err := m.MaxEndDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MinStartDate != nil {
+		{
+			size, err := m.MinStartDate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ProjectJurisdiction) > 0 {
+		i -= len(m.ProjectJurisdiction)
+		copy(dAtA[i:], m.ProjectJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ProjectJurisdiction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Sum != nil {
+		{
+			size := m.Sum.Size()
+			i -= size
+			if _, err := m.Sum.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyOrder_Selection_BatchKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Selection_BatchKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_Selection_ProjectKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Selection_ProjectKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintState(dAtA, i, uint64(m.ProjectKey))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
+}
+func (m *BuyOrder_Selection_ClassKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyOrder_Selection_ClassKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarintState(dAtA, i, uint64(m.ClassKey))
+	i--
+	dAtA[i] = 0x18
+	return len(dAtA) - i, nil
+}
+func (m *AllowedDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exponent != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Exponent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DisplayDenom) > 0 {
+		i -= len(m.DisplayDenom)
+		copy(dAtA[i:], m.DisplayDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.DisplayDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Market) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Market) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Market) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeDestination != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.FeeDestination))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.SellerFee) > 0 {
+		i -= len(m.SellerFee)
+		copy(dAtA[i:], m.SellerFee)
+		i = encodeVarintState(dAtA, i, uint64(len(m.SellerFee)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.BuyerFee) > 0 {
+		i -= len(m.BuyerFee)
+		copy(dAtA[i:], m.BuyerFee)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BuyerFee)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ClearingMode != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.ClearingMode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.PrecisionModifier != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.PrecisionModifier))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CreditTypeAbbrev) > 0 {
+		i -= len(m.CreditTypeAbbrev)
+		copy(dAtA[i:], m.CreditTypeAbbrev)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CreditTypeAbbrev)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AuctionId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.SellOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Auction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Auction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Auction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuyerFee) > 0 {
+		i -= len(m.BuyerFee)
+		copy(dAtA[i:], m.BuyerFee)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BuyerFee)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.BidPrice) > 0 {
+		i -= len(m.BidPrice)
+		copy(dAtA[i:], m.BidPrice)
+		i = encodeVarintState(dAtA, i, uint64(len(m.BidPrice)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DecayInterval != nil {
+		{
+			size, err := m.DecayInterval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.PriceDecrement) > 0 {
+		i -= len(m.PriceDecrement)
+		copy(dAtA[i:], m.PriceDecrement)
+		i = encodeVarintState(dAtA, i, uint64(len(m.PriceDecrement)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.EndTime != nil {
+		{
+			size, err := m.EndTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ReservePrice) > 0 {
+		i -= len(m.ReservePrice)
+		copy(dAtA[i:], m.ReservePrice)
+		i = encodeVarintState(dAtA, i, uint64(len(m.ReservePrice)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StartPrice) > 0 {
+		i -= len(m.StartPrice)
+		copy(dAtA[i:], m.StartPrice)
+		i = encodeVarintState(dAtA, i, uint64(len(m.StartPrice)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.AuctionType != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.AuctionType))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuoteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuoteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuoteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RetirementReason) > 0 {
+		i -= len(m.RetirementReason)
+		copy(dAtA[i:], m.RetirementReason)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementReason)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.RetirementBeneficiary) > 0 {
+		i -= len(m.RetirementBeneficiary)
+		copy(dAtA[i:], m.RetirementBeneficiary)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementBeneficiary)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RetirementJurisdiction) > 0 {
+		i -= len(m.RetirementJurisdiction)
+		copy(dAtA[i:], m.RetirementJurisdiction)
+		i = encodeVarintState(dAtA, i, uint64(len(m.RetirementJurisdiction)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DisableAutoRetire {
+		i--
+		if m.DisableAutoRetire {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Quantity) > 0 {
+		i -= len(m.Quantity)
+		copy(dAtA[i:], m.Quantity)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Quantity)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Selection != nil {
+		{
+			size, err := m.Selection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Quote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Quote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Quote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SellOrderId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.SellOrderId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.QuoteRequestId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.QuoteRequestId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PriceObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeVolume) > 0 {
+		i -= len(m.CumulativeVolume)
+		copy(dAtA[i:], m.CumulativeVolume)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CumulativeVolume)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Volume) > 0 {
+		i -= len(m.Volume)
+		copy(dAtA[i:], m.Volume)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Volume)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Close) > 0 {
+		i -= len(m.Close)
+		copy(dAtA[i:], m.Close)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Close)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Low) > 0 {
+		i -= len(m.Low)
+		copy(dAtA[i:], m.Low)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Low)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.High) > 0 {
+		i -= len(m.High)
+		copy(dAtA[i:], m.High)
+		i = encodeVarintState(dAtA, i, uint64(len(m.High)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Open) > 0 {
+		i -= len(m.Open)
+		copy(dAtA[i:], m.Open)
+		i = encodeVarintState(dAtA, i, uint64(len(m.Open)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CumulativePrice) > 0 {
+		i -= len(m.CumulativePrice)
+		copy(dAtA[i:], m.CumulativePrice)
+		i = encodeVarintState(dAtA, i, uint64(len(m.CumulativePrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timestamp != nil {
+		{
+			size, err := m.Timestamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintState(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BatchKey != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.BatchKey))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MarketId != 0 {
+		i = encodeVarintState(dAtA, i, uint64(m.MarketId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintState(dAtA []byte, offset int, v uint64) int {
+	offset -= sovState(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.AskAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Maker {
+		n += 2
+	}
+	l = len(m.MinFillQuantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.LotSize)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.AllowedBuyers) > 0 {
+		for _, b := range m.AllowedBuyers {
+			l = len(b)
+			n += 1 + l + sovState(uint64(l))
+		}
+	}
+	l = len(m.AllowedBuyerGroupPolicy)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.TimeInForce != 0 {
+		n += 1 + sovState(uint64(m.TimeInForce))
+	}
+	if m.AutoRenew != nil {
+		l = m.AutoRenew.Size()
+		n += 2 + l + sovState(uint64(l))
+	}
+	if m.Renewals != 0 {
+		n += 2 + sovState(uint64(m.Renewals))
+	}
+	return n
+}
+
+func (m *SellOrder_AutoRenew) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RenewalPeriod != nil {
+		l = m.RenewalPeriod.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.PriceDecay)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.FloorAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxRenewals != 0 {
+		n += 1 + sovState(uint64(m.MaxRenewals))
+	}
+	return n
+}
+
+func (m *BuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Selection != nil {
+		l = m.Selection.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BidAmount)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Maker {
+		n += 2
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BuyerFee)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if len(m.FailedSellOrderIds) > 0 {
+		l = 0
+		for _, e := range m.FailedSellOrderIds {
+			l += sovState(uint64(e))
+		}
+		n += 1 + sovState(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *BuyOrder_Selection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sum != nil {
+		n += m.Sum.Size()
+	}
+	l = len(m.ProjectJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MinStartDate != nil {
+		l = m.MinStartDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MaxEndDate != nil {
+		l = m.MaxEndDate.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyOrder_Selection_BatchKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovState(uint64(m.BatchKey))
+	return n
+}
+func (m *BuyOrder_Selection_ProjectKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovState(uint64(m.ProjectKey))
+	return n
+}
+func (m *BuyOrder_Selection_ClassKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovState(uint64(m.ClassKey))
+	return n
+}
+func (m *AllowedDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.DisplayDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Exponent != 0 {
+		n += 1 + sovState(uint64(m.Exponent))
+	}
+	return n
+}
+
+func (m *Market) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.CreditTypeAbbrev)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.PrecisionModifier != 0 {
+		n += 1 + sovState(uint64(m.PrecisionModifier))
+	}
+	if m.ClearingMode != 0 {
+		n += 1 + sovState(uint64(m.ClearingMode))
+	}
+	if m.EpochLength != 0 {
+		n += 1 + sovState(uint64(m.EpochLength))
+	}
+	l = len(m.BuyerFee)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.SellerFee)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.FeeDestination != 0 {
+		n += 1 + sovState(uint64(m.FeeDestination))
+	}
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovState(uint64(m.SellOrderId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Retired {
+		n += 2
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovState(uint64(m.AuctionId))
+	}
+	return n
+}
+
+func (m *Auction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.AuctionType != 0 {
+		n += 1 + sovState(uint64(m.AuctionType))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.StartPrice)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.ReservePrice)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = m.EndTime.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.PriceDecrement)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DecayInterval != nil {
+		l = m.DecayInterval.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.BidPrice)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	l = len(m.BuyerFee)
+	if l > 0 {
+		n += 2 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *QuoteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Selection != nil {
+		l = m.Selection.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Quantity)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.DisableAutoRetire {
+		n += 2
+	}
+	l = len(m.RetirementJurisdiction)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementBeneficiary)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.RetirementReason)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func (m *Quote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovState(uint64(m.Id))
+	}
+	if m.QuoteRequestId != 0 {
+		n += 1 + sovState(uint64(m.QuoteRequestId))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	if m.SellOrderId != 0 {
+		n += 1 + sovState(uint64(m.SellOrderId))
+	}
+	return n
+}
+
+func (m *PriceObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MarketId != 0 {
+		n += 1 + sovState(uint64(m.MarketId))
+	}
+	if m.BatchKey != 0 {
+		n += 1 + sovState(uint64(m.BatchKey))
+	}
+	if m.Timestamp != nil {
+		l = m.Timestamp.Size()
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CumulativePrice)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Open)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.High)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Low)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Close)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Volume)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	l = len(m.CumulativeVolume)
+	if l > 0 {
+		n += 1 + l + sovState(uint64(l))
+	}
+	return n
+}
+
+func sovState(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozState(x uint64) (n int) {
+	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AskAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maker = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFillQuantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinFillQuantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LotSize", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LotSize = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyers", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyers = append(m.AllowedBuyers, make([]byte, postIndex-iNdEx))
+			copy(m.AllowedBuyers[len(m.AllowedBuyers)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedBuyerGroupPolicy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedBuyerGroupPolicy = append(m.AllowedBuyerGroupPolicy[:0], dAtA[iNdEx:postIndex]...)
+			if m.AllowedBuyerGroupPolicy == nil {
+				m.AllowedBuyerGroupPolicy = []byte{}
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeInForce", wireType)
+			}
+			m.TimeInForce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeInForce |= TimeInForce(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoRenew", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AutoRenew == nil {
+				m.AutoRenew = &SellOrder_AutoRenew{}
+			}
+			if err := m.AutoRenew.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Renewals", wireType)
+			}
+			m.Renewals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Renewals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SellOrder_AutoRenew) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoRenew: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoRenew: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RenewalPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RenewalPeriod == nil {
+				m.RenewalPeriod = &types.Duration{}
+			}
+			if err := m.RenewalPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecay", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDecay = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FloorAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRenewals", wireType)
+			}
+			m.MaxRenewals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRenewals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selection == nil {
+				m.Selection = &BuyOrder_Selection{}
+			}
+			if err := m.Selection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Maker = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.FailedSellOrderIds = append(m.FailedSellOrderIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowState
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthState
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthState
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.FailedSellOrderIds) == 0 {
+					m.FailedSellOrderIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowState
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.FailedSellOrderIds = append(m.FailedSellOrderIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedSellOrderIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyOrder_Selection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Selection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Selection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sum = &BuyOrder_Selection_BatchKey{v}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectKey", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sum = &BuyOrder_Selection_ProjectKey{v}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassKey", wireType)
+			}
+			var v uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sum = &BuyOrder_Selection_ClassKey{v}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStartDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MinStartDate == nil {
+				m.MinStartDate = &types.Timestamp{}
+			}
+			if err := m.MinStartDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxEndDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MaxEndDate == nil {
+				m.MaxEndDate = &types.Timestamp{}
+			}
+			if err := m.MaxEndDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+			}
+			m.Exponent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Exponent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Market) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Market: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+			}
+			m.PrecisionModifier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrecisionModifier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClearingMode", wireType)
+			}
+			m.ClearingMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClearingMode |= ClearingMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SellerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDestination", wireType)
+			}
+			m.FeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeDestination |= FeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
+			}
+			m.SellOrderId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SellOrderId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Auction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Auction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Auction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quantity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionType", wireType)
+			}
+			m.AuctionType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionType |= AuctionType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservePrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = &types.Timestamp{}
+			}
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = &types.Timestamp{}
+			}
+			if err := m.EndTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceDecrement", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceDecrement = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DecayInterval == nil {
+				m.DecayInterval = &types.Duration{}
+			}
+			if err := m.DecayInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = append(m.Bidder[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bidder == nil {
+				m.Bidder = []byte{}
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Market) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovState(uint64(m.Id))
-	}
-	l = len(m.CreditTypeAbbrev)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	l = len(m.BankDenom)
-	if l > 0 {
-		n += 1 + l + sovState(uint64(l))
-	}
-	if m.PrecisionModifier != 0 {
-		n += 1 + sovState(uint64(m.PrecisionModifier))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovState(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozState(x uint64) (n int) {
-	return sovState(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *SellOrder) Unmarshal(dAtA []byte) error {
+func (m *QuoteRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -677,10 +6116,10 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SellOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuoteRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuoteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -704,7 +6143,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -731,16 +6170,16 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seller == nil {
-				m.Seller = []byte{}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Selection", wireType)
 			}
-			m.BatchKey = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -750,11 +6189,28 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchKey |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Selection == nil {
+				m.Selection = &BuyOrder_Selection{}
+			}
+			if err := m.Selection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
@@ -788,10 +6244,10 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			m.Quantity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			m.MarketId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -801,14 +6257,147 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MarketId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoRetire = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AskAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementJurisdiction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementJurisdiction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = &types.Timestamp{}
+			}
+			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementBeneficiary", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetirementBeneficiary = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetirementReason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -836,13 +6425,82 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AskAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			m.RetirementReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipState(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthState
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Quote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowState
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Quote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Quote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoRetire", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteRequestId", wireType)
 			}
-			var v int
+			m.QuoteRequestId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -852,17 +6510,16 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.QuoteRequestId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableAutoRetire = bool(v != 0)
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -872,33 +6529,31 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthState
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Expiration == nil {
-				m.Expiration = &types.Timestamp{}
-			}
-			if err := m.Expiration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Seller = append(m.Seller[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seller == nil {
+				m.Seller = []byte{}
 			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Maker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrderId", wireType)
 			}
-			var v int
+			m.SellOrderId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -908,12 +6563,11 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.SellOrderId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Maker = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -935,7 +6589,7 @@ func (m *SellOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
+func (m *PriceObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -958,15 +6612,89 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AllowedDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: PriceObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowedDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PriceObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			m.MarketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchKey", wireType)
+			}
+			m.BatchKey = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchKey |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Timestamp == nil {
+				m.Timestamp = &types.Timestamp{}
+			}
+			if err := m.Timestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativePrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -994,11 +6722,11 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.CumulativePrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisplayDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1026,13 +6754,13 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DisplayDenom = string(dAtA[iNdEx:postIndex])
+			m.Open = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Exponent", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
-			m.Exponent = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1042,66 +6770,61 @@ func (m *AllowedDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Exponent |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipState(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthState
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Market) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowState
+			m.High = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowState
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Market: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Market: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
 			}
-			m.Id = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Low = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1111,14 +6834,27 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Close = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreditTypeAbbrev", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1146,11 +6882,11 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreditTypeAbbrev = string(dAtA[iNdEx:postIndex])
+			m.Volume = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1178,13 +6914,13 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			m.Value = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrecisionModifier", wireType)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeVolume", wireType)
 			}
-			m.PrecisionModifier = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowState
@@ -1194,11 +6930,24 @@ func (m *Market) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PrecisionModifier |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthState
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthState
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeVolume = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipState(dAtA[iNdEx:])
@@ -5,11 +5,13 @@
 package mocks
 
 import (
+	context "context"
 	reflect "reflect"
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	types0 "github.com/cosmos/cosmos-sdk/x/auth/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
+	group "github.com/cosmos/cosmos-sdk/x/group"
 	types2 "github.com/cosmos/cosmos-sdk/x/params/types"
 	gomock "github.com/golang/mock/gomock"
 )
@@ -238,6 +240,96 @@ func (mr *MockBankKeeperMockRecorder) SpendableCoins(ctx, addr interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpendableCoins", reflect.TypeOf((*MockBankKeeper)(nil).SpendableCoins), ctx, addr)
 }
 
+// MockDistributionKeeper is a mock of DistributionKeeper interface.
+type MockDistributionKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockDistributionKeeperMockRecorder
+}
+
+// MockDistributionKeeperMockRecorder is the mock recorder for MockDistributionKeeper.
+type MockDistributionKeeperMockRecorder struct {
+	mock *MockDistributionKeeper
+}
+
+// NewMockDistributionKeeper creates a new mock instance.
+func NewMockDistributionKeeper(ctrl *gomock.Controller) *MockDistributionKeeper {
+	mock := &MockDistributionKeeper{ctrl: ctrl}
+	mock.recorder = &MockDistributionKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDistributionKeeper) EXPECT() *MockDistributionKeeperMockRecorder {
+	return m.recorder
+}
+
+// FundCommunityPool mocks base method.
+func (m *MockDistributionKeeper) FundCommunityPool(ctx types.Context, amount types.Coins, sender types.AccAddress) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FundCommunityPool", ctx, amount, sender)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FundCommunityPool indicates an expected call of FundCommunityPool.
+func (mr *MockDistributionKeeperMockRecorder) FundCommunityPool(ctx, amount, sender interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FundCommunityPool", reflect.TypeOf((*MockDistributionKeeper)(nil).FundCommunityPool), ctx, amount, sender)
+}
+
+// MockGroupKeeper is a mock of GroupKeeper interface.
+type MockGroupKeeper struct {
+	ctrl     *gomock.Controller
+	recorder *MockGroupKeeperMockRecorder
+}
+
+// MockGroupKeeperMockRecorder is the mock recorder for MockGroupKeeper.
+type MockGroupKeeperMockRecorder struct {
+	mock *MockGroupKeeper
+}
+
+// NewMockGroupKeeper creates a new mock instance.
+func NewMockGroupKeeper(ctrl *gomock.Controller) *MockGroupKeeper {
+	mock := &MockGroupKeeper{ctrl: ctrl}
+	mock.recorder = &MockGroupKeeperMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGroupKeeper) EXPECT() *MockGroupKeeperMockRecorder {
+	return m.recorder
+}
+
+// GroupMembers mocks base method.
+func (m *MockGroupKeeper) GroupMembers(ctx context.Context, req *group.QueryGroupMembersRequest) (*group.QueryGroupMembersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupMembers", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupMembersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupMembers indicates an expected call of GroupMembers.
+func (mr *MockGroupKeeperMockRecorder) GroupMembers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupMembers", reflect.TypeOf((*MockGroupKeeper)(nil).GroupMembers), ctx, req)
+}
+
+// GroupPolicyInfo mocks base method.
+func (m *MockGroupKeeper) GroupPolicyInfo(ctx context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GroupPolicyInfo", ctx, req)
+	ret0, _ := ret[0].(*group.QueryGroupPolicyInfoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GroupPolicyInfo indicates an expected call of GroupPolicyInfo.
+func (mr *MockGroupKeeperMockRecorder) GroupPolicyInfo(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GroupPolicyInfo", reflect.TypeOf((*MockGroupKeeper)(nil).GroupPolicyInfo), ctx, req)
+}
+
 // MockParamKeeper is a mock of ParamKeeper interface.
 type MockParamKeeper struct {
 	ctrl     *gomock.Controller
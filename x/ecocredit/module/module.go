@@ -42,6 +42,8 @@ type Module struct {
 	paramSpace    paramtypes.Subspace
 	accountKeeper ecocredit.AccountKeeper
 	bankKeeper    ecocredit.BankKeeper
+	distrKeeper   ecocredit.DistributionKeeper
+	groupKeeper   ecocredit.GroupKeeper
 	Keeper        server.Keeper
 	authority     sdk.AccAddress
 }
@@ -51,6 +53,8 @@ func NewModule(
 	paramSpace paramtypes.Subspace,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
+	distrKeeper ecocredit.DistributionKeeper,
+	groupKeeper ecocredit.GroupKeeper,
 	authority sdk.AccAddress,
 ) *Module {
 	if !paramSpace.HasKeyTable() {
@@ -60,6 +64,8 @@ func NewModule(
 	return &Module{
 		paramSpace:    paramSpace,
 		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		groupKeeper:   groupKeeper,
 		accountKeeper: accountKeeper,
 		authority:     authority,
 	}
@@ -82,7 +88,7 @@ func (a Module) RegisterInterfaces(registry types.InterfaceRegistry) {
 }
 
 func (a *Module) RegisterServices(configurator servermodule.Configurator) {
-	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper, a.authority)
+	a.Keeper = server.RegisterServices(configurator, a.paramSpace, a.accountKeeper, a.bankKeeper, a.distrKeeper, a.groupKeeper, a.authority)
 }
 
 //nolint:errcheck
@@ -219,3 +225,13 @@ func (a Module) BeginBlock(ctx sdk.Context, req abci.RequestBeginBlock) {
 		panic(err)
 	}
 }
+
+// EndBlock matches and fills crossing buy and sell orders in the order book.
+func (a Module) EndBlock(ctx sdk.Context, req abci.RequestEndBlock) []abci.ValidatorUpdate {
+	err := server.EndBlocker(ctx, a.Keeper)
+	if err != nil {
+		panic(err)
+	}
+
+	return nil
+}
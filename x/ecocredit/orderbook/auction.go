@@ -0,0 +1,253 @@
+package orderbook
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	orderbookv1alpha1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// processAuction clears the crossing orders of the market in a batch auction at a
+// single uniform price. The clearing price is the lowest of the encoded bid and ask
+// prices in the order book at which the quantity of credits matched is the highest.
+// Every match with a bid price greater than or equal to the clearing price and an ask
+// price less than or equal to the clearing price is then filled in price-time priority
+// at the clearing price.
+func (o *orderbook) processAuction(ctx context.Context, market *marketplacev1.Market, fill FillFunc) error {
+	matches, err := o.marketMatches(ctx, market.Id)
+	if err != nil {
+		return err
+	}
+
+	matches, buyQuantities, sellOrders, err := o.auctionOrders(ctx, matches)
+	if err != nil {
+		return err
+	}
+
+	clearingPrice, ok, err := auctionClearingPrice(matches, buyQuantities, sellOrders)
+	if err != nil || !ok {
+		return err
+	}
+
+	uniformPrice := func(buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (string, error) {
+		return settlementPrice(clearingPrice, market, buyOrder, sellOrder)
+	}
+
+	for _, match := range matches {
+		if !clearsAt(match, clearingPrice) {
+			continue
+		}
+		if err = o.processMatch(ctx, match, fill, uniformPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// auctionOrders returns the matches of orders that still exist and can still be filled
+// along with the quantities of the buy orders and the sell orders keyed by their ids.
+// The matches of orders that no longer exist or can no longer be filled are removed.
+func (o *orderbook) auctionOrders(ctx context.Context, matches []*orderbookv1alpha1.BuyOrderSellOrderMatch) (
+	[]*orderbookv1alpha1.BuyOrderSellOrderMatch, map[uint64]math.Dec, map[uint64]*marketplacev1.SellOrder, error,
+) {
+	buyQuantities := make(map[uint64]math.Dec)
+	sellOrders := make(map[uint64]*marketplacev1.SellOrder)
+	removed := make(map[uint64]bool)
+
+	var valid []*orderbookv1alpha1.BuyOrderSellOrderMatch
+	for _, match := range matches {
+		if removed[match.BuyOrderId] || removed[match.SellOrderId] {
+			continue
+		}
+
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, match.BuyOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				removed[match.BuyOrderId] = true
+				err = o.removeBuyOrder(ctx, match.BuyOrderId)
+			}
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+
+		sellOrder, err := o.marketplaceStore.SellOrderTable().Get(ctx, match.SellOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				removed[match.SellOrderId] = true
+				err = o.removeSellOrder(ctx, match.SellOrderId)
+			}
+			if err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+
+		ok, err := canFill(buyOrder, sellOrder)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if !ok {
+			if err = o.memStore.BuyOrderSellOrderMatchTable().Delete(ctx, match); err != nil {
+				return nil, nil, nil, err
+			}
+			continue
+		}
+
+		if buyQuantities[buyOrder.Id], err = math.NewDecFromString(buyOrder.Quantity); err != nil {
+			return nil, nil, nil, err
+		}
+		sellOrders[sellOrder.Id] = sellOrder
+
+		valid = append(valid, match)
+	}
+
+	return valid, buyQuantities, sellOrders, nil
+}
+
+// auctionClearingPrice returns the lowest of the encoded bid and ask prices of the
+// matches at which the quantity of credits that can be matched is the highest. The
+// quantity that can be matched at a price is the lower of the demand, the quantity of the
+// buy orders with a bid price greater than or equal to the price, and the supply, the
+// quantity of the sell orders with an ask price less than or equal to the price. The
+// prices are sorted once and the demand and the supply are accumulated in a single sweep.
+// The minimum fill quantities and the lot sizes of the sell orders are only applied when
+// the matches are filled. It returns false if no credits can be matched at any price.
+func auctionClearingPrice(matches []*orderbookv1alpha1.BuyOrderSellOrderMatch, buyQuantities map[uint64]math.Dec, sellOrders map[uint64]*marketplacev1.SellOrder) (uint32, bool, error) {
+	// the quantities of the buy orders at their bid prices and of the sell orders at
+	// their ask prices, counting each order once
+	demand := make(map[uint32]math.Dec)
+	supply := make(map[uint32]math.Dec)
+	seenBuy := make(map[uint64]bool)
+	seenSell := make(map[uint64]bool)
+	for _, match := range matches {
+		if !seenBuy[match.BuyOrderId] {
+			seenBuy[match.BuyOrderId] = true
+			if err := addQuantity(demand, ^match.BidPriceComplement, buyQuantities[match.BuyOrderId]); err != nil {
+				return 0, false, err
+			}
+		}
+		if !seenSell[match.SellOrderId] {
+			seenSell[match.SellOrderId] = true
+			quantity, err := math.NewDecFromString(sellOrders[match.SellOrderId].Quantity)
+			if err != nil {
+				return 0, false, err
+			}
+			if err = addQuantity(supply, match.AskPrice, quantity); err != nil {
+				return 0, false, err
+			}
+		}
+	}
+
+	totalDemand := math.NewDecFromInt64(0)
+	for _, quantity := range demand {
+		var err error
+		if totalDemand, err = totalDemand.Add(quantity); err != nil {
+			return 0, false, err
+		}
+	}
+
+	candidates := make([]uint32, 0, len(demand)+len(supply))
+	for price := range demand {
+		candidates = append(candidates, price)
+	}
+	for price := range supply {
+		if _, ok := demand[price]; !ok {
+			candidates = append(candidates, price)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool { return candidates[i] < candidates[j] })
+
+	// the demand at a price is the total demand less the demand below the price and the
+	// supply at a price is the supply at or below the price
+	var clearingPrice uint32
+	maxQuantity := math.NewDecFromInt64(0)
+	demandBelow, supplyAtOrBelow := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+	for _, price := range candidates {
+		var err error
+		if sellQty, ok := supply[price]; ok {
+			if supplyAtOrBelow, err = supplyAtOrBelow.Add(sellQty); err != nil {
+				return 0, false, err
+			}
+		}
+
+		quantity, err := totalDemand.Sub(demandBelow)
+		if err != nil {
+			return 0, false, err
+		}
+		if supplyAtOrBelow.Cmp(quantity) == math.LessThan {
+			quantity = supplyAtOrBelow
+		}
+		if quantity.Cmp(maxQuantity) == math.GreaterThan {
+			clearingPrice, maxQuantity = price, quantity
+		}
+
+		if buyQty, ok := demand[price]; ok {
+			if demandBelow, err = demandBelow.Add(buyQty); err != nil {
+				return 0, false, err
+			}
+		}
+	}
+
+	return clearingPrice, !maxQuantity.IsZero(), nil
+}
+
+// addQuantity adds the quantity to the quantity at the price.
+func addQuantity(quantities map[uint32]math.Dec, price uint32, quantity math.Dec) error {
+	sum, ok := quantities[price]
+	if !ok {
+		quantities[price] = quantity
+		return nil
+	}
+	sum, err := sum.Add(quantity)
+	if err != nil {
+		return err
+	}
+	quantities[price] = sum
+	return nil
+}
+
+// clearsAt returns whether the bid price of the match is greater than or equal to and
+// the ask price of the match is less than or equal to the encoded price.
+func clearsAt(match *orderbookv1alpha1.BuyOrderSellOrderMatch, price uint32) bool {
+	return ^match.BidPriceComplement >= price && match.AskPrice <= price
+}
+
+// settlementPrice converts the encoded clearing price to an integer bank amount using
+// the precision modifier of the market. Because encoded prices are rounded or clamped,
+// the amount is limited to the bid amount of the buy order and the ask amount of the sell
+// order so that buyers never pay more than their bid and sellers never receive less than
+// their ask.
+func settlementPrice(clearingPrice uint32, market *marketplacev1.Market, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (string, error) {
+	amount := new(big.Int).Mul(
+		new(big.Int).SetUint64(uint64(clearingPrice)),
+		new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(market.PrecisionModifier)), nil),
+	)
+
+	bidAmount, ok := new(big.Int).SetString(buyOrder.BidAmount, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid bid amount %s of buy order %d", buyOrder.BidAmount, buyOrder.Id)
+	}
+
+	askAmount, ok := new(big.Int).SetString(sellOrder.AskAmount, 10)
+	if !ok {
+		return "", fmt.Errorf("invalid ask amount %s of sell order %d", sellOrder.AskAmount, sellOrder.Id)
+	}
+
+	if amount.Cmp(bidAmount) > 0 {
+		amount = bidAmount
+	}
+	if amount.Cmp(askAmount) < 0 {
+		amount = askAmount
+	}
+
+	return amount.String(), nil
+}
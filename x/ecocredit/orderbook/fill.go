@@ -0,0 +1,103 @@
+package orderbook
+
+import (
+	"fmt"
+
+	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// CheckFillQuantity checks that quantity credits can be filled from the sell order in
+// a single fill. The remaining quantity of the sell order can always be filled in full,
+// otherwise the quantity must be a multiple of the lot size and greater than or equal
+// to the minimum fill quantity of the sell order. Quantities greater than the remaining
+// quantity are not checked.
+func CheckFillQuantity(sellOrder *marketplacev1.SellOrder, quantity math.Dec) error {
+	remaining, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	if quantity.Cmp(remaining) != math.LessThan {
+		return nil
+	}
+
+	if sellOrder.LotSize != "" {
+		lotSize, err := math.NewDecFromString(sellOrder.LotSize)
+		if err != nil {
+			return err
+		}
+		rem, err := quantity.Rem(lotSize)
+		if err != nil {
+			return err
+		}
+		if !rem.IsZero() {
+			return fmt.Errorf("quantity %s is not a multiple of the lot size %s", quantity, sellOrder.LotSize)
+		}
+	}
+
+	if sellOrder.MinFillQuantity != "" {
+		minFill, err := math.NewDecFromString(sellOrder.MinFillQuantity)
+		if err != nil {
+			return err
+		}
+		if quantity.Cmp(minFill) == math.LessThan {
+			return fmt.Errorf("quantity %s is less than the minimum fill quantity %s", quantity, sellOrder.MinFillQuantity)
+		}
+	}
+
+	return nil
+}
+
+// FillableQuantity returns the highest quantity of credits less than or equal to quantity
+// that can be filled from the sell order in a single fill, or zero if no credits can be
+// filled.
+func FillableQuantity(sellOrder *marketplacev1.SellOrder, quantity math.Dec) (math.Dec, error) {
+	remaining, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	return fillableQuantity(sellOrder, quantity, remaining)
+}
+
+// fillableQuantity returns the highest quantity of credits less than or equal to quantity
+// that can be filled from the remaining quantity of the sell order in a single fill. The
+// quantity is rounded down to a multiple of the lot size and zero is returned if it is
+// less than the minimum fill quantity, unless the remaining quantity is filled in full.
+func fillableQuantity(sellOrder *marketplacev1.SellOrder, quantity, remaining math.Dec) (math.Dec, error) {
+	if quantity.Cmp(remaining) != math.LessThan {
+		return remaining, nil
+	}
+
+	return lotQuantity(sellOrder, quantity)
+}
+
+// lotQuantity returns the quantity rounded down to a multiple of the lot size of the sell
+// order, or zero if it is less than the minimum fill quantity of the sell order.
+func lotQuantity(sellOrder *marketplacev1.SellOrder, quantity math.Dec) (math.Dec, error) {
+	if sellOrder.LotSize != "" {
+		lotSize, err := math.NewDecFromString(sellOrder.LotSize)
+		if err != nil {
+			return math.Dec{}, err
+		}
+		lots, err := quantity.QuoInteger(lotSize)
+		if err != nil {
+			return math.Dec{}, err
+		}
+		if quantity, err = lots.Mul(lotSize); err != nil {
+			return math.Dec{}, err
+		}
+	}
+
+	if sellOrder.MinFillQuantity != "" {
+		minFill, err := math.NewDecFromString(sellOrder.MinFillQuantity)
+		if err != nil {
+			return math.Dec{}, err
+		}
+		if quantity.Cmp(minFill) == math.LessThan {
+			return math.NewDecFromInt64(0), nil
+		}
+	}
+
+	return quantity, nil
+}
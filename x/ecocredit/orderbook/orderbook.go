@@ -2,25 +2,35 @@ package orderbook
 
 import (
 	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 
 	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	orderbookv1alpha1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
 	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 )
 
-// TODO: revisit when BuyOrder is reintroduced for marketplace order-book https://github.com/regen-network/regen-ledger/issues/505
+// maxPrice is the highest encoded price in the order book.
+const maxPrice = ^uint32(0)
 
 type orderbook struct {
 	memStore         orderbookv1alpha1.MemoryStore
 	marketplaceStore marketplacev1.StateStore
 	ecocreditStore   ecocreditv1.StateStore
+	loaded           bool
 }
 
-// NewOrderBook creates a new OrderBook instance.
-func NewOrderBook(db ormdb.ModuleDB) (OrderBook, error) {
-	memStore, err := orderbookv1alpha1.NewMemoryStore(db)
+// NewOrderBook creates a new OrderBook instance. The memory tables of the order
+// book are stored in memDB and the marketplace and ecocredit state is read from db.
+func NewOrderBook(memDB ormdb.ModuleDB, db ormdb.ModuleDB) (OrderBook, error) {
+	memStore, err := orderbookv1alpha1.NewMemoryStore(memDB)
 	if err != nil {
 		return nil, err
 	}
@@ -42,33 +52,992 @@ func NewOrderBook(db ormdb.ModuleDB) (OrderBook, error) {
 	}, nil
 }
 
+// FillFunc fills quantity credits from the sell order for the buy order at the price
+// per credit, an integer bank amount. It is expected to update or remove the buy order
+// and the sell order in state.
+type FillFunc func(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder, quantity math.Dec, price string) error
+
 type OrderBook interface {
 	// OnInsertBuyOrder gets called whenever a buy order is inserted into the marketplace state.
-	//OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
+	OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
+
+	// OnRemoveBuyOrder gets called whenever a buy order is removed from the marketplace state
+	// without being filled, i.e. when it is cancelled or has expired.
+	OnRemoveBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error
 
 	// OnInsertSellOrder gets called whenever a sell order is inserted into the marketplace state.
+	// It is also called when a sell order is updated in which case the matches of the sell order
+	// are replaced.
 	OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error
 
-	// ProcessBatch called in end blocker, can happen every block or at some epoch.
-	ProcessBatch(ctx context.Context) error
+	// OnRemoveSellOrder gets called whenever a sell order is removed from the marketplace state
+	// without being filled, i.e. when it is cancelled, or when its matches must be dropped.
+	OnRemoveSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder) error
 
-	// Reload gets call on end blocker only when a node starts up.
+	// ProcessBatch called in end blocker, can happen every block or at some epoch. Markets
+	// with continuous clearing are processed every block and markets with batch auction
+	// clearing are processed when the block height is a multiple of the epoch length.
+	ProcessBatch(ctx context.Context, blockHeight int64, fill FillFunc) error
+
+	// ProcessSellOrder fills the matches of a single sell order in price-time priority at
+	// the ask price of the sell order. It is used to fill immediate-or-cancel sell orders
+	// when they are created and is only supported in markets with continuous clearing.
+	ProcessSellOrder(ctx context.Context, sellOrderId uint64, fill FillFunc) error
+
+	// Reload gets called in the begin blocker of the first block after a node starts up,
+	// before any transaction of the block is executed.
 	Reload(ctx context.Context) error
+
+	// CheckConsistency returns an error if the matches in the order book differ from
+	// the matches of an order book built from scratch from the marketplace state.
+	CheckConsistency(ctx context.Context) error
+
+	// Loaded returns whether the order book has been loaded since the node started up.
+	Loaded() bool
 }
 
-/*
-func (o orderbook) OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+func (o *orderbook) OnInsertBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+	if err := o.saveSelector(ctx, buyOrder); err != nil {
+		return err
+	}
+
+	batchKeys, err := o.selectedBatchKeys(ctx, buyOrder.Selection)
+	if err != nil {
+		return err
+	}
+
+	for _, batchKey := range batchKeys {
+		sellOrders, err := o.batchSellOrders(ctx, batchKey)
+		if err != nil {
+			return err
+		}
+
+		for _, sellOrder := range sellOrders {
+			if err = o.insertMatch(ctx, buyOrder, sellOrder); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
-*/
-func (o orderbook) OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error {
+
+// saveSelector saves the selector of the buy order to the order book.
+func (o *orderbook) saveSelector(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+	selection := buyOrder.Selection
+	switch sum := selection.GetSum().(type) {
+	case *marketplacev1.BuyOrder_Selection_BatchKey:
+		return o.memStore.BuyOrderBatchSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderBatchSelector{
+			BuyOrderId: buyOrder.Id,
+			BatchId:    sum.BatchKey,
+		})
+	case *marketplacev1.BuyOrder_Selection_ProjectKey:
+		return o.memStore.BuyOrderProjectSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderProjectSelector{
+			BuyOrderId:   buyOrder.Id,
+			ProjectId:    sum.ProjectKey,
+			MinStartDate: selection.MinStartDate,
+			MaxEndDate:   selection.MaxEndDate,
+		})
+	case *marketplacev1.BuyOrder_Selection_ClassKey:
+		return o.memStore.BuyOrderClassSelectorTable().Save(ctx, &orderbookv1alpha1.BuyOrderClassSelector{
+			BuyOrderId:      buyOrder.Id,
+			ClassId:         sum.ClassKey,
+			ProjectLocation: selection.ProjectJurisdiction,
+			MinStartDate:    selection.MinStartDate,
+			MaxEndDate:      selection.MaxEndDate,
+		})
+	default:
+		return fmt.Errorf("buy order %d has an unknown selection %T", buyOrder.Id, sum)
+	}
+}
+
+// selectedBatchKeys returns the keys of the credit batches that meet the selection.
+func (o *orderbook) selectedBatchKeys(ctx context.Context, selection *marketplacev1.BuyOrder_Selection) ([]uint64, error) {
+	switch sum := selection.GetSum().(type) {
+	case *marketplacev1.BuyOrder_Selection_BatchKey:
+		return []uint64{sum.BatchKey}, nil
+	case *marketplacev1.BuyOrder_Selection_ProjectKey:
+		return o.projectBatchKeys(ctx, sum.ProjectKey, selection.MinStartDate, selection.MaxEndDate)
+	case *marketplacev1.BuyOrder_Selection_ClassKey:
+		return o.classBatchKeys(ctx, sum.ClassKey, selection.ProjectJurisdiction, selection.MinStartDate, selection.MaxEndDate)
+	default:
+		return nil, fmt.Errorf("unknown selection %T", sum)
+	}
+}
+
+func (o *orderbook) OnRemoveBuyOrder(ctx context.Context, buyOrder *marketplacev1.BuyOrder) error {
+	return o.removeBuyOrder(ctx, buyOrder.Id)
+}
+
+func (o *orderbook) OnInsertSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder, batch *ecocreditv1.Batch) error {
+	// remove any existing matches in case the sell order has been updated
+	if err := o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchSellOrderIdIndexKey{}.WithSellOrderId(sellOrder.Id),
+	); err != nil {
+		return err
+	}
+
+	buyOrderIds, err := o.selectingBuyOrderIds(ctx, batch)
+	if err != nil {
+		return err
+	}
+
+	for _, buyOrderId := range buyOrderIds {
+		buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, buyOrderId)
+		if err != nil {
+			if ormerrors.IsNotFound(err) {
+				if err = o.removeBuyOrder(ctx, buyOrderId); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+
+		if err = o.insertMatch(ctx, buyOrder, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *orderbook) OnRemoveSellOrder(ctx context.Context, sellOrder *marketplacev1.SellOrder) error {
+	return o.removeSellOrder(ctx, sellOrder.Id)
+}
+
+// selectingBuyOrderIds returns the ids of the buy orders that select credits
+// from the batch either directly or through the project or class of the batch
+// with criteria that are met by the batch and its project.
+func (o *orderbook) selectingBuyOrderIds(ctx context.Context, batch *ecocreditv1.Batch) ([]uint64, error) {
+	var ids []uint64
+
+	batchIt, err := o.memStore.BuyOrderBatchSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBatchIdIndexKey{}.WithBatchId(batch.Key),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for batchIt.Next() {
+		selector, err := batchIt.Value()
+		if err != nil {
+			batchIt.Close()
+			return nil, err
+		}
+		ids = append(ids, selector.BuyOrderId)
+	}
+	batchIt.Close()
+
+	projectIt, err := o.memStore.BuyOrderProjectSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorProjectIdIndexKey{}.WithProjectId(batch.ProjectKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for projectIt.Next() {
+		selector, err := projectIt.Value()
+		if err != nil {
+			projectIt.Close()
+			return nil, err
+		}
+		if batchInDateRange(batch, selector.MinStartDate, selector.MaxEndDate) {
+			ids = append(ids, selector.BuyOrderId)
+		}
+	}
+	projectIt.Close()
+
+	project, err := o.ecocreditStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	classIt, err := o.memStore.BuyOrderClassSelectorTable().List(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorClassIdIndexKey{}.WithClassId(project.ClassKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	for classIt.Next() {
+		selector, err := classIt.Value()
+		if err != nil {
+			classIt.Close()
+			return nil, err
+		}
+		if projectInJurisdiction(project, selector.ProjectLocation) &&
+			batchInDateRange(batch, selector.MinStartDate, selector.MaxEndDate) {
+			ids = append(ids, selector.BuyOrderId)
+		}
+	}
+	classIt.Close()
+
+	return ids, nil
+}
+
+// classBatchKeys returns the keys of the credit batches within a credit class
+// of projects within the jurisdiction that are within the date range.
+func (o *orderbook) classBatchKeys(ctx context.Context, classKey uint64, jurisdiction string, minStartDate, maxEndDate *timestamppb.Timestamp) ([]uint64, error) {
+	it, err := o.ecocreditStore.ProjectTable().List(ctx, ecocreditv1.ProjectClassKeyIdIndexKey{}.WithClassKey(classKey))
+	if err != nil {
+		return nil, err
+	}
+
+	var projectKeys []uint64
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			it.Close()
+			return nil, err
+		}
+		if projectInJurisdiction(project, jurisdiction) {
+			projectKeys = append(projectKeys, project.Key)
+		}
+	}
+	it.Close()
+
+	var batchKeys []uint64
+	for _, projectKey := range projectKeys {
+		keys, err := o.projectBatchKeys(ctx, projectKey, minStartDate, maxEndDate)
+		if err != nil {
+			return nil, err
+		}
+		batchKeys = append(batchKeys, keys...)
+	}
+
+	return batchKeys, nil
+}
+
+// projectBatchKeys returns the keys of the credit batches within a project that
+// are within the date range.
+func (o *orderbook) projectBatchKeys(ctx context.Context, projectKey uint64, minStartDate, maxEndDate *timestamppb.Timestamp) ([]uint64, error) {
+	it, err := o.ecocreditStore.BatchTable().List(ctx, ecocreditv1.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var keys []uint64
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		if batchInDateRange(batch, minStartDate, maxEndDate) {
+			keys = append(keys, batch.Key)
+		}
+	}
+
+	return keys, nil
+}
+
+// batchInDateRange returns whether the credit batch started on or after the
+// optional minStartDate and ended on or before the optional maxEndDate.
+func batchInDateRange(batch *ecocreditv1.Batch, minStartDate, maxEndDate *timestamppb.Timestamp) bool {
+	if minStartDate != nil && batch.StartDate.AsTime().Before(minStartDate.AsTime()) {
+		return false
+	}
+
+	if maxEndDate != nil && batch.EndDate.AsTime().After(maxEndDate.AsTime()) {
+		return false
+	}
+
+	return true
+}
+
+// projectInJurisdiction returns whether the project is within the optional
+// jurisdiction. A jurisdiction includes all of its subdivisions, e.g. "US"
+// includes "US-OR" and "US-OR" includes "US-OR 97201".
+func projectInJurisdiction(project *ecocreditv1.Project, jurisdiction string) bool {
+	if jurisdiction == "" || project.Jurisdiction == jurisdiction {
+		return true
+	}
+
+	return strings.HasPrefix(project.Jurisdiction, jurisdiction+"-") ||
+		strings.HasPrefix(project.Jurisdiction, jurisdiction+" ")
+}
+
+// batchSellOrders returns all sell orders of a credit batch.
+func (o *orderbook) batchSellOrders(ctx context.Context, batchKey uint64) ([]*marketplacev1.SellOrder, error) {
+	it, err := o.marketplaceStore.SellOrderTable().List(ctx, marketplacev1.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var sellOrders []*marketplacev1.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		sellOrders = append(sellOrders, sellOrder)
+	}
+
+	return sellOrders, nil
+}
+
+// insertMatch inserts a match into the order book if the buy order can be filled
+// from the sell order.
+func (o *orderbook) insertMatch(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) error {
+	match, err := o.newMatch(ctx, buyOrder, sellOrder)
+	if err != nil || match == nil {
+		return err
+	}
+
+	return o.memStore.BuyOrderSellOrderMatchTable().Save(ctx, match)
+}
+
+// newMatch returns the match of the buy order and the sell order or nil if the buy
+// order cannot be filled from the sell order.
+func (o *orderbook) newMatch(ctx context.Context, buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (*orderbookv1alpha1.BuyOrderSellOrderMatch, error) {
+	ok, err := canFill(buyOrder, sellOrder)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	market, err := o.marketplaceStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	bidPrice, err := encodePrice(buyOrder.BidAmount, market)
+	if err != nil {
+		return nil, err
+	}
+
+	askPrice, err := encodePrice(sellOrder.AskAmount, market)
+	if err != nil {
+		return nil, err
+	}
+
+	return &orderbookv1alpha1.BuyOrderSellOrderMatch{
+		MarketId:           market.Id,
+		BuyOrderId:         buyOrder.Id,
+		SellOrderId:        sellOrder.Id,
+		BidPriceComplement: ^bidPrice,
+		AskPrice:           askPrice,
+	}, nil
+}
+
+func (o *orderbook) ProcessBatch(ctx context.Context, blockHeight int64, fill FillFunc) error {
+	markets, err := o.markets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, market := range markets {
+		switch market.ClearingMode {
+		case marketplacev1.ClearingMode_CLEARING_MODE_CONTINUOUS:
+			err = o.processContinuous(ctx, market, fill)
+		case marketplacev1.ClearingMode_CLEARING_MODE_BATCH_AUCTION:
+			if market.EpochLength == 0 || uint64(blockHeight)%market.EpochLength != 0 {
+				continue
+			}
+			err = o.processAuction(ctx, market, fill)
+		default:
+			err = fmt.Errorf("market %d has an unknown clearing mode %s", market.Id, market.ClearingMode)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (o orderbook) ProcessBatch(ctx context.Context) error {
+// processContinuous fills the matches of the market in price-time priority at the
+// ask price of each sell order.
+func (o *orderbook) processContinuous(ctx context.Context, market *marketplacev1.Market, fill FillFunc) error {
+	matches, err := o.marketMatches(ctx, market.Id)
+	if err != nil {
+		return err
+	}
+
+	askPrice := func(_ *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (string, error) {
+		return sellOrder.AskAmount, nil
+	}
+
+	for _, match := range matches {
+		if err = o.processMatch(ctx, match, fill, askPrice); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (o orderbook) Reload(ctx context.Context) error {
+// markets returns all markets in ascending order of their ids.
+func (o *orderbook) markets(ctx context.Context) ([]*marketplacev1.Market, error) {
+	it, err := o.marketplaceStore.MarketTable().List(ctx, marketplacev1.MarketIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var markets []*marketplacev1.Market
+	for it.Next() {
+		market, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		markets = append(markets, market)
+	}
+
+	return markets, nil
+}
+
+// marketMatches returns the matches within a market sorted by price-time priority,
+// i.e. the highest bids first and within a bid the lowest asks first.
+func (o *orderbook) marketMatches(ctx context.Context, marketId uint64) ([]*orderbookv1alpha1.BuyOrderSellOrderMatch, error) {
+	it, err := o.memStore.BuyOrderSellOrderMatchTable().List(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchMarketIdBidPriceComplementBuyOrderIdAskPriceSellOrderIdIndexKey{}.WithMarketId(marketId),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var matches []*orderbookv1alpha1.BuyOrderSellOrderMatch
+	for it.Next() {
+		match, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		matches = append(matches, match)
+	}
+
+	return matches, nil
+}
+
+func (o *orderbook) ProcessSellOrder(ctx context.Context, sellOrderId uint64, fill FillFunc) error {
+	it, err := o.memStore.BuyOrderSellOrderMatchTable().List(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchSellOrderIdIndexKey{}.WithSellOrderId(sellOrderId),
+	)
+	if err != nil {
+		return err
+	}
+
+	var matches []*orderbookv1alpha1.BuyOrderSellOrderMatch
+	for it.Next() {
+		match, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		matches = append(matches, match)
+	}
+	it.Close()
+
+	// the highest bids first and within a bid the earliest buy orders first
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].BidPriceComplement != matches[j].BidPriceComplement {
+			return matches[i].BidPriceComplement < matches[j].BidPriceComplement
+		}
+		return matches[i].BuyOrderId < matches[j].BuyOrderId
+	})
+
+	askPrice := func(_ *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (string, error) {
+		return sellOrder.AskAmount, nil
+	}
+
+	for _, match := range matches {
+		if err = o.processMatch(ctx, match, fill, askPrice); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+// priceFunc returns the price per credit at which the buy order is filled from the
+// sell order.
+type priceFunc func(buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (string, error)
+
+// processMatch fills the match at the price if both orders still exist and can still
+// be filled and removes the matches of any order that no longer exists afterwards. The
+// quantity filled is limited by the minimum fill quantity and the lot size of the sell
+// order. The match is removed if the orders cannot be filled, including after a fill
+// that failed, so that no match is processed again without a change to the orders.
+func (o *orderbook) processMatch(ctx context.Context, match *orderbookv1alpha1.BuyOrderSellOrderMatch, fill FillFunc, price priceFunc) error {
+	// the match may have been removed while processing a previous match
+	found, err := o.memStore.BuyOrderSellOrderMatchTable().Has(ctx, match.BuyOrderId, match.SellOrderId)
+	if err != nil || !found {
+		return err
+	}
+
+	buyOrder, err := o.marketplaceStore.BuyOrderTable().Get(ctx, match.BuyOrderId)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return o.removeBuyOrder(ctx, match.BuyOrderId)
+		}
+		return err
+	}
+
+	sellOrder, err := o.marketplaceStore.SellOrderTable().Get(ctx, match.SellOrderId)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return o.removeSellOrder(ctx, match.SellOrderId)
+		}
+		return err
+	}
+
+	ok, err := canFill(buyOrder, sellOrder)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return o.memStore.BuyOrderSellOrderMatchTable().Delete(ctx, match)
+	}
+
+	buyQty, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	sellQty, err := math.NewDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := fillableQuantity(sellOrder, buyQty, sellQty)
+	if err != nil {
+		return err
+	}
+	if quantity.IsZero() {
+		return o.memStore.BuyOrderSellOrderMatchTable().Delete(ctx, match)
+	}
+
+	amount, err := price(buyOrder, sellOrder)
+	if err != nil {
+		return err
+	}
+
+	if err = fill(ctx, buyOrder, sellOrder, quantity, amount); err != nil {
+		return err
+	}
+
+	buyOrder, err = o.marketplaceStore.BuyOrderTable().Get(ctx, buyOrder.Id)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		buyOrder = nil
+		if err = o.removeBuyOrder(ctx, match.BuyOrderId); err != nil {
+			return err
+		}
+	}
+
+	sellOrder, err = o.marketplaceStore.SellOrderTable().Get(ctx, sellOrder.Id)
+	if err != nil {
+		if !ormerrors.IsNotFound(err) {
+			return err
+		}
+		sellOrder = nil
+		if err = o.removeSellOrder(ctx, match.SellOrderId); err != nil {
+			return err
+		}
+	}
+
+	if buyOrder == nil || sellOrder == nil {
+		return nil
+	}
+
+	// the match is removed if the orders can no longer be filled, e.g. because the
+	// remaining quantity of the buy order is too small or the fill failed
+	ok, err = canFill(buyOrder, sellOrder)
+	if err != nil || ok {
+		return err
+	}
+
+	return o.memStore.BuyOrderSellOrderMatchTable().Delete(ctx, match)
+}
+
+// removeBuyOrder removes a buy order and its matches from the order book.
+func (o *orderbook) removeBuyOrder(ctx context.Context, buyOrderId uint64) error {
+	if err := o.memStore.BuyOrderBatchSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey{}.WithBuyOrderId(buyOrderId),
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderProjectSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey{}.WithBuyOrderId(buyOrderId),
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderClassSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorBuyOrderIdClassIdIndexKey{}.WithBuyOrderId(buyOrderId),
+	); err != nil {
+		return err
+	}
+
+	return o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchBuyOrderIdSellOrderIdIndexKey{}.WithBuyOrderId(buyOrderId),
+	)
+}
+
+// removeSellOrder removes the matches of a sell order from the order book.
+func (o *orderbook) removeSellOrder(ctx context.Context, sellOrderId uint64) error {
+	return o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchSellOrderIdIndexKey{}.WithSellOrderId(sellOrderId),
+	)
+}
+
+// Reload rebuilds the order book from the buy orders and sell orders in state. The
+// selectors of the buy orders are restored first and the matches are then inserted
+// one sell order at a time in the order of the sell order ids, in the same way as
+// the order book is updated when sell orders are created, so that the order book of
+// a node that restarted is the same as the order book of every other node.
+func (o *orderbook) Reload(ctx context.Context) error {
+	if err := o.clear(ctx); err != nil {
+		return err
+	}
+
+	buyIt, err := o.marketplaceStore.BuyOrderTable().List(ctx, marketplacev1.BuyOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+	for buyIt.Next() {
+		buyOrder, err := buyIt.Value()
+		if err != nil {
+			buyIt.Close()
+			return err
+		}
+		if err = o.saveSelector(ctx, buyOrder); err != nil {
+			buyIt.Close()
+			return err
+		}
+	}
+	buyIt.Close()
+
+	sellIt, err := o.marketplaceStore.SellOrderTable().List(ctx, marketplacev1.SellOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+	defer sellIt.Close()
+
+	batches := make(map[uint64]*ecocreditv1.Batch)
+	for sellIt.Next() {
+		sellOrder, err := sellIt.Value()
+		if err != nil {
+			return err
+		}
+
+		batch, ok := batches[sellOrder.BatchKey]
+		if !ok {
+			batch, err = o.ecocreditStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+			if err != nil {
+				return err
+			}
+			batches[sellOrder.BatchKey] = batch
+		}
+
+		if err = o.OnInsertSellOrder(ctx, sellOrder, batch); err != nil {
+			return err
+		}
+	}
+
+	o.loaded = true
+
+	return nil
+}
+
+func (o *orderbook) Loaded() bool {
+	return o.loaded
+}
+
+// clear removes all entries from the order book.
+func (o *orderbook) clear(ctx context.Context) error {
+	if err := o.memStore.BuyOrderSellOrderMatchTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderSellOrderMatchBuyOrderIdSellOrderIdIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderBatchSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderBatchSelectorBuyOrderIdBatchIdIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	if err := o.memStore.BuyOrderProjectSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderProjectSelectorBuyOrderIdProjectIdIndexKey{},
+	); err != nil {
+		return err
+	}
+
+	return o.memStore.BuyOrderClassSelectorTable().DeleteBy(ctx,
+		orderbookv1alpha1.BuyOrderClassSelectorBuyOrderIdClassIdIndexKey{},
+	)
+}
+
+// CheckConsistency compares the matches in the order book with the matches of an
+// order book built from scratch by checking every buy order against every sell order
+// in state. Matches of orders that have been removed from state are ignored because
+// they are only removed from the order book when they are processed.
+func (o *orderbook) CheckConsistency(ctx context.Context) error {
+	expected, err := o.buildMatches(ctx)
+	if err != nil {
+		return err
+	}
+
+	it, err := o.memStore.BuyOrderSellOrderMatchTable().List(ctx, orderbookv1alpha1.BuyOrderSellOrderMatchPrimaryKey{})
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	var inconsistencies []string
+	for it.Next() {
+		match, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		key := [2]uint64{match.BuyOrderId, match.SellOrderId}
+		exp, ok := expected[key]
+		if !ok {
+			removed, err := o.isRemoved(ctx, match)
+			if err != nil {
+				return err
+			}
+			if !removed {
+				inconsistencies = append(inconsistencies, fmt.Sprintf(
+					"unexpected match of buy order %d and sell order %d", match.BuyOrderId, match.SellOrderId,
+				))
+			}
+			continue
+		}
+		delete(expected, key)
+
+		if match.MarketId != exp.MarketId || match.BidPriceComplement != exp.BidPriceComplement || match.AskPrice != exp.AskPrice {
+			inconsistencies = append(inconsistencies, fmt.Sprintf(
+				"match of buy order %d and sell order %d is %v, expected %v", match.BuyOrderId, match.SellOrderId, match, exp,
+			))
+		}
+	}
+
+	missing := make([][2]uint64, 0, len(expected))
+	for key := range expected {
+		missing = append(missing, key)
+	}
+	sort.Slice(missing, func(i, j int) bool {
+		if missing[i][0] != missing[j][0] {
+			return missing[i][0] < missing[j][0]
+		}
+		return missing[i][1] < missing[j][1]
+	})
+	for _, key := range missing {
+		inconsistencies = append(inconsistencies, fmt.Sprintf(
+			"missing match of buy order %d and sell order %d", key[0], key[1],
+		))
+	}
+
+	if len(inconsistencies) != 0 {
+		return fmt.Errorf("order book is inconsistent with state: %s", strings.Join(inconsistencies, "; "))
+	}
+
+	return nil
+}
+
+// buildMatches returns all matches of the buy orders and sell orders in state keyed
+// by buy order id and sell order id without using the order book.
+func (o *orderbook) buildMatches(ctx context.Context) (map[[2]uint64]*orderbookv1alpha1.BuyOrderSellOrderMatch, error) {
+	type sellOrderInfo struct {
+		sellOrder *marketplacev1.SellOrder
+		batch     *ecocreditv1.Batch
+		project   *ecocreditv1.Project
+	}
+
+	sellIt, err := o.marketplaceStore.SellOrderTable().List(ctx, marketplacev1.SellOrderIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+
+	var sellOrders []sellOrderInfo
+	for sellIt.Next() {
+		sellOrder, err := sellIt.Value()
+		if err != nil {
+			sellIt.Close()
+			return nil, err
+		}
+		sellOrders = append(sellOrders, sellOrderInfo{sellOrder: sellOrder})
+	}
+	sellIt.Close()
+
+	for i, info := range sellOrders {
+		batch, err := o.ecocreditStore.BatchTable().Get(ctx, info.sellOrder.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+		project, err := o.ecocreditStore.ProjectTable().Get(ctx, batch.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+		sellOrders[i].batch, sellOrders[i].project = batch, project
+	}
+
+	buyIt, err := o.marketplaceStore.BuyOrderTable().List(ctx, marketplacev1.BuyOrderIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer buyIt.Close()
+
+	matches := make(map[[2]uint64]*orderbookv1alpha1.BuyOrderSellOrderMatch)
+	for buyIt.Next() {
+		buyOrder, err := buyIt.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range sellOrders {
+			if !SelectsBatch(buyOrder.Selection, info.batch, info.project) {
+				continue
+			}
+
+			match, err := o.newMatch(ctx, buyOrder, info.sellOrder)
+			if err != nil {
+				return nil, err
+			}
+			if match != nil {
+				matches[[2]uint64{match.BuyOrderId, match.SellOrderId}] = match
+			}
+		}
+	}
+
+	return matches, nil
+}
+
+// isRemoved returns whether the buy order or the sell order of the match has been
+// removed from state.
+func (o *orderbook) isRemoved(ctx context.Context, match *orderbookv1alpha1.BuyOrderSellOrderMatch) (bool, error) {
+	found, err := o.marketplaceStore.BuyOrderTable().Has(ctx, match.BuyOrderId)
+	if err != nil || !found {
+		return !found, err
+	}
+
+	found, err = o.marketplaceStore.SellOrderTable().Has(ctx, match.SellOrderId)
+	return !found, err
+}
+
+// SelectsBatch returns whether the selection includes the credit batch of the project.
+func SelectsBatch(selection *marketplacev1.BuyOrder_Selection, batch *ecocreditv1.Batch, project *ecocreditv1.Project) bool {
+	switch sum := selection.GetSum().(type) {
+	case *marketplacev1.BuyOrder_Selection_BatchKey:
+		return sum.BatchKey == batch.Key
+	case *marketplacev1.BuyOrder_Selection_ProjectKey:
+		return sum.ProjectKey == batch.ProjectKey &&
+			batchInDateRange(batch, selection.MinStartDate, selection.MaxEndDate)
+	case *marketplacev1.BuyOrder_Selection_ClassKey:
+		return sum.ClassKey == project.ClassKey &&
+			projectInJurisdiction(project, selection.ProjectJurisdiction) &&
+			batchInDateRange(batch, selection.MinStartDate, selection.MaxEndDate)
+	default:
+		return false
+	}
+}
+
+// IsPrivate returns whether the sell order is restricted to allowed buyers. Private
+// sell orders are not matched in the order book and can only be filled directly.
+func IsPrivate(sellOrder *marketplacev1.SellOrder) bool {
+	return len(sellOrder.AllowedBuyers) > 0 || len(sellOrder.AllowedBuyerGroupPolicy) > 0
+}
+
+// canFill returns whether the buy order and the sell order are in the same market,
+// the sell order is not private, the buy order has not failed to be filled from the
+// sell order, the bid amount is greater than or equal to the ask amount, the sell
+// order allows the buy order to disable auto-retirement if it does so and the quantity
+// of the buy order is large enough to be filled from the sell order. Whether the buy
+// order can be filled does not depend on the remaining quantity of the sell order so
+// that the matches only change when the orders are inserted, updated or filled.
+func canFill(buyOrder *marketplacev1.BuyOrder, sellOrder *marketplacev1.SellOrder) (bool, error) {
+	if buyOrder.MarketId != sellOrder.MarketId {
+		return false, nil
+	}
+
+	if IsPrivate(sellOrder) {
+		return false, nil
+	}
+
+	for _, id := range buyOrder.FailedSellOrderIds {
+		if id == sellOrder.Id {
+			return false, nil
+		}
+	}
+
+	if buyOrder.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return false, nil
+	}
+
+	bidAmount, err := math.NewDecFromString(buyOrder.BidAmount)
+	if err != nil {
+		return false, err
+	}
+
+	askAmount, err := math.NewDecFromString(sellOrder.AskAmount)
+	if err != nil {
+		return false, err
+	}
+
+	if bidAmount.Cmp(askAmount) == math.LessThan {
+		return false, nil
+	}
+
+	buyQty, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return false, err
+	}
+
+	quantity, err := lotQuantity(sellOrder, buyQty)
+	if err != nil {
+		return false, err
+	}
+
+	return !quantity.IsZero(), nil
+}
+
+// encodePrice converts an integer bank amount to the uint32 price used for sorting
+// in the order book using the precision modifier of the market. Prices that exceed
+// the range of the order book are clamped to the maximum price so that they are still
+// matched, in which case they are sorted by time priority only. Whether orders can be
+// filled is always decided using the bank amounts and not the encoded prices.
+func encodePrice(amount string, market *marketplacev1.Market) (uint32, error) {
+	x, err := math.NewDecFromString(amount)
+	if err != nil {
+		return 0, err
+	}
+
+	if market.PrecisionModifier > 0 {
+		x, err = x.Quo(math.NewDecFinite(1, int32(market.PrecisionModifier)))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	x, err = roundHalfAwayFromZero(x)
+	if err != nil {
+		return 0, err
+	}
+
+	if x.Cmp(math.NewDecFromInt64(int64(maxPrice))) == math.GreaterThan {
+		return maxPrice, nil
+	}
+
+	price, err := x.Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	return uint32(price), nil
+}
+
+// roundHalfAwayFromZero rounds a non-negative decimal to the nearest integer
+// using round half away from zero rounding.
+func roundHalfAwayFromZero(x math.Dec) (math.Dec, error) {
+	x, err := x.Add(math.NewDecFinite(5, -1))
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	return x.QuoInteger(math.NewDecFromInt64(1))
+}
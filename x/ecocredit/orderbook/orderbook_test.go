@@ -0,0 +1,245 @@
+package orderbook
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"gotest.tools/v3/assert"
+
+	marketplacev1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	orderbookv1alpha1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/orderbook/v1alpha1"
+	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+func TestEncodePrice(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		amount    string
+		precision uint32
+		expected  uint32
+		expErr    string
+	}{
+		{name: "no precision modifier", amount: "1234", expected: 1234},
+		{name: "rounds down", amount: "1234", precision: 2, expected: 12},
+		{name: "rounds half away from zero", amount: "1250", precision: 2, expected: 13},
+		{name: "max price", amount: "4294967295", expected: 4294967295},
+		{name: "exceeds range", amount: "4294967296", expected: 4294967295},
+		{name: "exceeds range with precision modifier", amount: "429496729600", precision: 1, expected: 4294967295},
+		{name: "invalid amount", amount: "abc", expErr: "invalid decimal string"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			price, err := encodePrice(tc.amount, &marketplacev1.Market{Id: 1, PrecisionModifier: tc.precision})
+			if tc.expErr != "" {
+				assert.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestProjectInJurisdiction(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		jurisdiction string
+		filter       string
+		expected     bool
+	}{
+		{jurisdiction: "US-OR", filter: "", expected: true},
+		{jurisdiction: "US-OR", filter: "US-OR", expected: true},
+		{jurisdiction: "US-OR", filter: "US", expected: true},
+		{jurisdiction: "US-OR 97201", filter: "US-OR", expected: true},
+		{jurisdiction: "US-OR 97201", filter: "US", expected: true},
+		{jurisdiction: "US", filter: "US-OR", expected: false},
+		{jurisdiction: "US-ORE", filter: "US-OR", expected: false},
+		{jurisdiction: "CA-BC", filter: "US", expected: false},
+	}
+
+	for _, tc := range testCases {
+		project := &ecocreditv1.Project{Jurisdiction: tc.jurisdiction}
+		assert.Equal(t, tc.expected, projectInJurisdiction(project, tc.filter), "%s in %s", tc.jurisdiction, tc.filter)
+	}
+}
+
+func TestBatchInDateRange(t *testing.T) {
+	t.Parallel()
+
+	day := func(d int) *timestamppb.Timestamp {
+		return timestamppb.New(time.Date(2020, 1, d, 0, 0, 0, 0, time.UTC))
+	}
+	batch := &ecocreditv1.Batch{StartDate: day(10), EndDate: day(20)}
+
+	assert.Check(t, batchInDateRange(batch, nil, nil))
+	assert.Check(t, batchInDateRange(batch, day(10), day(20)))
+	assert.Check(t, batchInDateRange(batch, day(1), nil))
+	assert.Check(t, batchInDateRange(batch, nil, day(31)))
+	assert.Check(t, !batchInDateRange(batch, day(11), nil))
+	assert.Check(t, !batchInDateRange(batch, nil, day(19)))
+}
+
+func TestSettlementPrice(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		price     uint32
+		precision uint32
+		bid       string
+		ask       string
+		expected  string
+	}{
+		{name: "clearing price", price: 10, bid: "12", ask: "8", expected: "10"},
+		{name: "precision modifier", price: 10, precision: 2, bid: "1200", ask: "800", expected: "1000"},
+		{name: "limited to bid", price: 13, precision: 2, bid: "1250", ask: "1200", expected: "1250"},
+		{name: "limited to ask", price: 12, precision: 2, bid: "1300", ask: "1249", expected: "1249"},
+		{name: "clamped clearing price", price: 4294967295, bid: "5000000000", ask: "4500000000", expected: "4500000000"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			price, err := settlementPrice(tc.price, &marketplacev1.Market{PrecisionModifier: tc.precision},
+				&marketplacev1.BuyOrder{BidAmount: tc.bid}, &marketplacev1.SellOrder{AskAmount: tc.ask})
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestAuctionClearingPrice(t *testing.T) {
+	t.Parallel()
+
+	type order struct {
+		price    uint32
+		quantity string
+	}
+
+	testCases := []struct {
+		name     string
+		bids     []order
+		asks     []order
+		expected uint32
+		ok       bool
+	}{
+		{name: "single crossing", bids: []order{{12, "4"}}, asks: []order{{8, "5"}}, expected: 8, ok: true},
+		{name: "lowest price of the highest quantity", bids: []order{{12, "4"}, {9, "4"}},
+			asks: []order{{8, "5"}, {11, "5"}}, expected: 8, ok: true},
+		{name: "demand limited", bids: []order{{12, "2"}, {10, "6"}},
+			asks: []order{{9, "3"}, {10, "5"}}, expected: 10, ok: true},
+		{name: "supply limited", bids: []order{{12, "10"}},
+			asks: []order{{9, "3"}, {11, "5"}}, expected: 11, ok: true},
+		{name: "no crossing", bids: []order{{8, "4"}}, asks: []order{{9, "5"}}, ok: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			buyQuantities := make(map[uint64]math.Dec)
+			sellOrders := make(map[uint64]*marketplacev1.SellOrder)
+			var matches []*orderbookv1alpha1.BuyOrderSellOrderMatch
+			for i, bid := range tc.bids {
+				buyOrderId := uint64(i + 1)
+				quantity, err := math.NewDecFromString(bid.quantity)
+				assert.NilError(t, err)
+				buyQuantities[buyOrderId] = quantity
+				for j, ask := range tc.asks {
+					sellOrderId := uint64(j + 1)
+					sellOrders[sellOrderId] = &marketplacev1.SellOrder{Id: sellOrderId, Quantity: ask.quantity}
+					matches = append(matches, &orderbookv1alpha1.BuyOrderSellOrderMatch{
+						BuyOrderId:         buyOrderId,
+						SellOrderId:        sellOrderId,
+						BidPriceComplement: ^bid.price,
+						AskPrice:           ask.price,
+					})
+				}
+			}
+			price, ok, err := auctionClearingPrice(matches, buyQuantities, sellOrders)
+			assert.NilError(t, err)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, price)
+		})
+	}
+}
+
+func TestFillableQuantity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		quantity  string
+		remaining string
+		minFill   string
+		lotSize   string
+		expected  string
+	}{
+		{name: "no constraints", quantity: "7", remaining: "10", expected: "7"},
+		{name: "limited to remaining", quantity: "12", remaining: "10", minFill: "4", lotSize: "3", expected: "10"},
+		{name: "rounds down to lot size", quantity: "7", remaining: "10", lotSize: "2", expected: "6"},
+		{name: "decimal lot size", quantity: "1.3", remaining: "10", lotSize: "0.25", expected: "1.25"},
+		{name: "less than lot size", quantity: "1", remaining: "10", lotSize: "2", expected: "0"},
+		{name: "equal to min fill", quantity: "4", remaining: "10", minFill: "4", expected: "4"},
+		{name: "less than min fill", quantity: "3", remaining: "10", minFill: "4", expected: "0"},
+		{name: "less than min fill after rounding", quantity: "5", remaining: "9", minFill: "6", lotSize: "3", expected: "0"},
+		{name: "remaining less than min fill", quantity: "3", remaining: "3", minFill: "6", lotSize: "3", expected: "3"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			quantity, err := math.NewDecFromString(tc.quantity)
+			assert.NilError(t, err)
+			remaining, err := math.NewDecFromString(tc.remaining)
+			assert.NilError(t, err)
+			sellOrder := &marketplacev1.SellOrder{MinFillQuantity: tc.minFill, LotSize: tc.lotSize}
+			actual, err := fillableQuantity(sellOrder, quantity, remaining)
+			assert.NilError(t, err)
+			expected, err := math.NewDecFromString(tc.expected)
+			assert.NilError(t, err)
+			assert.Check(t, expected.Equal(actual), "expected %s, got %s", expected, actual)
+		})
+	}
+}
+
+func TestCheckFillQuantity(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		quantity string
+		expErr   string
+	}{
+		{name: "valid quantity", quantity: "6"},
+		{name: "remaining quantity", quantity: "9"},
+		{name: "not a multiple of the lot size", quantity: "7", expErr: "quantity 7 is not a multiple of the lot size 3"},
+		{name: "less than the min fill quantity", quantity: "3", expErr: "quantity 3 is less than the minimum fill quantity 6"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			quantity, err := math.NewDecFromString(tc.quantity)
+			assert.NilError(t, err)
+			err = CheckFillQuantity(&marketplacev1.SellOrder{Quantity: "9", MinFillQuantity: "6", LotSize: "3"}, quantity)
+			if tc.expErr != "" {
+				assert.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			assert.NilError(t, err)
+		})
+	}
+}
@@ -9,13 +9,34 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 )
 
-// BeginBlocker checks if there are any expired sell or buy orders and removes them from state.
+// BeginBlocker rebuilds the order book after a node starts up, checks if there are any
+// expired sell orders, buy orders or quote requests and removes them from state, prunes
+// the price history, and settles the auctions that have ended.
 func BeginBlocker(ctx sdk.Context, k Keeper) error {
 	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
 
+	if err := k.LoadOrderBook(ctx); err != nil {
+		return err
+	}
+
 	if err := k.PruneOrders(ctx); err != nil {
 		return err
 	}
 
+	if err := k.SettleAuctions(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// EndBlocker matches and fills crossing buy and sell orders in the order book.
+func EndBlocker(ctx sdk.Context, k Keeper) error {
+	defer telemetry.ModuleMeasureSince(ecocredit.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	if err := k.ProcessOrders(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -62,6 +62,18 @@ Feature: Msg/Put
       When alice attempts to put credits from credit batch "A01-20200101-20210101-001" into the basket
       Then expect the error "credit class A01 is not allowed in this basket: invalid request"
 
+    Scenario: credit class of the project is allowed after a project transfer
+      Given alice owns credits from credit batch "C02-001-20200101-20210101-001"
+      And the project of credit batch "C02-001-20200101-20210101-001" is transferred to credit class "C01"
+      When alice attempts to put credits from credit batch "C02-001-20200101-20210101-001" into the basket
+      Then expect no error
+
+    Scenario: credit class of the project is not allowed after a project transfer
+      Given alice owns credits from credit batch "C01-001-20200101-20210101-001"
+      And the project of credit batch "C01-001-20200101-20210101-001" is transferred to credit class "C02"
+      When alice attempts to put credits from credit batch "C01-001-20200101-20210101-001" into the basket
+      Then expect the error "credit class C02 is not allowed in this basket: invalid request"
+
   Rule: The user must have a credit balance for the credits being put into the basket
 
     Background:
@@ -11,6 +11,7 @@ Feature: Msg/Take
   - the user retired credit balance is updated when the user sets retire on take to false
   - the user tradable credit balance is updated when the user sets retire on take to true
   - the basket credit balance is updated
+  - the retirement is recorded when the user sets retire on take to true
   - the response includes the credits received
 
   Rule: The basket must exist
@@ -157,6 +158,37 @@ Feature: Msg/Take
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The retirement is recorded when credits are retired upon taking from the basket
+
+    Scenario: the retirement is recorded
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with credit type "C" and disable auto retire "false"
+      And basket token supply amount "2000000"
+      And alice owns basket token amount "2000000"
+      And the retirement beneficiary "Acme Corporation" and reason "offsetting emissions"
+      When alice attempts to take credits with basket token amount "2000000" and retire on take "true"
+      Then expect retirement with id "1" and the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "2.000000",
+        "beneficiary": "Acme Corporation",
+        "reason": "offsetting emissions",
+        "jurisdiction": "US-WA"
+      }
+      """
+
+    Scenario: the retirement is not recorded
+      Given a credit type with abbreviation "C" and precision "6"
+      And a basket with credit type "C" and disable auto retire "true"
+      And basket token supply amount "2000000"
+      And alice owns basket token amount "2000000"
+      And the retirement beneficiary "Acme Corporation" and reason "offsetting emissions"
+      When alice attempts to take credits with basket token amount "2000000" and retire on take "false"
+      Then expect no retirement with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
  Rule: The message response includes the credits received when credits are taken from the basket
 
     Scenario Outline: message response includes basket token amount received
@@ -14,6 +14,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 // Put deposits ecocredits into a basket, returning fungible coins to the depositor.
@@ -141,22 +142,23 @@ func (k Keeper) canBasketAcceptCredit(ctx context.Context, basket *api.Basket, b
 
 	}
 
-	classId := core.GetClassIdFromBatchDenom(batch.Denom)
+	// the credit class of the project is used because the project may have been
+	// transferred to another credit class after the credit batch was issued
+	class, err := utils.GetBatchClass(ctx, k.coreStore, batch)
+	if err != nil {
+		return err
+	}
 
 	// check credit class match
-	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, classId)
+	found, err := k.stateStore.BasketClassTable().Has(ctx, basket.Id, class.Id)
 	if err != nil {
 		return err
 	}
 	if !found {
-		return errInvalidReq.Wrapf("credit class %s is not allowed in this basket", classId)
+		return errInvalidReq.Wrapf("credit class %s is not allowed in this basket", class.Id)
 	}
 
 	// check credit type match
-	class, err := k.coreStore.ClassTable().GetById(ctx, classId)
-	if err != nil {
-		return err
-	}
 	if class.CreditTypeAbbrev != basket.CreditTypeAbbrev {
 		return errInvalidReq.Wrapf("basket requires credit type %s but a credit with type %s was given", basket.CreditTypeAbbrev, class.CreditTypeAbbrev)
 	}
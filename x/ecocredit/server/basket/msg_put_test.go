@@ -322,6 +322,24 @@ func (s *putSuite) AliceOwnsCreditsFromCreditBatch(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *putSuite) TheProjectOfCreditBatchIsTransferredToCreditClass(a string, b string) {
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               b,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(b),
+	})
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	project.ClassKey = classKey
+	err = s.coreStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
 func (s *putSuite) AliceOwnsCreditsWithStartDate(a string) {
 	startDate, err := types.ParseDate("start-date", a)
 	require.NoError(s.t, err)
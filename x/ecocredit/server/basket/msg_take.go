@@ -6,6 +6,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
@@ -121,6 +122,8 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 				amountCreditsNeeded,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementBeneficiary,
+				msg.RetirementReason,
 			)
 			if err != nil {
 				return nil, err
@@ -151,6 +154,8 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 				balance,
 				retire,
 				retirementJurisdiction,
+				msg.RetirementBeneficiary,
+				msg.RetirementReason,
 			)
 			if err != nil {
 				return nil, err
@@ -186,7 +191,7 @@ func (k Keeper) Take(ctx context.Context, msg *baskettypes.MsgTake) (*baskettype
 	}, err
 }
 
-func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction string) error {
+func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batchDenom string, amount math.Dec, retire bool, jurisdiction, beneficiary, reason string) error {
 	sdkCtx := types.UnwrapSDKContext(ctx)
 	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, batchDenom)
 	if err != nil {
@@ -218,11 +223,25 @@ func (k Keeper) addCreditBalance(ctx context.Context, owner sdk.AccAddress, batc
 		if err != nil {
 			return err
 		}
+		retirementId, err := core.RecordRetirement(ctx, k.coreStore.RetirementTable(), &ecoApi.Retirement{
+			BatchKey:     batch.Key,
+			Amount:       amount.String(),
+			Owner:        owner,
+			Beneficiary:  beneficiary,
+			Reason:       reason,
+			Jurisdiction: jurisdiction,
+		})
+		if err != nil {
+			return err
+		}
 		return sdkCtx.EventManager().EmitTypedEvent(&coretypes.EventRetire{
 			Owner:        owner.String(),
 			BatchDenom:   batchDenom,
 			Amount:       amount.String(),
 			Jurisdiction: jurisdiction,
+			RetirementId: retirementId,
+			Beneficiary:  beneficiary,
+			Reason:       reason,
 		})
 	}
 }
@@ -28,6 +28,8 @@ type takeSuite struct {
 	basketDenom         string
 	tokenAmount         string
 	jurisdiction        string
+	beneficiary         string
+	reason              string
 	res                 *basket.MsgTakeResponse
 	err                 error
 }
@@ -196,6 +198,11 @@ func (s *takeSuite) BasketTokenSupplyAmount(a string) {
 	s.basketTokenSupply = coin
 }
 
+func (s *takeSuite) TheRetirementBeneficiaryAndReason(a string, b string) {
+	s.beneficiary = a
+	s.reason = b
+}
+
 func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketDenom(a string) {
 	s.basketDenom = a
 
@@ -238,6 +245,8 @@ func (s *takeSuite) AliceAttemptsToTakeCreditsWithBasketTokenAmountAndRetireOnTa
 		Amount:                 s.tokenAmount,
 		RetirementJurisdiction: s.jurisdiction,
 		RetireOnTake:           retireOnTake,
+		RetirementBeneficiary:  s.beneficiary,
+		RetirementReason:       s.reason,
 	})
 }
 
@@ -284,6 +293,37 @@ func (s *takeSuite) ExpectAliceRetiredCreditBalanceAmount(a string) {
 	require.Equal(s.t, a, balance.RetiredAmount)
 }
 
+func (s *takeSuite) ExpectRetirementWithIdAndTheProperties(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expected := &core.RetirementInfo{}
+	err = jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, expected.BatchDenom)
+	require.NoError(s.t, err)
+
+	retirement, err := s.coreStore.RetirementTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, batch.Key, retirement.BatchKey)
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+}
+
+func (s *takeSuite) ExpectNoRetirementWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.coreStore.RetirementTable().Has(s.ctx, id)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
 func (s *takeSuite) ExpectAliceBasketTokenBalanceAmount(a string) {
 	amount, err := strconv.ParseInt(a, 10, 32)
 	require.NoError(s.t, err)
@@ -29,6 +29,8 @@ func TestGetBasketBalances(t *testing.T) {
 
 	insertClass(t, s, "C01", "C")
 	insertClass(t, s, "C02", "C")
+	insertProject(t, s, "C01-001", 1)
+	insertProject(t, s, "C02-001", 2)
 
 	insertBasket(t, s, "foo", "basket", "C", &api.DateCriteria{YearsInThePast: 3}, []string{classId1})
 	insertBasket(t, s, "bar", "basket1", "C", &api.DateCriteria{YearsInThePast: 3}, []string{classId1, classId2})
@@ -113,6 +115,13 @@ func insertClass(t *testing.T, s *baseSuite, name, creditTypeAbb string) {
 	}))
 }
 
+func insertProject(t *testing.T, s *baseSuite, id string, classKey uint64) {
+	assert.NilError(t, s.coreStore.ProjectTable().Insert(s.ctx, &ecoApi.Project{
+		Id:       id,
+		ClassKey: classKey,
+	}))
+}
+
 func insertBasket(t *testing.T, s *baseSuite, denom, name, ctAbbrev string, criteria *api.DateCriteria, classes []string) {
 	id, err := s.stateStore.BasketTable().InsertReturningID(s.ctx, &api.Basket{
 		BasketDenom:       denom,
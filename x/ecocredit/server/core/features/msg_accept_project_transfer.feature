@@ -0,0 +1,149 @@
+Feature: Msg/AcceptProjectTransfer
+
+  A project transfer can be accepted:
+  - when the project exists
+  - when the project has a proposed transfer
+  - when the admin is the admin of the credit class that the project is being transferred to
+  - when the reference id of the project is unique within the credit class
+  - the project is transferred with a new project id
+  - the previous project id is an alias of the project
+  - the project transfer is removed
+  - when the buffer pool of the previous credit class holds no escrowed credits from the project
+  - the credits of the buffer pool are moved to the buffer pool of the new credit class
+  - the project issuers and project verifiers are removed
+  - the project is proposed when the new credit class has a verifier
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+      And a project transfer of project "C01-001" to credit class "C02"
+
+    Scenario: the project exists
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When bob attempts to accept project transfer with project id "C01-002"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The project must have a proposed transfer
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the project has a proposed transfer
+      Given a project transfer of project "C01-001" to credit class "C02"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project does not have a proposed transfer
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect the error "project C01-001 does not have a proposed transfer: invalid request"
+
+  Rule: The admin must be the admin of the credit class that the project is being transferred to
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+      And a project transfer of project "C01-001" to credit class "C02"
+
+    Scenario: the admin is the admin of the credit class
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When alice attempts to accept project transfer with project id "C01-001"
+      Then expect error contains "is not the admin of credit class C02: unauthorized"
+
+  Rule: The reference id of the project must be unique within the credit class
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001" and reference id "VCS-001"
+      And a project transfer of project "C01-001" to credit class "C02"
+
+    Scenario: the reference id is unique within the credit class
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no error
+
+    Scenario: the reference id is not unique within the credit class
+      Given a project with project id "C02-001" and reference id "VCS-001"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect the error "a project with reference id VCS-001 already exists within this credit class: invalid request"
+
+  Rule: The buffer pool of the previous credit class must not hold escrowed credits from the project
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And a project transfer of project "C01-001" to credit class "C02"
+
+    Scenario: the buffer pool does not hold escrowed credits
+      Given the buffer pool of credit class "C01" has tradable amount "10" and escrowed amount "0"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no error
+
+    Scenario: the buffer pool holds escrowed credits
+      Given the buffer pool of credit class "C01" has tradable amount "10" and escrowed amount "5"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect the error "buffer pool of credit class C01 holds 5 escrowed credits from C01-001-20200101-20210101-001: invalid request"
+
+  Rule: The project is transferred
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+      And a project with project id "C02-001"
+      And a project transfer of project "C01-001" to credit class "C02"
+
+    Scenario: the project is transferred with a new project id
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect project with project id "C02-002" and class id "C02"
+      And expect project alias "C01-001" of project "C02-002"
+      And expect no project transfer of project "C02-002"
+      And expect event accept project transfer with old project id "C01-001" and new project id "C02-002"
+      And expect the response
+      """
+      {
+        "project_id": "C02-002"
+      }
+      """
+
+    Scenario: the credits of the buffer pool are moved
+      Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And the buffer pool of credit class "C01" has tradable amount "10" and retired amount "2"
+      And the buffer pool of credit class "C02" has tradable amount "1" and retired amount "0"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect the buffer pool of credit class "C02" has tradable amount "11" and retired amount "2"
+      And expect no balance of the buffer pool of credit class "C01"
+      And expect event transfer from the buffer pool of credit class "C01" to the buffer pool of credit class "C02"
+
+    Scenario: the project issuers and project verifiers are removed
+      Given the project "C01-001" has project issuer alice
+      And the project "C01-001" has project verifier alice
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect no project issuers of project "C02-002"
+      And expect no project verifiers of project "C02-002"
+
+    Scenario: the project is proposed when the new credit class has a verifier
+      Given the project "C01-001" has status "PROJECT_STATUS_VALIDATED"
+      And the credit class "C02" has verifier bob
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect project "C02-002" has status "PROJECT_STATUS_PROPOSED"
+
+    Scenario: the project status is kept when the new credit class has no verifier
+      Given the project "C01-001" has status "PROJECT_STATUS_VALIDATED"
+      When bob attempts to accept project transfer with project id "C01-001"
+      Then expect project "C02-002" has status "PROJECT_STATUS_VALIDATED"
+
+    # no failing scenario - state transitions only occur upon successful message execution
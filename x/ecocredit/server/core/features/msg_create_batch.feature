@@ -3,6 +3,8 @@ Feature: Msg/CreateBatch
   Credits can be issued in batches:
   - when the project exists
   - when the issuer is an allowed credit class issuer
+  - when the issuer is an allowed project issuer if the project has project issuers
+  - when the project has an issuable status
   - when the decimal places in issuance amount does not exceed credit type precision
   - when the origin tx is unique within the scope of a credit class
   - when the contract is unique within the scope of a credit class
@@ -43,6 +45,51 @@ Feature: Msg/CreateBatch
       When bob attempts to create a batch with project id "C01-001"
       Then expect error contains "is not an issuer for the class: unauthorized"
 
+  Rule: The issuer must be an allowed project issuer if the project has project issuers
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+      And bob is an issuer of project "C01-001"
+
+    Scenario: the issuer is an allowed project issuer
+      When bob attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+    Scenario: the issuer is a credit class issuer but not an allowed project issuer
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect error contains "is not an issuer for the project: unauthorized"
+
+  Rule: The project must have an issuable status
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario Outline: the project has an issuable status
+      Given the project with project id "C01-001" has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect no error
+
+      Examples:
+        | status                     |
+        | PROJECT_STATUS_UNSPECIFIED |
+        | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_VALIDATED   |
+
+    Scenario Outline: the project does not have an issuable status
+      Given the project with project id "C01-001" has status "<status>"
+      When alice attempts to create a batch with project id "C01-001"
+      Then expect the error "credits cannot be issued under project C01-001 with status <status>: invalid request"
+
+      Examples:
+        | status                   |
+        | PROJECT_STATUS_PROPOSED  |
+        | PROJECT_STATUS_SUSPENDED |
+        | PROJECT_STATUS_COMPLETED |
+
   Rule: The decimal places in issuance amount must not exceed credit type precision
 
     Background:
@@ -391,6 +438,113 @@ Feature: Msg/CreateBatch
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: The buffer pool contribution is withheld from the tradable amount
+
+    Background:
+      Given a credit type with abbreviation "C" and precision "6"
+      And a credit class with class id "C01" and issuer alice
+      And a project with project id "C01-001"
+
+    Scenario: the buffer pool contribution is withheld
+      Given the credit class has buffer percentage "0.1"
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100",
+          "retired_amount": "100",
+          "retirement_jurisdiction": "US-WA"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "90",
+        "retired_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer batch balance
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "100",
+        "retired_amount": "100",
+        "cancelled_amount": "0"
+      }
+      """
+
+    Scenario: the buffer pool contribution is rounded down to credit type precision
+      Given the credit class has buffer percentage "0.15"
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "0.000015"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "0.000013",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer batch balance
+      """
+      {
+        "tradable_amount": "0.000002",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    Scenario: the buffer pool contribution is not withheld without a buffer percentage
+      When alice attempts to create a batch with project id "C01-001" and issuance
+      """
+      [
+        {
+          "recipient": "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw",
+          "tradable_amount": "100"
+        }
+      ]
+      """
+      Then expect recipient batch balance with address "regen1elq7ys34gpkj3jyvqee0h6yk4h9wsfxmgqelsw"
+      """
+      {
+        "tradable_amount": "100",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect no buffer batch balance
+
+    Scenario: the buffer pool contribution is not withheld from a bridged credit batch
+      Given the credit class has buffer percentage "0.1"
+      When alice attempts to create a batch with project id "C01-001" and origin tx
+      """
+      {
+        "id": "0x64",
+        "source": "polygon",
+        "contract": "0x40"
+      }
+      """
+      Then expect no buffer batch balance
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: the response includes the batch denom
 
     Background:
@@ -6,6 +6,7 @@ Feature: CreateProject
   - when the non-empty reference id is unique within the scope of the credit class
   - the project sequence is updated
   - the project properties are added
+  - the project status is set
   - the response includes the project id
 
   Rule: The credit class must exist
@@ -104,6 +105,23 @@ Feature: CreateProject
 
     # no failing scenario - state transitions only occur upon successful message execution
 
+  Rule: the project status is set
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and issuer alice
+
+    Scenario: the project is registered within a credit class without a verifier
+      When alice attempts to create a project with class id "C01"
+      Then expect project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the project is proposed within a credit class with a verifier
+      Given the credit class with class id "C01" has verifier bob
+      When alice attempts to create a project with class id "C01"
+      Then expect project with project id "C01-001" and status "PROJECT_STATUS_PROPOSED"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
   Rule: the response includes the project id
 
     Background:
@@ -0,0 +1,149 @@
+Feature: Msg/MergeBatches
+
+  Credit batches can be merged into a single credit batch:
+  - when the credit batches exist
+  - when the issuer is the issuer of the credit batches
+  - when the credit batches are open
+  - when the credit batches are from the same project
+  - when the dates of the credit batches are contiguous
+  - when each account holding credits is the issuer or a holder
+  - the new credit batch is created with the combined balances and supply
+  - the new credit batch is linked to the credit batches
+  - the credit batches are sealed with no remaining balances or supply
+
+  Rule: The credit batches must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+
+    Scenario: the credit batches exist
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect no error
+
+    Scenario: a credit batch does not exist
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-003"
+      Then expect the error "could not get batch with denom C01-001-20210101-20220101-003: not found: invalid request"
+
+  Rule: The issuer must be the issuer of the credit batches
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+
+    Scenario: the issuer is the issuer of the credit batches
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect no error
+
+    Scenario: the issuer is not the issuer of the credit batches
+      When bob attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect error contains "is not the issuer of credit batch C01-001-20200101-20210101-001: unauthorized"
+
+  Rule: The credit batches must be open
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+
+    Scenario: a credit batch is sealed
+      Given the credit batch "C01-001-20210101-20220101-002" is sealed
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect the error "credit batch C01-001-20210101-20220101-002 is sealed: invalid request"
+
+  Rule: The credit batches must be from the same project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a project with id "C01-002"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the credit batches are from the same project
+      Given a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect no error
+
+    Scenario: the credit batches are not from the same project
+      Given a credit batch with denom "C01-002-20210101-20220101-001" and issuer alice
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-002-20210101-20220101-001"
+      Then expect the error "batch_denoms[1]: credit batch C01-002-20210101-20220101-001 is not from the same project as credit batch C01-001-20200101-20210101-001: invalid request"
+
+  Rule: The dates of the credit batches must be contiguous
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario: the dates of the credit batches are contiguous
+      Given a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect no error
+
+    Scenario: the dates of the credit batches are not contiguous
+      Given a credit batch with denom "C01-001-20210201-20220101-002" and issuer alice
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210201-20220101-002"
+      Then expect the error "batch_denoms[1]: start date must equal the end date of the previous credit batch: invalid request"
+
+  Rule: Each account holding credits must be the issuer or a holder
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+      And bob has a balance of "C01-001-20210101-20220101-002" with tradable amount "10" and retired amount "0"
+
+    Scenario: the account holding credits is a holder
+      Given bob is a holder
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect no error
+
+    Scenario: the account holding credits is not a holder
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect error contains "holds credits from credit batch C01-001-20210101-20220101-002 and is not a holder: unauthorized"
+
+  Rule: The credit batches are merged into a new credit batch
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And a credit batch with denom "C01-001-20210101-20220101-002" and issuer alice
+      And alice has a balance of "C01-001-20200101-20210101-001" with tradable amount "10" and retired amount "0"
+      And bob has a balance of "C01-001-20200101-20210101-001" with tradable amount "2" and retired amount "3"
+      And bob has a balance of "C01-001-20210101-20220101-002" with tradable amount "5" and retired amount "1"
+      And the credit batch "C01-001-20210101-20220101-002" has a cancelled amount of "4"
+      And bob is a holder
+
+    Scenario: the credit batches are merged
+      When alice attempts to merge batches "C01-001-20200101-20210101-001 C01-001-20210101-20220101-002"
+      Then expect batch supply of "C01-001-20200101-20220101-003" with tradable amount "17" retired amount "4" and cancelled amount "4"
+      And expect alice batch balance of "C01-001-20200101-20220101-003" with tradable amount "10" and retired amount "0"
+      And expect bob batch balance of "C01-001-20200101-20220101-003" with tradable amount "7" and retired amount "4"
+      And expect credit batch "C01-001-20200101-20210101-001" is sealed with no balances
+      And expect credit batch "C01-001-20210101-20220101-002" is sealed with no balances
+      And expect batch lineage from "C01-001-20200101-20210101-001" to "C01-001-20200101-20220101-003"
+      And expect batch lineage from "C01-001-20210101-20220101-002" to "C01-001-20200101-20220101-003"
+      And expect event merge batches with new batch denom "C01-001-20200101-20220101-003"
+      And expect the response
+      """
+      {
+        "batch_denom": "C01-001-20200101-20220101-003"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
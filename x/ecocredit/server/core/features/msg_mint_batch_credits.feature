@@ -4,6 +4,7 @@ Feature: Msg/MintBatchCredits
   - when the credit batch exists
   - when the credit batch is open
   - when the issuer is the issuer of the credit batch
+  - when the project has an issuable status
   - when the origin tx id and source is unique within the scope of the credit class
   - when the contract is unique within the scope of the credit class
   - the recipient batch balance is updated
@@ -58,6 +59,36 @@ Feature: Msg/MintBatchCredits
       When bob attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
       Then expect the error "only the account that issued the batch can mint additional credits: unauthorized"
 
+  Rule: The project must have an issuable status
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+
+    Scenario Outline: the project has an issuable status
+      Given the project has status "<status>"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+      Examples:
+        | status                     |
+        | PROJECT_STATUS_UNSPECIFIED |
+        | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_VALIDATED   |
+
+    Scenario Outline: the project does not have an issuable status
+      Given the project has status "<status>"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect the error "credits cannot be issued under project C01-001 with status <status>: invalid request"
+
+      Examples:
+        | status                   |
+        | PROJECT_STATUS_PROPOSED  |
+        | PROJECT_STATUS_SUSPENDED |
+        | PROJECT_STATUS_COMPLETED |
+
   Rule: The origin tx must be unique within the scope of the credit class
 
     Background:
@@ -139,3 +170,57 @@ Feature: Msg/MintBatchCredits
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buffer pool contribution is withheld from the tradable amount
+
+    Scenario: the buffer pool contribution is withheld
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And the credit class has buffer percentage "0.2"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" recipient bob and tradable amount "10"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "8",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect buffer batch balance
+      """
+      {
+        "tradable_amount": "2",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "cancelled_amount": "0"
+      }
+      """
+
+    Scenario: the buffer pool contribution is not withheld from a bridged credit batch
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01" and issuer alice
+      And the credit class has buffer percentage "0.2"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20210101-001" and issuer alice
+      And the credit batch has contract "0x40"
+      When alice attempts to mint credits with batch denom "C01-001-20200101-20210101-001" recipient bob and tradable amount "10"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "10",
+        "retired_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect no buffer batch balance
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,101 @@
+Feature: Msg/ProposeProjectTransfer
+
+  A project transfer can be proposed:
+  - when the project exists
+  - when the admin is the admin of the credit class of the project
+  - when the credit class exists
+  - when the credit class is not the credit class of the project
+  - when the credit class has the same credit type as the credit class of the project
+  - the project transfer is saved
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the project exists
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to propose project transfer with project id "C01-002" and class id "C02"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class of the project
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the admin is the admin of the credit class of the project
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class of the project
+      When bob attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the credit class exists
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C03"
+      Then expect the error "could not get credit class with id C03: not found: invalid request"
+
+  Rule: The credit class must not be the credit class of the project
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the credit class is the credit class of the project
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C01"
+      Then expect the error "project C01-001 already belongs to credit class C01: invalid request"
+
+  Rule: The credit class must have the same credit type as the credit class of the project
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a credit class with class id "BIO01" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the credit class has the same credit type
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect no error
+
+    Scenario: the credit class has a different credit type
+      When alice attempts to propose project transfer with project id "C01-001" and class id "BIO01"
+      Then expect the error "cannot transfer project with credit type C to credit class with credit type BIO: invalid request"
+
+  Rule: The project transfer is saved
+
+    Background:
+      Given a credit class with class id "C01" and admin alice
+      And a credit class with class id "C02" and admin bob
+      And a credit class with class id "C03" and admin bob
+      And a project with project id "C01-001"
+
+    Scenario: the project transfer is added
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect project transfer of project "C01-001" to credit class "C02"
+      And expect event propose project transfer with project id "C01-001" and class id "C02"
+
+    Scenario: the project transfer is replaced
+      Given a project transfer of project "C01-001" to credit class "C03"
+      When alice attempts to propose project transfer with project id "C01-001" and class id "C02"
+      Then expect project transfer of project "C01-001" to credit class "C02"
+      And expect event propose project transfer with project id "C01-001" and class id "C02"
+
+    # no failing scenario - state transitions only occur upon successful message execution
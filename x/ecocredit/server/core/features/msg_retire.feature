@@ -6,6 +6,7 @@ Feature: Msg/Retire
   - when the decimal places in amount to cancel does not exceed credit type precision
   - the owner credit balance is updated
   - the batch supply is updated
+  - the retirement is recorded with the beneficiary and reason
 
   Rule: The credit batch must exist
 
@@ -106,3 +107,31 @@ Feature: Msg/Retire
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The retirement is recorded
+
+    Scenario: the retirement is recorded
+      Given a credit batch
+      And alice owns tradable credit amount "10"
+      And the block time "2022-06-01T00:00:00Z"
+      When alice attempts to retire credit amount "10" with the properties
+      """
+      {
+        "jurisdiction": "US-WA",
+        "beneficiary": "Acme Corporation",
+        "reason": "offsetting 2021 business travel emissions"
+      }
+      """
+      Then expect retirement with id "1" and the properties
+      """
+      {
+        "amount": "10",
+        "jurisdiction": "US-WA",
+        "beneficiary": "Acme Corporation",
+        "reason": "offsetting 2021 business travel emissions",
+        "timestamp": "2022-06-01T00:00:00Z"
+      }
+      """
+      And expect event retire with retirement id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
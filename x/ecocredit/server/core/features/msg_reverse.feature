@@ -0,0 +1,159 @@
+Feature: Msg/Reverse
+
+  Credits can be reversed from the buffer pool by the admin of the credit class and from a
+  holder by the governance authority:
+  - when the credit batch exists
+  - when the admin is the admin of the credit class if reversed from the buffer pool
+  - when the admin is the governance authority if reversed from a holder
+  - when the owner has a tradable credit balance greater than or equal to the amount to reverse
+  - when the buffer pool no longer holds tradable credits from the batch if reversed from a holder
+  - the owner credit balance is updated
+  - the batch supply is updated
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "10"
+
+    Scenario: the credit batch exists
+      When alice attempts to reverse credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to reverse credits with batch denom "C01-001-20200101-20210101-002"
+      Then expect the error "could not get batch with denom C01-001-20200101-20210101-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "10"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to reverse credit amount "5"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to reverse credit amount "5"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The owner must have a tradable credit balance greater than or equal to the amount to reverse
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "10"
+
+    Scenario Outline: tradable balance greater than or equal to amount to reverse
+      When alice attempts to reverse credit amount "<amount>"
+      Then expect no error
+
+      Examples:
+        | description  | amount |
+        | greater than | 5      |
+        | equal to     | 10     |
+
+    Scenario: tradable balance less than amount to reverse
+      When alice attempts to reverse credit amount "15"
+      Then expect the error "tradable balance: 10, reverse amount 15: insufficient credit balance"
+
+  Rule: Holder credits can only be reversed by the governance authority
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "0"
+      And bob has tradable credit amount "90"
+
+    Scenario: the admin is the governance authority
+      When the authority attempts to reverse credit amount "5" from bob
+      Then expect no error
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to reverse credit amount "5" from bob
+      Then expect error contains "holder credits can only be reversed by the governance authority"
+
+  Rule: Holder credits can only be reversed once the buffer pool is exhausted
+
+    Background:
+      Given a credit batch with class admin alice
+      And bob has tradable credit amount "90"
+
+    Scenario: the buffer pool is exhausted
+      Given the buffer pool has tradable credit amount "0"
+      When the authority attempts to reverse credit amount "5" from bob
+      Then expect no error
+
+    Scenario: the buffer pool is not exhausted
+      Given the buffer pool has tradable credit amount "10"
+      When the authority attempts to reverse credit amount "5" from bob
+      Then expect the error "buffer pool of credit class C01 holds 10 tradable credits from C01-001-20200101-20210101-001: holder credits can only be reversed once the buffer pool is exhausted: invalid request"
+
+  Rule: Credits are reversed from the buffer pool of the credit class of the project
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "10"
+      And bob has tradable credit amount "90"
+      And the project is transferred to credit class "C02" with admin alice
+
+    Scenario: credits are reversed from the buffer pool after a project transfer
+      When alice attempts to reverse credit amount "4"
+      Then expect the buffer pool of credit class "C02" has tradable credit amount "6"
+
+    Scenario: holder credits are not reversed after a project transfer
+      When the authority attempts to reverse credit amount "5" from bob
+      Then expect the error "buffer pool of credit class C02 holds 10 tradable credits from C01-001-20200101-20210101-001: holder credits can only be reversed once the buffer pool is exhausted: invalid request"
+
+  Rule: The owner credit balance and the batch supply are updated
+
+    Background:
+      Given a credit batch with class admin alice
+      And bob has tradable credit amount "90"
+
+    Scenario: credits are reversed from the buffer pool
+      Given the buffer pool has tradable credit amount "10"
+      When alice attempts to reverse credit amount "4"
+      Then expect buffer pool tradable credit amount "6"
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "96",
+        "retired_amount": "0",
+        "cancelled_amount": "4"
+      }
+      """
+
+    Scenario: credits are reversed from a holder
+      Given the buffer pool has tradable credit amount "0"
+      When the authority attempts to reverse credit amount "4" from bob
+      Then expect bob tradable credit amount "86"
+      And expect batch supply
+      """
+      {
+        "tradable_amount": "96",
+        "retired_amount": "0",
+        "cancelled_amount": "4"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: Event is emitted
+
+    Background:
+      Given a credit batch with class admin alice
+      And the buffer pool has tradable credit amount "10"
+
+    Scenario: EventReverse is emitted
+      When alice attempts to reverse credit amount "4"
+      Then expect event reverse with properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "4",
+        "buffer": true,
+        "reason": "forest fire"
+      }
+      """
+
+    # no failing scenario - events are only emitted upon successful message execution
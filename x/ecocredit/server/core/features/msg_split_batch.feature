@@ -0,0 +1,308 @@
+Feature: Msg/SplitBatch
+
+  A credit batch can be split into sub-vintage credit batches:
+  - when the credit batch exists
+  - when the issuer is the issuer of the credit batch
+  - when the credit batch is open
+  - when the sub batches start and end on the dates of the credit batch
+  - when each account holding credits is the issuer or a holder
+  - when the credit batch does not have escrowed credits
+  - when the credits and the cancelled supply are fully allocated to the sub batches
+  - the new credit batches are created with the allocated balances and supply
+  - the new credit batches are linked to the credit batch
+  - the credit batch is sealed with no remaining balances or supply
+
+  Rule: The credit batch must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+
+    Scenario: the credit batch exists
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to split batch "C01-001-20200101-20220101-002" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect the error "could not get batch with denom C01-001-20200101-20220101-002: not found: invalid request"
+
+  Rule: The issuer must be the issuer of the credit batch
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+
+    Scenario: the issuer is the issuer of the credit batch
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect no error
+
+    Scenario: the issuer is not the issuer of the credit batch
+      When bob attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect error contains "is not the issuer of credit batch C01-001-20200101-20220101-001: unauthorized"
+
+  Rule: The credit batch must be open
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+
+    Scenario: the credit batch is sealed
+      Given the credit batch "C01-001-20200101-20220101-001" is sealed
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect the error "credit batch C01-001-20200101-20220101-001 is sealed: invalid request"
+
+  Rule: The sub batches must start and end on the dates of the credit batch
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+
+    Scenario: the sub batches end before the end date of the credit batch
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2021-07-01T00:00:00Z"}
+      ]
+      """
+      Then expect the error "sub batches must start and end on the dates of credit batch C01-001-20200101-20220101-001: invalid request"
+
+  Rule: Each account holding credits must be the issuer or a holder
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+      And bob has a balance of "C01-001-20200101-20220101-001" with tradable amount "10" and retired amount "0"
+
+    Scenario: the account holding credits is a holder
+      Given bob is a holder
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "bob", "tradable_amount": "5"}]
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "bob", "tradable_amount": "5"}]
+        }
+      ]
+      """
+      Then expect no error
+
+    Scenario: the account holding credits is not a holder
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "bob", "tradable_amount": "5"}]
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "bob", "tradable_amount": "5"}]
+        }
+      ]
+      """
+      Then expect error contains "holds credits from credit batch C01-001-20200101-20220101-001 and is not a holder: unauthorized"
+
+  Rule: The credit batch must not have escrowed credits
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+
+    Scenario: the credit batch has escrowed credits
+      Given alice has an escrowed balance of "C01-001-20200101-20220101-001" with amount "10"
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {"start_date": "2020-01-01T00:00:00Z", "end_date": "2021-01-01T00:00:00Z"},
+        {"start_date": "2021-01-01T00:00:00Z", "end_date": "2022-01-01T00:00:00Z"}
+      ]
+      """
+      Then expect the error "credit batch C01-001-20200101-20220101-001 has escrowed credits: invalid request"
+
+  Rule: The credits and the cancelled supply must be fully allocated to the sub batches
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+      And alice has a balance of "C01-001-20200101-20220101-001" with tradable amount "10" and retired amount "4"
+      And the credit batch "C01-001-20200101-20220101-001" has a cancelled amount of "2"
+
+    Scenario: the credits and the cancelled supply are fully allocated
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5", "retired_amount": "4"}],
+          "cancelled_amount": "2"
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5"}]
+        }
+      ]
+      """
+      Then expect no error
+
+    Scenario: the credits allocated exceed the balance of the account
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5", "retired_amount": "4"}],
+          "cancelled_amount": "2"
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "6"}]
+        }
+      ]
+      """
+      Then expect error contains "sub_batches[1]: balances[0]: credits allocated to"
+
+    Scenario: the credits are not fully allocated
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5", "retired_amount": "4"}],
+          "cancelled_amount": "2"
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "4"}]
+        }
+      ]
+      """
+      Then expect error contains "are not fully allocated to the sub batches: invalid request"
+
+    Scenario: the cancelled supply is not fully allocated
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5", "retired_amount": "4"}],
+          "cancelled_amount": "1"
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "alice", "tradable_amount": "5"}]
+        }
+      ]
+      """
+      Then expect the error "cancelled supply of credit batch C01-001-20200101-20220101-001 is not fully allocated to the sub batches: invalid request"
+
+  Rule: The credit batch is split into new credit batches
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with id "C01"
+      And a project with id "C01-001"
+      And a credit batch with denom "C01-001-20200101-20220101-001" and issuer alice
+      And alice has a balance of "C01-001-20200101-20220101-001" with tradable amount "10" and retired amount "0"
+      And bob has a balance of "C01-001-20200101-20220101-001" with tradable amount "6" and retired amount "4"
+      And bob is a holder
+
+    Scenario: the credit batch is split
+      When alice attempts to split batch "C01-001-20200101-20220101-001" with sub batches
+      """
+      [
+        {
+          "start_date": "2020-01-01T00:00:00Z",
+          "end_date": "2021-01-01T00:00:00Z",
+          "balances": [
+            {"address": "alice", "tradable_amount": "10"},
+            {"address": "bob", "retired_amount": "4"}
+          ]
+        },
+        {
+          "start_date": "2021-01-01T00:00:00Z",
+          "end_date": "2022-01-01T00:00:00Z",
+          "balances": [{"address": "bob", "tradable_amount": "6"}]
+        }
+      ]
+      """
+      Then expect batch supply of "C01-001-20200101-20210101-002" with tradable amount "10" and retired amount "4"
+      And expect batch supply of "C01-001-20210101-20220101-003" with tradable amount "6" and retired amount "0"
+      And expect alice batch balance of "C01-001-20200101-20210101-002" with tradable amount "10" and retired amount "0"
+      And expect bob batch balance of "C01-001-20200101-20210101-002" with tradable amount "0" and retired amount "4"
+      And expect bob batch balance of "C01-001-20210101-20220101-003" with tradable amount "6" and retired amount "0"
+      And expect credit batch "C01-001-20200101-20220101-001" is sealed with no balances
+      And expect batch lineage from "C01-001-20200101-20220101-001" to "C01-001-20200101-20210101-002"
+      And expect batch lineage from "C01-001-20200101-20220101-001" to "C01-001-20210101-20220101-003"
+      And expect event split batch with new batch denoms "C01-001-20200101-20210101-002 C01-001-20210101-20220101-003"
+      And expect the response
+      """
+      {
+        "batch_denoms": [
+          "C01-001-20200101-20210101-002",
+          "C01-001-20210101-20220101-003"
+        ]
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
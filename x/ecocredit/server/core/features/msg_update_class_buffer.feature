@@ -0,0 +1,47 @@
+Feature: Msg/UpdateClassBuffer
+
+  The buffer percentage of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the credit class buffer percentage is updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to update class buffer with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update class buffer with class id "C02"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update class buffer with class id "C01"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update class buffer with class id "C01"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit class buffer percentage is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class buffer percentage is updated
+      When alice attempts to update class buffer with class id "C01" and buffer percentage "0.15"
+      Then expect credit class with class id "C01" and buffer percentage "0.15"
+      And expect event update class buffer with class id "C01"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,53 @@
+Feature: Msg/UpdateClassVerifier
+
+  The verifier of a credit class can be updated:
+  - when the credit class exists
+  - when the admin is the admin of the credit class
+  - the credit class verifier is updated
+
+  Rule: The credit class must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class exists
+      When alice attempts to update class verifier with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to update class verifier with class id "C02"
+      Then expect the error "could not get credit class with id C02: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to update class verifier with class id "C01"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to update class verifier with class id "C01"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The credit class verifier is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+
+    Scenario: the credit class verifier is set
+      When alice attempts to update class verifier with class id "C01" and verifier bob
+      Then expect credit class with class id "C01" and verifier bob
+      And expect event update class verifier with class id "C01"
+
+    Scenario: the credit class verifier is removed
+      Given the credit class with class id "C01" has verifier bob
+      When alice attempts to update class verifier with class id "C01" and no verifier
+      Then expect credit class with class id "C01" and no verifier
+      And expect event update class verifier with class id "C01"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,56 @@
+Feature: Msg/UpdateProjectIssuers
+
+  The issuers of a project can be updated:
+  - when the project exists
+  - when the admin is the admin of the credit class of the project
+  - the project issuers are updated
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the project exists
+      When alice attempts to add bob as an issuer of project "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to add bob as an issuer of project "C01-002"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class of the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to add bob as an issuer of project "C01-001"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to add bob as an issuer of project "C01-001"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The project issuers are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: an issuer is added
+      When alice attempts to add bob as an issuer of project "C01-001"
+      Then expect bob is an issuer of project "C01-001"
+      And expect event update project issuers with project id "C01-001"
+
+    Scenario: an issuer is removed
+      Given bob is an issuer of project "C01-001"
+      When alice attempts to remove bob as an issuer of project "C01-001"
+      Then expect bob is not an issuer of project "C01-001"
+      And expect event update project issuers with project id "C01-001"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,125 @@
+Feature: Msg/UpdateProjectStatus
+
+  The status of a project can be updated:
+  - when the project exists
+  - when the signer is the admin or the verifier of the credit class or a verifier of the project
+  - when the signer is a monitoring body of the project and the status is suspended
+  - when the status transition is allowed
+  - the project status is updated
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and verifier bob
+      And a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the project exists
+      When alice attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to update project status with project id "C01-002" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The signer must be the admin or the verifier of the credit class or a verifier of the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and verifier bob
+      And a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the signer is the admin of the credit class
+      When alice attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the signer is the verifier of the credit class
+      When bob attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the signer is a verifier of the project
+      Given charlie has role "PROJECT_VERIFIER_ROLE_VERIFIER" within project "C01-001"
+      When charlie attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_VALIDATED"
+      Then expect no error
+
+    Scenario: the signer is not the admin or the verifier of the credit class or a verifier of the project
+      When charlie attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect error contains "is not the admin or verifier of credit class C01 or a verifier of project C01-001: unauthorized"
+
+  Rule: A monitoring body of the project can only suspend the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and verifier bob
+      And a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+      And charlie has role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" within project "C01-001"
+
+    Scenario: the monitoring body suspends the project
+      When charlie attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      Then expect no error
+
+    Scenario: the monitoring body does not suspend the project
+      When charlie attempts to update project status with project id "C01-001" and status "PROJECT_STATUS_VALIDATED"
+      Then expect error contains "is a monitoring body of project C01-001 and can only suspend the project: unauthorized"
+
+  Rule: The status transition must be allowed
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and verifier bob
+
+    Scenario Outline: the status transition is allowed
+      Given a project with project id "C01-001" and status "<from>"
+      When alice attempts to update project status with project id "C01-001" and status "<to>"
+      Then expect no error
+
+      Examples:
+        | from                       | to                         |
+        | PROJECT_STATUS_UNSPECIFIED | PROJECT_STATUS_VALIDATED   |
+        | PROJECT_STATUS_UNSPECIFIED | PROJECT_STATUS_SUSPENDED   |
+        | PROJECT_STATUS_PROPOSED    | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_VALIDATED   |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_SUSPENDED   |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_COMPLETED   |
+        | PROJECT_STATUS_VALIDATED   | PROJECT_STATUS_SUSPENDED   |
+        | PROJECT_STATUS_VALIDATED   | PROJECT_STATUS_COMPLETED   |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_REGISTERED  |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_VALIDATED   |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_COMPLETED   |
+
+    Scenario Outline: the status transition is not allowed
+      Given a project with project id "C01-001" and status "<from>"
+      When alice attempts to update project status with project id "C01-001" and status "<to>"
+      Then expect the error "cannot update status of project C01-001 from <current> to <to>: invalid request"
+
+      Examples:
+        | from                       | current                   | to                        |
+        | PROJECT_STATUS_UNSPECIFIED | PROJECT_STATUS_REGISTERED | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_PROPOSED    | PROJECT_STATUS_PROPOSED   | PROJECT_STATUS_VALIDATED  |
+        | PROJECT_STATUS_PROPOSED    | PROJECT_STATUS_PROPOSED   | PROJECT_STATUS_SUSPENDED  |
+        | PROJECT_STATUS_REGISTERED  | PROJECT_STATUS_REGISTERED | PROJECT_STATUS_REGISTERED |
+        | PROJECT_STATUS_VALIDATED   | PROJECT_STATUS_VALIDATED  | PROJECT_STATUS_REGISTERED |
+        | PROJECT_STATUS_SUSPENDED   | PROJECT_STATUS_SUSPENDED  | PROJECT_STATUS_PROPOSED   |
+        | PROJECT_STATUS_COMPLETED   | PROJECT_STATUS_COMPLETED  | PROJECT_STATUS_REGISTERED |
+        | PROJECT_STATUS_COMPLETED   | PROJECT_STATUS_COMPLETED  | PROJECT_STATUS_SUSPENDED  |
+
+  Rule: The project status is updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" admin alice and verifier bob
+      And a project with project id "C01-001" and status "PROJECT_STATUS_REGISTERED"
+
+    Scenario: the project status is updated
+      When bob attempts to update project status with project id "C01-001" status "PROJECT_STATUS_SUSPENDED" and reason "under investigation"
+      Then expect project with project id "C01-001" and status "PROJECT_STATUS_SUSPENDED"
+      And expect event update project status
+      """
+      {
+        "project_id": "C01-001",
+        "status": "PROJECT_STATUS_SUSPENDED",
+        "reason": "under investigation"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
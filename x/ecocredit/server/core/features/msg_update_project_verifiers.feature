@@ -0,0 +1,90 @@
+Feature: Msg/UpdateProjectVerifiers
+
+  The verifiers and monitoring bodies of a project can be updated:
+  - when the project exists
+  - when the admin is the admin of the credit class of the project
+  - when the removed address has the role within the project
+  - when the added address does not already have a role within the project
+  - the project verifiers are updated
+
+  Rule: The project must exist
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the project exists
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" to project "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" to project "C01-002"
+      Then expect the error "could not get project with id C01-002: not found: invalid request"
+
+  Rule: The admin must be the admin of the credit class of the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the admin is the admin of the credit class
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" to project "C01-001"
+      Then expect no error
+
+    Scenario: the admin is not the admin of the credit class
+      When bob attempts to add bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" to project "C01-001"
+      Then expect error contains "is not the admin of credit class C01: unauthorized"
+
+  Rule: The removed address must have the role within the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+      And bob has role "PROJECT_VERIFIER_ROLE_VERIFIER" within project "C01-001"
+
+    Scenario: the removed address has the role
+      When alice attempts to remove bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" from project "C01-001"
+      Then expect no error
+
+    Scenario: the removed address has a different role
+      When alice attempts to remove bob with role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" from project "C01-001"
+      Then expect error contains "does not have role PROJECT_VERIFIER_ROLE_MONITORING_BODY within project C01-001: invalid request"
+
+  Rule: The added address must not already have a role within the project
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: the added address does not have a role
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" to project "C01-001"
+      Then expect no error
+
+    Scenario: the added address already has a role
+      Given bob has role "PROJECT_VERIFIER_ROLE_VERIFIER" within project "C01-001"
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" to project "C01-001"
+      Then expect error contains "already has a role within project C01-001: invalid request"
+
+  Rule: The project verifiers are updated
+
+    Background:
+      Given a credit type with abbreviation "C"
+      And a credit class with class id "C01" and admin alice
+      And a project with project id "C01-001"
+
+    Scenario: a verifier is added
+      When alice attempts to add bob with role "PROJECT_VERIFIER_ROLE_VERIFIER" to project "C01-001"
+      Then expect bob has role "PROJECT_VERIFIER_ROLE_VERIFIER" within project "C01-001"
+      And expect event update project verifiers with project id "C01-001"
+
+    Scenario: a monitoring body is removed
+      Given bob has role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" within project "C01-001"
+      When alice attempts to remove bob with role "PROJECT_VERIFIER_ROLE_MONITORING_BODY" from project "C01-001"
+      Then expect bob has no role within project "C01-001"
+      And expect event update project verifiers with project id "C01-001"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// AcceptProjectTransfer accepts a proposed project transfer. Only the admin of the credit class
+// that the project is being transferred to can accept the project transfer. The project receives
+// a new project ID within the credit class and the previous project ID is stored as an alias of
+// the project. Credit batches issued before the transfer keep their batch denoms. The project
+// issuers and project verifiers are removed, and the project must be registered again if the
+// credit class has a verifier.
+func (k Keeper) AcceptProjectTransfer(ctx context.Context, req *core.MsgAcceptProjectTransfer) (*core.MsgAcceptProjectTransferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get project with id %s: %s", req.ProjectId, err,
+		)
+	}
+
+	transfer, err := k.stateStore.ProjectTransferTable().Get(ctx, project.Key)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"project %s does not have a proposed transfer", req.ProjectId,
+			)
+		}
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, transfer.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !reqAddr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	// check if non-empty reference id is unique within the scope of the credit class
+	if err = k.verifyReferenceId(ctx, class.Key, project.ReferenceId); err != nil {
+		return nil, err
+	}
+
+	projectID, err := k.genProjectID(ctx, class.Key, class.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.ProjectAliasTable().Insert(ctx, &api.ProjectAlias{
+		Alias:      project.Id,
+		ProjectKey: project.Key,
+	}); err != nil {
+		return nil, err
+	}
+
+	oldClass, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.moveBufferBalances(sdkCtx, project.Key, oldClass.Id, class.Id); err != nil {
+		return nil, err
+	}
+
+	// the project issuers and project verifiers were approved within the previous credit class
+	if err = k.stateStore.ProjectIssuerTable().DeleteBy(ctx,
+		api.ProjectIssuerProjectKeyIssuerIndexKey{}.WithProjectKey(project.Key),
+	); err != nil {
+		return nil, err
+	}
+	if err = k.stateStore.ProjectVerifierTable().DeleteBy(ctx,
+		api.ProjectVerifierProjectKeyAddressIndexKey{}.WithProjectKey(project.Key),
+	); err != nil {
+		return nil, err
+	}
+
+	// projects within a credit class with a verifier must be registered by the
+	// admin or the verifier of the credit class before credits can be issued
+	if len(class.Verifier) != 0 {
+		project.Status = api.ProjectStatus_PROJECT_STATUS_PROPOSED
+	}
+
+	project.Id = projectID
+	project.ClassKey = class.Key
+	if err = k.stateStore.ProjectTable().Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err = k.stateStore.ProjectTransferTable().Delete(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventAcceptProjectTransfer{
+		OldProjectId: req.ProjectId,
+		NewProjectId: projectID,
+		ClassId:      class.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgAcceptProjectTransferResponse{ProjectId: projectID}, nil
+}
+
+// moveBufferBalances moves the credits of each credit batch of the project from the buffer
+// pool of the previous credit class to the buffer pool of the new credit class. Escrowed
+// credits cannot be moved because they are referenced by sell orders.
+func (k Keeper) moveBufferBalances(ctx sdk.Context, projectKey uint64, oldClassId, newClassId string) error {
+	oldBuffer := core.BufferAddress(oldClassId)
+	newBuffer := core.BufferAddress(newClassId)
+
+	it, err := k.stateStore.BatchTable().List(ctx, api.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+
+	for it.Next() {
+		ctx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgAcceptProjectTransfer batch iteration")
+
+		batch, err := it.Value()
+		if err != nil {
+			return err
+		}
+
+		oldBalance, err := k.stateStore.BatchBalanceTable().Get(ctx, oldBuffer, batch.Key)
+		if err != nil {
+			if ormerrors.NotFound.Is(err) {
+				continue
+			}
+			return err
+		}
+
+		escrowed, err := math.NewDecFromString(oldBalance.EscrowedAmount)
+		if err != nil {
+			return err
+		}
+		if !escrowed.IsZero() {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"buffer pool of credit class %s holds %s escrowed credits from %s", oldClassId, escrowed, batch.Denom,
+			)
+		}
+
+		tradable, err := math.NewDecFromString(oldBalance.TradableAmount)
+		if err != nil {
+			return err
+		}
+		retired, err := math.NewDecFromString(oldBalance.RetiredAmount)
+		if err != nil {
+			return err
+		}
+
+		newBalance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), newBuffer, batch.Key)
+		if err != nil {
+			return err
+		}
+		newTradable, err := math.NewDecFromString(newBalance.TradableAmount)
+		if err != nil {
+			return err
+		}
+		newRetired, err := math.NewDecFromString(newBalance.RetiredAmount)
+		if err != nil {
+			return err
+		}
+		if newTradable, err = math.SafeAddBalance(newTradable, tradable); err != nil {
+			return err
+		}
+		if newRetired, err = math.SafeAddBalance(newRetired, retired); err != nil {
+			return err
+		}
+		newBalance.TradableAmount = newTradable.String()
+		newBalance.RetiredAmount = newRetired.String()
+
+		if err = k.stateStore.BatchBalanceTable().Save(ctx, newBalance); err != nil {
+			return err
+		}
+		if err = k.stateStore.BatchBalanceTable().Delete(ctx, oldBalance); err != nil {
+			return err
+		}
+
+		if err = ctx.EventManager().EmitTypedEvent(&core.EventTransfer{
+			Sender:         oldBuffer.String(),
+			Recipient:      newBuffer.String(),
+			BatchDenom:     batch.Denom,
+			TradableAmount: tradable.String(),
+			RetiredAmount:  retired.String(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
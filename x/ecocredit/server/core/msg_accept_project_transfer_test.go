@@ -0,0 +1,309 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type acceptProjectTransfer struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	res      *core.MsgAcceptProjectTransferResponse
+	err      error
+}
+
+func TestAcceptProjectTransfer(t *testing.T) {
+	gocuke.NewRunner(t, &acceptProjectTransfer{}).Path("./features/msg_accept_project_transfer.feature").Run()
+}
+
+func (s *acceptProjectTransfer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *acceptProjectTransfer) ACreditClassWithClassIdAndAdminAlice(a string) {
+	s.insertClass(a, s.alice)
+}
+
+func (s *acceptProjectTransfer) ACreditClassWithClassIdAndAdminBob(a string) {
+	s.insertClass(a, s.bob)
+}
+
+func (s *acceptProjectTransfer) AProjectWithProjectId(a string) {
+	s.insertProject(a, "")
+}
+
+func (s *acceptProjectTransfer) AProjectWithProjectIdAndReferenceId(a, b string) {
+	s.insertProject(a, b)
+}
+
+func (s *acceptProjectTransfer) AProjectTransferOfProjectToCreditClass(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTransferTable().Insert(s.ctx, &api.ProjectTransfer{
+		ProjectKey: project.Key,
+		ClassKey:   class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) ACreditBatchWithBatchDenom(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, "C01-001")
+	require.NoError(s.t, err)
+
+	s.batchKey, err = s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: project.Key,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) TheBufferPoolOfCreditClassHasTradableAmountAndEscrowedAmount(a, b, c string) {
+	s.insertBufferBalance(a, b, "0", c)
+}
+
+func (s *acceptProjectTransfer) TheBufferPoolOfCreditClassHasTradableAmountAndRetiredAmount(a, b, c string) {
+	s.insertBufferBalance(a, b, c, "0")
+}
+
+func (s *acceptProjectTransfer) TheProjectHasProjectIssuerAlice(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectIssuerTable().Insert(s.ctx, &api.ProjectIssuer{
+		ProjectKey: project.Key,
+		Issuer:     s.alice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) TheProjectHasProjectVerifierAlice(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectVerifierTable().Insert(s.ctx, &api.ProjectVerifier{
+		ProjectKey: project.Key,
+		Address:    s.alice,
+		Role:       api.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_VERIFIER,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) TheProjectHasStatus(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[b])
+	err = s.k.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) TheCreditClassHasVerifierBob(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class.Verifier = s.bob
+	err = s.k.stateStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) AliceAttemptsToAcceptProjectTransferWithProjectId(a string) {
+	s.res, s.err = s.k.AcceptProjectTransfer(s.ctx, &core.MsgAcceptProjectTransfer{
+		Admin:     s.alice.String(),
+		ProjectId: a,
+	})
+}
+
+func (s *acceptProjectTransfer) BobAttemptsToAcceptProjectTransferWithProjectId(a string) {
+	s.res, s.err = s.k.AcceptProjectTransfer(s.ctx, &core.MsgAcceptProjectTransfer{
+		Admin:     s.bob.String(),
+		ProjectId: a,
+	})
+}
+
+func (s *acceptProjectTransfer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *acceptProjectTransfer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *acceptProjectTransfer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *acceptProjectTransfer) ExpectProjectWithProjectIdAndClassId(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().Get(s.ctx, project.ClassKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, class.Id)
+}
+
+func (s *acceptProjectTransfer) ExpectProjectAliasOfProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	alias, err := s.stateStore.ProjectAliasTable().Get(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, project.Key, alias.ProjectKey)
+}
+
+func (s *acceptProjectTransfer) ExpectNoProjectIssuersOfProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	it, err := s.stateStore.ProjectIssuerTable().List(s.ctx, api.ProjectIssuerProjectKeyIssuerIndexKey{}.WithProjectKey(project.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+	require.False(s.t, it.Next())
+}
+
+func (s *acceptProjectTransfer) ExpectNoProjectVerifiersOfProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	it, err := s.stateStore.ProjectVerifierTable().List(s.ctx, api.ProjectVerifierProjectKeyAddressIndexKey{}.WithProjectKey(project.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+	require.False(s.t, it.Next())
+}
+
+func (s *acceptProjectTransfer) ExpectProjectHasStatus(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, project.Status.String())
+}
+
+func (s *acceptProjectTransfer) ExpectNoProjectTransferOfProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ProjectTransferTable().Has(s.ctx, project.Key)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *acceptProjectTransfer) ExpectEventAcceptProjectTransferWithOldProjectIdAndNewProjectId(a, b string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventAcceptProjectTransfer{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventAcceptProjectTransfer)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.OldProjectId)
+		require.Equal(s.t, b, event.NewProjectId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventAcceptProjectTransfer{}))
+}
+
+func (s *acceptProjectTransfer) ExpectTheBufferPoolOfCreditClassHasTradableAmountAndRetiredAmount(a, b, c string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(a), s.batchKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, balance.TradableAmount)
+	require.Equal(s.t, c, balance.RetiredAmount)
+	require.Equal(s.t, "0", balance.EscrowedAmount)
+}
+
+func (s *acceptProjectTransfer) ExpectNoBalanceOfTheBufferPoolOfCreditClass(a string) {
+	found, err := s.stateStore.BatchBalanceTable().Has(s.ctx, core.BufferAddress(a), s.batchKey)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *acceptProjectTransfer) ExpectEventTransferFromTheBufferPoolOfCreditClassToTheBufferPoolOfCreditClass(a, b string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventTransfer{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventTransfer)
+		require.True(s.t, ok)
+		require.Equal(s.t, core.BufferAddress(a).String(), event.Sender)
+		require.Equal(s.t, core.BufferAddress(b).String(), event.Recipient)
+		require.Equal(s.t, "10", event.TradableAmount)
+		require.Equal(s.t, "2", event.RetiredAmount)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventTransfer{}))
+}
+
+func (s *acceptProjectTransfer) ExpectTheResponse(a gocuke.DocString) {
+	var res core.MsgAcceptProjectTransferResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *acceptProjectTransfer) insertClass(classId string, admin sdk.AccAddress) {
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               classId,
+		Admin:            admin,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(classId),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) insertProject(projectId, referenceId string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, core.GetClassIdFromProjectId(projectId))
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:          projectId,
+		ClassKey:    class.Key,
+		ReferenceId: referenceId,
+	})
+	require.NoError(s.t, err)
+
+	seq, err := strconv.ParseUint(strings.Split(projectId, "-")[1], 10, 64)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectSequenceTable().Save(s.ctx, &api.ProjectSequence{
+		ClassKey:     class.Key,
+		NextSequence: seq + 1,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptProjectTransfer) insertBufferBalance(classId, tradable, retired, escrowed string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        core.BufferAddress(classId),
+		TradableAmount: tradable,
+		RetiredAmount:  retired,
+		EscrowedAmount: escrowed,
+	})
+	require.NoError(s.t, err)
+}
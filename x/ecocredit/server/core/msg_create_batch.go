@@ -36,11 +36,15 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		return nil, err
 	}
 
-	err = k.assertClassIssuer(ctx, class.Key, issuer)
+	err = k.assertProjectIssuer(ctx, class.Key, project.Key, issuer)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = assertProjectIssuable(project); err != nil {
+		return nil, err
+	}
+
 	batchSeqNo, err := k.getBatchSeqNo(ctx, project.Key)
 	if err != nil {
 		return nil, err
@@ -74,6 +78,10 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 	maxDecimalPlaces := creditType.Precision
 
 	tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+	bufferSupply := math.NewDecFromInt64(0)
+
+	// credit batches with a contract are bridged from another chain
+	bridged := req.OriginTx != nil && len(req.OriginTx.Contract) != 0
 
 	// set module address string once for better performance
 	moduleAddrString := k.moduleAddress.String()
@@ -86,6 +94,21 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		}
 		tradableAmount, retiredAmount := decs[0], decs[1]
 
+		// withhold the buffer pool contribution of the credit class from the
+		// tradable amount received by the recipient
+		receivedAmount, bufferAmount, err := withholdBuffer(class, tradableAmount, maxDecimalPlaces, bridged)
+		if err != nil {
+			return nil, err
+		}
+		receivedTradable := issuance.TradableAmount
+		if !bufferAmount.IsZero() {
+			receivedTradable = receivedAmount.String()
+			bufferSupply, err = bufferSupply.Add(bufferAmount)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		recipient, _ := sdk.AccAddressFromBech32(issuance.Recipient)
 
 		// get the current batch balance of the recipient account
@@ -112,7 +135,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		}
 
 		// add tradable amount and retired amount to existing batch balance
-		tradableBalance, err = tradableBalance.Add(tradableAmount)
+		tradableBalance, err = tradableBalance.Add(receivedAmount)
 		if err != nil {
 			return nil, err
 		}
@@ -148,12 +171,22 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			if err != nil {
 				return nil, err
 			}
-			// emit retired event only if retired amount is positive
+			// record retirement and emit retired event only if retired amount is positive
+			retirementId, err := RecordRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				BatchKey:     batchKey,
+				Amount:       issuance.RetiredAmount,
+				Owner:        recipient,
+				Jurisdiction: issuance.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 				Owner:        issuance.Recipient,
 				BatchDenom:   batchDenom,
 				Amount:       issuance.RetiredAmount,
 				Jurisdiction: issuance.RetirementJurisdiction,
+				RetirementId: retirementId,
 			}); err != nil {
 				return nil, err
 			}
@@ -171,7 +204,7 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 			Sender:         moduleAddrString, // ecocredit module
 			Recipient:      issuance.Recipient,
 			BatchDenom:     batchDenom,
-			TradableAmount: issuance.TradableAmount,
+			TradableAmount: receivedTradable,
 			RetiredAmount:  issuance.RetiredAmount,
 		}); err != nil {
 			return nil, err
@@ -180,6 +213,12 @@ func (k Keeper) CreateBatch(ctx context.Context, req *core.MsgCreateBatch) (*cor
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgCreateBatch issuance iteration")
 	}
 
+	if !bufferSupply.IsZero() {
+		if err = k.depositBuffer(ctx, class.Id, batchKey, batchDenom, bufferSupply); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
 		BatchKey:        batchKey,
 		TradableAmount:  tradableSupply.String(),
@@ -117,6 +117,26 @@ func (s *createBatchSuite) ACreditClassWithClassIdAndIssuerAlice(a string) {
 	s.classKey = cKey
 }
 
+func (s *createBatchSuite) TheCreditClassHasBufferPercentage(a string) {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.BufferPercentage = a
+
+	err = s.k.stateStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+}
+
+func (s *createBatchSuite) TheProjectWithProjectIdHasStatus(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[b])
+
+	err = s.k.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
 func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	classId := core.GetClassIdFromProjectId(a)
 
@@ -141,6 +161,17 @@ func (s *createBatchSuite) AProjectWithProjectId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *createBatchSuite) BobIsAnIssuerOfProject(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectIssuerTable().Insert(s.ctx, &api.ProjectIssuer{
+		ProjectKey: project.Key,
+		Issuer:     s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *createBatchSuite) ABatchSequenceWithProjectIdAndNextSequence(a string, b string) {
 	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
 	require.NoError(s.t, err)
@@ -329,6 +360,31 @@ func (s *createBatchSuite) ExpectRecipientBatchBalanceWithAddress(a string, b go
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *createBatchSuite) ExpectBufferBatchBalance(a gocuke.DocString) {
+	var expected api.BatchBalance
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.res.BatchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(s.classId), batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *createBatchSuite) ExpectNoBufferBatchBalance() {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.res.BatchDenom)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchBalanceTable().Has(s.ctx, core.BufferAddress(s.classId), batch.Key)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
 func (s *createBatchSuite) ExpectBatchSupply(a gocuke.DocString) {
 	var expected api.BatchSupply
 	err := jsonpb.UnmarshalString(a.Content, &expected)
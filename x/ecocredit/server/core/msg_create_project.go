@@ -41,6 +41,13 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 		return nil, err
 	}
 
+	// projects within a credit class with a verifier must be registered by the
+	// admin or the verifier of the credit class before credits can be issued
+	status := api.ProjectStatus_PROJECT_STATUS_REGISTERED
+	if len(classInfo.Verifier) != 0 {
+		status = api.ProjectStatus_PROJECT_STATUS_PROPOSED
+	}
+
 	if err = k.stateStore.ProjectTable().Insert(ctx, &api.Project{
 		Id:           projectID,
 		Admin:        adminAddress,
@@ -48,6 +55,7 @@ func (k Keeper) CreateProject(ctx context.Context, req *core.MsgCreateProject) (
 		Jurisdiction: req.Jurisdiction,
 		Metadata:     req.Metadata,
 		ReferenceId:  req.ReferenceId,
+		Status:       status,
 	}); err != nil {
 		return nil, err
 	}
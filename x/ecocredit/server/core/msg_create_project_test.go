@@ -57,6 +57,16 @@ func (s *createProjectSuite) ACreditClassWithClassIdAndIssuerAlice(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *createProjectSuite) TheCreditClassWithClassIdHasVerifierBob(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class.Verifier = s.bob
+
+	err = s.k.stateStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+}
+
 func (s *createProjectSuite) AProjectSequenceWithClassIdAndNextSequence(a, b string) {
 	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, a)
 	require.NoError(s.t, err)
@@ -168,6 +178,13 @@ func (s *createProjectSuite) ExpectProjectProperties(a gocuke.DocString) {
 	require.Equal(s.t, expected.ReferenceId, batch.ReferenceId)
 }
 
+func (s *createProjectSuite) ExpectProjectWithProjectIdAndStatus(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, project.Status.String())
+}
+
 func (s *createProjectSuite) ExpectTheResponse(a gocuke.DocString) {
 	var res core.MsgCreateProjectResponse
 	err := jsonpb.UnmarshalString(a.Content, &res)
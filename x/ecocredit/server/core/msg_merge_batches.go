@@ -0,0 +1,196 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// MergeBatches merges open credit batches from the same project with contiguous dates into a
+// single credit batch. The balances and supply of the credit batches are combined in the new
+// credit batch, the new credit batch is linked to the credit batches in the batch lineage and
+// the credit batches are sealed with no remaining balances or supply.
+func (k Keeper) MergeBatches(ctx context.Context, req *core.MsgMergeBatches) (*core.MsgMergeBatchesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	batches := make([]*api.Batch, len(req.BatchDenoms))
+	for i, denom := range req.BatchDenoms {
+		batch, err := k.stateStore.BatchTable().GetByDenom(ctx, denom)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", denom, err)
+		}
+
+		if err = k.assertCanRemapBatch(ctx, issuer, batch); err != nil {
+			return nil, err
+		}
+
+		if i > 0 {
+			prev := batches[i-1]
+			if batch.ProjectKey != prev.ProjectKey {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"batch_denoms[%d]: credit batch %s is not from the same project as credit batch %s",
+					i, denom, prev.Denom,
+				)
+			}
+			if !batch.StartDate.AsTime().Equal(prev.EndDate.AsTime()) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"batch_denoms[%d]: start date must equal the end date of the previous credit batch", i,
+				)
+			}
+		}
+
+		batches[i] = batch
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batches[0].ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSeqNo, err := k.getBatchSeqNo(ctx, project.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	startDate, endDate := batches[0].StartDate.AsTime(), batches[len(batches)-1].EndDate.AsTime()
+	batchDenom, err := core.FormatBatchDenom(project.Id, batchSeqNo, &startDate, &endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	// the new credit batch keeps the earliest issuance date of the credit batches
+	issuanceDate := batches[0].IssuanceDate
+	for _, batch := range batches[1:] {
+		if batch.IssuanceDate.AsTime().Before(issuanceDate.AsTime()) {
+			issuanceDate = batch.IssuanceDate
+		}
+	}
+
+	batchKey, err := k.stateStore.BatchTable().InsertReturningID(ctx, &api.Batch{
+		Issuer:       issuer,
+		ProjectKey:   project.Key,
+		Denom:        batchDenom,
+		Metadata:     req.Metadata,
+		StartDate:    timestamppb.New(startDate),
+		EndDate:      timestamppb.New(endDate),
+		IssuanceDate: issuanceDate,
+		Open:         true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// combine the balances in the order in which the accounts are first found
+	var holders []string
+	merged := make(map[string]*api.BatchBalance)
+	supply := []math.Dec{math.NewDecFromInt64(0), math.NewDecFromInt64(0), math.NewDecFromInt64(0)}
+	for _, batch := range batches {
+		balances, err := k.getConsentedBalances(ctx, batch, req.Holders)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, balance := range balances {
+			holder := sdk.AccAddress(balance.Address).String()
+			mergedBalance, ok := merged[holder]
+			if !ok {
+				mergedBalance = &api.BatchBalance{
+					BatchKey:       batchKey,
+					Address:        balance.Address,
+					TradableAmount: "0",
+					RetiredAmount:  "0",
+					EscrowedAmount: "0",
+				}
+				merged[holder] = mergedBalance
+				holders = append(holders, holder)
+			}
+
+			if mergedBalance.TradableAmount, err = addDecStrings(mergedBalance.TradableAmount, balance.TradableAmount); err != nil {
+				return nil, err
+			}
+			if mergedBalance.RetiredAmount, err = addDecStrings(mergedBalance.RetiredAmount, balance.RetiredAmount); err != nil {
+				return nil, err
+			}
+
+			sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgMergeBatches balance iteration")
+		}
+
+		batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+		for i, amount := range []string{batchSupply.TradableAmount, batchSupply.RetiredAmount, batchSupply.CancelledAmount} {
+			dec, err := math.NewDecFromString(amount)
+			if err != nil {
+				return nil, err
+			}
+			if supply[i], err = supply[i].Add(dec); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = k.stateStore.BatchLineageTable().Insert(ctx, &api.BatchLineage{
+			ParentBatchKey: batch.Key,
+			ChildBatchKey:  batchKey,
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = k.closeRemappedBatch(ctx, batch, balances); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, holder := range holders {
+		if err = k.stateStore.BatchBalanceTable().Insert(ctx, merged[holder]); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  supply[0].String(),
+		RetiredAmount:   supply[1].String(),
+		CancelledAmount: supply[2].String(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventMergeBatches{
+		BatchDenoms:   req.BatchDenoms,
+		NewBatchDenom: batchDenom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgMergeBatchesResponse{BatchDenom: batchDenom}, nil
+}
+
+// addDecStrings returns the sum of two decimal strings, treating empty strings as zero.
+func addDecStrings(a, b string) (string, error) {
+	x, err := math.NewDecFromString(a)
+	if err != nil {
+		return "", err
+	}
+	y, err := math.NewDecFromString(b)
+	if err != nil {
+		return "", err
+	}
+	sum, err := x.Add(y)
+	if err != nil {
+		return "", err
+	}
+	return sum.String(), nil
+}
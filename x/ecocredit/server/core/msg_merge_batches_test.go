@@ -0,0 +1,267 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type mergeBatches struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	classKey         uint64
+	holders          []string
+	res              *core.MsgMergeBatchesResponse
+	err              error
+}
+
+func TestMergeBatches(t *testing.T) {
+	gocuke.NewRunner(t, &mergeBatches{}).Path("./features/msg_merge_batches.feature").Run()
+}
+
+func (s *mergeBatches) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *mergeBatches) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+}
+
+func (s *mergeBatches) ACreditClassWithId(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *mergeBatches) AProjectWithId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mergeBatches) ACreditBatchWithDenomAndIssuerAlice(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, core.GetProjectIdFromBatchDenom(a))
+	require.NoError(s.t, err)
+
+	startDate, endDate, seq := parseBatchDenom(s.t, a)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey:   project.Key,
+		Issuer:       s.alice,
+		Denom:        a,
+		StartDate:    timestamppb.New(startDate),
+		EndDate:      timestamppb.New(endDate),
+		IssuanceDate: timestamppb.New(endDate),
+		Open:         true,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSequenceTable().Save(s.ctx, &api.BatchSequence{
+		ProjectKey:   project.Key,
+		NextSequence: seq + 1,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *mergeBatches) TheCreditBatchIsSealed(a string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	batch.Open = false
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *mergeBatches) TheCreditBatchHasACancelledAmountOf(a, b string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	supply, err := s.k.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+
+	supply.CancelledAmount = b
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *mergeBatches) AliceHasABalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.insertBalance(s.alice, a, b, c)
+}
+
+func (s *mergeBatches) BobHasABalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.insertBalance(s.bob, a, b, c)
+}
+
+func (s *mergeBatches) BobIsAHolder() {
+	s.holders = append(s.holders, s.bob.String())
+}
+
+func (s *mergeBatches) AliceAttemptsToMergeBatches(a string) {
+	s.res, s.err = s.k.MergeBatches(s.ctx, &core.MsgMergeBatches{
+		Issuer:      s.alice.String(),
+		BatchDenoms: strings.Fields(a),
+		Holders:     s.holders,
+	})
+}
+
+func (s *mergeBatches) BobAttemptsToMergeBatches(a string) {
+	s.res, s.err = s.k.MergeBatches(s.ctx, &core.MsgMergeBatches{
+		Issuer:      s.bob.String(),
+		BatchDenoms: strings.Fields(a),
+		Holders:     s.holders,
+	})
+}
+
+func (s *mergeBatches) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *mergeBatches) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *mergeBatches) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *mergeBatches) ExpectBatchSupplyOfWithTradableAmountRetiredAmountAndCancelledAmount(a, b, c, d string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, supply.TradableAmount)
+	require.Equal(s.t, c, supply.RetiredAmount)
+	require.Equal(s.t, d, supply.CancelledAmount)
+}
+
+func (s *mergeBatches) ExpectAliceBatchBalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.expectBalance(s.alice, a, b, c)
+}
+
+func (s *mergeBatches) ExpectBobBatchBalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.expectBalance(s.bob, a, b, c)
+}
+
+func (s *mergeBatches) ExpectCreditBatchIsSealedWithNoBalances(a string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.False(s.t, batch.Open)
+
+	it, err := s.stateStore.BatchBalanceTable().List(s.ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batch.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+	require.False(s.t, it.Next())
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, "0", supply.TradableAmount)
+	require.Equal(s.t, "0", supply.RetiredAmount)
+	require.Equal(s.t, "0", supply.CancelledAmount)
+}
+
+func (s *mergeBatches) ExpectBatchLineageFromTo(a, b string) {
+	parent, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	child, err := s.stateStore.BatchTable().GetByDenom(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchLineageTable().Has(s.ctx, parent.Key, child.Key)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *mergeBatches) ExpectEventMergeBatchesWithNewBatchDenom(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventMergeBatches{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventMergeBatches)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.NewBatchDenom)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventMergeBatches{}))
+}
+
+func (s *mergeBatches) ExpectTheResponse(a gocuke.DocString) {
+	var res core.MsgMergeBatchesResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *mergeBatches) insertBalance(address sdk.AccAddress, batchDenom, tradable, retired string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        address,
+		TradableAmount: tradable,
+		RetiredAmount:  retired,
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	supply, err := s.k.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+
+	supply.TradableAmount = addAmounts(s.t, supply.TradableAmount, tradable)
+	supply.RetiredAmount = addAmounts(s.t, supply.RetiredAmount, retired)
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *mergeBatches) expectBalance(address sdk.AccAddress, batchDenom, tradable, retired string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, address, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, tradable, balance.TradableAmount)
+	require.Equal(s.t, retired, balance.RetiredAmount)
+}
@@ -8,6 +8,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
@@ -33,6 +34,10 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		return nil, err
 	}
 
+	if err = assertProjectIssuable(project); err != nil {
+		return nil, err
+	}
+
 	if err = k.stateStore.OriginTxIndexTable().Insert(ctx, &api.OriginTxIndex{
 		ClassKey: project.ClassKey,
 		Id:       req.OriginTx.Id,
@@ -44,12 +49,25 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		return nil, err
 	}
 
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
 	ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
 	if err != nil {
 		return nil, err
 	}
 	precision := ct.Precision
+
+	// credit batches with a contract are bridged from another chain
+	bridged, err := k.stateStore.BatchContractTable().Has(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
 	moduleAddrString := k.moduleAddress.String()
+	bufferSupply := math.NewDecFromInt64(0)
 	for _, iss := range req.Issuance {
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 		recipient, err := sdk.AccAddressFromBech32(iss.Recipient)
@@ -87,11 +105,21 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 			if err != nil {
 				return nil, err
 			}
+			retirementId, err := RecordRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+				BatchKey:     batch.Key,
+				Amount:       iss.RetiredAmount,
+				Owner:        recipient,
+				Jurisdiction: iss.RetirementJurisdiction,
+			})
+			if err != nil {
+				return nil, err
+			}
 			if err := sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 				Owner:        iss.Recipient,
 				BatchDenom:   req.BatchDenom,
 				Amount:       iss.RetiredAmount,
 				Jurisdiction: iss.RetirementJurisdiction,
+				RetirementId: retirementId,
 			}); err != nil {
 				return nil, err
 			}
@@ -99,7 +127,21 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 			supply.RetiredAmount = supplyRetired.String()
 		}
 		if !tradable.IsZero() {
-			balanceTradable, err = balanceTradable.Add(tradable)
+			// withhold the buffer pool contribution of the credit class from the
+			// tradable amount received by the recipient
+			received, withheld, err := withholdBuffer(class, tradable, precision, bridged)
+			if err != nil {
+				return nil, err
+			}
+			receivedTradable := iss.TradableAmount
+			if !withheld.IsZero() {
+				receivedTradable = received.String()
+				bufferSupply, err = bufferSupply.Add(withheld)
+				if err != nil {
+					return nil, err
+				}
+			}
+			balanceTradable, err = balanceTradable.Add(received)
 			if err != nil {
 				return nil, err
 			}
@@ -111,7 +153,7 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 				Sender:         moduleAddrString, // ecocredit module
 				Recipient:      iss.Recipient,
 				BatchDenom:     req.BatchDenom,
-				TradableAmount: iss.TradableAmount,
+				TradableAmount: receivedTradable,
 				RetiredAmount:  iss.RetiredAmount,
 			}); err != nil {
 				return nil, err
@@ -135,6 +177,12 @@ func (k Keeper) MintBatchCredits(ctx context.Context, req *core.MsgMintBatchCred
 		}
 	}
 
+	if !bufferSupply.IsZero() {
+		if err := k.depositBuffer(ctx, class.Id, batch.Key, batch.Denom, bufferSupply); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventMintBatchCredits{
 		BatchDenom: batch.Denom,
 		OriginTx:   req.OriginTx,
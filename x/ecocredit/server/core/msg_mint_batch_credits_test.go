@@ -20,6 +20,7 @@ type mintBatchCredits struct {
 	alice            sdk.AccAddress
 	bob              sdk.AccAddress
 	creditTypeAbbrev string
+	classId          string
 	classKey         uint64
 	projectKey       uint64
 	batchDenom       string
@@ -66,9 +67,20 @@ func (s *mintBatchCredits) ACreditClassWithIdAndIssuerAlice(a string) {
 	})
 	require.NoError(s.t, err)
 
+	s.classId = a
 	s.classKey = cKey
 }
 
+func (s *mintBatchCredits) TheCreditClassHasBufferPercentage(a string) {
+	class, err := s.k.stateStore.ClassTable().Get(s.ctx, s.classKey)
+	require.NoError(s.t, err)
+
+	class.BufferPercentage = a
+
+	err = s.k.stateStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+}
+
 func (s *mintBatchCredits) AProjectWithId(a string) {
 	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
 		Id:       a,
@@ -79,6 +91,16 @@ func (s *mintBatchCredits) AProjectWithId(a string) {
 	s.projectKey = pKey
 }
 
+func (s *mintBatchCredits) TheProjectHasStatus(a string) {
+	project, err := s.k.stateStore.ProjectTable().Get(s.ctx, s.projectKey)
+	require.NoError(s.t, err)
+
+	project.Status = api.ProjectStatus(api.ProjectStatus_value[a])
+
+	err = s.k.stateStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
 func (s *mintBatchCredits) ACreditBatchWithDenomAndIssuerAlice(a string) {
 	projectId := core.GetProjectIdFromBatchDenom(a)
 
@@ -135,6 +157,18 @@ func (s *mintBatchCredits) ACreditBatchWithDenomOpenAndIssuerAlice(a, b string)
 	s.batchDenom = a
 }
 
+func (s *mintBatchCredits) TheCreditBatchHasContract(a string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchContractTable().Insert(s.ctx, &api.BatchContract{
+		BatchKey: batch.Key,
+		ClassKey: s.classKey,
+		Contract: a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *mintBatchCredits) AnOriginTxIndex(a gocuke.DocString) {
 	originTxIndex := &api.OriginTxIndex{}
 	err := jsonpb.UnmarshalString(a.Content, originTxIndex)
@@ -242,6 +276,31 @@ func (s *mintBatchCredits) ExpectBobBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *mintBatchCredits) ExpectBufferBatchBalance(a gocuke.DocString) {
+	var expected api.BatchBalance
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(s.classId), batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *mintBatchCredits) ExpectNoBufferBatchBalance() {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchBalanceTable().Has(s.ctx, core.BufferAddress(s.classId), batch.Key)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
 func (s *mintBatchCredits) ExpectBatchSupply(a gocuke.DocString) {
 	var expected api.BatchSupply
 	err := jsonpb.UnmarshalString(a.Content, &expected)
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProposeProjectTransfer proposes to transfer a project to another credit class with the same
+// credit type. Only the admin of the credit class of the project can propose a project transfer.
+// A new proposal replaces any existing proposal for the project.
+func (k Keeper) ProposeProjectTransfer(ctx context.Context, req *core.MsgProposeProjectTransfer) (*core.MsgProposeProjectTransferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.getProjectAndAssertClassAdmin(ctx, req.ProjectId, reqAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	newClass, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	if newClass.Key == project.ClassKey {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"project %s already belongs to credit class %s", req.ProjectId, req.ClassId,
+		)
+	}
+
+	if newClass.CreditTypeAbbrev != class.CreditTypeAbbrev {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot transfer project with credit type %s to credit class with credit type %s",
+			class.CreditTypeAbbrev, newClass.CreditTypeAbbrev,
+		)
+	}
+
+	if err = k.stateStore.ProjectTransferTable().Save(ctx, &api.ProjectTransfer{
+		ProjectKey: project.Key,
+		ClassKey:   newClass.Key,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventProposeProjectTransfer{
+		ProjectId: req.ProjectId,
+		ClassId:   req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgProposeProjectTransferResponse{}, nil
+}
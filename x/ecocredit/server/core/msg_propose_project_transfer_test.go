@@ -0,0 +1,134 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type proposeProjectTransfer struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *core.MsgProposeProjectTransferResponse
+	err   error
+}
+
+func TestProposeProjectTransfer(t *testing.T) {
+	gocuke.NewRunner(t, &proposeProjectTransfer{}).Path("./features/msg_propose_project_transfer.feature").Run()
+}
+
+func (s *proposeProjectTransfer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *proposeProjectTransfer) ACreditClassWithClassIdAndAdminAlice(a string) {
+	s.insertClass(a, s.alice)
+}
+
+func (s *proposeProjectTransfer) ACreditClassWithClassIdAndAdminBob(a string) {
+	s.insertClass(a, s.bob)
+}
+
+func (s *proposeProjectTransfer) AProjectWithProjectId(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, core.GetClassIdFromProjectId(a))
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeProjectTransfer) AProjectTransferOfProjectToCreditClass(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTransferTable().Insert(s.ctx, &api.ProjectTransfer{
+		ProjectKey: project.Key,
+		ClassKey:   class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *proposeProjectTransfer) AliceAttemptsToProposeProjectTransferWithProjectIdAndClassId(a, b string) {
+	s.res, s.err = s.k.ProposeProjectTransfer(s.ctx, &core.MsgProposeProjectTransfer{
+		Admin:     s.alice.String(),
+		ProjectId: a,
+		ClassId:   b,
+	})
+}
+
+func (s *proposeProjectTransfer) BobAttemptsToProposeProjectTransferWithProjectIdAndClassId(a, b string) {
+	s.res, s.err = s.k.ProposeProjectTransfer(s.ctx, &core.MsgProposeProjectTransfer{
+		Admin:     s.bob.String(),
+		ProjectId: a,
+		ClassId:   b,
+	})
+}
+
+func (s *proposeProjectTransfer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *proposeProjectTransfer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *proposeProjectTransfer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *proposeProjectTransfer) ExpectProjectTransferOfProjectToCreditClass(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	transfer, err := s.stateStore.ProjectTransferTable().Get(s.ctx, project.Key)
+	require.NoError(s.t, err)
+
+	class, err := s.stateStore.ClassTable().Get(s.ctx, transfer.ClassKey)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, class.Id)
+}
+
+func (s *proposeProjectTransfer) ExpectEventProposeProjectTransferWithProjectIdAndClassId(a, b string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventProposeProjectTransfer{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventProposeProjectTransfer)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.ProjectId)
+		require.Equal(s.t, b, event.ClassId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventProposeProjectTransfer{}))
+}
+
+func (s *proposeProjectTransfer) insertClass(classId string, admin sdk.AccAddress) {
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               classId,
+		Admin:            admin,
+		CreditTypeAbbrev: core.GetCreditTypeAbbrevFromClassId(classId),
+	})
+	require.NoError(s.t, err)
+}
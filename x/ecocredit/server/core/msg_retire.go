@@ -16,7 +16,8 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
-// Retire credits to the specified jurisdiction.
+// Retire credits to the specified jurisdiction on behalf of the beneficiary, recording the
+// retirement in state.
 // WARNING: retiring credits is permanent. Retired credits cannot be un-retired.
 func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetireResponse, error) {
 	sdkCtx := types.UnwrapSDKContext(ctx)
@@ -93,11 +94,26 @@ func (k Keeper) Retire(ctx context.Context, req *core.MsgRetire) (*core.MsgRetir
 			return nil, err
 		}
 
+		retirementId, err := RecordRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+			BatchKey:     batch.Key,
+			Amount:       amtToRetire.String(),
+			Owner:        owner,
+			Beneficiary:  req.Beneficiary,
+			Reason:       req.Reason,
+			Jurisdiction: req.Jurisdiction,
+		})
+		if err != nil {
+			return nil, err
+		}
+
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 			Owner:        req.Owner,
 			BatchDenom:   credit.BatchDenom,
 			Amount:       credit.Amount,
 			Jurisdiction: req.Jurisdiction,
+			RetirementId: retirementId,
+			Beneficiary:  req.Beneficiary,
+			Reason:       req.Reason,
 		}); err != nil {
 			return nil, err
 		}
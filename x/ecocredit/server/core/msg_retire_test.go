@@ -3,14 +3,18 @@ package core
 import (
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/regen-network/gocuke"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 )
 
@@ -184,6 +188,30 @@ func (s *retire) AliceAttemptsToRetireCreditsWithBatchDenom(a string) {
 	})
 }
 
+func (s *retire) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *retire) AliceAttemptsToRetireCreditAmountWithTheProperties(a string, b gocuke.DocString) {
+	msg := &core.MsgRetire{}
+	err := jsonpb.UnmarshalString(b.Content, msg)
+	require.NoError(s.t, err)
+
+	msg.Owner = s.alice.String()
+	msg.Credits = []*core.Credits{
+		{
+			BatchDenom: s.batchDenom,
+			Amount:     a,
+		},
+	}
+
+	s.res, s.err = s.k.Retire(s.ctx, msg)
+}
+
 func (s *retire) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -217,6 +245,46 @@ func (s *retire) ExpectBatchSupply(a gocuke.DocString) {
 	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
 }
 
+func (s *retire) ExpectRetirementWithIdAndTheProperties(a string, b gocuke.DocString) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expected := &core.RetirementInfo{}
+	err = jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	retirement, err := s.stateStore.RetirementTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.batchKey, retirement.BatchKey)
+	require.Equal(s.t, s.alice.Bytes(), retirement.Owner)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Timestamp, types.ProtobufToGogoTimestamp(retirement.Timestamp))
+}
+
+func (s *retire) ExpectEventRetireWithRetirementId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventRetire{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventRetire)
+		require.True(s.t, ok)
+		require.Equal(s.t, id, event.RetirementId)
+		return
+	}
+
+	require.Fail(s.t, "event retire not found")
+}
+
 func (s *retire) projectSetup() {
 	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
 		Abbreviation: s.creditTypeAbbrev,
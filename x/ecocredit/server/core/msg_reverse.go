@@ -0,0 +1,145 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Reverse cancels credits from the buffer pool of the class or from a holder of the
+// credits in response to a reversal event, removing them from the tradable supply and
+// adding them to the cancelled supply of the batch. Credits are reversed from the buffer
+// pool by the admin of the credit class and from a holder by the governance authority.
+func (k Keeper) Reverse(ctx context.Context, req *core.MsgReverse) (*core.MsgReverseResponse, error) {
+	sdkCtx := types.UnwrapSDKContext(ctx)
+	admin, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// holders have not consented to the cancellation of their credits, so holder
+	// credits can only be reversed through a governance proposal, which gives
+	// holders notice of the reversal and delays it until the end of the vote
+	if req.Holder != "" {
+		if !admin.Equals(k.authority) {
+			return nil, govtypes.ErrInvalidSigner.Wrapf(
+				"holder credits can only be reversed by the governance authority: expected %s, got %s",
+				k.authority, req.Admin,
+			)
+		}
+	} else if !admin.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, class.Id,
+		)
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	bufferAddr := core.BufferAddress(class.Id)
+	bufferBalance, err := utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), bufferAddr, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, balance := bufferAddr, bufferBalance
+	if req.Holder != "" {
+		holder, err := sdk.AccAddressFromBech32(req.Holder)
+		if err != nil {
+			return nil, err
+		}
+
+		// holder credits can only be reversed once the buffer pool no longer
+		// holds tradable credits from the batch
+		bufferTradable, err := math.NewDecFromString(bufferBalance.TradableAmount)
+		if err != nil {
+			return nil, err
+		}
+		if bufferTradable.IsPositive() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"buffer pool of credit class %s holds %s tradable credits from %s: holder credits "+
+					"can only be reversed once the buffer pool is exhausted", class.Id, bufferTradable, batch.Denom,
+			)
+		}
+
+		owner = holder
+		balance, err = utils.GetBalance(ctx, k.stateStore.BatchBalanceTable(), holder, batch.Key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	batchSupply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	decs, err := utils.GetNonNegativeFixedDecs(creditType.Precision, req.Amount, batchSupply.TradableAmount, balance.TradableAmount, batchSupply.CancelledAmount)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap(err.Error())
+	}
+	amount, supplyTradable, balanceTradable, supplyCancelled := decs[0], decs[1], decs[2], decs[3]
+
+	balanceTradable, err = math.SafeSubBalance(balanceTradable, amount)
+	if err != nil {
+		return nil, ecocredit.ErrInsufficientCredits.Wrapf(
+			"tradable balance: %s, reverse amount %s", decs[2], amount,
+		)
+	}
+	supplyTradable, err = math.SafeSubBalance(supplyTradable, amount)
+	if err != nil {
+		return nil, err
+	}
+	supplyCancelled, err = supplyCancelled.Add(amount)
+	if err != nil {
+		return nil, err
+	}
+
+	balance.TradableAmount = balanceTradable.String()
+	if err = k.stateStore.BatchBalanceTable().Update(ctx, balance); err != nil {
+		return nil, err
+	}
+
+	batchSupply.TradableAmount = supplyTradable.String()
+	batchSupply.CancelledAmount = supplyCancelled.String()
+	if err = k.stateStore.BatchSupplyTable().Update(ctx, batchSupply); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventReverse{
+		Owner:      owner.String(),
+		BatchDenom: batch.Denom,
+		Amount:     req.Amount,
+		Buffer:     req.Holder == "",
+		Reason:     req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgReverseResponse{}, nil
+}
@@ -0,0 +1,240 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type reverse struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	bob        sdk.AccAddress
+	classId    string
+	batchDenom string
+	batchKey   uint64
+	res        *core.MsgReverseResponse
+	err        error
+}
+
+func TestReverse(t *testing.T) {
+	gocuke.NewRunner(t, &reverse{}).Path("./features/msg_reverse.feature").Run()
+}
+
+func (s *reverse) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	s.classId = "C01"
+	s.batchDenom = "C01-001-20200101-20210101-001"
+}
+
+func (s *reverse) ACreditBatchWithClassAdminAlice() {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: "C",
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               s.classId,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	pKey, err := s.k.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: cKey,
+	})
+	require.NoError(s.t, err)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey: pKey,
+		Denom:      s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "100",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	s.batchKey = bKey
+}
+
+func (s *reverse) TheBufferPoolHasTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        core.BufferAddress(s.classId),
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reverse) BobHasTradableCreditAmount(a string) {
+	err := s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.bob,
+		TradableAmount: a,
+		RetiredAmount:  "0",
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reverse) TheProjectIsTransferredToCreditClassWithAdminAlice(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, "C01-001")
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTransferTable().Insert(s.ctx, &api.ProjectTransfer{
+		ProjectKey: project.Key,
+		ClassKey:   cKey,
+	})
+	require.NoError(s.t, err)
+
+	_, err = s.k.AcceptProjectTransfer(s.ctx, &core.MsgAcceptProjectTransfer{
+		Admin:     s.alice.String(),
+		ProjectId: project.Id,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *reverse) AliceAttemptsToReverseCreditsWithBatchDenom(a string) {
+	s.res, s.err = s.k.Reverse(s.ctx, &core.MsgReverse{
+		Admin:      s.alice.String(),
+		BatchDenom: a,
+		Amount:     "5",
+		Reason:     "forest fire",
+	})
+}
+
+func (s *reverse) AliceAttemptsToReverseCreditAmount(a string) {
+	s.res, s.err = s.k.Reverse(s.ctx, &core.MsgReverse{
+		Admin:      s.alice.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+		Reason:     "forest fire",
+	})
+}
+
+func (s *reverse) BobAttemptsToReverseCreditAmount(a string) {
+	s.res, s.err = s.k.Reverse(s.ctx, &core.MsgReverse{
+		Admin:      s.bob.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+		Reason:     "forest fire",
+	})
+}
+
+func (s *reverse) AliceAttemptsToReverseCreditAmountFromBob(a string) {
+	s.res, s.err = s.k.Reverse(s.ctx, &core.MsgReverse{
+		Admin:      s.alice.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+		Holder:     s.bob.String(),
+		Reason:     "forest fire",
+	})
+}
+
+func (s *reverse) TheAuthorityAttemptsToReverseCreditAmountFromBob(a string) {
+	s.res, s.err = s.k.Reverse(s.ctx, &core.MsgReverse{
+		Admin:      s.authority.String(),
+		BatchDenom: s.batchDenom,
+		Amount:     a,
+		Holder:     s.bob.String(),
+		Reason:     "forest fire",
+	})
+}
+
+func (s *reverse) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *reverse) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *reverse) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *reverse) ExpectBufferPoolTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(s.classId), s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *reverse) ExpectTheBufferPoolOfCreditClassHasTradableCreditAmount(a, b string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, core.BufferAddress(a), s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, balance.TradableAmount)
+}
+
+func (s *reverse) ExpectBobTradableCreditAmount(a string) {
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, s.bob, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *reverse) ExpectBatchSupply(a gocuke.DocString) {
+	expected := &api.BatchSupply{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, supply.TradableAmount)
+	require.Equal(s.t, expected.RetiredAmount, supply.RetiredAmount)
+	require.Equal(s.t, expected.CancelledAmount, supply.CancelledAmount)
+}
+
+func (s *reverse) ExpectEventReverseWithProperties(a gocuke.DocString) {
+	var expected core.EventReverse
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	// the owner of the buffer pool is derived from the credit class
+	expected.Owner = core.BufferAddress(s.classId).String()
+
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventReverse{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventReverse)
+		require.True(s.t, ok)
+		require.Equal(s.t, expected, *event)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventReverse{}))
+}
@@ -156,11 +156,21 @@ func (k Keeper) sendEcocredits(ctx context.Context, credit *core.MsgSend_SendCre
 		}); err != nil {
 			return err
 		}
+		retirementId, err := RecordRetirement(ctx, k.stateStore.RetirementTable(), &api.Retirement{
+			BatchKey:     batch.Key,
+			Amount:       sendAmtRetired.String(),
+			Owner:        to,
+			Jurisdiction: credit.RetirementJurisdiction,
+		})
+		if err != nil {
+			return err
+		}
 		if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventRetire{
 			Owner:        to.String(),
 			BatchDenom:   credit.BatchDenom,
 			Amount:       sendAmtRetired.String(),
 			Jurisdiction: credit.RetirementJurisdiction,
+			RetirementId: retirementId,
 		}); err != nil {
 			return err
 		}
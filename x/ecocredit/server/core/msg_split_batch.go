@@ -0,0 +1,215 @@
+package core
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SplitBatch splits an open credit batch into sub-vintage credit batches. The balances and
+// cancelled supply of the credit batch must be fully allocated to the sub-batches, the new
+// credit batches are linked to the credit batch in the batch lineage and the credit batch is
+// sealed with no remaining balances or supply.
+func (k Keeper) SplitBatch(ctx context.Context, req *core.MsgSplitBatch) (*core.MsgSplitBatchResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	issuer, err := sdk.AccAddressFromBech32(req.Issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err)
+	}
+
+	if err = k.assertCanRemapBatch(ctx, issuer, batch); err != nil {
+		return nil, err
+	}
+
+	firstSubBatch, lastSubBatch := req.SubBatches[0], req.SubBatches[len(req.SubBatches)-1]
+	if !firstSubBatch.StartDate.Equal(batch.StartDate.AsTime()) || !lastSubBatch.EndDate.Equal(batch.EndDate.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"sub batches must start and end on the dates of credit batch %s", batch.Denom,
+		)
+	}
+
+	balances, err := k.getConsentedBalances(ctx, batch, req.Holders)
+	if err != nil {
+		return nil, err
+	}
+
+	supply, err := k.stateStore.BatchSupplyTable().Get(ctx, batch.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.stateStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+	precision := creditType.Precision
+
+	// track the credits of the credit batch that have not yet been allocated
+	remaining := make(map[string][]math.Dec, len(balances))
+	for _, balance := range balances {
+		decs, err := utils.GetNonNegativeFixedDecs(precision, balance.TradableAmount, balance.RetiredAmount)
+		if err != nil {
+			return nil, err
+		}
+		remaining[sdk.AccAddress(balance.Address).String()] = decs
+	}
+	remainingCancelled, err := math.NewNonNegativeFixedDecFromString(supply.CancelledAmount, precision)
+	if err != nil {
+		return nil, err
+	}
+
+	batchDenoms := make([]string, len(req.SubBatches))
+	for i, subBatch := range req.SubBatches {
+		batchSeqNo, err := k.getBatchSeqNo(ctx, project.Key)
+		if err != nil {
+			return nil, err
+		}
+
+		batchDenom, err := core.FormatBatchDenom(project.Id, batchSeqNo, subBatch.StartDate, subBatch.EndDate)
+		if err != nil {
+			return nil, err
+		}
+
+		batchKey, err := k.stateStore.BatchTable().InsertReturningID(ctx, &api.Batch{
+			Issuer:       batch.Issuer,
+			ProjectKey:   batch.ProjectKey,
+			Denom:        batchDenom,
+			Metadata:     batch.Metadata,
+			StartDate:    timestamppb.New(*subBatch.StartDate),
+			EndDate:      timestamppb.New(*subBatch.EndDate),
+			IssuanceDate: batch.IssuanceDate,
+			Open:         true,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		tradableSupply, retiredSupply := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+		for j, balance := range subBatch.Balances {
+			decs, err := utils.GetNonNegativeFixedDecs(precision, balance.TradableAmount, balance.RetiredAmount)
+			if err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("sub_batches[%d]: balances[%d]: %s", i, j, err)
+			}
+
+			address, err := sdk.AccAddressFromBech32(balance.Address)
+			if err != nil {
+				return nil, err
+			}
+
+			// deduct the allocated credits from the credits remaining to be allocated
+			remainingDecs, ok := remaining[address.String()]
+			if !ok {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"sub_batches[%d]: balances[%d]: %s does not hold credits from credit batch %s",
+					i, j, balance.Address, batch.Denom,
+				)
+			}
+			for n := range decs {
+				remainingDecs[n], err = math.SafeSubBalance(remainingDecs[n], decs[n])
+				if err != nil {
+					return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+						"sub_batches[%d]: balances[%d]: credits allocated to %s exceed the balance of the account",
+						i, j, balance.Address,
+					)
+				}
+			}
+
+			if err = k.stateStore.BatchBalanceTable().Insert(ctx, &api.BatchBalance{
+				BatchKey:       batchKey,
+				Address:        address,
+				TradableAmount: decs[0].String(),
+				RetiredAmount:  decs[1].String(),
+				EscrowedAmount: "0",
+			}); err != nil {
+				return nil, err
+			}
+
+			if tradableSupply, err = tradableSupply.Add(decs[0]); err != nil {
+				return nil, err
+			}
+			if retiredSupply, err = retiredSupply.Add(decs[1]); err != nil {
+				return nil, err
+			}
+
+			sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgSplitBatch balance iteration")
+		}
+
+		cancelledSupply, err := math.NewNonNegativeFixedDecFromString(subBatch.CancelledAmount, precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("sub_batches[%d]: cancelled amount: %s", i, err)
+		}
+		remainingCancelled, err = math.SafeSubBalance(remainingCancelled, cancelledSupply)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"sub_batches[%d]: cancelled amount exceeds the cancelled supply of credit batch %s", i, batch.Denom,
+			)
+		}
+
+		if err = k.stateStore.BatchSupplyTable().Insert(ctx, &api.BatchSupply{
+			BatchKey:        batchKey,
+			TradableAmount:  tradableSupply.String(),
+			RetiredAmount:   retiredSupply.String(),
+			CancelledAmount: cancelledSupply.String(),
+		}); err != nil {
+			return nil, err
+		}
+
+		if err = k.stateStore.BatchLineageTable().Insert(ctx, &api.BatchLineage{
+			ParentBatchKey: batch.Key,
+			ChildBatchKey:  batchKey,
+		}); err != nil {
+			return nil, err
+		}
+
+		batchDenoms[i] = batchDenom
+	}
+
+	// all credits of the credit batch must be allocated to the sub-batches
+	for _, balance := range balances {
+		holder := sdk.AccAddress(balance.Address).String()
+		for _, dec := range remaining[holder] {
+			if !dec.IsZero() {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"credits held by %s are not fully allocated to the sub batches", holder,
+				)
+			}
+		}
+	}
+	if !remainingCancelled.IsZero() {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cancelled supply of credit batch %s is not fully allocated to the sub batches", batch.Denom,
+		)
+	}
+
+	if err = k.closeRemappedBatch(ctx, batch, balances); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventSplitBatch{
+		BatchDenom:     batch.Denom,
+		NewBatchDenoms: batchDenoms,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgSplitBatchResponse{BatchDenoms: batchDenoms}, nil
+}
@@ -0,0 +1,312 @@
+package core
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type splitBatch struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	classKey         uint64
+	holders          []string
+	res              *core.MsgSplitBatchResponse
+	err              error
+}
+
+func TestSplitBatch(t *testing.T) {
+	gocuke.NewRunner(t, &splitBatch{}).Path("./features/msg_split_batch.feature").Run()
+}
+
+func (s *splitBatch) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *splitBatch) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+
+	s.creditTypeAbbrev = a
+}
+
+func (s *splitBatch) ACreditClassWithId(a string) {
+	cKey, err := s.k.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               a,
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classKey = cKey
+}
+
+func (s *splitBatch) AProjectWithId(a string) {
+	err := s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: s.classKey,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *splitBatch) ACreditBatchWithDenomAndIssuerAlice(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, core.GetProjectIdFromBatchDenom(a))
+	require.NoError(s.t, err)
+
+	startDate, endDate, seq := parseBatchDenom(s.t, a)
+
+	bKey, err := s.k.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		ProjectKey:   project.Key,
+		Issuer:       s.alice,
+		Denom:        a,
+		StartDate:    timestamppb.New(startDate),
+		EndDate:      timestamppb.New(endDate),
+		IssuanceDate: timestamppb.New(endDate),
+		Open:         true,
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSupplyTable().Insert(s.ctx, &api.BatchSupply{
+		BatchKey:        bKey,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	})
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchSequenceTable().Save(s.ctx, &api.BatchSequence{
+		ProjectKey:   project.Key,
+		NextSequence: seq + 1,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *splitBatch) TheCreditBatchIsSealed(a string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	batch.Open = false
+	err = s.k.stateStore.BatchTable().Update(s.ctx, batch)
+	require.NoError(s.t, err)
+}
+
+func (s *splitBatch) TheCreditBatchHasACancelledAmountOf(a, b string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	supply, err := s.k.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+
+	supply.CancelledAmount = b
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *splitBatch) AliceHasABalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.insertBalance(s.alice, a, b, c, "0")
+}
+
+func (s *splitBatch) BobHasABalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.insertBalance(s.bob, a, b, c, "0")
+}
+
+func (s *splitBatch) AliceHasAnEscrowedBalanceOfWithAmount(a, b string) {
+	s.insertBalance(s.alice, a, "0", "0", b)
+}
+
+func (s *splitBatch) BobIsAHolder() {
+	s.holders = append(s.holders, s.bob.String())
+}
+
+func (s *splitBatch) AliceAttemptsToSplitBatchWithSubBatches(a string, b gocuke.DocString) {
+	s.splitBatch(s.alice, a, b)
+}
+
+func (s *splitBatch) BobAttemptsToSplitBatchWithSubBatches(a string, b gocuke.DocString) {
+	s.splitBatch(s.bob, a, b)
+}
+
+func (s *splitBatch) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *splitBatch) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *splitBatch) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *splitBatch) ExpectBatchSupplyOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, supply.TradableAmount)
+	require.Equal(s.t, c, supply.RetiredAmount)
+}
+
+func (s *splitBatch) ExpectAliceBatchBalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.expectBalance(s.alice, a, b, c)
+}
+
+func (s *splitBatch) ExpectBobBatchBalanceOfWithTradableAmountAndRetiredAmount(a, b, c string) {
+	s.expectBalance(s.bob, a, b, c)
+}
+
+func (s *splitBatch) ExpectCreditBatchIsSealedWithNoBalances(a string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+	require.False(s.t, batch.Open)
+
+	it, err := s.stateStore.BatchBalanceTable().List(s.ctx, api.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batch.Key))
+	require.NoError(s.t, err)
+	defer it.Close()
+	require.False(s.t, it.Next())
+
+	supply, err := s.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, "0", supply.TradableAmount)
+	require.Equal(s.t, "0", supply.RetiredAmount)
+	require.Equal(s.t, "0", supply.CancelledAmount)
+}
+
+func (s *splitBatch) ExpectBatchLineageFromTo(a, b string) {
+	parent, err := s.stateStore.BatchTable().GetByDenom(s.ctx, a)
+	require.NoError(s.t, err)
+
+	child, err := s.stateStore.BatchTable().GetByDenom(s.ctx, b)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.BatchLineageTable().Has(s.ctx, parent.Key, child.Key)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *splitBatch) ExpectEventSplitBatchWithNewBatchDenoms(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventSplitBatch{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventSplitBatch)
+		require.True(s.t, ok)
+		require.Equal(s.t, strings.Fields(a), event.NewBatchDenoms)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventSplitBatch{}))
+}
+
+func (s *splitBatch) ExpectTheResponse(a gocuke.DocString) {
+	var res core.MsgSplitBatchResponse
+	err := jsonpb.UnmarshalString(a.Content, &res)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, &res, s.res)
+}
+
+func (s *splitBatch) splitBatch(issuer sdk.AccAddress, batchDenom string, subBatches gocuke.DocString) {
+	// the addresses of the balances are written as account names in the feature file
+	content := strings.NewReplacer(
+		`"alice"`, strconv.Quote(s.alice.String()),
+		`"bob"`, strconv.Quote(s.bob.String()),
+	).Replace(subBatches.Content)
+
+	var msg core.MsgSplitBatch
+	err := jsonpb.UnmarshalString(`{"sub_batches": `+content+`}`, &msg)
+	require.NoError(s.t, err)
+
+	msg.Issuer = issuer.String()
+	msg.BatchDenom = batchDenom
+	msg.Holders = s.holders
+
+	s.res, s.err = s.k.SplitBatch(s.ctx, &msg)
+}
+
+func (s *splitBatch) insertBalance(address sdk.AccAddress, batchDenom, tradable, retired, escrowed string) {
+	batch, err := s.k.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.BatchBalanceTable().Insert(s.ctx, &api.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        address,
+		TradableAmount: tradable,
+		RetiredAmount:  retired,
+		EscrowedAmount: escrowed,
+	})
+	require.NoError(s.t, err)
+
+	supply, err := s.k.stateStore.BatchSupplyTable().Get(s.ctx, batch.Key)
+	require.NoError(s.t, err)
+
+	supply.TradableAmount = addAmounts(s.t, supply.TradableAmount, tradable, escrowed)
+	supply.RetiredAmount = addAmounts(s.t, supply.RetiredAmount, retired)
+	err = s.k.stateStore.BatchSupplyTable().Update(s.ctx, supply)
+	require.NoError(s.t, err)
+}
+
+func (s *splitBatch) expectBalance(address sdk.AccAddress, batchDenom, tradable, retired string) {
+	batch, err := s.stateStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.stateStore.BatchBalanceTable().Get(s.ctx, address, batch.Key)
+	require.NoError(s.t, err)
+	require.Equal(s.t, tradable, balance.TradableAmount)
+	require.Equal(s.t, retired, balance.RetiredAmount)
+}
+
+// parseBatchDenom returns the start date, end date and sequence number of a batch denom.
+func parseBatchDenom(t gocuke.TestingT, denom string) (time.Time, time.Time, uint64) {
+	parts := strings.Split(denom, "-")
+
+	startDate, err := time.Parse("20060102", parts[2])
+	require.NoError(t, err)
+
+	endDate, err := time.Parse("20060102", parts[3])
+	require.NoError(t, err)
+
+	seq, err := strconv.ParseUint(parts[4], 10, 64)
+	require.NoError(t, err)
+
+	return startDate, endDate, seq
+}
+
+// addAmounts returns the sum of the decimal amounts.
+func addAmounts(t gocuke.TestingT, amounts ...string) string {
+	sum := math.NewDecFromInt64(0)
+	for _, amount := range amounts {
+		dec, err := math.NewDecFromString(amount)
+		require.NoError(t, err)
+
+		sum, err = sum.Add(dec)
+		require.NoError(t, err)
+	}
+	return sum.String()
+}
@@ -0,0 +1,113 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateClassBuffer struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *core.MsgUpdateClassBufferResponse
+	err   error
+}
+
+func TestUpdateClassBuffer(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassBuffer{}).Path("./features/msg_update_class_buffer.feature").Run()
+}
+
+func (s *updateClassBuffer) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassBuffer) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassBuffer) AliceAttemptsToUpdateClassBufferWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:            s.alice.String(),
+		ClassId:          a,
+		BufferPercentage: "0.1",
+	})
+}
+
+func (s *updateClassBuffer) BobAttemptsToUpdateClassBufferWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:            s.bob.String(),
+		ClassId:          a,
+		BufferPercentage: "0.1",
+	})
+}
+
+func (s *updateClassBuffer) AliceAttemptsToUpdateClassBufferWithClassIdAndBufferPercentage(a, b string) {
+	s.res, s.err = s.k.UpdateClassBuffer(s.ctx, &core.MsgUpdateClassBuffer{
+		Admin:            s.alice.String(),
+		ClassId:          a,
+		BufferPercentage: b,
+	})
+}
+
+func (s *updateClassBuffer) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassBuffer) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassBuffer) ExpectCreditClassWithClassIdAndBufferPercentage(a, b string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, class.BufferPercentage)
+}
+
+func (s *updateClassBuffer) ExpectEventUpdateClassBufferWithClassId(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventUpdateClassBuffer{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventUpdateClassBuffer)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.ClassId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventUpdateClassBuffer{}))
+}
@@ -0,0 +1,55 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateClassVerifier updates the verifier of the class that is able to update the status
+// of projects within the class.
+func (k Keeper) UpdateClassVerifier(ctx context.Context, req *core.MsgUpdateClassVerifier) (*core.MsgUpdateClassVerifierResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	var verifier sdk.AccAddress
+	if req.Verifier != "" {
+		verifier, err = sdk.AccAddressFromBech32(req.Verifier)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	classInfo, err := k.stateStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get credit class with id %s: %s", req.ClassId, err,
+		)
+	}
+
+	admin := sdk.AccAddress(classInfo.Admin)
+	if !reqAddr.Equals(admin) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", req.Admin, req.ClassId,
+		)
+	}
+
+	classInfo.Verifier = verifier
+	if err = k.stateStore.ClassTable().Update(ctx, classInfo); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateClassVerifier{
+		ClassId: req.ClassId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateClassVerifierResponse{}, err
+}
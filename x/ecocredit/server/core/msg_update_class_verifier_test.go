@@ -0,0 +1,137 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateClassVerifier struct {
+	*baseSuite
+	alice sdk.AccAddress
+	bob   sdk.AccAddress
+	res   *core.MsgUpdateClassVerifierResponse
+	err   error
+}
+
+func TestUpdateClassVerifier(t *testing.T) {
+	gocuke.NewRunner(t, &updateClassVerifier{}).Path("./features/msg_update_class_verifier.feature").Run()
+}
+
+func (s *updateClassVerifier) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateClassVerifier) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassVerifier) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassVerifier) TheCreditClassWithClassIdHasVerifierBob(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	class.Verifier = s.bob
+
+	err = s.k.stateStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+}
+
+func (s *updateClassVerifier) AliceAttemptsToUpdateClassVerifierWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassVerifier(s.ctx, &core.MsgUpdateClassVerifier{
+		Admin:    s.alice.String(),
+		ClassId:  a,
+		Verifier: s.bob.String(),
+	})
+}
+
+func (s *updateClassVerifier) BobAttemptsToUpdateClassVerifierWithClassId(a string) {
+	s.res, s.err = s.k.UpdateClassVerifier(s.ctx, &core.MsgUpdateClassVerifier{
+		Admin:    s.bob.String(),
+		ClassId:  a,
+		Verifier: s.bob.String(),
+	})
+}
+
+func (s *updateClassVerifier) AliceAttemptsToUpdateClassVerifierWithClassIdAndVerifierBob(a string) {
+	s.res, s.err = s.k.UpdateClassVerifier(s.ctx, &core.MsgUpdateClassVerifier{
+		Admin:    s.alice.String(),
+		ClassId:  a,
+		Verifier: s.bob.String(),
+	})
+}
+
+func (s *updateClassVerifier) AliceAttemptsToUpdateClassVerifierWithClassIdAndNoVerifier(a string) {
+	s.res, s.err = s.k.UpdateClassVerifier(s.ctx, &core.MsgUpdateClassVerifier{
+		Admin:   s.alice.String(),
+		ClassId: a,
+	})
+}
+
+func (s *updateClassVerifier) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateClassVerifier) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateClassVerifier) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateClassVerifier) ExpectCreditClassWithClassIdAndVerifierBob(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.bob, sdk.AccAddress(class.Verifier))
+}
+
+func (s *updateClassVerifier) ExpectCreditClassWithClassIdAndNoVerifier(a string) {
+	class, err := s.stateStore.ClassTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Empty(s.t, class.Verifier)
+}
+
+func (s *updateClassVerifier) ExpectEventUpdateClassVerifierWithClassId(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventUpdateClassVerifier{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventUpdateClassVerifier)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.ClassId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventUpdateClassVerifier{}))
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateProjectIssuers updates a project's issuers by either adding more issuers, or removing issuers from the
+// project issuer store. Only the admin of the credit class of the project can update the project issuers.
+func (k Keeper) UpdateProjectIssuers(ctx context.Context, req *core.MsgUpdateProjectIssuers) (*core.MsgUpdateProjectIssuersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.getProjectAndAssertClassAdmin(ctx, req.ProjectId, reqAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// remove issuers
+	for _, issuer := range req.RemoveIssuers {
+		issuerAcc, err := sdk.AccAddressFromBech32(issuer)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.stateStore.ProjectIssuerTable().Delete(ctx, &api.ProjectIssuer{
+			ProjectKey: project.Key,
+			Issuer:     issuerAcc,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgUpdateProjectIssuers issuer iteration")
+	}
+
+	// add the new issuers
+	for _, issuer := range req.AddIssuers {
+		issuerAcc, err := sdk.AccAddressFromBech32(issuer)
+		if err != nil {
+			return nil, err
+		}
+		if err = k.stateStore.ProjectIssuerTable().Insert(ctx, &api.ProjectIssuer{
+			ProjectKey: project.Key,
+			Issuer:     issuerAcc,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgUpdateProjectIssuers issuer iteration")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateProjectIssuers{
+		ProjectId: req.ProjectId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateProjectIssuersResponse{}, nil
+}
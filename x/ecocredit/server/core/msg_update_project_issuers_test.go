@@ -0,0 +1,150 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateProjectIssuers struct {
+	*baseSuite
+	alice   sdk.AccAddress
+	bob     sdk.AccAddress
+	classId string
+	res     *core.MsgUpdateProjectIssuersResponse
+	err     error
+}
+
+func TestUpdateProjectIssuers(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectIssuers{}).Path("./features/msg_update_project_issuers.feature").Run()
+}
+
+func (s *updateProjectIssuers) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateProjectIssuers) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectIssuers) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classId = a
+}
+
+func (s *updateProjectIssuers) AProjectWithProjectId(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, s.classId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		Admin:    s.alice,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectIssuers) BobIsAnIssuerOfProject(a string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectIssuerTable().Insert(s.ctx, &api.ProjectIssuer{
+		ProjectKey: project.Key,
+		Issuer:     s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectIssuers) AliceAttemptsToAddBobAsAnIssuerOfProject(a string) {
+	s.res, s.err = s.k.UpdateProjectIssuers(s.ctx, &core.MsgUpdateProjectIssuers{
+		Admin:      s.alice.String(),
+		ProjectId:  a,
+		AddIssuers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectIssuers) BobAttemptsToAddBobAsAnIssuerOfProject(a string) {
+	s.res, s.err = s.k.UpdateProjectIssuers(s.ctx, &core.MsgUpdateProjectIssuers{
+		Admin:      s.bob.String(),
+		ProjectId:  a,
+		AddIssuers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectIssuers) AliceAttemptsToRemoveBobAsAnIssuerOfProject(a string) {
+	s.res, s.err = s.k.UpdateProjectIssuers(s.ctx, &core.MsgUpdateProjectIssuers{
+		Admin:         s.alice.String(),
+		ProjectId:     a,
+		RemoveIssuers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectIssuers) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectIssuers) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectIssuers) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectIssuers) ExpectBobIsAnIssuerOfProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ProjectIssuerTable().Has(s.ctx, project.Key, s.bob)
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *updateProjectIssuers) ExpectBobIsNotAnIssuerOfProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ProjectIssuerTable().Has(s.ctx, project.Key, s.bob)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *updateProjectIssuers) ExpectEventUpdateProjectIssuersWithProjectId(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventUpdateProjectIssuers{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventUpdateProjectIssuers)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.ProjectId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventUpdateProjectIssuers{}))
+}
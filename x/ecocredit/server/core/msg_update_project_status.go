@@ -0,0 +1,114 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// projectStatusTransitions maps each project status to the statuses a project can be updated
+// to. A project with an unspecified status was created before the introduction of project
+// status and is treated as registered.
+var projectStatusTransitions = map[api.ProjectStatus][]api.ProjectStatus{
+	api.ProjectStatus_PROJECT_STATUS_PROPOSED: {
+		api.ProjectStatus_PROJECT_STATUS_REGISTERED,
+	},
+	api.ProjectStatus_PROJECT_STATUS_REGISTERED: {
+		api.ProjectStatus_PROJECT_STATUS_VALIDATED,
+		api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		api.ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	api.ProjectStatus_PROJECT_STATUS_VALIDATED: {
+		api.ProjectStatus_PROJECT_STATUS_SUSPENDED,
+		api.ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+	api.ProjectStatus_PROJECT_STATUS_SUSPENDED: {
+		api.ProjectStatus_PROJECT_STATUS_REGISTERED,
+		api.ProjectStatus_PROJECT_STATUS_VALIDATED,
+		api.ProjectStatus_PROJECT_STATUS_COMPLETED,
+	},
+}
+
+// UpdateProjectStatus updates the lifecycle status of a project. Only the admin or the
+// verifier of the credit class of the project or a verifier of the project can update the
+// project status. A monitoring body of the project can only suspend the project.
+func (k Keeper) UpdateProjectStatus(ctx context.Context, req *core.MsgUpdateProjectStatus) (*core.MsgUpdateProjectStatusResponse, error) {
+	signer, err := sdk.AccAddressFromBech32(req.Signer)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get project with id %s: %s", req.ProjectId, err,
+		)
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	status := api.ProjectStatus(req.Status)
+
+	if !signer.Equals(sdk.AccAddress(class.Admin)) && !signer.Equals(sdk.AccAddress(class.Verifier)) {
+		projectVerifier, err := k.stateStore.ProjectVerifierTable().Get(ctx, project.Key, signer)
+		if err != nil && !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+
+		switch {
+		case projectVerifier == nil:
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is not the admin or verifier of credit class %s or a verifier of project %s",
+				req.Signer, class.Id, req.ProjectId,
+			)
+		case projectVerifier.Role == api.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_MONITORING_BODY &&
+			status != api.ProjectStatus_PROJECT_STATUS_SUSPENDED:
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s is a monitoring body of project %s and can only suspend the project", req.Signer, req.ProjectId,
+			)
+		}
+	}
+
+	current := project.Status
+	if current == api.ProjectStatus_PROJECT_STATUS_UNSPECIFIED {
+		current = api.ProjectStatus_PROJECT_STATUS_REGISTERED
+	}
+
+	if !isProjectStatusTransition(current, status) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"cannot update status of project %s from %s to %s", req.ProjectId, current, status,
+		)
+	}
+
+	project.Status = status
+	if err = k.stateStore.ProjectTable().Update(ctx, project); err != nil {
+		return nil, err
+	}
+
+	if err = sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventUpdateProjectStatus{
+		ProjectId: project.Id,
+		Status:    req.Status,
+		Reason:    req.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateProjectStatusResponse{}, nil
+}
+
+func isProjectStatusTransition(from, to api.ProjectStatus) bool {
+	for _, status := range projectStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateProjectStatus struct {
+	*baseSuite
+	alice   sdk.AccAddress
+	bob     sdk.AccAddress
+	charlie sdk.AccAddress
+	res     *core.MsgUpdateProjectStatusResponse
+	err     error
+}
+
+func TestUpdateProjectStatus(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectStatus{}).Path("./features/msg_update_project_status.feature").Run()
+}
+
+func (s *updateProjectStatus) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+	_, _, s.charlie = testdata.KeyTestPubAddr()
+}
+
+func (s *updateProjectStatus) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) ACreditClassWithClassIdAdminAliceAndVerifierBob(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+		Verifier:         s.bob,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AProjectWithProjectIdAndStatus(a, b string) {
+	classId := core.GetClassIdFromProjectId(a)
+
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, classId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		ClassKey: class.Key,
+		Status:   api.ProjectStatus(api.ProjectStatus_value[b]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) CharlieHasRoleWithinProject(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectVerifierTable().Insert(s.ctx, &api.ProjectVerifier{
+		ProjectKey: project.Key,
+		Address:    s.charlie,
+		Role:       api.ProjectVerifierRole(api.ProjectVerifierRole_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectStatus) AliceAttemptsToUpdateProjectStatusWithProjectIdAndStatus(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Signer:    s.alice.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+	})
+}
+
+func (s *updateProjectStatus) BobAttemptsToUpdateProjectStatusWithProjectIdAndStatus(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Signer:    s.bob.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+	})
+}
+
+func (s *updateProjectStatus) CharlieAttemptsToUpdateProjectStatusWithProjectIdAndStatus(a, b string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Signer:    s.charlie.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+	})
+}
+
+func (s *updateProjectStatus) BobAttemptsToUpdateProjectStatusWithProjectIdStatusAndReason(a, b, c string) {
+	s.res, s.err = s.k.UpdateProjectStatus(s.ctx, &core.MsgUpdateProjectStatus{
+		Signer:    s.bob.String(),
+		ProjectId: a,
+		Status:    core.ProjectStatus(core.ProjectStatus_value[b]),
+		Reason:    c,
+	})
+}
+
+func (s *updateProjectStatus) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectStatus) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectStatus) ExpectProjectWithProjectIdAndStatus(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, project.Status.String())
+}
+
+func (s *updateProjectStatus) ExpectEventUpdateProjectStatus(a gocuke.DocString) {
+	var expected core.EventUpdateProjectStatus
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventUpdateProjectStatus{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventUpdateProjectStatus)
+		require.True(s.t, ok)
+		require.Equal(s.t, expected, *event)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventUpdateProjectStatus{}))
+}
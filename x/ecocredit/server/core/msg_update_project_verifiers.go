@@ -0,0 +1,86 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// UpdateProjectVerifiers updates the verifiers or monitoring bodies of a project by either adding
+// or removing addresses with the given role. An address can only have one role within a project.
+// Only the admin of the credit class of the project can update the project verifiers.
+func (k Keeper) UpdateProjectVerifiers(ctx context.Context, req *core.MsgUpdateProjectVerifiers) (*core.MsgUpdateProjectVerifiersResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	reqAddr, err := sdk.AccAddressFromBech32(req.Admin)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.getProjectAndAssertClassAdmin(ctx, req.ProjectId, reqAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	role := api.ProjectVerifierRole(req.Role)
+
+	// remove verifiers
+	for _, verifier := range req.RemoveVerifiers {
+		verifierAcc, err := sdk.AccAddressFromBech32(verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		projectVerifier, err := k.stateStore.ProjectVerifierTable().Get(ctx, project.Key, verifierAcc)
+		if err != nil && !ormerrors.NotFound.Is(err) {
+			return nil, err
+		}
+		if projectVerifier == nil || projectVerifier.Role != role {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s does not have role %s within project %s", verifier, role, req.ProjectId,
+			)
+		}
+
+		if err = k.stateStore.ProjectVerifierTable().Delete(ctx, projectVerifier); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgUpdateProjectVerifiers verifier iteration")
+	}
+
+	// add the new verifiers
+	for _, verifier := range req.AddVerifiers {
+		verifierAcc, err := sdk.AccAddressFromBech32(verifier)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.stateStore.ProjectVerifierTable().Insert(ctx, &api.ProjectVerifier{
+			ProjectKey: project.Key,
+			Address:    verifierAcc,
+			Role:       role,
+		}); err != nil {
+			if ormerrors.PrimaryKeyConstraintViolation.Is(err) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s already has a role within project %s", verifier, req.ProjectId,
+				)
+			}
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgUpdateProjectVerifiers verifier iteration")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventUpdateProjectVerifiers{
+		ProjectId: req.ProjectId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &core.MsgUpdateProjectVerifiersResponse{}, nil
+}
@@ -0,0 +1,154 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+type updateProjectVerifiers struct {
+	*baseSuite
+	alice   sdk.AccAddress
+	bob     sdk.AccAddress
+	classId string
+	res     *core.MsgUpdateProjectVerifiersResponse
+	err     error
+}
+
+func TestUpdateProjectVerifiers(t *testing.T) {
+	gocuke.NewRunner(t, &updateProjectVerifiers{}).Path("./features/msg_update_project_verifiers.feature").Run()
+}
+
+func (s *updateProjectVerifiers) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t)
+	s.alice = s.addr
+	s.bob = s.addr2
+}
+
+func (s *updateProjectVerifiers) ACreditTypeWithAbbreviation(a string) {
+	err := s.k.stateStore.CreditTypeTable().Insert(s.ctx, &api.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectVerifiers) ACreditClassWithClassIdAndAdminAlice(a string) {
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(a)
+
+	err := s.k.stateStore.ClassTable().Insert(s.ctx, &api.Class{
+		Id:               a,
+		Admin:            s.alice,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	s.classId = a
+}
+
+func (s *updateProjectVerifiers) AProjectWithProjectId(a string) {
+	class, err := s.k.stateStore.ClassTable().GetById(s.ctx, s.classId)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       a,
+		Admin:    s.alice,
+		ClassKey: class.Key,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectVerifiers) BobHasRoleWithinProject(a, b string) {
+	project, err := s.k.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.k.stateStore.ProjectVerifierTable().Insert(s.ctx, &api.ProjectVerifier{
+		ProjectKey: project.Key,
+		Address:    s.bob,
+		Role:       api.ProjectVerifierRole(api.ProjectVerifierRole_value[a]),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *updateProjectVerifiers) AliceAttemptsToAddBobWithRoleToProject(a, b string) {
+	s.res, s.err = s.k.UpdateProjectVerifiers(s.ctx, &core.MsgUpdateProjectVerifiers{
+		Admin:        s.alice.String(),
+		ProjectId:    b,
+		Role:         core.ProjectVerifierRole(core.ProjectVerifierRole_value[a]),
+		AddVerifiers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectVerifiers) BobAttemptsToAddBobWithRoleToProject(a, b string) {
+	s.res, s.err = s.k.UpdateProjectVerifiers(s.ctx, &core.MsgUpdateProjectVerifiers{
+		Admin:        s.bob.String(),
+		ProjectId:    b,
+		Role:         core.ProjectVerifierRole(core.ProjectVerifierRole_value[a]),
+		AddVerifiers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectVerifiers) AliceAttemptsToRemoveBobWithRoleFromProject(a, b string) {
+	s.res, s.err = s.k.UpdateProjectVerifiers(s.ctx, &core.MsgUpdateProjectVerifiers{
+		Admin:           s.alice.String(),
+		ProjectId:       b,
+		Role:            core.ProjectVerifierRole(core.ProjectVerifierRole_value[a]),
+		RemoveVerifiers: []string{s.bob.String()},
+	})
+}
+
+func (s *updateProjectVerifiers) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *updateProjectVerifiers) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *updateProjectVerifiers) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *updateProjectVerifiers) ExpectBobHasRoleWithinProject(a, b string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, b)
+	require.NoError(s.t, err)
+
+	projectVerifier, err := s.stateStore.ProjectVerifierTable().Get(s.ctx, project.Key, s.bob)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, projectVerifier.Role.String())
+}
+
+func (s *updateProjectVerifiers) ExpectBobHasNoRoleWithinProject(a string) {
+	project, err := s.stateStore.ProjectTable().GetById(s.ctx, a)
+	require.NoError(s.t, err)
+
+	found, err := s.stateStore.ProjectVerifierTable().Has(s.ctx, project.Key, s.bob)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *updateProjectVerifiers) ExpectEventUpdateProjectVerifiersWithProjectId(a string) {
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(&core.EventUpdateProjectVerifiers{}) {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*core.EventUpdateProjectVerifiers)
+		require.True(s.t, ok)
+		require.Equal(s.t, a, event.ProjectId)
+		return
+	}
+
+	s.t.Fatalf("expected event %s", proto.MessageName(&core.EventUpdateProjectVerifiers{}))
+}
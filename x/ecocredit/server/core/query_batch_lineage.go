@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// BatchLineage queries the credit batches that the given credit batch was split or merged from
+// and the credit batches that were created from the given credit batch.
+func (k Keeper) BatchLineage(ctx context.Context, request *core.QueryBatchLineageRequest) (*core.QueryBatchLineageResponse, error) {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", request.BatchDenom, err.Error())
+	}
+
+	parentIt, err := k.stateStore.BatchLineageTable().List(ctx, api.BatchLineageChildBatchKeyIndexKey{}.WithChildBatchKey(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer parentIt.Close()
+
+	parents := make([]string, 0)
+	for parentIt.Next() {
+		lineage, err := parentIt.Value()
+		if err != nil {
+			return nil, err
+		}
+		parent, err := k.stateStore.BatchTable().Get(ctx, lineage.ParentBatchKey)
+		if err != nil {
+			return nil, err
+		}
+		parents = append(parents, parent.Denom)
+	}
+
+	childIt, err := k.stateStore.BatchLineageTable().List(ctx, api.BatchLineageParentBatchKeyChildBatchKeyIndexKey{}.WithParentBatchKey(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer childIt.Close()
+
+	children := make([]string, 0)
+	for childIt.Next() {
+		lineage, err := childIt.Value()
+		if err != nil {
+			return nil, err
+		}
+		child, err := k.stateStore.BatchTable().Get(ctx, lineage.ChildBatchKey)
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, child.Denom)
+	}
+
+	return &core.QueryBatchLineageResponse{
+		ParentBatchDenoms: parents,
+		ChildBatchDenoms:  children,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_BatchLineage(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	parentKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20220101-001",
+	})
+	assert.NilError(t, err)
+
+	child1Key, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	child2Key, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20210101-20220101-003",
+	})
+	assert.NilError(t, err)
+
+	// insert batch lineage
+	for _, childKey := range []uint64{child1Key, child2Key} {
+		err = s.stateStore.BatchLineageTable().Insert(s.ctx, &api.BatchLineage{
+			ParentBatchKey: parentKey,
+			ChildBatchKey:  childKey,
+		})
+		assert.NilError(t, err)
+	}
+
+	// query batch lineage of the split credit batch
+	res, err := s.k.BatchLineage(s.ctx, &core.QueryBatchLineageRequest{BatchDenom: "C01-001-20200101-20220101-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.ParentBatchDenoms))
+	assert.DeepEqual(t, []string{"C01-001-20200101-20210101-002", "C01-001-20210101-20220101-003"}, res.ChildBatchDenoms)
+
+	// query batch lineage of a sub-vintage credit batch
+	res, err = s.k.BatchLineage(s.ctx, &core.QueryBatchLineageRequest{BatchDenom: "C01-001-20210101-20220101-003"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"C01-001-20200101-20220101-001"}, res.ParentBatchDenoms)
+	assert.Equal(t, 0, len(res.ChildBatchDenoms))
+
+	// query batch lineage by unknown batch denom
+	_, err = s.k.BatchLineage(s.ctx, &core.QueryBatchLineageRequest{BatchDenom: "C01-001-20200101-20220101-004"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -20,7 +20,7 @@ func (k Keeper) BatchesByProject(ctx context.Context, request *core.QueryBatches
 		return nil, err
 	}
 
-	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	project, err := k.getProjectByIdOrAlias(ctx, request.ProjectId)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
 	}
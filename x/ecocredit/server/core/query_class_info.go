@@ -23,6 +23,9 @@ func (k Keeper) Class(ctx context.Context, request *core.QueryClassRequest) (*co
 		Admin:            admin.String(),
 		Metadata:         class.Metadata,
 		CreditTypeAbbrev: class.CreditTypeAbbrev,
+		BufferPercentage: class.BufferPercentage,
+		BufferAddress:    core.BufferAddress(class.Id).String(),
+		Verifier:         sdk.AccAddress(class.Verifier).String(),
 	}
 
 	return &core.QueryClassResponse{Class: &info}, nil
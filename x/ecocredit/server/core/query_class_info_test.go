@@ -20,6 +20,7 @@ func TestQuery_ClassInfo(t *testing.T) {
 		Admin:            s.addr,
 		Metadata:         "data",
 		CreditTypeAbbrev: "C",
+		Verifier:         s.addr2,
 	}
 
 	err := s.stateStore.ClassTable().Insert(s.ctx, class)
@@ -32,6 +33,7 @@ func TestQuery_ClassInfo(t *testing.T) {
 	assert.Equal(t, s.addr.String(), res.Class.Admin)
 	assert.Equal(t, class.Metadata, res.Class.Metadata)
 	assert.Equal(t, class.CreditTypeAbbrev, res.Class.CreditTypeAbbrev)
+	assert.Equal(t, s.addr2.String(), res.Class.Verifier)
 
 	// query class by an unknown class id
 	_, err = s.k.Class(s.ctx, &core.QueryClassRequest{ClassId: "C02"})
@@ -38,6 +38,9 @@ func (k Keeper) Classes(ctx context.Context, request *core.QueryClassesRequest)
 			Admin:            admin.String(),
 			Metadata:         class.Metadata,
 			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			BufferPercentage: class.BufferPercentage,
+			BufferAddress:    core.BufferAddress(class.Id).String(),
+			Verifier:         sdk.AccAddress(class.Verifier).String(),
 		}
 
 		classes = append(classes, &info)
@@ -43,6 +43,9 @@ func (k Keeper) ClassesByAdmin(ctx context.Context, req *core.QueryClassesByAdmi
 			Admin:            adminString,
 			Metadata:         class.Metadata,
 			CreditTypeAbbrev: class.CreditTypeAbbrev,
+			BufferPercentage: class.BufferPercentage,
+			BufferAddress:    core.BufferAddress(class.Id).String(),
+			Verifier:         sdk.AccAddress(class.Verifier).String(),
 		}
 
 		classes = append(classes, &info)
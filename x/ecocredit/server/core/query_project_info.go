@@ -11,7 +11,7 @@ import (
 
 // Project queries project info from the given project name.
 func (k Keeper) Project(ctx context.Context, request *core.QueryProjectRequest) (*core.QueryProjectResponse, error) {
-	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	project, err := k.getProjectByIdOrAlias(ctx, request.ProjectId)
 	if err != nil {
 		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
 	}
@@ -30,6 +30,7 @@ func (k Keeper) Project(ctx context.Context, request *core.QueryProjectRequest)
 		Jurisdiction: project.Jurisdiction,
 		Metadata:     project.Metadata,
 		ReferenceId:  project.ReferenceId,
+		Status:       core.ProjectStatus(project.Status),
 	}
 
 	return &core.QueryProjectResponse{Project: &info}, nil
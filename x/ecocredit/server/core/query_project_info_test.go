@@ -27,6 +27,7 @@ func TestQuery_Project(t *testing.T) {
 		Jurisdiction: "US-CA",
 		Metadata:     "data",
 		ReferenceId:  "R01",
+		Status:       api.ProjectStatus_PROJECT_STATUS_VALIDATED,
 	}
 
 	// insert project
@@ -41,6 +42,19 @@ func TestQuery_Project(t *testing.T) {
 	assert.Equal(t, project.Jurisdiction, res.Project.Jurisdiction)
 	assert.Equal(t, project.Metadata, res.Project.Metadata)
 	assert.Equal(t, project.ReferenceId, res.Project.ReferenceId)
+	assert.Equal(t, core.ProjectStatus_PROJECT_STATUS_VALIDATED, res.Project.Status)
+
+	// insert alias of previous project id
+	err = s.stateStore.ProjectAliasTable().Insert(s.ctx, &api.ProjectAlias{
+		Alias:      "C02-001",
+		ProjectKey: 1,
+	})
+	assert.NilError(t, err)
+
+	// query project by "C02-001" previous project id
+	res, err = s.k.Project(s.ctx, &core.QueryProjectRequest{ProjectId: "C02-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, project.Id, res.Project.Id)
 
 	// query project by unknown project id
 	_, err = s.k.Project(s.ctx, &core.QueryProjectRequest{ProjectId: "F01"})
@@ -0,0 +1,49 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectIssuers returns a list of addresses that are allowed to issue credits under the given project.
+func (k Keeper) ProjectIssuers(ctx context.Context, request *core.QueryProjectIssuersRequest) (*core.QueryProjectIssuersResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ProjectIssuerTable().List(ctx, api.ProjectIssuerProjectKeyIssuerIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	issuers := make([]string, 0)
+	for it.Next() {
+		val, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		issuers = append(issuers, sdk.AccAddress(val.Issuer).String())
+	}
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+	return &core.QueryProjectIssuersResponse{
+		Issuers:    issuers,
+		Pagination: pr,
+	}, nil
+}
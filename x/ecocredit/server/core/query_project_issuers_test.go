@@ -0,0 +1,57 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ProjectIssuers(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// make a project with 2 issuers.
+	addrs := genAddrs(1)
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &ecocreditv1.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &ecocreditv1.Project{
+		Id:       "C01-001",
+		Admin:    s.addr,
+		ClassKey: 1,
+	}))
+	assert.NilError(t, s.stateStore.ProjectIssuerTable().Insert(s.ctx, &ecocreditv1.ProjectIssuer{
+		ProjectKey: 1,
+		Issuer:     s.addr,
+	}))
+	assert.NilError(t, s.stateStore.ProjectIssuerTable().Insert(s.ctx, &ecocreditv1.ProjectIssuer{
+		ProjectKey: 1,
+		Issuer:     addrs[0],
+	}))
+
+	// base request
+	res, err := s.k.ProjectIssuers(s.ctx, &core.QueryProjectIssuersRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Issuers))
+
+	// bad request
+	_, err = s.k.ProjectIssuers(s.ctx, &core.QueryProjectIssuersRequest{ProjectId: "C01-002"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// paginated request
+	res, err = s.k.ProjectIssuers(s.ctx, &core.QueryProjectIssuersRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Issuers))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectTransfer queries the proposed transfer of the given project.
+func (k Keeper) ProjectTransfer(ctx context.Context, request *core.QueryProjectTransferRequest) (*core.QueryProjectTransferResponse, error) {
+	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
+	}
+
+	transfer, err := k.stateStore.ProjectTransferTable().Get(ctx, project.Key)
+	if err != nil {
+		if ormerrors.NotFound.Is(err) {
+			return nil, sdkerrors.ErrNotFound.Wrapf("project %s does not have a proposed transfer", request.ProjectId)
+		}
+		return nil, err
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, transfer.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryProjectTransferResponse{
+		ProjectId: project.Id,
+		ClassId:   class.Id,
+	}, nil
+}
@@ -0,0 +1,65 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ProjectTransfer(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert classes
+	classKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+
+	newClassKey, err := s.stateStore.ClassTable().InsertReturningID(s.ctx, &api.Class{
+		Id:               "C02",
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(t, err)
+
+	// insert projects
+	projectKey, err := s.stateStore.ProjectTable().InsertReturningID(s.ctx, &api.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+	})
+	assert.NilError(t, err)
+
+	err = s.stateStore.ProjectTable().Insert(s.ctx, &api.Project{
+		Id:       "C01-002",
+		ClassKey: classKey,
+	})
+	assert.NilError(t, err)
+
+	// insert project transfer
+	err = s.stateStore.ProjectTransferTable().Insert(s.ctx, &api.ProjectTransfer{
+		ProjectKey: projectKey,
+		ClassKey:   newClassKey,
+	})
+	assert.NilError(t, err)
+
+	// query project transfer by "C01-001" project id
+	res, err := s.k.ProjectTransfer(s.ctx, &core.QueryProjectTransferRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, "C01-001", res.ProjectId)
+	assert.Equal(t, "C02", res.ClassId)
+
+	// query project transfer of project without proposed transfer
+	_, err = s.k.ProjectTransfer(s.ctx, &core.QueryProjectTransferRequest{ProjectId: "C01-002"})
+	assert.ErrorContains(t, err, sdkerrors.ErrNotFound.Error())
+
+	// query project transfer by unknown project id
+	_, err = s.k.ProjectTransfer(s.ctx, &core.QueryProjectTransferRequest{ProjectId: "C01-003"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,52 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// ProjectVerifiers returns a list of the verifiers and monitoring bodies of the given project.
+func (k Keeper) ProjectVerifiers(ctx context.Context, request *core.QueryProjectVerifiersRequest) (*core.QueryProjectVerifiersResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := k.stateStore.ProjectTable().GetById(ctx, request.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project with id %s: %s", request.ProjectId, err.Error())
+	}
+
+	it, err := k.stateStore.ProjectVerifierTable().List(ctx, api.ProjectVerifierProjectKeyAddressIndexKey{}.WithProjectKey(project.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	verifiers := make([]*core.ProjectVerifierInfo, 0)
+	for it.Next() {
+		val, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		verifiers = append(verifiers, &core.ProjectVerifierInfo{
+			Address: sdk.AccAddress(val.Address).String(),
+			Role:    core.ProjectVerifierRole(val.Role),
+		})
+	}
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+	return &core.QueryProjectVerifiersResponse{
+		Verifiers:  verifiers,
+		Pagination: pr,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ecocreditv1 "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_ProjectVerifiers(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// make a project with a verifier and a monitoring body.
+	assert.NilError(t, s.stateStore.ClassTable().Insert(s.ctx, &ecocreditv1.Class{
+		Id:               "C01",
+		Admin:            s.addr,
+		CreditTypeAbbrev: "C",
+	}))
+	assert.NilError(t, s.stateStore.ProjectTable().Insert(s.ctx, &ecocreditv1.Project{
+		Id:       "C01-001",
+		Admin:    s.addr,
+		ClassKey: 1,
+	}))
+	assert.NilError(t, s.stateStore.ProjectVerifierTable().Insert(s.ctx, &ecocreditv1.ProjectVerifier{
+		ProjectKey: 1,
+		Address:    s.addr,
+		Role:       ecocreditv1.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_VERIFIER,
+	}))
+	assert.NilError(t, s.stateStore.ProjectVerifierTable().Insert(s.ctx, &ecocreditv1.ProjectVerifier{
+		ProjectKey: 1,
+		Address:    s.addr2,
+		Role:       ecocreditv1.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_MONITORING_BODY,
+	}))
+
+	// base request
+	res, err := s.k.ProjectVerifiers(s.ctx, &core.QueryProjectVerifiersRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Verifiers))
+	for _, verifier := range res.Verifiers {
+		if verifier.Address == s.addr.String() {
+			assert.Equal(t, core.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_VERIFIER, verifier.Role)
+		} else {
+			assert.Equal(t, s.addr2.String(), verifier.Address)
+			assert.Equal(t, core.ProjectVerifierRole_PROJECT_VERIFIER_ROLE_MONITORING_BODY, verifier.Role)
+		}
+	}
+
+	// bad request
+	_, err = s.k.ProjectVerifiers(s.ctx, &core.QueryProjectVerifiersRequest{ProjectId: "C01-002"})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	// paginated request
+	res, err = s.k.ProjectVerifiers(s.ctx, &core.QueryProjectVerifiersRequest{
+		ProjectId:  "C01-001",
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Verifiers))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
@@ -45,6 +45,7 @@ func (k Keeper) Projects(ctx context.Context, request *core.QueryProjectsRequest
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       core.ProjectStatus(project.Status),
 		}
 
 		projects = append(projects, &info)
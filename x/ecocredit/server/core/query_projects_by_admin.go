@@ -47,6 +47,7 @@ func (k Keeper) ProjectsByAdmin(ctx context.Context, req *core.QueryProjectsByAd
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       core.ProjectStatus(project.Status),
 		})
 	}
 
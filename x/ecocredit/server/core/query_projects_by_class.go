@@ -51,6 +51,7 @@ func (k Keeper) ProjectsByClass(ctx context.Context, request *core.QueryProjects
 			Jurisdiction: project.Jurisdiction,
 			Metadata:     project.Metadata,
 			ReferenceId:  project.ReferenceId,
+			Status:       core.ProjectStatus(project.Status),
 		}
 
 		projects = append(projects, &info)
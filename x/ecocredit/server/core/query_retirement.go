@@ -0,0 +1,41 @@
+package core
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// Retirement queries a retirement of credits by its unique identifier.
+func (k Keeper) Retirement(ctx context.Context, request *core.QueryRetirementRequest) (*core.QueryRetirementResponse, error) {
+	retirement, err := k.stateStore.RetirementTable().Get(ctx, request.Id)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get retirement with id %d: %s", request.Id, err.Error())
+	}
+
+	batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementResponse{Retirement: retirementInfo(retirement, batch.Denom)}, nil
+}
+
+// retirementInfo converts a retirement in state to the retirement info returned by queries.
+func retirementInfo(retirement *api.Retirement, batchDenom string) *core.RetirementInfo {
+	return &core.RetirementInfo{
+		Id:           retirement.Id,
+		BatchDenom:   batchDenom,
+		Amount:       retirement.Amount,
+		Owner:        sdk.AccAddress(retirement.Owner).String(),
+		Beneficiary:  retirement.Beneficiary,
+		Reason:       retirement.Reason,
+		Jurisdiction: retirement.Jurisdiction,
+		Timestamp:    types.ProtobufToGogoTimestamp(retirement.Timestamp),
+	}
+}
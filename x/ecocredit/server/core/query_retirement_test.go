@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_Retirement(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batch
+	bKey, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+
+	retirement := &api.Retirement{
+		BatchKey:     bKey,
+		Amount:       "10",
+		Owner:        s.addr,
+		Beneficiary:  "Acme Corporation",
+		Reason:       "offsetting emissions",
+		Jurisdiction: "US-WA",
+		Timestamp:    timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+	}
+
+	// insert retirement
+	id, err := s.stateStore.RetirementTable().InsertReturningID(s.ctx, retirement)
+	assert.NilError(t, err)
+
+	// query retirement by id
+	res, err := s.k.Retirement(s.ctx, &core.QueryRetirementRequest{Id: id})
+	assert.NilError(t, err)
+	assert.Equal(t, id, res.Retirement.Id)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.Retirement.BatchDenom)
+	assert.Equal(t, retirement.Amount, res.Retirement.Amount)
+	assert.Equal(t, s.addr.String(), res.Retirement.Owner)
+	assert.Equal(t, retirement.Beneficiary, res.Retirement.Beneficiary)
+	assert.Equal(t, retirement.Reason, res.Retirement.Reason)
+	assert.Equal(t, retirement.Jurisdiction, res.Retirement.Jurisdiction)
+	assert.DeepEqual(t, types.ProtobufToGogoTimestamp(retirement.Timestamp), res.Retirement.Timestamp)
+
+	// query retirement by unknown id
+	_, err = s.k.Retirement(s.ctx, &core.QueryRetirementRequest{Id: 2})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
@@ -0,0 +1,48 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RetirementsByBatch queries all retirements of credits within the given credit batch.
+func (k Keeper) RetirementsByBatch(ctx context.Context, request *core.QueryRetirementsByBatchRequest) (*core.QueryRetirementsByBatchResponse, error) {
+	batch, err := k.stateStore.BatchTable().GetByDenom(ctx, request.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", request.BatchDenom, err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	retirements := make([]*core.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		retirements = append(retirements, retirementInfo(retirement, batch.Denom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementsByBatchResponse{Retirements: retirements, Pagination: pr}, nil
+}
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_RetirementsByBatch(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	_, _, otherOwner := testdata.KeyTestPubAddr()
+
+	// insert two retirements from the first batch and one from the second batch
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey: bKey1,
+		Amount:   "10",
+		Owner:    s.addr,
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey: bKey2,
+		Amount:   "20",
+		Owner:    s.addr,
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey: bKey1,
+		Amount:   "30",
+		Owner:    otherOwner,
+		Reason:   "offsetting emissions",
+	}))
+
+	// query retirements by the first batch
+	res, err := s.k.RetirementsByBatch(s.ctx, &core.QueryRetirementsByBatchRequest{
+		BatchDenom: "C01-001-20200101-20210101-001",
+		Pagination: &query.PageRequest{Offset: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, uint64(3), res.Retirements[0].Id)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.Retirements[0].BatchDenom)
+	assert.Equal(t, "30", res.Retirements[0].Amount)
+	assert.Equal(t, otherOwner.String(), res.Retirements[0].Owner)
+	assert.Equal(t, "offsetting emissions", res.Retirements[0].Reason)
+
+	// query retirements by unknown batch denom
+	_, err = s.k.RetirementsByBatch(s.ctx, &core.QueryRetirementsByBatchRequest{
+		BatchDenom: "A00-000-00000000-00000000-000",
+	})
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+}
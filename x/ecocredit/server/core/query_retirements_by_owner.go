@@ -0,0 +1,62 @@
+package core
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+// RetirementsByOwner queries all retirements of credits by the given owner address.
+func (k Keeper) RetirementsByOwner(ctx context.Context, request *core.QueryRetirementsByOwnerRequest) (*core.QueryRetirementsByOwnerResponse, error) {
+	owner, err := sdk.AccAddressFromBech32(request.Owner)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrap(err.Error())
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(request.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.RetirementTable().List(ctx, api.RetirementOwnerIndexKey{}.WithOwner(owner), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	// batch denoms are cached because an owner will often retire credits from the same batch
+	batchDenoms := make(map[uint64]string)
+
+	retirements := make([]*core.RetirementInfo, 0)
+	for it.Next() {
+		retirement, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batchDenom, ok := batchDenoms[retirement.BatchKey]
+		if !ok {
+			batch, err := k.stateStore.BatchTable().Get(ctx, retirement.BatchKey)
+			if err != nil {
+				return nil, err
+			}
+			batchDenom = batch.Denom
+			batchDenoms[retirement.BatchKey] = batchDenom
+		}
+
+		retirements = append(retirements, retirementInfo(retirement, batchDenom))
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &core.QueryRetirementsByOwnerResponse{Retirements: retirements, Pagination: pr}, nil
+}
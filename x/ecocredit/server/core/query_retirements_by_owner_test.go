@@ -0,0 +1,80 @@
+package core
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+)
+
+func TestQuery_RetirementsByOwner(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t)
+
+	// insert batches
+	bKey1, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	assert.NilError(t, err)
+	bKey2, err := s.stateStore.BatchTable().InsertReturningID(s.ctx, &api.Batch{
+		Denom: "C01-001-20200101-20210101-002",
+	})
+	assert.NilError(t, err)
+
+	_, _, otherOwner := testdata.KeyTestPubAddr()
+
+	// insert two retirements with s.addr as the owner and one with another owner
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey:    bKey1,
+		Amount:      "10",
+		Owner:       s.addr,
+		Beneficiary: "Acme Corporation",
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey: bKey2,
+		Amount:   "20",
+		Owner:    s.addr,
+	}))
+	assert.NilError(t, s.stateStore.RetirementTable().Insert(s.ctx, &api.Retirement{
+		BatchKey: bKey1,
+		Amount:   "30",
+		Owner:    otherOwner,
+	}))
+
+	// query retirements by owner s.addr
+	res, err := s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{
+		Owner:      s.addr.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Retirements))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+	assert.Equal(t, uint64(1), res.Retirements[0].Id)
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.Retirements[0].BatchDenom)
+	assert.Equal(t, "10", res.Retirements[0].Amount)
+	assert.Equal(t, s.addr.String(), res.Retirements[0].Owner)
+	assert.Equal(t, "Acme Corporation", res.Retirements[0].Beneficiary)
+
+	// query retirements by owner s.addr without pagination
+	res, err = s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{Owner: s.addr.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Retirements))
+	assert.Equal(t, "C01-001-20200101-20210101-002", res.Retirements[1].BatchDenom)
+
+	_, _, notOwner := testdata.KeyTestPubAddr()
+
+	// query retirements by an address that has not retired credits
+	res, err = s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{Owner: notOwner.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(res.Retirements))
+
+	// query retirements by an invalid address
+	_, err = s.k.RetirementsByOwner(s.ctx, &core.QueryRetirementsByOwnerRequest{Owner: "foobar"})
+	assert.ErrorContains(t, err, sdkerrors.ErrInvalidAddress.Error())
+}
@@ -5,11 +5,13 @@ import (
 
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // assertClassIssuer makes sure that the issuer is part of issuers of given class key.
@@ -25,6 +27,91 @@ func (k Keeper) assertClassIssuer(goCtx context.Context, classKey uint64, addr s
 	return nil
 }
 
+// assertProjectIssuer makes sure that the issuer is able to issue credits under the project.
+// When the project has project issuers, the issuer must be a project issuer, otherwise the
+// issuer must be a class issuer. Returns ErrUnauthorized otherwise.
+func (k Keeper) assertProjectIssuer(ctx context.Context, classKey, projectKey uint64, addr sdk.AccAddress) error {
+	it, err := k.stateStore.ProjectIssuerTable().List(ctx, ecoApi.ProjectIssuerProjectKeyIssuerIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return err
+	}
+	hasProjectIssuers := it.Next()
+	it.Close()
+
+	if !hasProjectIssuers {
+		return k.assertClassIssuer(ctx, classKey, addr)
+	}
+
+	found, err := k.stateStore.ProjectIssuerTable().Has(ctx, projectKey, addr)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not an issuer for the project", addr)
+	}
+	return nil
+}
+
+// getProjectAndAssertClassAdmin returns the project and makes sure that the address is the
+// admin of the credit class of the project. Returns ErrUnauthorized otherwise.
+func (k Keeper) getProjectAndAssertClassAdmin(ctx context.Context, projectId string, addr sdk.AccAddress) (*ecoApi.Project, error) {
+	project, err := k.stateStore.ProjectTable().GetById(ctx, projectId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"could not get project with id %s: %s", projectId, err,
+		)
+	}
+
+	class, err := k.stateStore.ClassTable().Get(ctx, project.ClassKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !addr.Equals(sdk.AccAddress(class.Admin)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s is not the admin of credit class %s", addr, class.Id,
+		)
+	}
+
+	return project, nil
+}
+
+// getProjectByIdOrAlias returns the project with the given project id. If no project exists
+// with the project id, the project id is looked up as the previous project id of a project
+// that was transferred to another credit class.
+func (k Keeper) getProjectByIdOrAlias(ctx context.Context, projectId string) (*ecoApi.Project, error) {
+	project, err := k.stateStore.ProjectTable().GetById(ctx, projectId)
+	if !ormerrors.NotFound.Is(err) {
+		return project, err
+	}
+
+	alias, aliasErr := k.stateStore.ProjectAliasTable().Get(ctx, projectId)
+	if aliasErr != nil {
+		if ormerrors.NotFound.Is(aliasErr) {
+			return nil, err
+		}
+		return nil, aliasErr
+	}
+
+	return k.stateStore.ProjectTable().Get(ctx, alias.ProjectKey)
+}
+
+// assertProjectIssuable makes sure that credits can be issued under the project, i.e. that
+// the project is registered or validated. A project with an unspecified status was created
+// before the introduction of project status and is treated as registered.
+func assertProjectIssuable(project *ecoApi.Project) error {
+	switch project.Status {
+	case ecoApi.ProjectStatus_PROJECT_STATUS_UNSPECIFIED,
+		ecoApi.ProjectStatus_PROJECT_STATUS_REGISTERED,
+		ecoApi.ProjectStatus_PROJECT_STATUS_VALIDATED:
+		return nil
+	default:
+		return sdkerrors.ErrInvalidRequest.Wrapf(
+			"credits cannot be issued under project %s with status %s", project.Id, project.Status,
+		)
+	}
+}
+
 // AddAndSaveBalance adds 'amt' to the addr's tradable balance.
 func AddAndSaveBalance(ctx context.Context, table ecoApi.BatchBalanceTable, addr sdk.AccAddress, batchKey uint64, amt math.Dec) error {
 	bal, err := utils.GetBalance(ctx, table, addr, batchKey)
@@ -71,6 +158,13 @@ func RetireAndSaveBalance(ctx context.Context, table ecoApi.BatchBalanceTable, a
 	return table.Save(ctx, bal)
 }
 
+// RecordRetirement inserts the retirement into the retirement table with the block time as
+// the timestamp and returns the unique identifier of the retirement.
+func RecordRetirement(ctx context.Context, table ecoApi.RetirementTable, retirement *ecoApi.Retirement) (uint64, error) {
+	retirement.Timestamp = timestamppb.New(sdk.UnwrapSDKContext(ctx).BlockTime())
+	return table.InsertReturningID(ctx, retirement)
+}
+
 // RetireSupply moves `amount` of credits from the supply's tradable amount to its retired amount.
 func RetireSupply(ctx context.Context, table ecoApi.BatchSupplyTable, batchKey uint64, amount math.Dec) error {
 	supply, err := table.Get(ctx, batchKey)
@@ -102,3 +196,172 @@ func RetireSupply(ctx context.Context, table ecoApi.BatchSupplyTable, batchKey u
 
 	return table.Update(ctx, supply)
 }
+
+// withholdBuffer splits the tradable amount of an issuance into the amount received by
+// the recipient and the amount withheld into the buffer pool of the credit class. The
+// withheld amount is rounded down to the precision of the credit type. Credits of bridged
+// credit batches are not withheld because they represent credits issued on another chain.
+func withholdBuffer(class *ecoApi.Class, tradable math.Dec, precision uint32, bridged bool) (math.Dec, math.Dec, error) {
+	zero := math.NewDecFromInt64(0)
+	if bridged || class.BufferPercentage == "" || tradable.IsZero() {
+		return tradable, zero, nil
+	}
+
+	percentage, err := math.NewNonNegativeDecFromString(class.BufferPercentage)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	withheld, err := tradable.Mul(percentage)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	multiplier := math.NewDecFinite(1, int32(precision))
+	units, err := withheld.Mul(multiplier)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	units, err = units.QuoInteger(math.NewDecFromInt64(1))
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	withheld, err = units.QuoExact(multiplier)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+	withheld, _ = withheld.Reduce()
+
+	received, err := math.SafeSubBalance(tradable, withheld)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	return received, withheld, nil
+}
+
+// depositBuffer adds the credits withheld from the issuance of a credit batch to the
+// tradable balance of the buffer pool of the credit class.
+func (k Keeper) depositBuffer(ctx context.Context, classId string, batchKey uint64, batchDenom string, amount math.Dec) error {
+	bufferAddr := core.BufferAddress(classId)
+	if err := AddAndSaveBalance(ctx, k.stateStore.BatchBalanceTable(), bufferAddr, batchKey, amount); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventTransfer{
+		Sender:         k.moduleAddress.String(), // ecocredit module
+		Recipient:      bufferAddr.String(),
+		BatchDenom:     batchDenom,
+		TradableAmount: amount.String(),
+		RetiredAmount:  "0",
+	})
+}
+
+// assertCanRemapBatch makes sure that the credit batch is open, that the address is the
+// issuer of the credit batch and that the credit batch was not bridged, i.e. that the
+// credit batch can be split or merged.
+func (k Keeper) assertCanRemapBatch(ctx context.Context, issuer sdk.AccAddress, batch *ecoApi.Batch) error {
+	if !batch.Open {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s is sealed", batch.Denom)
+	}
+
+	if !sdk.AccAddress(batch.Issuer).Equals(issuer) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the issuer of credit batch %s", issuer, batch.Denom)
+	}
+
+	found, err := k.stateStore.BatchContractTable().Has(ctx, batch.Key)
+	if err != nil {
+		return err
+	}
+	if found {
+		return sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s has a contract and cannot be remapped", batch.Denom)
+	}
+
+	return nil
+}
+
+// getConsentedBalances returns the balances of the credit batch and makes sure that each
+// account holding credits from the credit batch is the issuer, the buffer pool of the
+// credit class or one of the holders that consented by signing the message. Escrowed
+// credits cannot be remapped because they are referenced by sell orders.
+func (k Keeper) getConsentedBalances(ctx context.Context, batch *ecoApi.Batch, holders []string) ([]*ecoApi.BatchBalance, error) {
+	class, err := utils.GetBatchClass(ctx, k.stateStore, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	consented := map[string]bool{
+		sdk.AccAddress(batch.Issuer).String(): true,
+		core.BufferAddress(class.Id).String(): true,
+	}
+	for _, holder := range holders {
+		consented[holder] = true
+	}
+
+	it, err := k.stateStore.BatchBalanceTable().List(ctx, ecoApi.BatchBalanceBatchKeyAddressIndexKey{}.WithBatchKey(batch.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	balances := make([]*ecoApi.BatchBalance, 0)
+	for it.Next() {
+		balance, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		tradable, err := math.NewDecFromString(balance.TradableAmount)
+		if err != nil {
+			return nil, err
+		}
+		retired, err := math.NewDecFromString(balance.RetiredAmount)
+		if err != nil {
+			return nil, err
+		}
+		escrowed, err := math.NewDecFromString(balance.EscrowedAmount)
+		if err != nil {
+			return nil, err
+		}
+		if !escrowed.IsZero() {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("credit batch %s has escrowed credits", batch.Denom)
+		}
+
+		// an account with no remaining credits does not need to consent
+		holder := sdk.AccAddress(balance.Address).String()
+		if !consented[holder] && !(tradable.IsZero() && retired.IsZero()) {
+			return nil, sdkerrors.ErrUnauthorized.Wrapf(
+				"%s holds credits from credit batch %s and is not a holder", holder, batch.Denom,
+			)
+		}
+
+		balances = append(balances, balance)
+	}
+
+	return balances, nil
+}
+
+// closeRemappedBatch removes the balances and supply of a credit batch that was split or
+// merged and seals the credit batch. The credit batch remains in state so that existing
+// retirements and the lineage of the new credit batches can still be resolved.
+func (k Keeper) closeRemappedBatch(ctx context.Context, batch *ecoApi.Batch, balances []*ecoApi.BatchBalance) error {
+	for _, balance := range balances {
+		if err := k.stateStore.BatchBalanceTable().Delete(ctx, balance); err != nil {
+			return err
+		}
+	}
+
+	if err := k.stateStore.BatchSupplyTable().Save(ctx, &ecoApi.BatchSupply{
+		BatchKey:        batch.Key,
+		TradableAmount:  "0",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}); err != nil {
+		return err
+	}
+
+	batch.Open = false
+	return k.stateStore.BatchTable().Update(ctx, batch)
+}
@@ -6,5 +6,8 @@ import (
 
 // Keeper defines a set of methods the ecocredit module exposes.
 type Keeper interface {
+	LoadOrderBook(ctx sdk.Context) error
 	PruneOrders(ctx sdk.Context) error
+	ProcessOrders(ctx sdk.Context) error
+	SettleAuctions(ctx sdk.Context) error
 }
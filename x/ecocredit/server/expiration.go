@@ -4,7 +4,35 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// PruneOrders checks if there are any expired sell or buy orders and removes them from state.
+// LoadOrderBook rebuilds the order book from state after a node starts up.
+func (s serverImpl) LoadOrderBook(ctx sdk.Context) error {
+	return s.marketplaceKeeper.LoadOrderBook(sdk.WrapSDKContext(ctx))
+}
+
+// PruneOrders checks if there are any expired sell orders, buy orders or quote requests and
+// removes them from state, and prunes the price observations outside the retention window.
 func (s serverImpl) PruneOrders(ctx sdk.Context) error {
-	return s.marketplaceKeeper.PruneSellOrders(sdk.WrapSDKContext(ctx))
+	if err := s.marketplaceKeeper.PruneSellOrders(sdk.WrapSDKContext(ctx)); err != nil {
+		return err
+	}
+
+	if err := s.marketplaceKeeper.PruneBuyOrders(sdk.WrapSDKContext(ctx)); err != nil {
+		return err
+	}
+
+	if err := s.marketplaceKeeper.PruneQuoteRequests(sdk.WrapSDKContext(ctx)); err != nil {
+		return err
+	}
+
+	return s.marketplaceKeeper.PrunePriceObservations(sdk.WrapSDKContext(ctx))
+}
+
+// ProcessOrders matches and fills crossing buy and sell orders in the order book.
+func (s serverImpl) ProcessOrders(ctx sdk.Context) error {
+	return s.marketplaceKeeper.ProcessOrders(sdk.WrapSDKContext(ctx))
+}
+
+// SettleAuctions settles the auctions that have ended and removes them from state.
+func (s serverImpl) SettleAuctions(ctx sdk.Context) error {
+	return s.marketplaceKeeper.SettleAuctions(sdk.WrapSDKContext(ctx))
 }
@@ -11,6 +11,7 @@ import (
 func (s serverImpl) RegisterInvariants(ir sdk.InvariantRegistry) {
 	ir.RegisterRoute(ecocredit.ModuleName, "batch-supply", s.batchSupplyInvariant())
 	s.basketKeeper.RegisterInvariants(ir)
+	s.marketplaceKeeper.RegisterInvariants(ir)
 }
 
 func (s serverImpl) batchSupplyInvariant() sdk.Invariant {
@@ -0,0 +1,171 @@
+Feature: Msg/AcceptQuote
+
+  A quote can be accepted:
+    - when the quote exists
+    - when the buyer is the owner of the quote request
+    - when the sell order of the quote has not been cancelled or pruned
+    - when the denom of the quote request is an allowed denom
+    - when the buyer has a bank balance greater than or equal to the total cost
+    - the credits of the quote are sent to the buyer and the seller is paid
+    - the credits of the other quotes are returned to their sellers
+    - the quote request and its quotes are removed from state
+
+  The sell order of a quote cannot be updated and the quote request is closed when the
+  sell order of a quote is filled directly.
+
+  Background:
+    Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+    And alice has a tradable batch balance with amount "100"
+    And carol has a tradable batch balance with amount "100"
+
+  Rule: The quote must exist
+
+    Scenario: quote exists
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And bob has a bank balance "1000regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect no error
+
+    Scenario: quote does not exist
+      When bob attempts to accept the quote with id "1"
+      Then expect the error "quote with id 1: not found: invalid request"
+
+  Rule: The buyer must be the owner of the quote request
+
+    Scenario: buyer is not the owner of the quote request
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      When carol attempts to accept the quote with id "1"
+      Then expect the error "buyer must be the owner of the quote request: unauthorized"
+
+  Rule: The sell order of the quote must not have been cancelled or pruned
+
+    Scenario: sell order of the quote was cancelled
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And alice cancelled the sell order with id "1"
+      When bob attempts to accept the quote with id "1"
+      Then expect the error "sell order with id 1 of quote with id 1: not found: invalid request"
+
+  Rule: The denom of the quote request must be an allowed denom
+
+    Scenario: denom of the quote request is no longer allowed
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And the denom "regen" is no longer allowed
+      When bob attempts to accept the quote with id "1"
+      Then expect the error "regen is not an allowed denom: invalid request"
+
+  Rule: The buyer must have a bank balance greater than or equal to the total cost
+
+    Scenario: buyer has insufficient bank balance
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And bob has a bank balance "999regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect the error "quantity: 100, ask price: 10regen, total price: 1000regen, bank balance: 999regen: insufficient funds"
+
+  Rule: The credits of the quote are sent to the buyer and the seller is paid
+
+    Scenario: the credits are received in a tradable state
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And bob has a bank balance "1000regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "100",
+        "retired_amount": "0"
+      }
+      """
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "0",
+        "escrowed_amount": "0"
+      }
+      """
+
+    Scenario: the credits are retired
+      Given bob created a quote request with quantity "100" and auto-retire enabled
+      And alice submitted a quote with ask price "10regen"
+      And bob has a bank balance "1000regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect bob batch balance
+      """
+      {
+        "tradable_amount": "0",
+        "retired_amount": "100"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The credits of the other quotes are returned to their sellers
+
+    Scenario: the credits of the other quotes are returned
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And carol submitted a quote with ask price "12regen"
+      And bob has a bank balance "1000regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect carol batch balance
+      """
+      {
+        "tradable_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect no sell order with id "2"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The quote request and its quotes are removed from state
+
+    Scenario: the quote request and its quotes are removed
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And carol submitted a quote with ask price "12regen"
+      And bob has a bank balance "1000regen"
+      When bob attempts to accept the quote with id "1"
+      Then expect no quote request with id "1"
+      And expect no quotes for quote request "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The sell order of a quote cannot be updated
+
+    Scenario: the ask price of the sell order of a quote is updated
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      When alice attempts to update the ask price of the sell order with id "1" to "20regen"
+      Then expect the error "updates[0]: sell order with id 1 is the sell order of a quote and cannot be updated: invalid request"
+
+  Rule: The quote request is closed when the sell order of a quote is filled directly
+
+    Scenario: the sell order of a quote is partially filled directly
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      And carol submitted a quote with ask price "12regen"
+      And bob has a bank balance "1000regen"
+      When bob buys "40" credits directly from the sell order with id "1"
+      Then expect no error
+      And expect no quote request with id "1"
+      And expect no quotes for quote request "1"
+      And expect no sell order with id "1"
+      And expect alice batch balance
+      """
+      {
+        "tradable_amount": "60",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect carol batch balance
+      """
+      {
+        "tradable_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
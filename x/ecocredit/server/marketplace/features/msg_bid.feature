@@ -0,0 +1,349 @@
+Feature: Msg/Bid
+
+  A bid can be placed:
+    - when the auction exists
+    - when the bidder is not the seller of the auction
+    - when the block time is within the bidding window of the auction
+    - when the bid price denom matches the denom of the auction
+    - when the denom of the auction is an allowed denom
+    - when the bid price of a dutch auction is greater than or equal to the current price
+    - when the bid price of an english auction is greater than or equal to the start price
+    - when the bid price of an english auction is greater than the highest bid
+    - a bid in a dutch auction wins the auction at the current price and closes the auction
+    - the escrowed funds of the previous highest bid of an english auction are refunded
+    - the total cost of the bid and the buyer fee of the market are moved into escrow
+
+  Rule: The auction must exist
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+
+    Scenario: auction exists
+      Given alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      When bob attempts to bid in auction with id "1" and bid price "100regen"
+      Then expect no error
+
+    Scenario: auction does not exist
+      When bob attempts to bid in auction with id "1" and bid price "100regen"
+      Then expect the error "auction with id 1: not found: invalid request"
+
+  Rule: The bidder must not be the seller of the auction
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: bidder is not the seller
+      When bob attempts to bid with bid price "100regen"
+      Then expect no error
+
+    Scenario: bidder is the seller
+      When alice attempts to bid with bid price "100regen"
+      Then expect the error "bidder cannot be the seller of the auction: unauthorized"
+
+  Rule: The block time must be within the bidding window of the auction
+
+    Background:
+      Given alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: block time is within the bidding window
+      Given a block time with timestamp "2020-01-01"
+      When bob attempts to bid with bid price "100regen"
+      Then expect no error
+
+    Scenario: block time is before the start time
+      Given a block time with timestamp "2019-12-31"
+      When bob attempts to bid with bid price "100regen"
+      Then expect the error "auction has not started: start time: 2020-01-01 00:00:00 +0000 UTC: invalid request"
+
+    Scenario: block time is equal to the end time
+      Given a block time with timestamp "2020-02-01"
+      When bob attempts to bid with bid price "100regen"
+      Then expect the error "auction with id 1 has ended: invalid request"
+
+  Rule: The bid price denom must match the denom of the auction
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: bid price denom does not match
+      When bob attempts to bid with bid price "100foo"
+      Then expect the error "bid price denom: foo, auction denom: regen: invalid request"
+
+  Rule: The denom of the auction must be an allowed denom
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: denom of the auction is no longer allowed
+      Given the denom "regen" is no longer allowed
+      When bob attempts to bid with bid price "100regen"
+      Then expect the error "regen is not an allowed denom: invalid request"
+
+  Rule: The bid price of a dutch auction must be greater than or equal to the current price
+
+    Background:
+      Given alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_DUTCH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "50",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z",
+        "price_decrement": "10",
+        "decay_interval": "86400s"
+      }
+      """
+
+    Scenario Outline: bid price is greater than or equal to the current price
+      Given a block time with timestamp "<block-time>"
+      When bob attempts to bid with bid price "<bid-price>"
+      Then expect no error
+
+      Examples:
+        | description   | block-time | bid-price |
+        | start price   | 2020-01-01 | 100regen  |
+        | decayed price | 2020-01-03 | 80regen   |
+        | greater than  | 2020-01-03 | 90regen   |
+        | reserve price | 2020-01-31 | 50regen   |
+
+    Scenario Outline: bid price is less than the current price
+      Given a block time with timestamp "<block-time>"
+      When bob attempts to bid with bid price "<bid-price>"
+      Then expect the error "<error>"
+
+      Examples:
+        | description   | block-time | bid-price | error                                                                                |
+        | start price   | 2020-01-01 | 99regen   | bid price: 99regen, current price: 100regen, insufficient bid price: invalid request |
+        | decayed price | 2020-01-03 | 79regen   | bid price: 79regen, current price: 80regen, insufficient bid price: invalid request  |
+        | reserve price | 2020-01-31 | 49regen   | bid price: 49regen, current price: 50regen, insufficient bid price: invalid request  |
+
+  Rule: A bid in a dutch auction wins the auction at the current price and closes the auction
+
+    Scenario: the auction is won at the current price
+      Given a block time with timestamp "2020-01-03"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_DUTCH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "50",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z",
+        "price_decrement": "10",
+        "decay_interval": "86400s"
+      }
+      """
+      When bob attempts to bid with bid price "100regen"
+      Then expect the highest bidder to be bob
+      And expect auction with properties
+      """
+      {
+        "bid_price": "80",
+        "end_time": "2020-01-03T00:00:00Z"
+      }
+      """
+      And expect bob escrowed funds "8000regen"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The bid price of an english auction must be greater than or equal to the start price
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: bid price is equal to the start price
+      When bob attempts to bid with bid price "100regen"
+      Then expect no error
+
+    Scenario: bid price is less than the start price
+      When bob attempts to bid with bid price "99regen"
+      Then expect the error "bid price: 99regen, start price: 100regen, insufficient bid price: invalid request"
+
+  Rule: The bid price of an english auction must be greater than the highest bid
+
+    Background:
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      And carol placed a bid with bid price "150"
+
+    Scenario: bid price is greater than the highest bid
+      When bob attempts to bid with bid price "151regen"
+      Then expect no error
+
+    Scenario: bid price is equal to the highest bid
+      When bob attempts to bid with bid price "150regen"
+      Then expect the error "bid price: 150regen, highest bid: 150regen, bid price must be greater than the highest bid: invalid request"
+
+  Rule: The escrowed funds of the previous highest bid of an english auction are refunded
+
+    Scenario: the previous highest bid is refunded
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      And carol placed a bid with bid price "150"
+      When bob attempts to bid with bid price "160regen"
+      Then expect carol refunded funds "15000regen"
+      And expect the highest bidder to be bob
+      And expect auction with properties
+      """
+      {
+        "bid_price": "160",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    Scenario: the previous highest bid is refunded with the escrowed buyer fee
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      And carol placed a bid with bid price "150" and buyer fee "0.1"
+      When bob attempts to bid with bid price "160regen"
+      Then expect carol refunded funds "16500regen"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The total cost of the bid and the buyer fee are moved into escrow
+
+    Scenario: the total cost is escrowed
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "2.5",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      When bob attempts to bid with bid price "120regen"
+      Then expect bob escrowed funds "300regen"
+
+    Scenario: the buyer fee of the market is escrowed
+      Given a block time with timestamp "2020-01-02"
+      And alice created an auction
+      """
+      {
+        "quantity": "2.5",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      And the market has buyer fee "0.1"
+      When bob attempts to bid with bid price "120regen"
+      Then expect bob escrowed funds "330regen"
+      And expect the auction has buyer fee "0.1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
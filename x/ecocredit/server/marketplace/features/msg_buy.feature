@@ -0,0 +1,177 @@
+Feature: Msg/Buy
+
+  A buy order can be created:
+    - when the selected credit batch, project or credit class exists
+    - when the bid denom is an allowed denom
+    - when the expiration is in the future
+    - when the decimal places in quantity do not exceed credit type precision
+    - when the buyer has enough funds to cover the total bid
+    - the total bid and the buyer fee are moved into escrow
+    - the buy order is stored in state
+
+  Rule: The selected credits must exist
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+
+    Scenario: the credit batch exists
+      When alice attempts to buy credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: the credit batch does not exist
+      When alice attempts to buy credits with batch denom "C02-001-20200101-20210101-001"
+      Then expect the error "orders[0]: selection: batch denom C02-001-20200101-20210101-001: not found: not found: invalid request"
+
+    Scenario: the project exists
+      When alice attempts to buy credits with project id "C01-001"
+      Then expect no error
+
+    Scenario: the project does not exist
+      When alice attempts to buy credits with project id "C02-001"
+      Then expect the error "orders[0]: selection: project id C02-001: not found: not found: invalid request"
+
+    Scenario: the credit class exists
+      When alice attempts to buy credits with class id "C01"
+      Then expect no error
+
+    Scenario: the credit class does not exist
+      When alice attempts to buy credits with class id "C02"
+      Then expect the error "orders[0]: selection: class id C02: not found: not found: invalid request"
+
+  Rule: The bid denom must be an allowed denom
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+
+    Scenario: the bid denom is allowed
+      When alice attempts to buy credits with bid price "100regen"
+      Then expect no error
+
+    Scenario: the bid denom is not allowed
+      When alice attempts to buy credits with bid price "100foo"
+      Then expect the error "orders[0]: foo is not allowed to be used in buy orders: invalid request"
+
+    Scenario: the bid denom is a basket denom
+      Given a basket with basket denom "eco.uC.NCT"
+      When alice attempts to buy credits with bid price "100eco.uC.NCT"
+      Then expect the error "orders[0]: eco.uC.NCT is not allowed to be used in buy orders: invalid request"
+
+  Rule: The expiration must be in the future
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+      And a block time with timestamp "2020-01-01"
+
+    Scenario: the expiration is in the future
+      When alice attempts to buy credits with expiration "2020-01-02"
+      Then expect no error
+
+    Scenario: the expiration is not in the future
+      When alice attempts to buy credits with expiration "2020-01-01"
+      Then expect the error "orders[0]: expiration must be in the future: 2020-01-01 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The decimal places in quantity must not exceed credit type precision
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+
+    Scenario: quantity decimal places less than precision
+      When alice attempts to buy credits with quantity "10.12345"
+      Then expect no error
+
+    Scenario: quantity decimal places more than precision
+      When alice attempts to buy credits with quantity "10.1234567"
+      Then expect the error "orders[0]: decimal places exceeds precision: quantity: 10.1234567, credit type precision: 6: invalid request"
+
+  Rule: The total bid is moved into escrow
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+
+    Scenario: the total bid is moved into escrow
+      When alice attempts to buy credits with quantity "10" and bid price "100regen"
+      Then expect "1000regen" moved into escrow
+
+    Scenario: the total bid of a decimal quantity is moved into escrow
+      When alice attempts to buy credits with quantity "2.5" and bid price "100regen"
+      Then expect "250regen" moved into escrow
+
+    Scenario: the buyer does not have enough funds
+      Given alice has insufficient funds
+      When alice attempts to buy credits with quantity "10" and bid price "100regen"
+      Then expect the error "orders[0]: total price: 1000regen: insufficient funds"
+
+    Scenario: the total bid and the buyer fee are moved into escrow
+      Given a market with bank denom "regen" and buyer fee "0.01"
+      When alice attempts to buy credits with quantity "10" and bid price "100regen"
+      Then expect "1010regen" moved into escrow
+
+  Rule: The buy order is stored in state
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C01-001-20200101-20210101-001"
+      And an allowed denom with bank denom "regen"
+
+    Scenario: the buy order is stored with a batch selection
+      When alice attempts to buy credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect buy order with id "1"
+      """
+      {
+        "id": 1,
+        "selection": {
+          "batch_key": 1
+        },
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "100",
+        "maker": true,
+        "retirement_jurisdiction": "US-WA"
+      }
+      """
+
+    Scenario: the buy order is stored with a class selection and disable auto-retire
+      When alice attempts to buy credits with class id "C01" and disable auto-retire
+      Then expect buy order with id "1"
+      """
+      {
+        "id": 1,
+        "selection": {
+          "class_key": 1
+        },
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "100",
+        "maker": true,
+        "disable_auto_retire": true
+      }
+      """
+
+    Scenario: the buy order is stored with the buyer fee of the market
+      Given a market with bank denom "regen" and buyer fee "0.01"
+      When alice attempts to buy credits with batch denom "C01-001-20200101-20210101-001"
+      Then expect buy order with id "1"
+      """
+      {
+        "id": 1,
+        "selection": {
+          "batch_key": 1
+        },
+        "quantity": "10",
+        "market_id": 1,
+        "bid_amount": "100",
+        "maker": true,
+        "retirement_jurisdiction": "US-WA",
+        "buyer_fee": "0.01"
+      }
+      """
@@ -3,10 +3,13 @@ Feature: Msg/BuyDirect
   Credits can be bought directly:
   - when the sell order exists
   - when the buyer is not the seller
+  - when the buyer is allowed to buy credits from a private sell order
   - when the bid denom matches the sell denom
   - when the buyer has a bank balance greater than or equal to the total cost
+  - when the buyer has a tradable credit balance greater than or equal to the total cost in credits
   - when the buyer provides a bid price greater than or equal to the ask price
   - when the buyer provides a quantity less than or equal to the sell order quantity
+  - when the quantity satisfies the minimum fill quantity and the lot size of the sell order
   - when the number of decimal places in quantity is less than or equal to the credit type precision
   - the buyer cannot disable auto-retire when auto-retire is enabled for the sell order
   - the sell order is removed when the sell order is filled
@@ -16,6 +19,10 @@ Feature: Msg/BuyDirect
   - the seller batch balance is updated
   - the buyer batch balance is updated
   - the batch supply is updated when the credits are auto-retired
+  - the buyer fee and the seller fee are sent to the fee destination of the market
+  - no fees are charged when the sell order asks for credits of another batch
+  - the trade is recorded
+  - the retirement is recorded when the credits are auto-retired
 
   Rule: The sell order must exist
 
@@ -46,6 +53,32 @@ Feature: Msg/BuyDirect
       When alice attempts to buy credits with sell order id "1"
       Then expect the error "orders[0]: buyer account cannot be the same as seller account: unauthorized"
 
+  Rule: The buyer must be allowed to buy credits from a private sell order
+
+    Background:
+      Given a credit type
+      And bob has a bank balance with amount "100"
+
+    Scenario: the buyer is an allowed buyer
+      Given alice created a sell order with allowed buyer "bob"
+      When bob attempts to buy credits with quantity "10"
+      Then expect no error
+
+    Scenario: the buyer is not an allowed buyer
+      Given alice created a sell order with allowed buyer "carol"
+      When bob attempts to buy credits with quantity "10"
+      Then expect the error "orders[0]: buyer is not allowed to buy credits from private sell order 1: unauthorized"
+
+    Scenario: the buyer is a member of the allowed buyer group policy
+      Given alice created a sell order with an allowed buyer group policy with member "bob"
+      When bob attempts to buy credits with quantity "10"
+      Then expect no error
+
+    Scenario: the buyer is not a member of the allowed buyer group policy
+      Given alice created a sell order with an allowed buyer group policy with member "carol"
+      When bob attempts to buy credits with quantity "10"
+      Then expect the error "orders[0]: buyer is not allowed to buy credits from private sell order 1: unauthorized"
+
   Rule: The bid denom must match the sell denom
 
     Background:
@@ -140,6 +173,85 @@ Feature: Msg/BuyDirect
       When bob attempts to buy credits with quantity "15"
       Then expect the error "orders[0]: requested quantity: 15, sell order quantity 10: invalid request"
 
+  Rule: The fill policy determines how an order that cannot be filled in full is handled
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10"
+      And bob has a bank balance with amount "150"
+
+    Scenario: all or nothing fill policy fails the message
+      When bob attempts to buy credits with quantity "15" and fill policy "FILL_POLICY_ALL_OR_NOTHING"
+      Then expect the error "orders[0]: requested quantity: 15, sell order quantity 10: invalid request"
+
+    Scenario: partial fill policy fills the available credits
+      When bob attempts to buy credits with quantity "15" and fill policy "FILL_POLICY_PARTIAL"
+      Then expect no error
+      And expect filled quantities "10"
+      And expect no sell order with id "1"
+
+    Scenario: partial fill policy fills no credits if the sell order does not exist
+      When bob attempts to buy credits with sell order id "2" and fill policy "FILL_POLICY_PARTIAL"
+      Then expect no error
+      And expect filled quantities "0"
+
+    Scenario: skip on failure fill policy skips the order
+      When bob attempts to buy credits with quantity "15" and fill policy "FILL_POLICY_SKIP_ON_FAILURE"
+      Then expect no error
+      And expect filled quantities "0"
+      And expect sell order with quantity "10"
+
+    Scenario: skip on failure fill policy fills the order if it can be filled
+      When bob attempts to buy credits with quantity "10" and fill policy "FILL_POLICY_SKIP_ON_FAILURE"
+      Then expect no error
+      And expect filled quantities "10"
+      And expect no sell order with id "1"
+
+  Rule: The quantity must satisfy the minimum fill quantity and the lot size of the sell order
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10" min fill quantity "4" and lot size "2"
+      And bob has a bank balance with amount "150"
+
+    Scenario Outline: quantity satisfies the minimum fill quantity and the lot size
+      When bob attempts to buy credits with quantity "<quantity>"
+      Then expect no error
+
+      Examples:
+        | description                                 | quantity |
+        | equal to the minimum fill quantity          | 4        |
+        | greater than the minimum fill quantity      | 6        |
+        | equal to the remaining sell order quantity  | 10       |
+
+    Scenario: quantity is not a multiple of the lot size
+      When bob attempts to buy credits with quantity "5"
+      Then expect the error "orders[0]: quantity 5 is not a multiple of the lot size 2: invalid request"
+
+    Scenario: quantity is less than the minimum fill quantity
+      When bob attempts to buy credits with quantity "2"
+      Then expect the error "orders[0]: quantity 2 is less than the minimum fill quantity 4: invalid request"
+
+    Scenario: partial fill policy rounds the quantity down to the lot size
+      When bob attempts to buy credits with quantity "7" and fill policy "FILL_POLICY_PARTIAL"
+      Then expect no error
+      And expect filled quantities "6"
+      And expect sell order with quantity "4"
+      And expect alice bank balance "160regen"
+
+    Scenario: partial fill policy fills no credits below the minimum fill quantity
+      When bob attempts to buy credits with quantity "3" and fill policy "FILL_POLICY_PARTIAL"
+      Then expect no error
+      And expect filled quantities "0"
+      And expect sell order with quantity "10"
+
+    Scenario: partial fill policy fills the remaining quantity below the minimum fill quantity
+      When bob attempts to buy credits with quantity "6"
+      And bob attempts to buy credits with quantity "5" and fill policy "FILL_POLICY_PARTIAL"
+      Then expect no error
+      And expect filled quantities "4"
+      And expect no sell order with id "1"
+
   Rule: The number of decimal places in quantity must be less than or equal to the credit type precision
 
     Background:
@@ -338,3 +450,160 @@ Feature: Msg/BuyDirect
       """
 
     # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buyer must have a tradable credit balance greater than or equal to the total cost in credits
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C02-001-20200101-20210101-001"
+      And alice created a sell order with quantity "10" and ask price "1500000C02-001-20200101-20210101-001"
+
+    Scenario: buyer tradable credit balance is greater than or equal to total cost in credits
+      Given bob has a tradable credit balance of "20" with batch denom "C02-001-20200101-20210101-001"
+      When bob attempts to buy credits with quantity "10" and bid price "1500000C02-001-20200101-20210101-001"
+      Then expect no error
+      And expect bob tradable credit balance of "5" with batch denom "C02-001-20200101-20210101-001"
+      And expect alice tradable credit balance of "15" with batch denom "C02-001-20200101-20210101-001"
+
+    Scenario: buyer tradable credit balance is less than total cost in credits
+      Given bob has a tradable credit balance of "10" with batch denom "C02-001-20200101-20210101-001"
+      When bob attempts to buy credits with quantity "10" and bid price "1500000C02-001-20200101-20210101-001"
+      Then expect the error "orders[0]: ask batch: C02-001-20200101-20210101-001, total price: 15, tradable balance: 10: insufficient credit balance"
+
+  Rule: The buyer fee and the seller fee are sent to the fee destination of the market
+
+    Background:
+      Given a credit type
+      And alice created a sell order with quantity "10" and ask price "10regen"
+      And alice has bank balance "0regen"
+      And bob has the bank balance "110regen"
+
+    Scenario Outline: fees sent to the fee destination
+      Given the market has buyer fee "0.1" seller fee "0.1" and fee destination "<destination>"
+      When bob attempts to buy credits with quantity "10" and bid price "10regen"
+      Then expect no error
+      And expect bob bank balance "0regen"
+      And expect alice bank balance "90regen"
+      And expect fees "20regen" sent to the fee destination
+      And expect event buy direct with properties
+      """
+      {
+        "sell_order_id": 1,
+        "buyer_fee": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "seller_fee": {
+          "denom": "regen",
+          "amount": "10"
+        }
+      }
+      """
+
+      Examples:
+        | destination                    |
+        | FEE_DESTINATION_BURN           |
+        | FEE_DESTINATION_COMMUNITY_POOL |
+        | FEE_DESTINATION_CLASS_ADMIN    |
+
+    Scenario: fees sent to the admin of the credit class of the project after a project transfer
+      Given the market has buyer fee "0.1" seller fee "0.1" and fee destination "FEE_DESTINATION_CLASS_ADMIN"
+      And the project is transferred to another credit class
+      When bob attempts to buy credits with quantity "10" and bid price "10regen"
+      Then expect no error
+      And expect fees "20regen" sent to the fee destination
+
+    Scenario: fees rounded down to an integer amount
+      Given the market has buyer fee "0.015" seller fee "0.015" and fee destination "FEE_DESTINATION_BURN"
+      When bob attempts to buy credits with quantity "10" and bid price "10regen"
+      Then expect no error
+      And expect bob bank balance "9regen"
+      And expect alice bank balance "99regen"
+      And expect fees "2regen" sent to the fee destination
+
+    Scenario: the buyer does not have enough funds to cover the buyer fee
+      Given the market has buyer fee "0.2" seller fee "0" and fee destination "FEE_DESTINATION_BURN"
+      When bob attempts to buy credits with quantity "10" and bid price "10regen"
+      Then expect the error "orders[0]: quantity: 10, ask price: 10regen, total price: 120regen, bank balance: 110regen: insufficient funds"
+
+  Rule: No fees are charged when the sell order asks for credits of another batch
+
+    Fees are charged in the bank denom of the market and the fee destinations only
+    accept bank coins so swaps of credits for credits are exempt from the fees.
+
+    Background:
+      Given a credit type with precision "6"
+      And a credit batch with batch denom "C02-001-20200101-20210101-001"
+      And alice created a sell order with quantity "10" and ask price "1500000C02-001-20200101-20210101-001"
+      And bob has a tradable credit balance of "15" with batch denom "C02-001-20200101-20210101-001"
+
+    Scenario: no fees charged for credits of another batch
+      Given the market has buyer fee "0.1" seller fee "0.1" and fee destination "FEE_DESTINATION_BURN"
+      When bob attempts to buy credits with quantity "10" and bid price "1500000C02-001-20200101-20210101-001"
+      Then expect no error
+      And expect no fees sent to the fee destination
+      And expect bob tradable credit balance of "0" with batch denom "C02-001-20200101-20210101-001"
+      And expect alice tradable credit balance of "15" with batch denom "C02-001-20200101-20210101-001"
+
+  Rule: The trade is recorded
+
+    Background:
+      Given a credit type
+
+    Scenario: the trade is recorded
+      Given alice created a sell order with quantity "10" and ask price "10regen"
+      When bob attempts to buy credits with quantity "10" and bid price "12regen"
+      Then expect trade with properties
+      """
+      {
+        "id": 1,
+        "sell_order_id": 1,
+        "batch_key": 1,
+        "quantity": "10",
+        "price": "10",
+        "denom": "regen",
+        "retired": true
+      }
+      """
+
+    Scenario: the trade of tradable credits is recorded
+      Given alice created a sell order with disable auto retire "true"
+      When bob attempts to buy credits with disable auto retire "true"
+      Then expect trade with properties
+      """
+      {
+        "id": 1,
+        "sell_order_id": 1,
+        "batch_key": 1,
+        "quantity": "10",
+        "price": "10",
+        "denom": "regen"
+      }
+      """
+
+  Rule: The retirement is recorded when the credits are auto-retired
+
+    Background:
+      Given a credit type
+
+    Scenario: the retirement is recorded
+      Given alice created a sell order with quantity "10" and disable auto retire "true"
+      When bob attempts to buy credits with retirement beneficiary "Acme Corporation" and reason "offsetting emissions"
+      Then expect retirement with properties
+      """
+      {
+        "id": 1,
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "amount": "10",
+        "beneficiary": "Acme Corporation",
+        "reason": "offsetting emissions",
+        "jurisdiction": "US-WA"
+      }
+      """
+
+    Scenario: the retirement is not recorded
+      Given alice created a sell order with disable auto retire "true"
+      When bob attempts to buy credits with disable auto retire "true"
+      Then expect no retirement with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
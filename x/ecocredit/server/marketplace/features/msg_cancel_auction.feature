@@ -0,0 +1,63 @@
+Feature: Msg/CancelAuction
+
+  An auction can be cancelled:
+    - when the auction exists
+    - when the user is the seller of the auction
+    - when the auction has not received any bids
+    - the escrowed credits are converted to tradable credits
+    - the auction is removed from state
+
+  Rule: The auction must exist
+
+    Scenario: auction exists
+      Given alice created an auction with quantity "100"
+      When alice attempts to cancel the auction with id "1"
+      Then expect no error
+
+    Scenario: auction does not exist
+      When alice attempts to cancel the auction with id "1"
+      Then expect the error "auction with id 1: not found: invalid request"
+
+  Rule: The user must be the seller of the auction
+
+    Scenario: user is the seller
+      Given alice created an auction with quantity "100"
+      When alice attempts to cancel the auction with id "1"
+      Then expect no error
+
+    Scenario: user is not the seller
+      Given alice created an auction with quantity "100"
+      When bob attempts to cancel the auction with id "1"
+      Then expect the error "seller must be the owner of the auction: unauthorized"
+
+  Rule: The auction must not have received any bids
+
+    Scenario: auction has received a bid
+      Given alice created an auction with quantity "100"
+      And bob placed a bid with bid price "100"
+      When alice attempts to cancel the auction with id "1"
+      Then expect the error "auction with id 1 has received bids and cannot be cancelled: invalid request"
+
+  Rule: The escrowed credits are converted to tradable credits
+
+    Scenario: the credits are converted to tradable credits
+      Given alice created an auction with quantity "100"
+      When alice attempts to cancel the auction with id "1"
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The auction is removed from state
+
+    Scenario: the auction is removed from state
+      Given alice created an auction with quantity "100"
+      When alice attempts to cancel the auction with id "1"
+      Then expect no auction with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
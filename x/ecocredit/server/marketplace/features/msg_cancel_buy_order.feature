@@ -0,0 +1,53 @@
+Feature: Msg/CancelBuyOrder
+
+  A buy order can be cancelled:
+    - when the buy order exists
+    - when the user is the owner of the buy order
+    - the escrowed funds are returned to the buyer
+    - the buy order is removed from state
+
+  Rule: The buy order must exist
+
+    Scenario: buy order exists
+      Given alice created a buy order with id "1"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect no error
+
+    Scenario: buy order does not exist
+      When alice attempts to cancel the buy order with id "1"
+      Then expect the error "buy order with id 1: not found: invalid request"
+
+  Rule: The user must be the owner of the buy order
+
+    Scenario: user is the owner
+      Given alice created a buy order with id "1"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect no error
+
+    Scenario: user is not the owner
+      Given alice created a buy order with id "1"
+      When bob attempts to cancel the buy order with id "1"
+      Then expect the error "buyer must be the owner of the buy order: unauthorized"
+
+  Rule: The escrowed funds are returned to the buyer
+
+    Scenario: the escrowed funds are returned
+      Given alice created a buy order with id "1" and quantity "10"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect "1000regen" returned to alice
+
+    Scenario: the escrowed funds of a decimal quantity are returned
+      Given alice created a buy order with id "1" and quantity "2.5"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect "250regen" returned to alice
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The buy order is removed from state
+
+    Scenario: the buy order is removed from state
+      Given alice created a buy order with id "1"
+      When alice attempts to cancel the buy order with id "1"
+      Then expect no buy order with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,57 @@
+Feature: Msg/CancelQuoteRequest
+
+  A quote request can be cancelled:
+    - when the quote request exists
+    - when the user is the buyer of the quote request
+    - the credits of the quotes are returned to their sellers
+    - the quote request and its quotes are removed from state
+
+  Background:
+    Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+    And alice has a tradable batch balance with amount "100"
+
+  Rule: The quote request must exist
+
+    Scenario: quote request exists
+      Given bob created a quote request with quantity "100"
+      When bob attempts to cancel the quote request with id "1"
+      Then expect no error
+
+    Scenario: quote request does not exist
+      When bob attempts to cancel the quote request with id "1"
+      Then expect the error "quote request with id 1: not found: invalid request"
+
+  Rule: The user must be the buyer of the quote request
+
+    Scenario: user is not the buyer
+      Given bob created a quote request with quantity "100"
+      When alice attempts to cancel the quote request with id "1"
+      Then expect the error "buyer must be the owner of the quote request: unauthorized"
+
+  Rule: The credits of the quotes are returned to their sellers
+
+    Scenario: the credits are returned
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      When bob attempts to cancel the quote request with id "1"
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "100",
+        "escrowed_amount": "0"
+      }
+      """
+      And expect no sell order with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The quote request and its quotes are removed from state
+
+    Scenario: the quote request and its quotes are removed
+      Given bob created a quote request with quantity "100"
+      And alice submitted a quote with ask price "10regen"
+      When bob attempts to cancel the quote request with id "1"
+      Then expect no quote request with id "1"
+      And expect no quote with id "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
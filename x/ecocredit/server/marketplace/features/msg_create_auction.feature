@@ -0,0 +1,293 @@
+Feature: Msg/CreateAuction
+
+  An auction can be created:
+    - when the credit batch exists
+    - when the number of decimal places in quantity is less than or equal to the credit type precision
+    - when the denom of the prices is an allowed denom
+    - when the start time is not before the block time
+    - when the end time is after the start time
+    - when the seller owns greater than or equal to the quantity of credits
+    - the tradable credits are converted to escrowed credits
+    - the auction is stored in state and starts at the block time unless a start time is provided
+    - the response includes the auction id
+
+  Background:
+    Given a block time with timestamp "2020-01-01"
+    And an allowed denom
+
+  Rule: The credit batch must exist
+
+    Scenario: credit batch exists
+      Given alice has a tradable batch balance with amount "100"
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect no error
+
+    Scenario: credit batch does not exist
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "batch denom C01-001-20200101-20210101-001: not found: invalid request"
+
+  Rule: The number of decimal places in quantity must be less than or equal to the credit type precision
+
+    Background:
+      Given alice has a tradable batch balance with amount "100"
+
+    Scenario: quantity decimal places less than or equal to precision
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "9.123456",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect no error
+
+    Scenario: quantity decimal places more than precision
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "9.1234567",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "decimal places exceeds precision: quantity: 9.1234567, credit type precision: 6: invalid request"
+
+  Rule: The denom of the prices must be an allowed denom
+
+    Background:
+      Given alice has a tradable batch balance with amount "100"
+
+    Scenario: denom is not allowed
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "foo", "amount": "100"},
+        "reserve_price": {"denom": "foo", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "foo is not allowed to be used in auctions: invalid request"
+
+    Scenario: denom is a basket denom
+      Given a basket with basket denom "eco.uC.NCT"
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "eco.uC.NCT", "amount": "100"},
+        "reserve_price": {"denom": "eco.uC.NCT", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "eco.uC.NCT is not allowed to be used in auctions: invalid request"
+
+  Rule: The start time must not be before the block time
+
+    Background:
+      Given alice has a tradable batch balance with amount "100"
+
+    Scenario: start time is after the block time
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "start_time": "2020-01-15T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect no error
+
+    Scenario: start time is before the block time
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "start_time": "2019-12-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "start time cannot be in the past: 2019-12-01 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The end time must be after the start time
+
+    Background:
+      Given alice has a tradable batch balance with amount "100"
+
+    Scenario: end time is before the block time
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2019-12-01T00:00:00Z"
+      }
+      """
+      Then expect the error "end time must be after the start time: 2019-12-01 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The seller must own greater than or equal to the quantity of credits
+
+    Scenario: seller owns less than the quantity of credits
+      Given alice has a tradable batch balance with amount "50"
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect the error "auction: credit quantity: 100, tradable balance: 50: insufficient credit balance"
+
+  Rule: The tradable credits are converted to escrowed credits
+
+    Scenario: the credits are escrowed
+      Given alice has a tradable batch balance with amount "100"
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "40",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect alice batch balance
+      """
+      {
+        "tradable_amount": "60",
+        "escrowed_amount": "40"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The auction is stored in state
+
+    Background:
+      Given alice has a tradable batch balance with amount "100"
+
+    Scenario: a dutch auction is stored in state
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_DUTCH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "50"},
+        "end_time": "2020-02-01T00:00:00Z",
+        "price_decrement": {"denom": "regen", "amount": "10"},
+        "decay_interval": "3600s"
+      }
+      """
+      Then expect auction with properties
+      """
+      {
+        "id": 1,
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_DUTCH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "50",
+        "start_time": "2020-01-01T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z",
+        "price_decrement": "10",
+        "decay_interval": "3600s"
+      }
+      """
+
+    Scenario: an english auction is stored in state
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "start_time": "2020-01-15T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect auction with properties
+      """
+      {
+        "id": 1,
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "denom": "regen",
+        "start_price": "100",
+        "reserve_price": "200",
+        "start_time": "2020-01-15T00:00:00Z",
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The response includes the auction id
+
+    Scenario: the response includes the auction id
+      Given alice has a tradable batch balance with amount "100"
+      When alice attempts to create an auction
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "auction_type": "AUCTION_TYPE_ENGLISH",
+        "start_price": {"denom": "regen", "amount": "100"},
+        "reserve_price": {"denom": "regen", "amount": "200"},
+        "end_time": "2020-02-01T00:00:00Z"
+      }
+      """
+      Then expect auction id "1" in the response
+
+    # no failing scenario - response verification only occurs upon successful message execution
@@ -0,0 +1,86 @@
+Feature: Msg/CreateQuoteRequest
+
+  A quote request can be created:
+    - when the selected credit batch, project or credit class exists
+    - when the denom is an allowed denom
+    - when the expiration is after the block time
+    - when the number of decimal places in quantity is less than or equal to the credit type precision
+    - the quote request is stored in state
+    - the response includes the quote request id
+
+  Background:
+    Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+
+  Rule: The selected credit batch, project or credit class must exist
+
+    Scenario Outline: the selection exists
+      When alice attempts to create a quote request with the selection
+      """
+      <selection>
+      """
+      Then expect no error
+
+      Examples:
+        | description | selection                                           |
+        | batch       | {"batch_denom": "C01-001-20200101-20210101-001"}    |
+        | project     | {"project_id": "C01-001"}                           |
+        | class       | {"class_id": "C01", "project_jurisdiction": "US"}   |
+
+    Scenario: the selection does not exist
+      When alice attempts to create a quote request with the selection
+      """
+      {"project_id": "C01-002"}
+      """
+      Then expect the error "selection: project id C01-002: not found: not found: invalid request"
+
+  Rule: The denom must be an allowed denom
+
+    Scenario: denom is allowed
+      When alice attempts to create a quote request with denom "regen"
+      Then expect no error
+
+    Scenario: denom is not allowed
+      When alice attempts to create a quote request with denom "atom"
+      Then expect the error "atom is not allowed to be used in quote requests: invalid request"
+
+    Scenario: denom is a basket denom
+      Given a basket with basket denom "eco.uC.NCT"
+      When alice attempts to create a quote request with denom "eco.uC.NCT"
+      Then expect the error "eco.uC.NCT is not allowed to be used in quote requests: invalid request"
+
+  Rule: The expiration must be after the block time
+
+    Background:
+      Given a block time with timestamp "2020-01-01"
+
+    Scenario: expiration is after block time
+      When alice attempts to create a quote request with expiration "2021-01-01"
+      Then expect no error
+
+    Scenario: expiration is equal to block time
+      When alice attempts to create a quote request with expiration "2020-01-01"
+      Then expect the error "expiration must be in the future: 2020-01-01 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The number of decimal places in quantity must be less than or equal to the credit type precision
+
+    Scenario: quantity decimal places equal to precision
+      When alice attempts to create a quote request with quantity "10.123456"
+      Then expect no error
+
+    Scenario: quantity decimal places more than precision
+      When alice attempts to create a quote request with quantity "10.1234567"
+      Then expect the error "decimal places exceeds precision: quantity: 10.1234567, credit type precision: 6: invalid request"
+
+  Rule: The quote request is stored in state and the response includes the quote request id
+
+    Scenario: the quote request is stored in state
+      When alice attempts to create a quote request with quantity "100"
+      Then expect the response
+      """
+      {
+        "request_id": 1
+      }
+      """
+      And expect quote request with id "1" and quantity "100"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -0,0 +1,233 @@
+Feature: Msg/RemoveAllowedDenom
+
+  An allowed denom can be removed:
+  - when the authority is a governance account address
+  - when the denom is an allowed denom
+  - the open sell orders in the markets of the denom are cancelled unless the orders expire
+  - the open buy orders in the markets of the denom are cancelled unless the orders expire
+  - the auctions of the denom are cancelled unless the orders expire
+  - the open orders and auctions of the denom are kept if the orders expire
+  - the open orders of the denom expire no later than seven days after the removal if the orders expire
+  - the open orders of the denom cannot be filled
+  - the quote requests of the denom are closed
+  - the markets of the denom are kept
+  - the allowed denom is removed
+
+  Background:
+    Given a credit batch and an allowed denom "uregen"
+
+  Rule: The authority must be governance account address
+
+    Scenario: The authority is a governance account address
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no error
+
+    Scenario: The authority is not a governance account address
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "denom": "uregen"
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The denom must be an allowed denom
+
+    Scenario: The denom is not an allowed denom
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uatom"
+      }
+      """
+      Then expect the error "allowed denom uatom: not found: not found"
+
+  Rule: The allowed denom is removed and the markets of the denom are kept
+
+    Scenario: The allowed denom is removed and the market is kept
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no allowed denom "uregen"
+      And expect market with id "1"
+
+  Rule: The open orders and auctions of the denom are cancelled
+
+    Scenario: The sell orders are cancelled and the credits are returned to the seller
+      Given alice created a sell order with quantity "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no sell order with id "1"
+      And expect sell order with id "1" removed from the order book
+      And expect alice tradable credit balance "100" and escrowed credit balance "0"
+
+    Scenario: The buy orders are cancelled and the funds are returned to the buyer
+      Given bob created a buy order with quantity "10" and bid amount "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no buy order with id "1"
+      And expect "100uregen" returned to bob
+
+    Scenario: The auctions are cancelled and the credits and the bid are returned
+      Given alice created an auction with quantity "10" and a bid from bob with price "2"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no auction with id "1"
+      And expect alice tradable credit balance "100" and escrowed credit balance "0"
+      And expect "20uregen" returned to bob
+
+  Rule: The open orders and auctions of the denom are kept if the orders expire
+
+    Scenario: The orders and auctions are kept
+      Given alice created a sell order with quantity "10"
+      And bob created a buy order with quantity "10" and bid amount "10"
+      And alice created an auction with quantity "10" and a bid from bob with price "2"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      Then expect no allowed denom "uregen"
+      And expect sell order with id "1"
+      And expect buy order with id "1"
+      And expect auction with id "1"
+      And expect alice tradable credit balance "80" and escrowed credit balance "20"
+      And expect "" returned to bob
+
+  Rule: The open orders of the denom expire if the orders expire
+
+    Background:
+      Given the block time "2022-01-01T00:00:00Z"
+
+    Scenario: The orders without an expiration expire after the grace period
+      Given alice created a sell order with quantity "10"
+      And bob created a buy order with quantity "10" and bid amount "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      Then expect sell order with id "1" and expiration "2022-01-08T00:00:00Z"
+      And expect buy order with id "1" and expiration "2022-01-08T00:00:00Z"
+
+    Scenario: The orders expiring before the end of the grace period keep their expiration
+      Given alice created a sell order with quantity "10" and expiration "2022-01-02T00:00:00Z"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      Then expect sell order with id "1" and expiration "2022-01-02T00:00:00Z"
+
+    Scenario: The orders are removed when they expire
+      Given alice created a sell order with quantity "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      And the expired orders are pruned at "2022-01-08T00:00:00Z"
+      Then expect no sell order with id "1"
+      And expect alice tradable credit balance "100" and escrowed credit balance "0"
+
+  Rule: The open orders of the denom cannot be filled after the denom is removed
+
+    Background:
+      Given the block time "2022-01-01T00:00:00Z"
+
+    Scenario: The sell orders cannot be bought directly
+      Given alice created a sell order with quantity "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      And bob attempts to buy "10" credits directly from the sell order with id "1"
+      Then expect the error "orders[0]: uregen is not allowed to be used in sell orders: invalid request"
+
+    Scenario: The orders are not filled in the order book
+      Given alice created a sell order with quantity "10"
+      And bob created a buy order with quantity "10" and bid amount "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      And the orders are processed
+      Then expect buy order with id "1" and quantity "10"
+      And expect sell order with id "1" is a failed sell order of buy order with id "1"
+      And expect alice tradable credit balance "90" and escrowed credit balance "10"
+
+  Rule: The quote requests of the denom are closed
+
+    Scenario: The quote requests are closed when the orders are cancelled
+      Given bob created a quote request with a quote from alice with quantity "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen"
+      }
+      """
+      Then expect no quote request with id "1"
+      And expect no sell order with id "1"
+      And expect alice tradable credit balance "100" and escrowed credit balance "0"
+
+    Scenario: The quote requests are closed when the orders expire
+      Given bob created a quote request with a quote from alice with quantity "10"
+      When alice attempts to remove the allowed denom with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "denom": "uregen",
+        "expire_orders": true
+      }
+      """
+      Then expect no quote request with id "1"
+      And expect no sell order with id "1"
+      And expect alice tradable credit balance "100" and escrowed credit balance "0"
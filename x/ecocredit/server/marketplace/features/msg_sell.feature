@@ -5,8 +5,9 @@ Feature: Msg/Sell
     - when the seller owns credits from the credit batch
     - when the seller owns greater than or equal to the quantity of credits
     - when the number of decimal places in quantity is less than or equal to the credit type precision
-    - when the ask denom is an allowed denom
+    - when the ask denom is an allowed denom, a basket denom or the denom of another credit batch
     - when the expiration is after the block time
+    - when the allowed buyer group policy exists
     - the market is created when the credit type and bank denom pair is unique
     - the tradable credits are converted to escrowed credits
     - the sell orders are stored in state
@@ -99,7 +100,65 @@ Feature: Msg/Sell
       When alice attempts to create a sell order with credit quantity "99.1234567"
       Then expect the error "99.1234567 exceeds maximum decimal places: 6"
 
-  Rule: The ask denom must be an allowed denom
+  Rule: The quantity must be a multiple of the lot size
+
+    Background:
+      Given a credit type with precision "6"
+      And an allowed denom with bank denom "regen"
+      And alice has a tradable batch balance with amount "100"
+
+    Scenario Outline: quantity is a multiple of the lot size
+      When alice attempts to create a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "<quantity>",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "min_fill_quantity": "5",
+        "lot_size": "<lot-size>"
+      }
+      """
+      Then expect no error
+
+      Examples:
+        | description       | quantity | lot-size |
+        | integer lot size  | 100      | 5        |
+        | decimal lot size  | 10.5     | 0.25     |
+
+    Scenario: quantity is not a multiple of the lot size
+      When alice attempts to create a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "lot_size": "3"
+      }
+      """
+      Then expect the error "orders[0]: quantity 100 is not a multiple of the lot size 3: invalid request"
+
+    Scenario: lot size decimal places more than precision
+      When alice attempts to create a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "100",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "lot_size": "0.0000001"
+      }
+      """
+      Then expect the error "orders[0]: lot size: 0.0000001 exceeds maximum decimal places: 6: invalid request"
+
+  Rule: The ask denom must be an allowed denom, a basket denom or the denom of another credit batch
 
     Background:
       Given a credit type
@@ -115,6 +174,20 @@ Feature: Msg/Sell
       When alice attempts to create a sell order with ask price "100regen"
       Then expect the error "orders[0]: regen is not allowed to be used in sell orders: invalid request"
 
+    Scenario: ask denom is a basket denom
+      Given a basket with basket denom "eco.uC.NCT"
+      When alice attempts to create a sell order with ask price "100eco.uC.NCT"
+      Then expect no error
+
+    Scenario: ask denom is the denom of another credit batch
+      Given a credit batch with batch denom "C02-001-20200101-20210101-001"
+      When alice attempts to create a sell order with ask price "1000000C02-001-20200101-20210101-001"
+      Then expect no error
+
+    Scenario: ask denom is the denom of the credit batch being sold
+      When alice attempts to create a sell order with ask price "1000000C01-001-20200101-20210101-001"
+      Then expect the error "orders[0]: cannot ask for credits of the batch being sold: invalid request"
+
   Rule: The expiration must be after the block time
 
     Background:
@@ -136,6 +209,23 @@ Feature: Msg/Sell
       | before      | 2019-01-01 |
       | equal to    | 2020-01-01 |
 
+  Rule: The allowed buyer group policy must exist
+
+    Background:
+      Given a credit type
+      And an allowed denom
+      And alice has a tradable batch balance
+
+    Scenario: the allowed buyer group policy exists
+      Given a group policy with address "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      When alice attempts to create a sell order with allowed buyer group policy "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      Then expect no error
+      And expect sell order with allowed buyer group policy "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+
+    Scenario: the allowed buyer group policy does not exist
+      When alice attempts to create a sell order with allowed buyer group policy "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68"
+      Then expect the error "orders[0]: allowed buyer group policy regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68: not found: invalid request"
+
   Rule: The market is created when the credit type and bank denom pair is unique
 
     Background:
@@ -184,7 +274,9 @@ Feature: Msg/Sell
           "amount": "10"
         },
         "disable_auto_retire": true,
-        "expiration": "2030-01-01T00:00:00Z"
+        "expiration": "2030-01-01T00:00:00Z",
+        "min_fill_quantity": "4",
+        "lot_size": "2"
       }
       """
       Then expect sell order with seller alice and the properties
@@ -197,7 +289,50 @@ Feature: Msg/Sell
         "quantity": "10",
         "disable_auto_retire": true,
         "market_id": 1,
-        "maker": true
+        "maker": true,
+        "min_fill_quantity": "4",
+        "lot_size": "2"
+      }
+      """
+
+    Scenario: the sell order is stored in state (time in force and auto-renew)
+      Given alice has a tradable batch balance with denom "C01-001-20200101-20210101-001" and amount "10"
+      When alice attempts to create a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "expiration": "2030-01-01T00:00:00Z",
+        "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+        "auto_renew": {
+          "renewal_period": "86400s",
+          "price_decay": "0.1",
+          "floor_amount": "5",
+          "max_renewals": 3
+        }
+      }
+      """
+      Then expect sell order with seller alice and the properties
+      """
+      {
+        "id": 1,
+        "ask_amount": "10",
+        "expiration": "2030-01-01T00:00:00Z",
+        "batch_key": 1,
+        "quantity": "10",
+        "market_id": 1,
+        "maker": true,
+        "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE",
+        "auto_renew": {
+          "renewal_period": "86400s",
+          "price_decay": "0.1",
+          "floor_amount": "5",
+          "max_renewals": 3
+        }
       }
       """
 
@@ -0,0 +1,124 @@
+Feature: Msg/SetMarketFees
+
+  The fees of a market can be set:
+  - when the authority is a governance account address
+  - when the credit type exists
+  - when the bank denom is an allowed denom
+  - the market is created if it does not exist
+
+  Background:
+    Given a credit type with abbreviation "C"
+    And an allowed denom "uregen"
+
+  Rule: The authority must be governance account address
+
+    Scenario: The authority is a governance account address
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01",
+        "seller_fee": "0.02",
+        "fee_destination": "FEE_DESTINATION_COMMUNITY_POOL"
+      }
+      """
+      Then expect no error
+
+    Scenario: The authority is not a governance account address
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1fua8speyxgempgy06gpfs0p4z32zznkqakm57s",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01",
+        "seller_fee": "0.02",
+        "fee_destination": "FEE_DESTINATION_COMMUNITY_POOL"
+      }
+      """
+      Then expect error contains "expected gov account as only signer for proposal message"
+
+  Rule: The credit type must exist
+
+    Scenario: The credit type does not exist
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "BIO",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01"
+      }
+      """
+      Then expect error contains "credit type BIO: not found"
+
+  Rule: The bank denom must be an allowed denom
+
+    Scenario: The bank denom is not an allowed denom
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uatom",
+        "buyer_fee": "0.01"
+      }
+      """
+      Then expect the error "uatom is not allowed to be used in the marketplace: invalid request"
+
+  Rule: The fees of the market are set
+
+    Scenario: The market does not exist
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01",
+        "seller_fee": "0.02",
+        "fee_destination": "FEE_DESTINATION_CLASS_ADMIN"
+      }
+      """
+      Then expect market with properties
+      """
+      {
+        "id": 1,
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01",
+        "seller_fee": "0.02",
+        "fee_destination": "FEE_DESTINATION_CLASS_ADMIN"
+      }
+      """
+
+    Scenario: The fees of the market are removed
+      Given alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen",
+        "buyer_fee": "0.01",
+        "seller_fee": "0.02",
+        "fee_destination": "FEE_DESTINATION_CLASS_ADMIN"
+      }
+      """
+      When alice attempts to set the market fees with properties
+      """
+      {
+        "authority": "regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68",
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen"
+      }
+      """
+      Then expect market with properties
+      """
+      {
+        "id": 1,
+        "credit_type_abbrev": "C",
+        "bank_denom": "uregen"
+      }
+      """
@@ -0,0 +1,111 @@
+Feature: Msg/SubmitQuote
+
+  A quote can be submitted:
+    - when the quote request exists
+    - when the seller is not the buyer of the quote request
+    - when the credit batch matches the selection of the quote request
+    - when the ask denom matches the denom of the quote request
+    - when the seller owns greater than or equal to the quantity of the quote request
+    - the quote expires with the quote request if it does not expire before it
+    - the credits are escrowed in a private sell order for the buyer
+    - the quote is stored in state
+
+  Background:
+    Given a credit batch with batch denom "C01-001-20200101-20210101-001"
+    And alice has a tradable batch balance with amount "100"
+
+  Rule: The quote request must exist
+
+    Scenario: quote request exists
+      Given bob created a quote request with quantity "100"
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect no error
+
+    Scenario: quote request does not exist
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect the error "quote request with id 1: not found: invalid request"
+
+  Rule: The seller must not be the buyer of the quote request
+
+    Scenario: seller is the buyer
+      Given alice created a quote request with quantity "100"
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect the error "seller cannot be the buyer of the quote request: unauthorized"
+
+  Rule: The credit batch must match the selection of the quote request
+
+    Scenario: credit batch matches the selection
+      Given bob created a quote request with the selection
+      """
+      {"class_id": "C01", "project_jurisdiction": "US", "min_start_date": "2020-01-01T00:00:00Z"}
+      """
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect no error
+
+    Scenario: credit batch does not match the selection
+      Given bob created a quote request with the selection
+      """
+      {"project_id": "C01-001", "max_end_date": "2020-06-01T00:00:00Z"}
+      """
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect the error "batch denom C01-001-20200101-20210101-001 does not match the selection of the quote request: invalid request"
+
+  Rule: The ask denom must match the denom of the quote request
+
+    Scenario: ask denom does not match
+      Given bob created a quote request with quantity "100"
+      When alice attempts to submit a quote with ask price "10atom"
+      Then expect the error "ask price denom: atom, quote request denom: regen: invalid request"
+
+  Rule: The seller must own greater than or equal to the quantity of the quote request
+
+    Scenario: seller owns less than the quantity
+      Given bob created a quote request with quantity "150"
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect the error "quote: credit quantity: 150, tradable balance: 100: insufficient credit balance"
+
+  Rule: The quote expires with the quote request if it does not expire before it
+
+    Background:
+      Given a block time with timestamp "2020-01-01"
+      And bob created a quote request with expiration "2022-01-01"
+
+    Scenario Outline: the expiration of the sell order of the quote
+      When alice attempts to submit a quote with expiration "<expiration>"
+      Then expect sell order with id "1" and expiration "<expected>"
+
+      Examples:
+        | description | expiration | expected   |
+        | before      | 2021-01-01 | 2021-01-01 |
+        | after       | 2023-01-01 | 2022-01-01 |
+
+    Scenario: the expiration is not in the future
+      When alice attempts to submit a quote with expiration "2020-01-01"
+      Then expect the error "expiration must be in the future: 2020-01-01 00:00:00 +0000 UTC: invalid request"
+
+  Rule: The credits are escrowed in a private sell order for the buyer
+
+    Scenario: the credits are escrowed
+      Given bob created a quote request with quantity "100"
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect alice tradable credit balance "0"
+      And expect alice escrowed credit balance "100"
+      And expect sell order with id "1" private to bob
+
+    # no failing scenario - state transitions only occur upon successful message execution
+
+  Rule: The quote is stored in state
+
+    Scenario: the quote is stored in state
+      Given bob created a quote request with quantity "100"
+      When alice attempts to submit a quote with ask price "10regen"
+      Then expect the response
+      """
+      {
+        "quote_id": 1,
+        "sell_order_id": 1
+      }
+      """
+      And expect quote with id "1" for quote request "1" and sell order "1"
+
+    # no failing scenario - state transitions only occur upon successful message execution
@@ -7,6 +7,7 @@ Feature: Msg/UpdateSellOrders
     - when the number of decimal places in quantity is less than or equal to the credit type precision
     - when the ask denom is an allowed denom
     - when the expiration is after the block time
+    - when the expiration is not set for a good-til-cancelled sell order
     - the market is created when the credit type and bank denom pair is unique
     - the escrowed credits are converted to tradable credits when quantity is reduced
     - the tradable credits are converted to escrowed credits when quantity is increased
@@ -102,6 +103,68 @@ Feature: Msg/UpdateSellOrders
       When alice attempts to update the sell order with quantity "99.1234567"
       Then expect the error "99.1234567 exceeds maximum decimal places: 6"
 
+  Rule: The quantity must be a multiple of the lot size
+
+    Background:
+      Given a credit type
+      And an allowed denom with bank denom "regen"
+      And alice created a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "expiration": "2030-01-01T00:00:00Z",
+        "min_fill_quantity": "4",
+        "lot_size": "2"
+      }
+      """
+      And alice has a batch balance with tradable amount "10" and escrowed amount "10"
+
+    Scenario: quantity is a multiple of the lot size
+      When alice attempts to update the sell order with quantity "12"
+      Then expect no error
+
+    Scenario: quantity is not a multiple of the lot size
+      When alice attempts to update the sell order with quantity "11"
+      Then expect the error "updates[0]: quantity 11 is not a multiple of the lot size 2: invalid request"
+
+    Scenario: quantity is not a multiple of the new lot size
+      When alice attempts to update the sell order with the properties
+      """
+      {
+        "new_quantity": "10",
+        "new_lot_size": "3"
+      }
+      """
+      Then expect the error "updates[0]: quantity 10 is not a multiple of the lot size 3: invalid request"
+
+    Scenario: the minimum fill quantity and the lot size are removed
+      When alice attempts to update the sell order with the properties
+      """
+      {
+        "new_quantity": "11",
+        "new_min_fill_quantity": "0",
+        "new_lot_size": "0"
+      }
+      """
+      Then expect no error
+      And expect sell order with seller alice and the properties
+      """
+      {
+        "id": 1,
+        "ask_amount": "10",
+        "expiration": "2030-01-01T00:00:00Z",
+        "batch_key": 1,
+        "quantity": "11",
+        "market_id": 1,
+        "maker": true
+      }
+      """
+
   Rule: The ask denom must be an allowed denom
 
     Background:
@@ -140,6 +203,46 @@ Feature: Msg/UpdateSellOrders
       | before      | 2019-01-01 |
       | equal to    | 2020-01-01 |
 
+  Rule: The expiration cannot be set for good-til-cancelled sell orders
+
+    Background:
+      Given a block time with timestamp "2020-01-01"
+      And a credit type
+      And an allowed denom with bank denom "regen"
+
+    Scenario: the sell order is good-til-date
+      Given alice created a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "expiration": "2030-01-01T00:00:00Z",
+        "time_in_force": "TIME_IN_FORCE_GOOD_TIL_DATE"
+      }
+      """
+      When alice attempts to update the sell order with expiration "2021-01-01"
+      Then expect no error
+
+    Scenario: the sell order is good-til-cancelled
+      Given alice created a sell order with the properties
+      """
+      {
+        "batch_denom": "C01-001-20200101-20210101-001",
+        "quantity": "10",
+        "ask_price": {
+          "denom": "regen",
+          "amount": "10"
+        },
+        "time_in_force": "TIME_IN_FORCE_GOOD_TIL_CANCELLED"
+      }
+      """
+      When alice attempts to update the sell order with expiration "2021-01-01"
+      Then expect the error "updates[0]: expiration cannot be set for good-til-cancelled sell orders: invalid request"
+
   Rule: The market is created when the credit type and bank denom pair is unique
 
     Background:
@@ -0,0 +1,106 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// getFee returns the fee for the total cost at the fee rate rounded down to an integer
+// amount. No fee is charged if the fee rate is empty.
+func getFee(cost sdk.Int, rate string) (sdk.Int, error) {
+	if rate == "" {
+		return sdk.ZeroInt(), nil
+	}
+
+	feeRate, err := math.NewDecFromString(rate)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	total, err := math.NewDecFromString(cost.String())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	fee, err := total.Mul(feeRate)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return fee.SdkIntTrim(), nil
+}
+
+// payCoins pays the cost of a sell order in coins. The seller receives the cost less the
+// seller fee and the buyer fee and seller fee are sent to the fee destination of the
+// market. The coins are paid from escrow if the order is escrowed.
+func (k Keeper) payCoins(ctx context.Context, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress, cost sdk.Coin, opts orderOptions) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	proceeds := sdk.NewCoins(sdk.NewCoin(cost.Denom, cost.Amount.Sub(opts.sellerFee)))
+	var err error
+	if opts.escrowed {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, sellOrder.Seller, proceeds)
+	} else {
+		err = k.bankKeeper.SendCoins(sdkCtx, buyerAcc, sellOrder.Seller, proceeds)
+	}
+	if err != nil {
+		return err
+	}
+
+	fee := opts.buyerFee.Add(opts.sellerFee)
+	if !fee.IsPositive() {
+		return nil
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	return k.distributeFee(ctx, market, sellOrder.BatchKey, buyerAcc, opts.escrowed, sdk.NewCoin(cost.Denom, fee))
+}
+
+// distributeFee sends the fee collected when credits of the batch are sold in the market
+// to the fee destination of the market, i.e. it burns the fee, funds the community pool or
+// sends the fee to the admin of the credit class of the credits. The fee is paid from escrow
+// if the order is escrowed and from the buyer otherwise.
+func (k Keeper) distributeFee(ctx context.Context, market *api.Market, batchKey uint64, buyerAcc sdk.AccAddress, escrowed bool, fee sdk.Coin) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	fees := sdk.NewCoins(fee)
+
+	switch market.FeeDestination {
+	case api.FeeDestination_FEE_DESTINATION_COMMUNITY_POOL:
+		payer := buyerAcc
+		if escrowed {
+			payer = k.moduleAddr
+		}
+		return k.distrKeeper.FundCommunityPool(sdkCtx, fees, payer)
+	case api.FeeDestination_FEE_DESTINATION_CLASS_ADMIN:
+		batch, err := k.coreStore.BatchTable().Get(ctx, batchKey)
+		if err != nil {
+			return err
+		}
+		class, err := utils.GetBatchClass(ctx, k.coreStore, batch)
+		if err != nil {
+			return err
+		}
+		if escrowed {
+			return k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, class.Admin, fees)
+		}
+		return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, class.Admin, fees)
+	default:
+		if !escrowed {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, fees); err != nil {
+				return err
+			}
+		}
+		return k.bankKeeper.BurnCoins(sdkCtx, ecocredit.ModuleName, fees)
+	}
+}
@@ -0,0 +1,36 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// RegisterInvariants registers the marketplace invariants.
+func (k Keeper) RegisterInvariants(ir sdk.InvariantRegistry) {
+	ir.RegisterRoute(ecocredit.ModuleName, "order-book", k.orderBookInvariant())
+}
+
+func (k Keeper) orderBookInvariant() sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := OrderBookInvariant(sdk.WrapSDKContext(ctx), k)
+		return sdk.FormatInvariant(ecocredit.ModuleName, "order-book", msg), broken
+	}
+}
+
+// OrderBookInvariant checks that the order book is consistent with an order book
+// built from scratch from the buy orders and sell orders in state. The order book is
+// only checked once it has been loaded after the node started up.
+func OrderBookInvariant(ctx context.Context, k Keeper) (string, bool) {
+	if !k.orderBook.Loaded() {
+		return "order book has not been loaded", false
+	}
+
+	if err := k.orderBook.CheckConsistency(ctx); err != nil {
+		return err.Error(), true
+	}
+
+	return "", false
+}
@@ -0,0 +1,56 @@
+package marketplace
+
+import (
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestOrderBookInvariant(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+	_, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  "10",
+		MarketId:  1,
+		BidAmount: "10",
+	})
+	assert.NilError(t, err)
+
+	// the order book is not checked before it has been loaded
+	msg, broken := OrderBookInvariant(s.ctx, s.k)
+	assert.Check(t, !broken)
+	assert.Equal(t, "order book has not been loaded", msg)
+
+	assert.NilError(t, s.orderBook.Reload(s.ctx))
+	_, broken = OrderBookInvariant(s.ctx, s.k)
+	assert.Check(t, !broken)
+
+	// a buy order added to state without updating the order book
+	_, err = s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  "10",
+		MarketId:  1,
+		BidAmount: "20",
+	})
+	assert.NilError(t, err)
+
+	msg, broken = OrderBookInvariant(s.ctx, s.k)
+	assert.Check(t, broken)
+	assert.Check(t, strings.Contains(msg, "missing match of buy order 2 and sell order 1"), msg)
+}
@@ -2,27 +2,39 @@ package marketplace
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	basketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
 type Keeper struct {
 	stateStore   marketApi.StateStore
 	coreStore    ecoApi.StateStore
+	basketStore  basketApi.StateStore
 	bankKeeper   ecocredit.BankKeeper
+	distrKeeper  ecocredit.DistributionKeeper
+	groupKeeper  ecocredit.GroupKeeper
 	paramsKeeper ecocredit.ParamKeeper
+	orderBook    orderbook.OrderBook
+	moduleAddr   sdk.AccAddress
 	authority    sdk.AccAddress
 }
 
-func NewKeeper(ss marketApi.StateStore, cs ecoApi.StateStore, bk ecocredit.BankKeeper,
-	params ecocredit.ParamKeeper, authority sdk.AccAddress) Keeper {
+func NewKeeper(ss marketApi.StateStore, cs ecoApi.StateStore, bs basketApi.StateStore, bk ecocredit.BankKeeper,
+	dk ecocredit.DistributionKeeper, gk ecocredit.GroupKeeper, params ecocredit.ParamKeeper, ob orderbook.OrderBook, moduleAddr, authority sdk.AccAddress) Keeper {
 	return Keeper{
 		coreStore:    cs,
 		stateStore:   ss,
+		basketStore:  bs,
 		bankKeeper:   bk,
+		distrKeeper:  dk,
+		groupKeeper:  gk,
 		paramsKeeper: params,
+		orderBook:    ob,
+		moduleAddr:   moduleAddr,
 		authority:    authority,
 	}
 }
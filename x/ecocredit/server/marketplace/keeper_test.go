@@ -2,6 +2,7 @@ package marketplace
 
 import (
 	"context"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/regen-network/gocuke"
@@ -19,7 +20,9 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	basketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types/math"
@@ -27,6 +30,7 @@ import (
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 	"github.com/regen-network/regen-ledger/x/ecocredit/mocks"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
@@ -38,12 +42,16 @@ type baseSuite struct {
 	t            gocuke.TestingT
 	db           ormdb.ModuleDB
 	coreStore    ecoApi.StateStore
+	basketStore  basketApi.StateStore
 	marketStore  api.StateStore
+	orderBook    orderbook.OrderBook
 	ctx          context.Context
 	k            Keeper
 	ctrl         *gomock.Controller
 	addrs        []sdk.AccAddress
 	bankKeeper   *mocks.MockBankKeeper
+	distrKeeper  *mocks.MockDistributionKeeper
+	groupKeeper  *mocks.MockGroupKeeper
 	paramsKeeper *mocks.MockParamKeeper
 	storeKey     *storetypes.KVStoreKey
 	sdkCtx       sdk.Context
@@ -57,8 +65,14 @@ func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	assert.NilError(t, err)
 	s.coreStore, err = ecoApi.NewStateStore(s.db)
 	assert.NilError(t, err)
+	s.basketStore, err = basketApi.NewStateStore(s.db)
+	assert.NilError(t, err)
 	s.marketStore, err = api.NewStateStore(s.db)
 	assert.NilError(t, err)
+	memDB, err := ormdb.NewModuleDB(&ecocredit.OrderBookSchema, ormdb.ModuleDBOptions{})
+	assert.NilError(t, err)
+	s.orderBook, err = orderbook.NewOrderBook(memDB, s.db)
+	assert.NilError(t, err)
 
 	db := dbm.NewMemDB()
 	cms := store.NewCommitMultiStore(db)
@@ -73,11 +87,14 @@ func setupBase(t gocuke.TestingT, numAddresses int) *baseSuite {
 	s.ctrl = gomock.NewController(t)
 	assert.NilError(t, err)
 	s.bankKeeper = mocks.NewMockBankKeeper(s.ctrl)
+	s.distrKeeper = mocks.NewMockDistributionKeeper(s.ctrl)
+	s.groupKeeper = mocks.NewMockGroupKeeper(s.ctrl)
 	s.paramsKeeper = mocks.NewMockParamKeeper(s.ctrl)
 
 	authority, err := sdk.AccAddressFromBech32("regen1nzh226hxrsvf4k69sa8v0nfuzx5vgwkczk8j68")
 	assert.NilError(t, err)
-	s.k = NewKeeper(s.marketStore, s.coreStore, s.bankKeeper, s.paramsKeeper, authority)
+	moduleAddress := authtypes.NewModuleAddress(ecocredit.ModuleName)
+	s.k = NewKeeper(s.marketStore, s.coreStore, s.basketStore, s.bankKeeper, s.distrKeeper, s.groupKeeper, s.paramsKeeper, s.orderBook, moduleAddress, authority)
 
 	// set test accounts
 	for i := 0; i < numAddresses; i++ {
@@ -189,6 +206,11 @@ func (s *baseSuite) testSellSetup(batchDenom, bankDenom, displayDenom, classId s
 		Metadata:         "",
 		CreditTypeAbbrev: creditType.Abbreviation,
 	}))
+	assert.NilError(s.t, s.coreStore.ProjectTable().Insert(s.ctx, &ecoApi.Project{
+		Id:       classId + "-001",
+		Admin:    s.addrs[0],
+		ClassKey: 1,
+	}))
 	assert.NilError(s.t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{
 		ProjectKey: 1,
 		Denom:      batchDenom,
@@ -221,3 +243,45 @@ func (s *baseSuite) testSellSetup(batchDenom, bankDenom, displayDenom, classId s
 		CancelledAmount: "0",
 	}))
 }
+
+// quoteSetup sets up a credit type, class, project and batch with the batch denom
+// "C01-001-20200101-20210101-001" and the allowed denom "regen" for quote requests.
+func (s *baseSuite) quoteSetup() uint64 {
+	assert.NilError(s.t, s.coreStore.CreditTypeTable().Insert(s.ctx, &ecoApi.CreditType{
+		Abbreviation: "C",
+		Name:         "carbon",
+		Unit:         "metric ton C02",
+		Precision:    6,
+	}))
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &ecoApi.Class{
+		Id:               "C01",
+		Admin:            s.addrs[0],
+		CreditTypeAbbrev: "C",
+	})
+	assert.NilError(s.t, err)
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &ecoApi.Project{
+		Id:           "C01-001",
+		Admin:        s.addrs[0],
+		ClassKey:     classKey,
+		Jurisdiction: "US-OR",
+	})
+	assert.NilError(s.t, err)
+	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &ecoApi.Batch{
+		ProjectKey: projectKey,
+		Denom:      "C01-001-20200101-20210101-001",
+		StartDate:  timestamppb.New(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)),
+		EndDate:    timestamppb.New(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)),
+	})
+	assert.NilError(s.t, err)
+	assert.NilError(s.t, s.coreStore.BatchSupplyTable().Insert(s.ctx, &ecoApi.BatchSupply{
+		BatchKey:        batchKey,
+		TradableAmount:  "1000",
+		RetiredAmount:   "0",
+		CancelledAmount: "0",
+	}))
+	assert.NilError(s.t, s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom:    "regen",
+		DisplayDenom: "regen",
+	}))
+	return batchKey
+}
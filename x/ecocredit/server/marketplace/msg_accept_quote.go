@@ -0,0 +1,125 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// AcceptQuote fills the sell order of a quote in full at the quoted price and closes
+// the quote request, returning the escrowed credits of the other quotes to their
+// sellers. Market fees are charged as they are for direct purchases.
+func (k Keeper) AcceptQuote(ctx context.Context, req *marketplace.MsgAcceptQuote) (*marketplace.MsgAcceptQuoteResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	quote, err := k.stateStore.QuoteTable().Get(ctx, req.QuoteId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("quote with id %d: %s", req.QuoteId, err.Error())
+	}
+
+	request, err := k.stateStore.QuoteRequestTable().Get(ctx, quote.QuoteRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !buyerAcc.Equals(sdk.AccAddress(request.Buyer)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("buyer must be the owner of the quote request")
+	}
+
+	// the sell order of the quote no longer exists if the seller cancelled it or it expired
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, quote.SellOrderId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"sell order with id %d of quote with id %d: %s", quote.SellOrderId, quote.Id, err.Error(),
+		)
+	}
+
+	quantity, err := math.NewPositiveDecFromString(sellOrder.Quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
+	}
+
+	// check that the denom has not been removed from the allowed denoms
+	allowed, err := k.isDenomAllowed(ctx, market.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not an allowed denom", market.BankDenom)
+	}
+
+	askAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
+	if !ok {
+		return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sellOrder.AskAmount, sdk.Int{})
+	}
+
+	cost, err := getTotalCost(askAmount, quantity)
+	if err != nil {
+		return nil, err
+	}
+	coinCost := sdk.Coin{Amount: cost, Denom: market.BankDenom}
+
+	buyerFee, err := getFee(cost, market.BuyerFee)
+	if err != nil {
+		return nil, err
+	}
+	sellerFee, err := getFee(cost, market.SellerFee)
+	if err != nil {
+		return nil, err
+	}
+
+	// check address has the total cost (price per * quantity) and the buyer fee
+	totalCost := coinCost.AddAmount(buyerFee)
+	bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, market.BankDenom)
+	if bal.IsLT(totalCost) {
+		return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+			"quantity: %s, ask price: %s%s, total price: %v, bank balance: %v",
+			sellOrder.Quantity, sellOrder.AskAmount, market.BankDenom, totalCost, bal,
+		)
+	}
+
+	if err = k.fillOrder(ctx, "quote", sellOrder, buyerAcc, quantity, coinCost, orderOptions{
+		autoRetire:   !request.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: request.RetirementJurisdiction,
+		beneficiary:  request.RetirementBeneficiary,
+		reason:       request.RetirementReason,
+		buyerFee:     buyerFee,
+		sellerFee:    sellerFee,
+		price:        askAmount,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventAcceptQuote{
+		QuoteId:   quote.Id,
+		RequestId: request.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.closeQuoteRequest(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgAcceptQuoteResponse{}, nil
+}
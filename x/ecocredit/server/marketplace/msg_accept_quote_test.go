@@ -0,0 +1,256 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type acceptQuoteSuite struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	carol    sdk.AccAddress
+	batchKey uint64
+	err      error
+}
+
+func TestAcceptQuote(t *testing.T) {
+	gocuke.NewRunner(t, &acceptQuoteSuite{}).Path("./features/msg_accept_quote.feature").Run()
+}
+
+func (s *acceptQuoteSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 3)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.carol = s.addrs[2]
+}
+
+func (s *acceptQuoteSuite) ACreditBatchWithBatchDenom(a string) {
+	require.Equal(s.t, "C01-001-20200101-20210101-001", a)
+	s.batchKey = s.quoteSetup()
+}
+
+func (s *acceptQuoteSuite) AliceHasATradableBatchBalanceWithAmount(a string) {
+	s.tradableBalance(s.alice, a)
+}
+
+func (s *acceptQuoteSuite) CarolHasATradableBatchBalanceWithAmount(a string) {
+	s.tradableBalance(s.carol, a)
+}
+
+func (s *acceptQuoteSuite) BobCreatedAQuoteRequestWithQuantity(a string) {
+	s.createQuoteRequest(a, true)
+}
+
+func (s *acceptQuoteSuite) BobCreatedAQuoteRequestWithQuantityAndAutoretireEnabled(a string) {
+	s.createQuoteRequest(a, false)
+}
+
+func (s *acceptQuoteSuite) AliceSubmittedAQuoteWithAskPrice(a string) {
+	s.submitQuote(s.alice, a)
+}
+
+func (s *acceptQuoteSuite) CarolSubmittedAQuoteWithAskPrice(a string) {
+	s.submitQuote(s.carol, a)
+}
+
+func (s *acceptQuoteSuite) AliceCancelledTheSellOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.k.CancelSellOrder(s.ctx, &marketplace.MsgCancelSellOrder{
+		Seller:      s.alice.String(),
+		SellOrderId: id,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptQuoteSuite) TheDenomIsNoLongerAllowed(a string) {
+	err := s.marketStore.AllowedDenomTable().Delete(s.ctx, &api.AllowedDenom{BankDenom: a})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptQuoteSuite) BobHasABankBalance(a string) {
+	balance, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	s.bankKeeper.EXPECT().
+		GetBalance(gmAny, s.bob, balance.Denom).
+		Return(balance).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoins(gmAny, s.bob, gmAny, gmAny).
+		Return(nil).
+		AnyTimes()
+}
+
+func (s *acceptQuoteSuite) BobAttemptsToAcceptTheQuoteWithId(a string) {
+	s.acceptQuote(s.bob, a)
+}
+
+func (s *acceptQuoteSuite) CarolAttemptsToAcceptTheQuoteWithId(a string) {
+	s.acceptQuote(s.carol, a)
+}
+
+func (s *acceptQuoteSuite) AliceAttemptsToUpdateTheAskPriceOfTheSellOrderWithIdTo(a, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	price, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.UpdateSellOrders(s.ctx, &marketplace.MsgUpdateSellOrders{
+		Seller: s.alice.String(),
+		Updates: []*marketplace.MsgUpdateSellOrders_Update{
+			{
+				SellOrderId:       id,
+				NewAskPrice:       &price,
+				DisableAutoRetire: true,
+			},
+		},
+	})
+}
+
+func (s *acceptQuoteSuite) BobBuysCreditsDirectlyFromTheSellOrderWithId(a, b string) {
+	id, err := strconv.ParseUint(b, 10, 64)
+	require.NoError(s.t, err)
+
+	bidPrice := sdk.NewInt64Coin("regen", 10)
+
+	_, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId:       id,
+				Quantity:          a,
+				BidPrice:          &bidPrice,
+				DisableAutoRetire: true,
+			},
+		},
+	})
+}
+
+func (s *acceptQuoteSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *acceptQuoteSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *acceptQuoteSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.alice, a)
+}
+
+func (s *acceptQuoteSuite) ExpectBobBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.bob, a)
+}
+
+func (s *acceptQuoteSuite) ExpectCarolBatchBalance(a gocuke.DocString) {
+	s.expectBatchBalance(s.carol, a)
+}
+
+func (s *acceptQuoteSuite) ExpectNoSellOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *acceptQuoteSuite) ExpectNoQuoteRequestWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.QuoteRequestTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *acceptQuoteSuite) ExpectNoQuotesForQuoteRequest(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	it, err := s.marketStore.QuoteTable().List(s.ctx, api.QuoteQuoteRequestIdIndexKey{}.WithQuoteRequestId(id))
+	require.NoError(s.t, err)
+	defer it.Close()
+
+	require.False(s.t, it.Next())
+}
+
+func (s *acceptQuoteSuite) tradableBalance(addr sdk.AccAddress, amount string) {
+	err := s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        addr,
+		TradableAmount: amount,
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptQuoteSuite) createQuoteRequest(quantity string, disableAutoRetire bool) {
+	_, err := s.k.CreateQuoteRequest(s.ctx, &marketplace.MsgCreateQuoteRequest{
+		Buyer: s.bob.String(),
+		Selection: &marketplace.MsgBuy_Selection{
+			Sum: &marketplace.MsgBuy_Selection_ClassId{ClassId: "C01"},
+		},
+		Quantity:               quantity,
+		Denom:                  "regen",
+		DisableAutoRetire:      disableAutoRetire,
+		RetirementJurisdiction: "US-WA",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptQuoteSuite) submitQuote(seller sdk.AccAddress, askPrice string) {
+	price, err := sdk.ParseCoinNormalized(askPrice)
+	require.NoError(s.t, err)
+
+	_, err = s.k.SubmitQuote(s.ctx, &marketplace.MsgSubmitQuote{
+		Seller:     seller.String(),
+		RequestId:  1,
+		BatchDenom: "C01-001-20200101-20210101-001",
+		AskPrice:   &price,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *acceptQuoteSuite) acceptQuote(buyer sdk.AccAddress, quoteId string) {
+	id, err := strconv.ParseUint(quoteId, 10, 64)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.AcceptQuote(s.ctx, &marketplace.MsgAcceptQuote{
+		Buyer:   buyer.String(),
+		QuoteId: id,
+	})
+}
+
+func (s *acceptQuoteSuite) expectBatchBalance(addr sdk.AccAddress, a gocuke.DocString) {
+	expected := &coreapi.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, addr, s.batchKey)
+	require.NoError(s.t, err)
+
+	if expected.TradableAmount != "" {
+		require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	}
+	if expected.RetiredAmount != "" {
+		require.Equal(s.t, expected.RetiredAmount, balance.RetiredAmount)
+	}
+	if expected.EscrowedAmount != "" {
+		require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+	}
+}
@@ -0,0 +1,141 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Bid places a bid in an auction and moves the total cost of the bid and the buyer fee of
+// the market into escrow. A bid in a dutch auction wins the auction at the current price of
+// the auction and closes the auction, which is then settled in the next begin blocker. A bid
+// in an english auction becomes the highest bid of the auction and the escrowed funds of the
+// previous highest bid are returned to the previous bidder.
+func (k Keeper) Bid(ctx context.Context, req *marketplace.MsgBid) (*marketplace.MsgBidResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	bidderAcc, err := sdk.AccAddressFromBech32(req.Bidder)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.AuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	if bidderAcc.Equals(sdk.AccAddress(auction.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("bidder cannot be the seller of the auction")
+	}
+
+	blockTime := sdkCtx.BlockTime()
+	if blockTime.Before(auction.StartTime.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction has not started: start time: %s", auction.StartTime.AsTime())
+	}
+	if !blockTime.Before(auction.EndTime.AsTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d has ended", auction.Id)
+	}
+
+	if req.BidPrice.Denom != auction.Denom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"bid price denom: %s, auction denom: %s", req.BidPrice.Denom, auction.Denom,
+		)
+	}
+
+	// check that the denom has not been removed from the allowed denoms
+	allowed, err := k.isDenomAllowed(ctx, auction.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not an allowed denom", auction.Denom)
+	}
+
+	bidPrice := req.BidPrice.Amount
+	switch auction.AuctionType {
+	case api.AuctionType_AUCTION_TYPE_DUTCH:
+		price, err := dutchAuctionPrice(auction, blockTime)
+		if err != nil {
+			return nil, err
+		}
+		if bidPrice.LT(price) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"bid price: %v, current price: %s%s, insufficient bid price", req.BidPrice, price, auction.Denom,
+			)
+		}
+
+		// the auction is won at the current price and closed to further bids
+		bidPrice = price
+		auction.EndTime = timestamppb.New(blockTime)
+	case api.AuctionType_AUCTION_TYPE_ENGLISH:
+		startPrice, err := parseInt(auction.StartPrice)
+		if err != nil {
+			return nil, err
+		}
+		if bidPrice.LT(startPrice) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"bid price: %v, start price: %s%s, insufficient bid price", req.BidPrice, startPrice, auction.Denom,
+			)
+		}
+
+		if len(auction.Bidder) != 0 {
+			highestBid, err := parseInt(auction.BidPrice)
+			if err != nil {
+				return nil, err
+			}
+			if !bidPrice.GT(highestBid) {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"bid price: %v, highest bid: %s%s, bid price must be greater than the highest bid",
+					req.BidPrice, highestBid, auction.Denom,
+				)
+			}
+			if err = k.refundBid(ctx, auction); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("unknown auction type: %s", auction.AuctionType)
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return nil, err
+	}
+
+	cost, err := getAuctionCost(auction, bidPrice)
+	if err != nil {
+		return nil, err
+	}
+	buyerFee, err := getFee(cost.Amount, market.BuyerFee)
+	if err != nil {
+		return nil, err
+	}
+	escrow := sdk.NewCoin(cost.Denom, cost.Amount.Add(buyerFee))
+	if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, bidderAcc, ecocredit.ModuleName, sdk.NewCoins(escrow)); err != nil {
+		return nil, sdkerrors.Wrapf(err, "total price: %v", escrow)
+	}
+
+	auction.Bidder = bidderAcc
+	auction.BidPrice = bidPrice.String()
+	auction.BuyerFee = market.BuyerFee
+	if err = k.stateStore.AuctionTable().Update(ctx, auction); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBid{
+		AuctionId: auction.Id,
+		Bidder:    bidderAcc.String(),
+		BidPrice:  &sdk.Coin{Denom: auction.Denom, Amount: bidPrice},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgBidResponse{}, nil
+}
@@ -0,0 +1,219 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type bidSuite struct {
+	*baseSuite
+	alice     sdk.AccAddress
+	bob       sdk.AccAddress
+	carol     sdk.AccAddress
+	auctionId uint64
+	escrowed  map[string]sdk.Coins
+	refunded  map[string]sdk.Coins
+	err       error
+}
+
+func TestBid(t *testing.T) {
+	gocuke.NewRunner(t, &bidSuite{}).Path("./features/msg_bid.feature").Run()
+}
+
+func (s *bidSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 3)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.carol = s.addrs[2]
+	s.escrowed = make(map[string]sdk.Coins)
+	s.refunded = make(map[string]sdk.Coins)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gmAny, gmAny, gmAny, gmAny).
+		DoAndReturn(func(_ sdk.Context, addr sdk.AccAddress, _ string, coins sdk.Coins) error {
+			s.escrowed[addr.String()] = s.escrowed[addr.String()].Add(coins...)
+			return nil
+		}).
+		AnyTimes()
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, gmAny, gmAny, gmAny).
+		DoAndReturn(func(_ sdk.Context, _ string, addr sdk.AccAddress, coins sdk.Coins) error {
+			s.refunded[addr.String()] = s.refunded[addr.String()].Add(coins...)
+			return nil
+		}).
+		AnyTimes()
+}
+
+func (s *bidSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *bidSuite) AliceCreatedAnAuction(a gocuke.DocString) {
+	auction := &api.Auction{}
+	err := protojson.Unmarshal([]byte(a.Content), auction)
+	require.NoError(s.t, err)
+
+	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.alice,
+		EscrowedAmount: auction.Quantity,
+	})
+	require.NoError(s.t, err)
+
+	err = s.marketStore.AllowedDenomTable().Save(s.ctx, &api.AllowedDenom{
+		BankDenom:    auction.Denom,
+		DisplayDenom: auction.Denom,
+	})
+	require.NoError(s.t, err)
+
+	marketId, err := s.k.getOrCreateMarketId(s.ctx, "C", auction.Denom)
+	require.NoError(s.t, err)
+
+	auction.Seller = s.alice
+	auction.BatchKey = batchKey
+	auction.MarketId = marketId
+
+	s.auctionId, err = s.marketStore.AuctionTable().InsertReturningID(s.ctx, auction)
+	require.NoError(s.t, err)
+}
+
+func (s *bidSuite) TheDenomIsNoLongerAllowed(a string) {
+	err := s.marketStore.AllowedDenomTable().Delete(s.ctx, &api.AllowedDenom{BankDenom: a})
+	require.NoError(s.t, err)
+}
+
+func (s *bidSuite) CarolPlacedABidWithBidPrice(a string) {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	auction.Bidder = s.carol
+	auction.BidPrice = a
+
+	err = s.marketStore.AuctionTable().Update(s.ctx, auction)
+	require.NoError(s.t, err)
+}
+
+func (s *bidSuite) CarolPlacedABidWithBidPriceAndBuyerFee(a, b string) {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	auction.Bidder = s.carol
+	auction.BidPrice = a
+	auction.BuyerFee = b
+
+	err = s.marketStore.AuctionTable().Update(s.ctx, auction)
+	require.NoError(s.t, err)
+}
+
+func (s *bidSuite) TheMarketHasBuyerFee(a string) {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, auction.MarketId)
+	require.NoError(s.t, err)
+
+	market.BuyerFee = a
+	err = s.marketStore.MarketTable().Update(s.ctx, market)
+	require.NoError(s.t, err)
+}
+
+func (s *bidSuite) AliceAttemptsToBidWithBidPrice(a string) {
+	s.bid(s.alice, a)
+}
+
+func (s *bidSuite) BobAttemptsToBidWithBidPrice(a string) {
+	s.bid(s.bob, a)
+}
+
+func (s *bidSuite) BobAttemptsToBidInAuctionWithIdAndBidPrice(a string, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	bidPrice, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.Bid(s.ctx, &marketplace.MsgBid{
+		Bidder:    s.bob.String(),
+		AuctionId: id,
+		BidPrice:  &bidPrice,
+	})
+}
+
+func (s *bidSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *bidSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *bidSuite) ExpectAuctionWithProperties(a gocuke.DocString) {
+	expected := &api.Auction{}
+	err := protojson.Unmarshal([]byte(a.Content), expected)
+	require.NoError(s.t, err)
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.BidPrice, auction.BidPrice)
+	require.Equal(s.t, expected.EndTime.AsTime(), auction.EndTime.AsTime())
+}
+
+func (s *bidSuite) ExpectTheAuctionHasBuyerFee(a string) {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+	require.Equal(s.t, a, auction.BuyerFee)
+}
+
+func (s *bidSuite) ExpectTheHighestBidderToBeBob() {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.bob.Bytes(), auction.Bidder)
+}
+
+func (s *bidSuite) ExpectBobEscrowedFunds(a string) {
+	coins, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, coins, s.escrowed[s.bob.String()])
+}
+
+func (s *bidSuite) ExpectCarolRefundedFunds(a string) {
+	coins, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, coins, s.refunded[s.carol.String()])
+}
+
+func (s *bidSuite) bid(bidder sdk.AccAddress, price string) {
+	bidPrice, err := sdk.ParseCoinNormalized(price)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.Bid(s.ctx, &marketplace.MsgBid{
+		Bidder:    bidder.String(),
+		AuctionId: s.auctionId,
+		BidPrice:  &bidPrice,
+	})
+}
@@ -0,0 +1,182 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// Buy creates new buy orders and moves the funds of the buy orders into escrow.
+func (k Keeper) Buy(ctx context.Context, req *marketplace.MsgBuy) (*marketplace.MsgBuyResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	buyOrderIds := make([]uint64, len(req.Orders))
+
+	for i, order := range req.Orders {
+		// orderIndex is used for more granular error messages when
+		// an individual order in a list of orders fails to process
+		orderIndex := fmt.Sprintf("orders[%d]", i)
+
+		selection, creditTypeAbbrev, err := k.getBuyOrderSelection(ctx, order.Selection)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: selection: %s", orderIndex, err.Error())
+		}
+
+		creditType, err := k.coreStore.CreditTypeTable().Get(ctx, creditTypeAbbrev)
+		if err != nil {
+			return nil, err
+		}
+
+		allowed, err := k.isDenomAllowed(ctx, order.BidPrice.Denom)
+		if err != nil {
+			return nil, err
+		}
+		if !allowed {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: %s is not allowed to be used in buy orders", orderIndex, order.BidPrice.Denom,
+			)
+		}
+
+		marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, order.BidPrice.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+		if err != nil {
+			return nil, err
+		}
+
+		// verify expiration is in the future
+		if order.Expiration != nil && !order.Expiration.UTC().After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: expiration must be in the future: %s", orderIndex, order.Expiration,
+			)
+		}
+
+		buyQty, err := math.NewPositiveFixedDecFromString(order.Quantity, creditType.Precision)
+		if err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: decimal places exceeds precision: quantity: %s, credit type precision: %d",
+				orderIndex, order.Quantity, creditType.Precision,
+			)
+		}
+
+		// move the total bid (price per * order quantity) and the buyer fee into escrow
+		cost, err := getEscrowAmount(order.BidPrice.Amount, buyQty, market.BuyerFee)
+		if err != nil {
+			return nil, err
+		}
+		coinCost := sdk.Coin{Amount: cost, Denom: order.BidPrice.Denom}
+		if err = k.bankKeeper.SendCoinsFromAccountToModule(sdkCtx, buyerAcc, ecocredit.ModuleName, sdk.NewCoins(coinCost)); err != nil {
+			return nil, sdkerrors.Wrapf(err, "%s: total price: %v", orderIndex, coinCost)
+		}
+
+		buyOrder := &marketApi.BuyOrder{
+			Buyer:                  buyerAcc,
+			Selection:              selection,
+			Quantity:               order.Quantity,
+			MarketId:               marketId,
+			BidAmount:              order.BidPrice.Amount.String(),
+			Maker:                  true, // maker is always true for buy orders, they are matched in the end blocker
+			DisableAutoRetire:      order.DisableAutoRetire,
+			RetirementJurisdiction: order.RetirementJurisdiction,
+			RetirementBeneficiary:  order.RetirementBeneficiary,
+			RetirementReason:       order.RetirementReason,
+			Expiration:             toTimestamp(order.Expiration),
+			BuyerFee:               market.BuyerFee,
+		}
+
+		id, err := k.stateStore.BuyOrderTable().InsertReturningID(ctx, buyOrder)
+		if err != nil {
+			return nil, err
+		}
+
+		buyOrder.Id = id
+		if err = k.orderBook.OnInsertBuyOrder(ctx, buyOrder); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err.Error())
+		}
+
+		buyOrderIds[i] = id
+
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuy{
+			BuyOrderId: id,
+		}); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgBuy order iteration")
+	}
+
+	return &marketplace.MsgBuyResponse{BuyOrderIds: buyOrderIds}, nil
+}
+
+// getBuyOrderSelection converts the selection of a buy order request into the selection
+// stored in state and returns the abbreviation of the credit type of the selected credits.
+func (k Keeper) getBuyOrderSelection(ctx context.Context, selection *marketplace.MsgBuy_Selection) (*marketApi.BuyOrder_Selection, string, error) {
+	switch sum := selection.Sum.(type) {
+	case *marketplace.MsgBuy_Selection_BatchDenom:
+		batch, err := k.coreStore.BatchTable().GetByDenom(ctx, sum.BatchDenom)
+		if err != nil {
+			return nil, "", sdkerrors.ErrNotFound.Wrapf("batch denom %s: %s", sum.BatchDenom, err.Error())
+		}
+		class, err := utils.GetBatchClass(ctx, k.coreStore, batch)
+		if err != nil {
+			return nil, "", err
+		}
+		return &marketApi.BuyOrder_Selection{
+			Sum: &marketApi.BuyOrder_Selection_BatchKey{BatchKey: batch.Key},
+		}, class.CreditTypeAbbrev, nil
+	case *marketplace.MsgBuy_Selection_ProjectId:
+		project, err := k.coreStore.ProjectTable().GetById(ctx, sum.ProjectId)
+		if err != nil {
+			return nil, "", sdkerrors.ErrNotFound.Wrapf("project id %s: %s", sum.ProjectId, err.Error())
+		}
+		class, err := k.coreStore.ClassTable().Get(ctx, project.ClassKey)
+		if err != nil {
+			return nil, "", err
+		}
+		return &marketApi.BuyOrder_Selection{
+			Sum:          &marketApi.BuyOrder_Selection_ProjectKey{ProjectKey: project.Key},
+			MinStartDate: toTimestamp(selection.MinStartDate),
+			MaxEndDate:   toTimestamp(selection.MaxEndDate),
+		}, class.CreditTypeAbbrev, nil
+	case *marketplace.MsgBuy_Selection_ClassId:
+		class, err := k.coreStore.ClassTable().GetById(ctx, sum.ClassId)
+		if err != nil {
+			return nil, "", sdkerrors.ErrNotFound.Wrapf("class id %s: %s", sum.ClassId, err.Error())
+		}
+		return &marketApi.BuyOrder_Selection{
+			Sum:                 &marketApi.BuyOrder_Selection_ClassKey{ClassKey: class.Key},
+			ProjectJurisdiction: selection.ProjectJurisdiction,
+			MinStartDate:        toTimestamp(selection.MinStartDate),
+			MaxEndDate:          toTimestamp(selection.MaxEndDate),
+		}, class.CreditTypeAbbrev, nil
+	default:
+		return nil, "", sdkerrors.ErrInvalidRequest.Wrap("one of batch denom, project id or class id is required")
+	}
+}
+
+// toTimestamp converts an optional time into an optional protobuf timestamp.
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
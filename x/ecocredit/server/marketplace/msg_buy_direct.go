@@ -6,13 +6,17 @@ import (
 
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-// BuyDirect allows for the purchase of credits directly from sell orders.
+// BuyDirect allows for the purchase of credits directly from sell orders. Each order
+// is handled according to its fill policy when it cannot be filled in full and the
+// quantity of credits filled for each order is returned in the response.
 func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*marketplace.MsgBuyDirectResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -21,101 +25,208 @@ func (k Keeper) BuyDirect(ctx context.Context, req *marketplace.MsgBuyDirect) (*
 		return nil, err
 	}
 
+	filledQuantities := make([]string, len(req.Orders))
 	for i, order := range req.Orders {
 		// orderIndex is used for more granular error messages when
 		// an individual order in a list of orders fails to process
 		orderIndex := fmt.Sprintf("orders[%d]", i)
 
-		sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, order.SellOrderId)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d: %s", orderIndex, order.SellOrderId, err.Error())
+		var filled math.Dec
+		if order.FillPolicy == marketplace.FillPolicy_FILL_POLICY_SKIP_ON_FAILURE {
+			// the order is processed in a cached context so that a skipped order
+			// does not leave partial state changes behind
+			cacheCtx, writeCache := sdkCtx.CacheContext()
+			filled, err = k.buyDirect(sdk.WrapSDKContext(cacheCtx), orderIndex, buyerAcc, order)
+			if err != nil {
+				filled = math.NewDecFromInt64(0)
+			} else {
+				writeCache()
+				sdkCtx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			}
+		} else {
+			filled, err = k.buyDirect(ctx, orderIndex, buyerAcc, order)
+			if err != nil {
+				return nil, err
+			}
 		}
 
-		// check if buyer account is equal to seller account
-		if buyerAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
-			return nil, sdkerrors.ErrUnauthorized.Wrapf(
-				"%s: buyer account cannot be the same as seller account", orderIndex,
-			)
-		}
+		filledQuantities[i] = filled.String()
+	}
 
-		// check if disable auto-retire is required
-		if order.DisableAutoRetire && !sellOrder.DisableAutoRetire {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
-				"%s: cannot disable auto-retire for a sell order with auto-retire enabled", orderIndex,
-			)
-		}
+	return &marketplace.MsgBuyDirectResponse{FilledQuantities: filledQuantities}, nil
+}
 
-		// check decimal places does not exceed credit type precision
-		batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
-		if err != nil {
-			return nil, err
-		}
-		ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
-		if err != nil {
-			return nil, err
-		}
-		creditOrderQty, err := math.NewPositiveFixedDecFromString(order.Quantity, ct.Precision)
-		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
-				"%s: decimal places exceeds precision: quantity: %s, credit type precision: %d",
-				orderIndex, order.Quantity, ct.Precision,
-			)
+// buyDirect fills a single direct buy order and returns the quantity of credits filled.
+// With a partial fill policy, the quantity is limited to the quantity of the sell order,
+// rounded down to the lot size of the sell order, and no credits are filled if the sell
+// order no longer exists or the quantity is less than its minimum fill quantity.
+func (k Keeper) buyDirect(ctx context.Context, orderIndex string, buyerAcc sdk.AccAddress, order *marketplace.MsgBuyDirect_Order) (math.Dec, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	partial := order.FillPolicy == marketplace.FillPolicy_FILL_POLICY_PARTIAL
+
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, order.SellOrderId)
+	if err != nil {
+		if partial && ormerrors.IsNotFound(err) {
+			return math.NewDecFromInt64(0), nil
 		}
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("%s: sell order with id %d: %s", orderIndex, order.SellOrderId, err.Error())
+	}
+
+	// check if buyer account is equal to seller account
+	if buyerAcc.Equals(sdk.AccAddress(sellOrder.Seller)) {
+		return math.Dec{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s: buyer account cannot be the same as seller account", orderIndex,
+		)
+	}
+
+	// check if the buyer is allowed to buy credits from a private sell order
+	allowed, err := k.isAllowedBuyer(ctx, sellOrder, buyerAcc)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if !allowed {
+		return math.Dec{}, sdkerrors.ErrUnauthorized.Wrapf(
+			"%s: buyer is not allowed to buy credits from private sell order %d", orderIndex, sellOrder.Id,
+		)
+	}
+
+	// check if disable auto-retire is required
+	if order.DisableAutoRetire && !sellOrder.DisableAutoRetire {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: cannot disable auto-retire for a sell order with auto-retire enabled", orderIndex,
+		)
+	}
+
+	// check decimal places does not exceed credit type precision
+	batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	ct, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	creditOrderQty, err := math.NewPositiveFixedDecFromString(order.Quantity, ct.Precision)
+	if err != nil {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			orderIndex, order.Quantity, ct.Precision,
+		)
+	}
 
-		// check that bid price and ask price denoms match
-		market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	// check the quantity against the minimum fill quantity and the lot size of the sell
+	// order, limiting the quantity to the credits that can be filled from the sell order
+	// with a partial fill policy
+	if partial {
+		creditOrderQty, err = orderbook.FillableQuantity(sellOrder, creditOrderQty)
 		if err != nil {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
+			return math.Dec{}, err
 		}
-		if order.BidPrice.Denom != market.BankDenom {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
-				"%s: bid price denom: %s, ask price denom: %s",
-				orderIndex, order.BidPrice.Denom, market.BankDenom,
-			)
+		if creditOrderQty.IsZero() {
+			return creditOrderQty, nil
 		}
+	} else if err = orderbook.CheckFillQuantity(sellOrder, creditOrderQty); err != nil {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err.Error())
+	}
+
+	// check that bid price and ask price denoms match
+	market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf("market id %d: %s", sellOrder.MarketId, err.Error())
+	}
+	if order.BidPrice.Denom != market.BankDenom {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: bid price denom: %s, ask price denom: %s",
+			orderIndex, order.BidPrice.Denom, market.BankDenom,
+		)
+	}
+
+	// check that the ask price denom has not been removed from the allowed denoms
+	allowed, err = k.isAskDenomAllowed(ctx, market.BankDenom)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	if !allowed {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: %s is not allowed to be used in sell orders", orderIndex, market.BankDenom,
+		)
+	}
+
+	// check that bid price >= sell price
+	sellOrderAskAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
+	if !ok {
+		return math.Dec{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sellOrder.AskAmount, sdk.Int{})
+	}
+	sellOrderPriceCoin := sdk.Coin{Denom: market.BankDenom, Amount: sellOrderAskAmount}
+	if sellOrderAskAmount.GT(order.BidPrice.Amount) {
+		return math.Dec{}, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s: ask price: %v, bid price: %v, insufficient bid price",
+			orderIndex, sellOrderPriceCoin, order.BidPrice,
+		)
+	}
 
-		// check that bid price >= sell price
-		sellOrderAskAmount, ok := sdk.NewIntFromString(sellOrder.AskAmount)
-		if !ok {
-			return nil, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", sellOrder.AskAmount, sdk.Int{})
+	cost, err := getTotalCost(sellOrderAskAmount, creditOrderQty)
+	if err != nil {
+		return math.Dec{}, err
+	}
+	coinCost := sdk.Coin{Amount: cost, Denom: market.BankDenom}
+
+	// the cost of sell orders that ask for credits of another batch is paid from
+	// the tradable balance of the buyer when the order is filled
+	askBatch, err := k.getAskBatch(ctx, market.BankDenom)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	// fees are only charged for sell orders that ask for coins because the fee
+	// destinations only accept coins, i.e. swaps of credits are exempt from fees
+	buyerFee, sellerFee := sdk.ZeroInt(), sdk.ZeroInt()
+	if askBatch == nil {
+		if buyerFee, err = getFee(cost, market.BuyerFee); err != nil {
+			return math.Dec{}, err
 		}
-		sellOrderPriceCoin := sdk.Coin{Denom: market.BankDenom, Amount: sellOrderAskAmount}
-		if sellOrderAskAmount.GT(order.BidPrice.Amount) {
-			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
-				"%s: ask price: %v, bid price: %v, insufficient bid price",
-				orderIndex, sellOrderPriceCoin, order.BidPrice,
-			)
+		if sellerFee, err = getFee(cost, market.SellerFee); err != nil {
+			return math.Dec{}, err
 		}
 
-		// check address has the total cost (price per * order quantity)
+		// check address has the total cost (price per * order quantity) and the buyer fee
+		totalCost := coinCost.AddAmount(buyerFee)
 		bal := k.bankKeeper.GetBalance(sdkCtx, buyerAcc, order.BidPrice.Denom)
-		cost, err := getTotalCost(sellOrderAskAmount, creditOrderQty)
-		if err != nil {
-			return nil, err
-		}
-		coinCost := sdk.Coin{Amount: cost, Denom: market.BankDenom}
-		if bal.IsLT(coinCost) {
-			return nil, sdkerrors.ErrInsufficientFunds.Wrapf(
+		if bal.IsLT(totalCost) {
+			return math.Dec{}, sdkerrors.ErrInsufficientFunds.Wrapf(
 				"%s: quantity: %s, ask price: %s%s, total price: %v, bank balance: %v",
-				orderIndex, order.Quantity, sellOrder.AskAmount, market.BankDenom, coinCost, bal,
+				orderIndex, order.Quantity, sellOrder.AskAmount, market.BankDenom, totalCost, bal,
 			)
 		}
+	}
 
-		// fill the order, updating balances and the sell order in state
-		if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, orderOptions{
-			autoRetire:   !order.DisableAutoRetire,
-			batchDenom:   batch.Denom,
-			jurisdiction: order.RetirementJurisdiction,
-		}); err != nil {
-			return nil, err
-		}
+	// fill the order, updating balances and the sell order in state
+	if err = k.fillOrder(ctx, orderIndex, sellOrder, buyerAcc, creditOrderQty, coinCost, orderOptions{
+		autoRetire:   !order.DisableAutoRetire,
+		batchDenom:   batch.Denom,
+		jurisdiction: order.RetirementJurisdiction,
+		beneficiary:  order.RetirementBeneficiary,
+		reason:       order.RetirementReason,
+		askBatch:     askBatch,
+		buyerFee:     buyerFee,
+		sellerFee:    sellerFee,
+		price:        sellOrderAskAmount,
+	}); err != nil {
+		return math.Dec{}, err
+	}
 
-		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuyDirect{
-			SellOrderId: sellOrder.Id,
-		}); err != nil {
-			return nil, err
-		}
+	// the quote request of a quote is closed when the sell order of the quote is filled
+	if err = k.closeQuoteOfSellOrder(ctx, sellOrder.Id); err != nil {
+		return math.Dec{}, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventBuyDirect{
+		SellOrderId: sellOrder.Id,
+		BuyerFee:    &sdk.Coin{Denom: market.BankDenom, Amount: buyerFee},
+		SellerFee:   &sdk.Coin{Denom: market.BankDenom, Amount: sellerFee},
+	}); err != nil {
+		return math.Dec{}, err
 	}
 
-	return &marketplace.MsgBuyDirectResponse{}, nil
+	return creditOrderQty, nil
 }
@@ -2,17 +2,24 @@ package marketplace
 
 import (
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	"github.com/gogo/protobuf/jsonpb"
+	"github.com/gogo/protobuf/proto"
 	"github.com/regen-network/gocuke"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
 
@@ -22,14 +29,21 @@ type buyDirectSuite struct {
 	aliceBankBalance  sdk.Coin
 	bob               sdk.AccAddress
 	bobBankBalance    sdk.Coin
+	carol             sdk.AccAddress
 	creditTypeAbbrev  string
 	classId           string
 	batchDenom        string
 	sellOrderId       uint64
 	disableAutoRetire bool
 	quantity          string
+	minFillQuantity   string
+	lotSize           string
+	allowedBuyers     [][]byte
+	groupPolicy       sdk.AccAddress
 	askPrice          sdk.Coin
 	bidPrice          sdk.Coin
+	classAdmin        sdk.AccAddress
+	fees              sdk.Coins
 	res               *marketplace.MsgBuyDirectResponse
 	err               error
 }
@@ -39,9 +53,11 @@ func TestBuyDirect(t *testing.T) {
 }
 
 func (s *buyDirectSuite) Before(t gocuke.TestingT) {
-	s.baseSuite = setupBase(t, 2)
+	s.baseSuite = setupBase(t, 4)
 	s.alice = s.addrs[0]
 	s.bob = s.addrs[1]
+	s.classAdmin = s.addrs[2]
+	s.carol = s.addrs[3]
 	s.aliceBankBalance = sdk.Coin{
 		Denom:  "regen",
 		Amount: sdk.NewInt(100),
@@ -154,6 +170,31 @@ func (s *buyDirectSuite) TheBatchSupply(a gocuke.DocString) {
 	require.NoError(s.t, err)
 }
 
+func (s *buyDirectSuite) ACreditBatchWithBatchDenom(a string) {
+	err := s.coreStore.ClassTable().Insert(s.ctx, &coreapi.Class{
+		Id:               core.GetClassIdFromBatchDenom(a),
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchTable().Insert(s.ctx, &coreapi.Batch{
+		Denom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buyDirectSuite) BobHasATradableCreditBalanceOfWithBatchDenom(a string, b string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, b)
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       batch.Key,
+		Address:        s.bob,
+		TradableAmount: a,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *buyDirectSuite) AliceCreatedASellOrderWithId(a string) {
 	id, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -223,6 +264,37 @@ func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityAndDisableAutoRetire(
 	s.createSellOrders(1)
 }
 
+func (s *buyDirectSuite) AliceCreatedASellOrderWithQuantityMinFillQuantityAndLotSize(a string, b string, c string) {
+	s.quantity = a
+	s.minFillQuantity = b
+	s.lotSize = c
+
+	s.createSellOrders(1)
+}
+
+func (s *buyDirectSuite) AliceCreatedASellOrderWithAllowedBuyer(a string) {
+	s.allowedBuyers = [][]byte{s.getAccount(a)}
+
+	s.createSellOrders(1)
+}
+
+func (s *buyDirectSuite) AliceCreatedASellOrderWithAnAllowedBuyerGroupPolicyWithMember(a string) {
+	_, _, s.groupPolicy = testdata.KeyTestPubAddr()
+
+	s.groupKeeper.EXPECT().
+		GroupPolicyInfo(gmAny, &group.QueryGroupPolicyInfoRequest{Address: s.groupPolicy.String()}).
+		Return(&group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: s.groupPolicy.String(), GroupId: 1}}, nil).
+		AnyTimes()
+	s.groupKeeper.EXPECT().
+		GroupMembers(gmAny, gmAny).
+		Return(&group.QueryGroupMembersResponse{Members: []*group.GroupMember{
+			{GroupId: 1, Member: &group.Member{Address: s.getAccount(a).String(), Weight: "1"}},
+		}}, nil).
+		AnyTimes()
+
+	s.createSellOrders(1)
+}
+
 func (s *buyDirectSuite) AliceCreatedTwoSellOrdersEachWithQuantityAndAskAmount(a string, b string) {
 	askAmount, ok := sdk.NewIntFromString(b)
 	require.True(s.t, ok)
@@ -233,6 +305,53 @@ func (s *buyDirectSuite) AliceCreatedTwoSellOrdersEachWithQuantityAndAskAmount(a
 	s.createSellOrders(2)
 }
 
+func (s *buyDirectSuite) TheMarketHasBuyerFeeSellerFeeAndFeeDestination(a string, b string, c string) {
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+
+	market.BuyerFee = a
+	market.SellerFee = b
+	market.FeeDestination = api.FeeDestination(api.FeeDestination_value[c])
+	err = s.marketStore.MarketTable().Update(s.ctx, market)
+	require.NoError(s.t, err)
+
+	class, err := s.coreStore.ClassTable().GetById(s.ctx, s.classId)
+	require.NoError(s.t, err)
+
+	class.Admin = s.classAdmin
+	err = s.coreStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+
+	s.feeExpectCalls(market.FeeDestination)
+}
+
+func (s *buyDirectSuite) TheProjectIsTransferredToAnotherCreditClass() {
+	class, err := s.coreStore.ClassTable().GetById(s.ctx, s.classId)
+	require.NoError(s.t, err)
+
+	// the admin of the credit class in the batch denom no longer receives fees
+	class.Admin = s.carol
+	err = s.coreStore.ClassTable().Update(s.ctx, class)
+	require.NoError(s.t, err)
+
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               "C02",
+		Admin:            s.classAdmin,
+		CreditTypeAbbrev: class.CreditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, batch.ProjectKey)
+	require.NoError(s.t, err)
+
+	project.ClassKey = classKey
+	err = s.coreStore.ProjectTable().Update(s.ctx, project)
+	require.NoError(s.t, err)
+}
+
 func (s *buyDirectSuite) AliceAttemptsToBuyCreditsWithSellOrderId(a string) {
 	id, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -306,6 +425,24 @@ func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithDisableAutoRetire(a string)
 	})
 }
 
+func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithRetirementBeneficiaryAndReason(a string, b string) {
+	s.singleBuyOrderExpectCalls()
+
+	s.res, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId:            s.sellOrderId,
+				Quantity:               s.quantity,
+				BidPrice:               &s.bidPrice,
+				RetirementJurisdiction: "US-WA",
+				RetirementBeneficiary:  a,
+				RetirementReason:       b,
+			},
+		},
+	})
+}
+
 func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithQuantity(a string) {
 	s.quantity = a
 
@@ -412,6 +549,57 @@ func (s *buyDirectSuite) BobAttemptsToBuyCreditsInTwoOrdersEachWithQuantityAndBi
 	})
 }
 
+func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithQuantityAndFillPolicy(a string, b string) {
+	fillPolicy, ok := marketplace.FillPolicy_value[b]
+	require.True(s.t, ok)
+
+	// orders with a skip on failure fill policy are processed in a cached context and
+	// orders with a partial fill policy may be filled for less than the quantity
+	s.bankKeeper.EXPECT().
+		GetBalance(gmAny, s.bob, s.bidPrice.Denom).
+		Return(s.bobBankBalance).
+		AnyTimes() // not expected on failed attempt
+	s.bankKeeper.EXPECT().
+		SendCoins(gmAny, s.bob, s.alice, gmAny).
+		Do(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, amt sdk.Coins) {
+			s.bobBankBalance = s.bobBankBalance.Sub(amt[0])
+			s.aliceBankBalance = s.aliceBankBalance.Add(amt[0])
+		}).
+		AnyTimes() // not expected on failed attempt
+
+	s.res, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId: s.sellOrderId,
+				Quantity:    a,
+				BidPrice:    &s.bidPrice,
+				FillPolicy:  marketplace.FillPolicy(fillPolicy),
+			},
+		},
+	})
+}
+
+func (s *buyDirectSuite) BobAttemptsToBuyCreditsWithSellOrderIdAndFillPolicy(a string, b string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	fillPolicy, ok := marketplace.FillPolicy_value[b]
+	require.True(s.t, ok)
+
+	s.res, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{
+				SellOrderId: id,
+				Quantity:    s.quantity,
+				BidPrice:    &s.bidPrice,
+				FillPolicy:  marketplace.FillPolicy(fillPolicy),
+			},
+		},
+	})
+}
+
 func (s *buyDirectSuite) ExpectNoError() {
 	require.NoError(s.t, s.err)
 }
@@ -420,6 +608,11 @@ func (s *buyDirectSuite) ExpectTheError(a string) {
 	require.EqualError(s.t, s.err, a)
 }
 
+func (s *buyDirectSuite) ExpectFilledQuantities(a string) {
+	require.NotNil(s.t, s.res)
+	require.Equal(s.t, strings.Split(a, ","), s.res.FilledQuantities)
+}
+
 func (s *buyDirectSuite) ExpectSellOrderWithId(a string) {
 	id, err := strconv.ParseUint(a, 10, 32)
 	require.NoError(s.t, err)
@@ -461,6 +654,93 @@ func (s *buyDirectSuite) ExpectBobBankBalance(a string) {
 	require.Equal(s.t, bankBalance.Amount.String(), s.bobBankBalance.Amount.String())
 }
 
+func (s *buyDirectSuite) ExpectFeesSentToTheFeeDestination(a string) {
+	require.NoError(s.t, s.err)
+
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.fees.String())
+}
+
+func (s *buyDirectSuite) ExpectNoFeesSentToTheFeeDestination() {
+	require.NoError(s.t, s.err)
+	require.True(s.t, s.fees.IsZero())
+}
+
+func (s *buyDirectSuite) ExpectRetirementWithProperties(a gocuke.DocString) {
+	require.NoError(s.t, s.err)
+
+	expected := &core.RetirementInfo{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, expected.BatchDenom)
+	require.NoError(s.t, err)
+
+	retirement, err := s.coreStore.RetirementTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, batch.Key, retirement.BatchKey)
+	require.Equal(s.t, s.bob.Bytes(), retirement.Owner)
+	require.Equal(s.t, expected.Amount, retirement.Amount)
+	require.Equal(s.t, expected.Beneficiary, retirement.Beneficiary)
+	require.Equal(s.t, expected.Reason, retirement.Reason)
+	require.Equal(s.t, expected.Jurisdiction, retirement.Jurisdiction)
+}
+
+func (s *buyDirectSuite) ExpectNoRetirementWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.coreStore.RetirementTable().Has(s.ctx, id)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *buyDirectSuite) ExpectTradeWithProperties(a gocuke.DocString) {
+	require.NoError(s.t, s.err)
+
+	expected := &marketplace.Trade{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.SellOrderId, trade.SellOrderId)
+	require.Equal(s.t, s.bob.Bytes(), trade.Buyer)
+	require.Equal(s.t, s.alice.Bytes(), trade.Seller)
+	require.Equal(s.t, expected.BatchKey, trade.BatchKey)
+	require.Equal(s.t, expected.Quantity, trade.Quantity)
+	require.Equal(s.t, expected.Price, trade.Price)
+	require.Equal(s.t, expected.Denom, trade.Denom)
+	require.Equal(s.t, expected.Retired, trade.Retired)
+}
+
+func (s *buyDirectSuite) ExpectEventBuyDirectWithProperties(a gocuke.DocString) {
+	expected := &marketplace.EventBuyDirect{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	for _, e := range s.sdkCtx.EventManager().Events() {
+		if e.Type != proto.MessageName(expected) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(s.t, err)
+
+		event, ok := msg.(*marketplace.EventBuyDirect)
+		require.True(s.t, ok)
+		require.Equal(s.t, expected.SellOrderId, event.SellOrderId)
+		require.Equal(s.t, expected.BuyerFee.String(), event.BuyerFee.String())
+		require.Equal(s.t, expected.SellerFee.String(), event.SellerFee.String())
+		return
+	}
+
+	s.t.Fatalf("event %s not found", proto.MessageName(expected))
+}
+
 func (s *buyDirectSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
 	expected := &coreapi.BatchBalance{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -493,6 +773,14 @@ func (s *buyDirectSuite) ExpectBobBatchBalance(a gocuke.DocString) {
 	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
 }
 
+func (s *buyDirectSuite) ExpectBobTradableCreditBalanceOfWithBatchDenom(a string, b string) {
+	s.expectTradableCreditBalance(s.bob, b, a)
+}
+
+func (s *buyDirectSuite) ExpectAliceTradableCreditBalanceOfWithBatchDenom(a string, b string) {
+	s.expectTradableCreditBalance(s.alice, b, a)
+}
+
 func (s *buyDirectSuite) ExpectBatchSupply(a gocuke.DocString) {
 	expected := &coreapi.BatchSupply{}
 	err := jsonpb.UnmarshalString(a.Content, expected)
@@ -508,7 +796,31 @@ func (s *buyDirectSuite) ExpectBatchSupply(a gocuke.DocString) {
 	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
 }
 
+func (s *buyDirectSuite) expectTradableCreditBalance(addr sdk.AccAddress, batchDenom, expected string) {
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, addr, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected, balance.TradableAmount)
+}
+
 // count is the number of sell orders created
+func (s *buyDirectSuite) getAccount(name string) sdk.AccAddress {
+	switch name {
+	case "alice":
+		return s.alice
+	case "bob":
+		return s.bob
+	case "carol":
+		return s.carol
+	default:
+		s.t.Fatalf("unknown account %s", name)
+		return nil
+	}
+}
+
 func (s *buyDirectSuite) createSellOrders(count int) {
 	totalQuantity := s.quantity
 
@@ -521,14 +833,21 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.coreStore.ClassTable().Insert(s.ctx, &coreapi.Class{
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
 		Id:               s.classId,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       core.GetProjectIdFromBatchDenom(s.batchDenom),
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -551,13 +870,23 @@ func (s *buyDirectSuite) createSellOrders(count int) {
 	})
 	require.NoError(s.t, err)
 
+	err = s.marketStore.AllowedDenomTable().Save(s.ctx, &api.AllowedDenom{
+		BankDenom:    s.askPrice.Denom,
+		DisplayDenom: s.askPrice.Denom,
+	})
+	require.NoError(s.t, err)
+
 	order := &api.SellOrder{
-		Seller:            s.alice,
-		BatchKey:          batchKey,
-		Quantity:          s.quantity,
-		MarketId:          marketKey,
-		AskAmount:         s.askPrice.Amount.String(),
-		DisableAutoRetire: s.disableAutoRetire,
+		Seller:                  s.alice,
+		BatchKey:                batchKey,
+		Quantity:                s.quantity,
+		MarketId:                marketKey,
+		AskAmount:               s.askPrice.Amount.String(),
+		DisableAutoRetire:       s.disableAutoRetire,
+		MinFillQuantity:         s.minFillQuantity,
+		LotSize:                 s.lotSize,
+		AllowedBuyers:           s.allowedBuyers,
+		AllowedBuyerGroupPolicy: s.groupPolicy,
 	}
 
 	sellOrderId, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, order)
@@ -614,6 +943,45 @@ func (s *buyDirectSuite) multipleBuyOrderExpectCalls() {
 		AnyTimes() // not expected on failed attempt
 }
 
+// feeExpectCalls expects the proceeds to be sent to the seller and the fees to be sent
+// to the fee destination. The expected calls are registered before the calls of the buy
+// attempt so that they take precedence.
+func (s *buyDirectSuite) feeExpectCalls(destination api.FeeDestination) {
+	s.bankKeeper.EXPECT().
+		SendCoins(s.sdkCtx, s.bob, s.alice, gmAny).
+		Do(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, amt sdk.Coins) {
+			s.bobBankBalance = s.bobBankBalance.Sub(amt[0])
+			s.aliceBankBalance = s.aliceBankBalance.Add(amt[0])
+		}).
+		AnyTimes() // not expected on failed attempt
+
+	collect := func(amt sdk.Coins) {
+		s.bobBankBalance = s.bobBankBalance.Sub(amt[0])
+		s.fees = s.fees.Add(amt...)
+	}
+
+	switch destination {
+	case api.FeeDestination_FEE_DESTINATION_COMMUNITY_POOL:
+		s.distrKeeper.EXPECT().
+			FundCommunityPool(s.sdkCtx, gmAny, s.bob).
+			Do(func(_ sdk.Context, amt sdk.Coins, _ sdk.AccAddress) { collect(amt) }).
+			AnyTimes() // not expected on failed attempt
+	case api.FeeDestination_FEE_DESTINATION_CLASS_ADMIN:
+		s.bankKeeper.EXPECT().
+			SendCoins(s.sdkCtx, s.bob, s.classAdmin, gmAny).
+			Do(func(_ sdk.Context, _ sdk.AccAddress, _ sdk.AccAddress, amt sdk.Coins) { collect(amt) }).
+			AnyTimes() // not expected on failed attempt
+	default:
+		s.bankKeeper.EXPECT().
+			SendCoinsFromAccountToModule(s.sdkCtx, s.bob, ecocredit.ModuleName, gmAny).
+			Do(func(_ sdk.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) { collect(amt) }).
+			AnyTimes() // not expected on failed attempt
+		s.bankKeeper.EXPECT().
+			BurnCoins(s.sdkCtx, ecocredit.ModuleName, gmAny).
+			AnyTimes() // not expected on failed attempt
+	}
+}
+
 func (s *buyDirectSuite) calculateAskTotal(quantity string, amount string) sdk.Int {
 	q, err := math.NewDecFromString(quantity)
 	require.NoError(s.t, err)
@@ -0,0 +1,258 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type buySuite struct {
+	*baseSuite
+	alice             sdk.AccAddress
+	creditTypeAbbrev  string
+	selection         *marketplace.MsgBuy_Selection
+	bidPrice          *sdk.Coin
+	quantity          string
+	expiration        *time.Time
+	disableAutoRetire bool
+	insufficientFunds bool
+	escrowed          sdk.Coins
+	res               *marketplace.MsgBuyResponse
+	err               error
+}
+
+func TestBuy(t *testing.T) {
+	gocuke.NewRunner(t, &buySuite{}).Path("./features/msg_buy.feature").Run()
+}
+
+func (s *buySuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+	s.alice = s.addrs[0]
+	s.creditTypeAbbrev = "C"
+	s.selection = &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+	}
+	s.bidPrice = &sdk.Coin{
+		Denom:  "regen",
+		Amount: sdk.NewInt(100),
+	}
+	s.quantity = "10"
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gmAny, s.alice, ecocredit.ModuleName, gmAny).
+		DoAndReturn(func(_ sdk.Context, _ sdk.AccAddress, _ string, amt sdk.Coins) error {
+			if s.insufficientFunds {
+				return sdkerrors.ErrInsufficientFunds
+			}
+			s.escrowed = s.escrowed.Add(amt...)
+			return nil
+		}).
+		AnyTimes()
+}
+
+func (s *buySuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *buySuite) ACreditTypeWithPrecision(a string) {
+	precision, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	err = s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
+		Abbreviation: s.creditTypeAbbrev,
+		Precision:    uint32(precision),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buySuite) AnAllowedDenomWithBankDenom(a string) {
+	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buySuite) ABasketWithBasketDenom(a string) {
+	err := s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      a,
+		Name:             "NCT",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buySuite) AMarketWithBankDenomAndBuyerFee(a string, b string) {
+	err := s.marketStore.MarketTable().Insert(s.ctx, &api.Market{
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		BankDenom:        a,
+		BuyerFee:         b,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buySuite) ACreditBatchWithBatchDenom(a string) {
+	classId := core.GetClassIdFromBatchDenom(a)
+	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(classId)
+
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               classId,
+		CreditTypeAbbrev: creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       classId + "-001",
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchTable().Insert(s.ctx, &coreapi.Batch{
+		ProjectKey: projectKey,
+		Denom:      a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *buySuite) AliceHasInsufficientFunds() {
+	s.insufficientFunds = true
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithBatchDenom(a string) {
+	s.selection = &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: a},
+	}
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithProjectId(a string) {
+	s.selection = &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_ProjectId{ProjectId: a},
+	}
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithClassId(a string) {
+	s.selection = &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_ClassId{ClassId: a},
+	}
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithClassIdAndDisableAutoretire(a string) {
+	s.disableAutoRetire = true
+	s.AliceAttemptsToBuyCreditsWithClassId(a)
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithBidPrice(a string) {
+	bidPrice, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	s.bidPrice = &bidPrice
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithExpiration(a string) {
+	expiration, err := types.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.expiration = &expiration
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithQuantity(a string) {
+	s.quantity = a
+	s.buy()
+}
+
+func (s *buySuite) AliceAttemptsToBuyCreditsWithQuantityAndBidPrice(a string, b string) {
+	bidPrice, err := sdk.ParseCoinNormalized(b)
+	require.NoError(s.t, err)
+
+	s.quantity = a
+	s.bidPrice = &bidPrice
+	s.buy()
+}
+
+func (s *buySuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *buySuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *buySuite) ExpectMovedIntoEscrow(a string) {
+	require.NoError(s.t, s.err)
+
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.escrowed.String())
+}
+
+func (s *buySuite) ExpectBuyOrderWithId(a string, b gocuke.DocString) {
+	require.NoError(s.t, s.err)
+
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expected := &marketplace.BuyOrder{}
+	err = jsonpb.UnmarshalString(b.Content, expected)
+	require.NoError(s.t, err)
+
+	order, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.Id, order.Id)
+	require.Equal(s.t, s.alice.Bytes(), order.Buyer)
+	require.Equal(s.t, expected.Selection.GetBatchKey(), order.Selection.GetBatchKey())
+	require.Equal(s.t, expected.Selection.GetProjectKey(), order.Selection.GetProjectKey())
+	require.Equal(s.t, expected.Selection.GetClassKey(), order.Selection.GetClassKey())
+	require.Equal(s.t, expected.Quantity, order.Quantity)
+	require.Equal(s.t, expected.MarketId, order.MarketId)
+	require.Equal(s.t, expected.BidAmount, order.BidAmount)
+	require.Equal(s.t, expected.Maker, order.Maker)
+	require.Equal(s.t, expected.DisableAutoRetire, order.DisableAutoRetire)
+	require.Equal(s.t, expected.RetirementJurisdiction, order.RetirementJurisdiction)
+	require.Equal(s.t, expected.BuyerFee, order.BuyerFee)
+}
+
+func (s *buySuite) buy() {
+	jurisdiction := "US-WA"
+	if s.disableAutoRetire {
+		jurisdiction = ""
+	}
+
+	s.res, s.err = s.k.Buy(s.ctx, &marketplace.MsgBuy{
+		Buyer: s.alice.String(),
+		Orders: []*marketplace.MsgBuy_Order{
+			{
+				Selection:              s.selection,
+				Quantity:               s.quantity,
+				BidPrice:               s.bidPrice,
+				DisableAutoRetire:      s.disableAutoRetire,
+				RetirementJurisdiction: jurisdiction,
+				Expiration:             s.expiration,
+			},
+		},
+	})
+}
@@ -0,0 +1,46 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelAuction cancels an auction that has not received any bids and returns the
+// escrowed credits to the seller.
+func (k Keeper) CancelAuction(ctx context.Context, req *marketplace.MsgCancelAuction) (*marketplace.MsgCancelAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	auction, err := k.stateStore.AuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	if !sellerAcc.Equals(sdk.AccAddress(auction.Seller)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("seller must be the owner of the auction")
+	}
+
+	if len(auction.Bidder) != 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("auction with id %d has received bids and cannot be cancelled", auction.Id)
+	}
+
+	if err = k.unescrowCredits(ctx, sellerAcc, auction.BatchKey, auction.Quantity); err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelAuction{
+		AuctionId: auction.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelAuctionResponse{}, k.stateStore.AuctionTable().Delete(ctx, auction)
+}
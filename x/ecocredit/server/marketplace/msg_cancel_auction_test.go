@@ -0,0 +1,123 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type cancelAuctionSuite struct {
+	*baseSuite
+	alice     sdk.AccAddress
+	bob       sdk.AccAddress
+	batchKey  uint64
+	auctionId uint64
+	err       error
+}
+
+func TestCancelAuction(t *testing.T) {
+	gocuke.NewRunner(t, &cancelAuctionSuite{}).Path("./features/msg_cancel_auction.feature").Run()
+}
+
+func (s *cancelAuctionSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+}
+
+func (s *cancelAuctionSuite) AliceCreatedAnAuctionWithQuantity(a string) {
+	var err error
+	s.batchKey, err = s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+		Denom: "C01-001-20200101-20210101-001",
+	})
+	require.NoError(s.t, err)
+
+	err = s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: "0",
+		EscrowedAmount: a,
+	})
+	require.NoError(s.t, err)
+
+	s.auctionId, err = s.marketStore.AuctionTable().InsertReturningID(s.ctx, &api.Auction{
+		Seller:       s.alice,
+		BatchKey:     s.batchKey,
+		Quantity:     a,
+		AuctionType:  api.AuctionType_AUCTION_TYPE_ENGLISH,
+		Denom:        "regen",
+		StartPrice:   "100",
+		ReservePrice: "200",
+		StartTime:    timestamppb.Now(),
+		EndTime:      timestamppb.Now(),
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelAuctionSuite) BobPlacedABidWithBidPrice(a string) {
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, s.auctionId)
+	require.NoError(s.t, err)
+
+	auction.Bidder = s.bob
+	auction.BidPrice = a
+
+	err = s.marketStore.AuctionTable().Update(s.ctx, auction)
+	require.NoError(s.t, err)
+}
+
+func (s *cancelAuctionSuite) AliceAttemptsToCancelTheAuctionWithId(a string) {
+	s.cancel(s.alice, a)
+}
+
+func (s *cancelAuctionSuite) BobAttemptsToCancelTheAuctionWithId(a string) {
+	s.cancel(s.bob, a)
+}
+
+func (s *cancelAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *cancelAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *cancelAuctionSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	expected := &coreapi.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *cancelAuctionSuite) ExpectNoAuctionWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.AuctionTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelAuctionSuite) cancel(seller sdk.AccAddress, auctionId string) {
+	id, err := strconv.ParseUint(auctionId, 10, 64)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.CancelAuction(s.ctx, &marketplace.MsgCancelAuction{
+		Seller:    seller.String(),
+		AuctionId: id,
+	})
+}
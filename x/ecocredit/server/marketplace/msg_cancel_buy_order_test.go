@@ -0,0 +1,132 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type cancelBuyOrder struct {
+	*baseSuite
+	alice            sdk.AccAddress
+	bob              sdk.AccAddress
+	creditTypeAbbrev string
+	buyOrderId       uint64
+	bidPrice         *sdk.Coin
+	quantity         string
+	refunded         sdk.Coins
+	res              *marketplace.MsgCancelBuyOrderResponse
+	err              error
+}
+
+func TestCancelBuyOrder(t *testing.T) {
+	gocuke.NewRunner(t, &cancelBuyOrder{}).Path("./features/msg_cancel_buy_order.feature").Run()
+}
+
+func (s *cancelBuyOrder) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.creditTypeAbbrev = "C"
+	s.bidPrice = &sdk.Coin{
+		Denom:  "regen",
+		Amount: sdk.NewInt(100),
+	}
+	s.quantity = "100"
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, s.alice, gmAny).
+		Do(func(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) {
+			s.refunded = s.refunded.Add(amt...)
+		}).
+		AnyTimes()
+}
+
+func (s *cancelBuyOrder) AliceCreatedABuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.buyOrderId = id
+
+	s.buyOrderSetup()
+}
+
+func (s *cancelBuyOrder) AliceCreatedABuyOrderWithIdAndQuantity(a string, b string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.buyOrderId = id
+	s.quantity = b
+
+	s.buyOrderSetup()
+}
+
+func (s *cancelBuyOrder) AliceAttemptsToCancelTheBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      s.alice.String(),
+		BuyOrderId: id,
+	})
+}
+
+func (s *cancelBuyOrder) BobAttemptsToCancelTheBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	s.res, s.err = s.k.CancelBuyOrder(s.ctx, &marketplace.MsgCancelBuyOrder{
+		Buyer:      s.bob.String(),
+		BuyOrderId: id,
+	})
+}
+
+func (s *cancelBuyOrder) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *cancelBuyOrder) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *cancelBuyOrder) ExpectReturnedToAlice(a string) {
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.refunded.String())
+}
+
+func (s *cancelBuyOrder) ExpectNoBuyOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 32)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelBuyOrder) buyOrderSetup() {
+	marketKey, err := s.marketStore.MarketTable().InsertReturningID(s.ctx, &api.Market{
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+		BankDenom:        s.bidPrice.Denom,
+	})
+	require.NoError(s.t, err)
+
+	buyOrderId, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, &api.BuyOrder{
+		Buyer:     s.alice,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  s.quantity,
+		MarketId:  marketKey,
+		BidAmount: s.bidPrice.Amount.String(),
+	})
+	require.NoError(s.t, err)
+	require.Equal(s.t, buyOrderId, s.buyOrderId)
+}
@@ -0,0 +1,112 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CancelQuoteRequest cancels a quote request and returns the escrowed credits of its
+// quotes to their sellers.
+func (k Keeper) CancelQuoteRequest(ctx context.Context, req *marketplace.MsgCancelQuoteRequest) (*marketplace.MsgCancelQuoteRequestResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.stateStore.QuoteRequestTable().Get(ctx, req.RequestId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("quote request with id %d: %s", req.RequestId, err.Error())
+	}
+
+	if !buyerAcc.Equals(sdk.AccAddress(request.Buyer)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("buyer must be the owner of the quote request")
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelQuoteRequest{
+		RequestId: request.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	if err = k.closeQuoteRequest(ctx, request); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCancelQuoteRequestResponse{}, nil
+}
+
+// closeQuoteOfSellOrder closes the quote request of the quote the sell order belongs to,
+// if any, so that a quote request is closed whenever one of its quotes is filled.
+func (k Keeper) closeQuoteOfSellOrder(ctx context.Context, sellOrderId uint64) error {
+	quote, err := k.stateStore.QuoteTable().GetBySellOrderId(ctx, sellOrderId)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	request, err := k.stateStore.QuoteRequestTable().Get(ctx, quote.QuoteRequestId)
+	if err != nil {
+		return err
+	}
+
+	return k.closeQuoteRequest(ctx, request)
+}
+
+// closeQuoteRequest removes the quote request and its quotes from state, cancelling the
+// sell orders of the quotes that have not been filled, cancelled or pruned and returning
+// their escrowed credits to the sellers.
+func (k Keeper) closeQuoteRequest(ctx context.Context, request *api.QuoteRequest) error {
+	indexKey := api.QuoteQuoteRequestIdIndexKey{}.WithQuoteRequestId(request.Id)
+
+	it, err := k.stateStore.QuoteTable().List(ctx, indexKey)
+	if err != nil {
+		return err
+	}
+
+	var sellOrderIds []uint64
+	for it.Next() {
+		quote, err := it.Value()
+		if err != nil {
+			return err
+		}
+		sellOrderIds = append(sellOrderIds, quote.SellOrderId)
+	}
+	it.Close()
+
+	for _, id := range sellOrderIds {
+		found, err := k.stateStore.SellOrderTable().Has(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			continue
+		}
+
+		sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
+			return err
+		}
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	if err = k.stateStore.QuoteTable().DeleteBy(ctx, indexKey); err != nil {
+		return err
+	}
+
+	return k.stateStore.QuoteRequestTable().Delete(ctx, request)
+}
@@ -0,0 +1,137 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type cancelQuoteRequestSuite struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	err      error
+}
+
+func TestCancelQuoteRequest(t *testing.T) {
+	gocuke.NewRunner(t, &cancelQuoteRequestSuite{}).Path("./features/msg_cancel_quote_request.feature").Run()
+}
+
+func (s *cancelQuoteRequestSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+}
+
+func (s *cancelQuoteRequestSuite) ACreditBatchWithBatchDenom(a string) {
+	require.Equal(s.t, "C01-001-20200101-20210101-001", a)
+	s.batchKey = s.quoteSetup()
+}
+
+func (s *cancelQuoteRequestSuite) AliceHasATradableBatchBalanceWithAmount(a string) {
+	err := s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelQuoteRequestSuite) BobCreatedAQuoteRequestWithQuantity(a string) {
+	_, err := s.k.CreateQuoteRequest(s.ctx, &marketplace.MsgCreateQuoteRequest{
+		Buyer: s.bob.String(),
+		Selection: &marketplace.MsgBuy_Selection{
+			Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+		},
+		Quantity:          a,
+		Denom:             "regen",
+		DisableAutoRetire: true,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelQuoteRequestSuite) AliceSubmittedAQuoteWithAskPrice(a string) {
+	price, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	_, err = s.k.SubmitQuote(s.ctx, &marketplace.MsgSubmitQuote{
+		Seller:     s.alice.String(),
+		RequestId:  1,
+		BatchDenom: "C01-001-20200101-20210101-001",
+		AskPrice:   &price,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *cancelQuoteRequestSuite) AliceAttemptsToCancelTheQuoteRequestWithId(a string) {
+	s.cancel(s.alice, a)
+}
+
+func (s *cancelQuoteRequestSuite) BobAttemptsToCancelTheQuoteRequestWithId(a string) {
+	s.cancel(s.bob, a)
+}
+
+func (s *cancelQuoteRequestSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *cancelQuoteRequestSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *cancelQuoteRequestSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	expected := &coreapi.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *cancelQuoteRequestSuite) ExpectNoSellOrderWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelQuoteRequestSuite) ExpectNoQuoteRequestWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.QuoteRequestTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelQuoteRequestSuite) ExpectNoQuoteWithId(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	_, err = s.marketStore.QuoteTable().Get(s.ctx, id)
+	require.ErrorContains(s.t, err, ormerrors.NotFound.Error())
+}
+
+func (s *cancelQuoteRequestSuite) cancel(buyer sdk.AccAddress, requestId string) {
+	id, err := strconv.ParseUint(requestId, 10, 64)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.CancelQuoteRequest(s.ctx, &marketplace.MsgCancelQuoteRequest{
+		Buyer:     buyer.String(),
+		RequestId: id,
+	})
+}
@@ -0,0 +1,106 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// CreateAuction creates a new auction and moves the credits being auctioned into escrow.
+func (k Keeper) CreateAuction(ctx context.Context, req *marketplace.MsgCreateAuction) (*marketplace.MsgCreateAuctionResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, req.StartPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in auctions", req.StartPrice.Denom)
+	}
+
+	// the auction starts at the block time unless a start time is provided
+	startTime := sdkCtx.BlockTime()
+	if req.StartTime != nil {
+		if req.StartTime.Before(startTime) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("start time cannot be in the past: %s", req.StartTime)
+		}
+		startTime = *req.StartTime
+	}
+
+	if !req.EndTime.After(startTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time must be after the start time: %s", req.EndTime)
+	}
+
+	if err = k.escrowCredits(ctx, "auction", sellerAcc, batch.Key, quantity); err != nil {
+		return nil, err
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, req.StartPrice.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	auction := &api.Auction{
+		Seller:       sellerAcc,
+		BatchKey:     batch.Key,
+		Quantity:     quantity.String(),
+		AuctionType:  api.AuctionType(req.AuctionType),
+		Denom:        req.StartPrice.Denom,
+		StartPrice:   req.StartPrice.Amount.String(),
+		ReservePrice: req.ReservePrice.Amount.String(),
+		StartTime:    timestamppb.New(startTime),
+		EndTime:      timestamppb.New(*req.EndTime),
+		MarketId:     marketId,
+	}
+	if req.PriceDecrement != nil {
+		auction.PriceDecrement = req.PriceDecrement.Amount.String()
+	}
+	if req.DecayInterval != nil {
+		auction.DecayInterval = durationpb.New(*req.DecayInterval)
+	}
+
+	id, err := k.stateStore.AuctionTable().InsertReturningID(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCreateAuction{
+		AuctionId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCreateAuctionResponse{AuctionId: id}, nil
+}
@@ -0,0 +1,169 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type createAuctionSuite struct {
+	*baseSuite
+	alice      sdk.AccAddress
+	batchDenom string
+	bankDenom  string
+	res        *marketplace.MsgCreateAuctionResponse
+	err        error
+}
+
+func TestCreateAuction(t *testing.T) {
+	gocuke.NewRunner(t, &createAuctionSuite{}).Path("./features/msg_create_auction.feature").Run()
+}
+
+func (s *createAuctionSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+	s.alice = s.addrs[0]
+	s.batchDenom = "C01-001-20200101-20210101-001"
+	s.bankDenom = "regen"
+}
+
+func (s *createAuctionSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *createAuctionSuite) AnAllowedDenom() {
+	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom:    s.bankDenom,
+		DisplayDenom: s.bankDenom,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createAuctionSuite) ABasketWithBasketDenom(a string) {
+	err := s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      a,
+		Name:             "NCT",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createAuctionSuite) ACreditBatchWithBatchDenom(a string) {
+	s.batchDenom = a
+	s.creditBatchSetup()
+}
+
+func (s *createAuctionSuite) AliceHasATradableBatchBalanceWithAmount(a string) {
+	batchKey := s.creditBatchSetup()
+
+	err := s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createAuctionSuite) AliceAttemptsToCreateAnAuction(a gocuke.DocString) {
+	msg := &marketplace.MsgCreateAuction{}
+	err := jsonpb.UnmarshalString(a.Content, msg)
+	require.NoError(s.t, err)
+
+	msg.Seller = s.alice.String()
+
+	s.res, s.err = s.k.CreateAuction(s.ctx, msg)
+}
+
+func (s *createAuctionSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *createAuctionSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *createAuctionSuite) ExpectAuctionIdInTheResponse(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, id, s.res.AuctionId)
+}
+
+func (s *createAuctionSuite) ExpectAliceBatchBalance(a gocuke.DocString) {
+	expected := &coreapi.BatchBalance{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	batch, err := s.coreStore.BatchTable().GetByDenom(s.ctx, s.batchDenom)
+	require.NoError(s.t, err)
+
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, batch.Key)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.TradableAmount, balance.TradableAmount)
+	require.Equal(s.t, expected.EscrowedAmount, balance.EscrowedAmount)
+}
+
+func (s *createAuctionSuite) ExpectAuctionWithProperties(a gocuke.DocString) {
+	expected := &api.Auction{}
+	err := protojson.Unmarshal([]byte(a.Content), expected)
+	require.NoError(s.t, err)
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), auction.Seller)
+	require.Equal(s.t, expected.Quantity, auction.Quantity)
+	require.Equal(s.t, expected.AuctionType, auction.AuctionType)
+	require.Equal(s.t, expected.Denom, auction.Denom)
+	require.Equal(s.t, expected.StartPrice, auction.StartPrice)
+	require.Equal(s.t, expected.ReservePrice, auction.ReservePrice)
+	require.Equal(s.t, expected.StartTime.AsTime(), auction.StartTime.AsTime())
+	require.Equal(s.t, expected.EndTime.AsTime(), auction.EndTime.AsTime())
+	require.Equal(s.t, expected.PriceDecrement, auction.PriceDecrement)
+	require.Equal(s.t, expected.DecayInterval.AsDuration(), auction.DecayInterval.AsDuration())
+}
+
+func (s *createAuctionSuite) creditBatchSetup() uint64 {
+	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &coreapi.CreditType{
+		Abbreviation: "C",
+		Precision:    6,
+	})
+	require.NoError(s.t, err)
+
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
+		Id:               "C01",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       "C01-001",
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
+	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
+	})
+	require.NoError(s.t, err)
+
+	return batchKey
+}
@@ -0,0 +1,76 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// CreateQuoteRequest creates a new request for quote for a quantity of credits matching
+// the selection of the request. Nothing is held in escrow until a quote is accepted.
+func (k Keeper) CreateQuoteRequest(ctx context.Context, req *marketplace.MsgCreateQuoteRequest) (*marketplace.MsgCreateQuoteRequestResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	buyerAcc, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, err
+	}
+
+	selection, creditTypeAbbrev, err := k.getBuyOrderSelection(ctx, req.Selection)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("selection: %s", err.Error())
+	}
+
+	creditType, err := k.coreStore.CreditTypeTable().Get(ctx, creditTypeAbbrev)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in quote requests", req.Denom)
+	}
+
+	// verify expiration is in the future
+	if req.Expiration != nil && !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+	}
+
+	if _, err = math.NewPositiveFixedDecFromString(req.Quantity, creditType.Precision); err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"decimal places exceeds precision: quantity: %s, credit type precision: %d",
+			req.Quantity, creditType.Precision,
+		)
+	}
+
+	id, err := k.stateStore.QuoteRequestTable().InsertReturningID(ctx, &api.QuoteRequest{
+		Buyer:                  buyerAcc,
+		Selection:              selection,
+		Quantity:               req.Quantity,
+		Denom:                  req.Denom,
+		DisableAutoRetire:      req.DisableAutoRetire,
+		RetirementJurisdiction: req.RetirementJurisdiction,
+		RetirementBeneficiary:  req.RetirementBeneficiary,
+		RetirementReason:       req.RetirementReason,
+		Expiration:             toTimestamp(req.Expiration),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCreateQuoteRequest{
+		RequestId: id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgCreateQuoteRequestResponse{RequestId: id}, nil
+}
@@ -0,0 +1,123 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type createQuoteRequestSuite struct {
+	*baseSuite
+	alice sdk.AccAddress
+	msg   *marketplace.MsgCreateQuoteRequest
+	res   *marketplace.MsgCreateQuoteRequestResponse
+	err   error
+}
+
+func TestCreateQuoteRequest(t *testing.T) {
+	gocuke.NewRunner(t, &createQuoteRequestSuite{}).Path("./features/msg_create_quote_request.feature").Run()
+}
+
+func (s *createQuoteRequestSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+	s.alice = s.addrs[0]
+	s.msg = &marketplace.MsgCreateQuoteRequest{
+		Buyer: s.alice.String(),
+		Selection: &marketplace.MsgBuy_Selection{
+			Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+		},
+		Quantity:               "100",
+		Denom:                  "regen",
+		RetirementJurisdiction: "US-WA",
+	}
+}
+
+func (s *createQuoteRequestSuite) ACreditBatchWithBatchDenom(a string) {
+	require.Equal(s.t, "C01-001-20200101-20210101-001", a)
+	s.quoteSetup()
+}
+
+func (s *createQuoteRequestSuite) ABasketWithBasketDenom(a string) {
+	err := s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      a,
+		Name:             "NCT",
+		CreditTypeAbbrev: "C",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *createQuoteRequestSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *createQuoteRequestSuite) AliceAttemptsToCreateAQuoteRequestWithTheSelection(a gocuke.DocString) {
+	s.msg.Selection = &marketplace.MsgBuy_Selection{}
+	err := jsonpb.UnmarshalString(a.Content, s.msg.Selection)
+	require.NoError(s.t, err)
+
+	s.createQuoteRequest()
+}
+
+func (s *createQuoteRequestSuite) AliceAttemptsToCreateAQuoteRequestWithDenom(a string) {
+	s.msg.Denom = a
+	s.createQuoteRequest()
+}
+
+func (s *createQuoteRequestSuite) AliceAttemptsToCreateAQuoteRequestWithExpiration(a string) {
+	expiration, err := types.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.msg.Expiration = &expiration
+	s.createQuoteRequest()
+}
+
+func (s *createQuoteRequestSuite) AliceAttemptsToCreateAQuoteRequestWithQuantity(a string) {
+	s.msg.Quantity = a
+	s.createQuoteRequest()
+}
+
+func (s *createQuoteRequestSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *createQuoteRequestSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *createQuoteRequestSuite) ExpectTheResponse(a gocuke.DocString) {
+	expected := &marketplace.MsgCreateQuoteRequestResponse{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected, s.res)
+}
+
+func (s *createQuoteRequestSuite) ExpectQuoteRequestWithIdAndQuantity(a, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	request, err := s.marketStore.QuoteRequestTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), request.Buyer)
+	require.Equal(s.t, b, request.Quantity)
+	require.Equal(s.t, "regen", request.Denom)
+	require.Equal(s.t, "US-WA", request.RetirementJurisdiction)
+}
+
+func (s *createQuoteRequestSuite) createQuoteRequest() {
+	s.res, s.err = s.k.CreateQuoteRequest(s.ctx, s.msg)
+}
@@ -0,0 +1,343 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// removedDenomExpiration is the maximum period that the open orders of a denom are kept
+// after the denom is removed from the allowed denoms with the expire orders option.
+const removedDenomExpiration = 7 * 24 * time.Hour
+
+// RemoveAllowedDenom removes a denom from the list of approved denoms that may be used in
+// the marketplace. The quote requests of the denom are closed. Unless the orders are set to
+// expire, the open sell orders, buy orders and auctions of the denom are cancelled, returning
+// the escrowed credits and funds to their owners. Otherwise they are kept until they expire,
+// which is no later than removedDenomExpiration after the removal, and cannot be filled in
+// the meantime. The markets of the denom are kept so that their fees and clearing mode apply
+// again if the denom is allowed again.
+func (k Keeper) RemoveAllowedDenom(ctx context.Context, req *marketplace.MsgRemoveAllowedDenom) (*marketplace.MsgRemoveAllowedDenomResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	allowedDenom, err := k.stateStore.AllowedDenomTable().Get(ctx, req.Denom)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("allowed denom %s: %s", req.Denom, err.Error())
+	}
+
+	if err = k.closeDenomQuoteRequests(ctx, req.Denom); err != nil {
+		return nil, err
+	}
+
+	markets, err := k.getDenomMarkets(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.ExpireOrders {
+		expiration := sdk.UnwrapSDKContext(ctx).BlockTime().Add(removedDenomExpiration)
+
+		if err = k.expireMarketSellOrders(ctx, markets, expiration); err != nil {
+			return nil, err
+		}
+
+		if err = k.expireMarketBuyOrders(ctx, markets, expiration); err != nil {
+			return nil, err
+		}
+	} else {
+		if err = k.cancelMarketSellOrders(ctx, markets); err != nil {
+			return nil, err
+		}
+
+		if err = k.cancelMarketBuyOrders(ctx, markets); err != nil {
+			return nil, err
+		}
+
+		if err = k.cancelDenomAuctions(ctx, req.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = k.stateStore.AllowedDenomTable().Delete(ctx, allowedDenom); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventRemoveAllowedDenom{
+		Denom: req.Denom,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgRemoveAllowedDenomResponse{}, nil
+}
+
+// getDenomMarkets returns the markets of the bank denom keyed by market id.
+func (k Keeper) getDenomMarkets(ctx context.Context, bankDenom string) (map[uint64]*api.Market, error) {
+	it, err := k.stateStore.MarketTable().List(ctx, api.MarketIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	markets := make(map[uint64]*api.Market)
+	for it.Next() {
+		market, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		if market.BankDenom == bankDenom {
+			markets[market.Id] = market
+		}
+	}
+
+	return markets, nil
+}
+
+// closeDenomQuoteRequests closes the quote requests of the bank denom, returning the
+// escrowed credits of their quotes to the sellers.
+func (k Keeper) closeDenomQuoteRequests(ctx context.Context, bankDenom string) error {
+	it, err := k.stateStore.QuoteRequestTable().List(ctx, api.QuoteRequestIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var requests []*api.QuoteRequest
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if request.Denom == bankDenom {
+			requests = append(requests, request)
+		}
+	}
+	it.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, request := range requests {
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelQuoteRequest{
+			RequestId: request.Id,
+		}); err != nil {
+			return err
+		}
+		if err = k.closeQuoteRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireMarketSellOrders sets the expiration of the sell orders in the markets that have no
+// expiration or expire later than the expiration and disables their auto-renewal.
+func (k Keeper) expireMarketSellOrders(ctx context.Context, markets map[uint64]*api.Market, expiration time.Time) error {
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*api.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if _, ok := markets[sellOrder.MarketId]; ok {
+			sellOrders = append(sellOrders, sellOrder)
+		}
+	}
+	it.Close()
+
+	for _, sellOrder := range sellOrders {
+		if !hasExpiration(sellOrder.Expiration) || sellOrder.Expiration.AsTime().After(expiration) {
+			sellOrder.Expiration = timestamppb.New(expiration)
+		}
+		sellOrder.AutoRenew = nil
+		if err = k.stateStore.SellOrderTable().Update(ctx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// expireMarketBuyOrders sets the expiration of the buy orders in the markets that have no
+// expiration or expire later than the expiration.
+func (k Keeper) expireMarketBuyOrders(ctx context.Context, markets map[uint64]*api.Market, expiration time.Time) error {
+	it, err := k.stateStore.BuyOrderTable().List(ctx, api.BuyOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var buyOrders []*api.BuyOrder
+	for it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if _, ok := markets[buyOrder.MarketId]; ok {
+			buyOrders = append(buyOrders, buyOrder)
+		}
+	}
+	it.Close()
+
+	for _, buyOrder := range buyOrders {
+		if hasExpiration(buyOrder.Expiration) && !buyOrder.Expiration.AsTime().After(expiration) {
+			continue
+		}
+		buyOrder.Expiration = timestamppb.New(expiration)
+		if err = k.stateStore.BuyOrderTable().Update(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// hasExpiration checks if the expiration of an order is set. Orders without an expiration
+// may be read from state with the zero value timestamp instead of nil.
+func hasExpiration(expiration *timestamppb.Timestamp) bool {
+	return expiration != nil && (expiration.Seconds != 0 || expiration.Nanos != 0)
+}
+
+// cancelMarketSellOrders cancels the sell orders in the markets and returns the escrowed
+// credits to the sellers.
+func (k Keeper) cancelMarketSellOrders(ctx context.Context, markets map[uint64]*api.Market) error {
+	it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var sellOrders []*api.SellOrder
+	for it.Next() {
+		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if _, ok := markets[sellOrder.MarketId]; ok {
+			sellOrders = append(sellOrders, sellOrder)
+		}
+	}
+	it.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, sellOrder := range sellOrders {
+		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
+			return err
+		}
+		if err = k.orderBook.OnRemoveSellOrder(ctx, sellOrder); err != nil {
+			return err
+		}
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelSellOrder{
+			SellOrderId: sellOrder.Id,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelMarketBuyOrders cancels the buy orders in the markets and returns the escrowed
+// funds to the buyers.
+func (k Keeper) cancelMarketBuyOrders(ctx context.Context, markets map[uint64]*api.Market) error {
+	it, err := k.stateStore.BuyOrderTable().List(ctx, api.BuyOrderIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var buyOrders []*api.BuyOrder
+	for it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if _, ok := markets[buyOrder.MarketId]; ok {
+			buyOrders = append(buyOrders, buyOrder)
+		}
+	}
+	it.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, buyOrder := range buyOrders {
+		if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.orderBook.OnRemoveBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.stateStore.BuyOrderTable().Delete(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelBuyOrder{
+			BuyOrderId: buyOrder.Id,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// cancelDenomAuctions cancels the auctions priced in the bank denom, returning the escrowed
+// credits to the sellers and the escrowed funds of the highest bids to the bidders.
+func (k Keeper) cancelDenomAuctions(ctx context.Context, bankDenom string) error {
+	it, err := k.stateStore.AuctionTable().List(ctx, api.AuctionIdIndexKey{})
+	if err != nil {
+		return err
+	}
+
+	var auctions []*api.Auction
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		if auction.Denom == bankDenom {
+			auctions = append(auctions, auction)
+		}
+	}
+	it.Close()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, auction := range auctions {
+		if len(auction.Bidder) != 0 {
+			if err = k.refundBid(ctx, auction); err != nil {
+				return err
+			}
+		}
+		if err = k.unescrowCredits(ctx, auction.Seller, auction.BatchKey, auction.Quantity); err != nil {
+			return err
+		}
+		if err = k.stateStore.AuctionTable().Delete(ctx, auction); err != nil {
+			return err
+		}
+		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventCancelAuction{
+			AuctionId: auction.Id,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,343 @@
+package marketplace
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+)
+
+type removeAllowedDenomSuite struct {
+	*baseSuite
+	alice     sdk.AccAddress
+	bob       sdk.AccAddress
+	orderBook *recordingOrderBook
+	returned  sdk.Coins
+	err       error
+}
+
+// recordingOrderBook is an order book that records the sell orders removed from it.
+type recordingOrderBook struct {
+	orderbook.OrderBook
+	removedSellOrders []uint64
+}
+
+func (o *recordingOrderBook) OnRemoveSellOrder(ctx context.Context, sellOrder *api.SellOrder) error {
+	o.removedSellOrders = append(o.removedSellOrders, sellOrder.Id)
+	return o.OrderBook.OnRemoveSellOrder(ctx, sellOrder)
+}
+
+func TestRemoveAllowedDenom(t *testing.T) {
+	gocuke.NewRunner(t, &removeAllowedDenomSuite{}).Path("./features/msg_remove_allowed_denom.feature").Run()
+}
+
+func (s *removeAllowedDenomSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.orderBook = &recordingOrderBook{OrderBook: s.baseSuite.orderBook}
+	s.k.orderBook = s.orderBook
+}
+
+func (s *removeAllowedDenomSuite) ACreditBatchAndAnAllowedDenom(a string) {
+	s.testSellSetup(batchDenom, a, a[1:], classId, start, end, creditType)
+}
+
+func (s *removeAllowedDenomSuite) AliceCreatedASellOrderWithQuantity(a string) {
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	require.NoError(s.t, err)
+
+	askPrice := sdk.NewInt64Coin(market.BankDenom, 10)
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: s.alice.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: a, AskPrice: &askPrice},
+		},
+	})
+}
+
+func (s *removeAllowedDenomSuite) TheBlockTime(a string) {
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.setBlockTime(blockTime)
+}
+
+func (s *removeAllowedDenomSuite) AliceCreatedASellOrderWithQuantityAndExpiration(a, b string) {
+	expiration, err := time.Parse(time.RFC3339, b)
+	require.NoError(s.t, err)
+
+	askPrice := sdk.NewInt64Coin("uregen", 10)
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: s.alice.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: a, AskPrice: &askPrice, Expiration: &expiration},
+		},
+	})
+}
+
+func (s *removeAllowedDenomSuite) BobCreatedAQuoteRequestWithAQuoteFromAliceWithQuantity(a string) {
+	_, err := s.k.CreateQuoteRequest(s.ctx, &marketplace.MsgCreateQuoteRequest{
+		Buyer: s.bob.String(),
+		Selection: &marketplace.MsgBuy_Selection{
+			Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: batchDenom},
+		},
+		Quantity: a,
+		Denom:    "uregen",
+	})
+	require.NoError(s.t, err)
+
+	askPrice := sdk.NewInt64Coin("uregen", 10)
+	_, err = s.k.SubmitQuote(s.ctx, &marketplace.MsgSubmitQuote{
+		Seller:     s.alice.String(),
+		RequestId:  1,
+		BatchDenom: batchDenom,
+		AskPrice:   &askPrice,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *removeAllowedDenomSuite) BobCreatedABuyOrderWithQuantityAndBidAmount(a string, b string) {
+	s.insertBuyOrder(s.bob, a, b)
+	s.expectReturnedToBob()
+}
+
+func (s *removeAllowedDenomSuite) AliceCreatedAnAuctionWithQuantityAndABidFromBobWithPrice(a string, b string) {
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, 1)
+	require.NoError(s.t, err)
+
+	tradable, escrowed, quantity := s.parseDec(balance.TradableAmount), s.parseDec(balance.EscrowedAmount), s.parseDec(a)
+	tradable, err = tradable.Sub(quantity)
+	require.NoError(s.t, err)
+	escrowed, err = escrowed.Add(quantity)
+	require.NoError(s.t, err)
+
+	balance.TradableAmount = tradable.String()
+	balance.EscrowedAmount = escrowed.String()
+	require.NoError(s.t, s.coreStore.BatchBalanceTable().Update(s.ctx, balance))
+
+	err = s.marketStore.AuctionTable().Insert(s.ctx, &api.Auction{
+		Seller:      s.alice,
+		BatchKey:    1,
+		Quantity:    a,
+		AuctionType: api.AuctionType_AUCTION_TYPE_ENGLISH,
+		Denom:       "uregen",
+		StartPrice:  "1",
+		BidPrice:    b,
+		Bidder:      s.bob,
+	})
+	require.NoError(s.t, err)
+
+	s.expectReturnedToBob()
+}
+
+func (s *removeAllowedDenomSuite) expectReturnedToBob() {
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(s.sdkCtx, ecocredit.ModuleName, s.bob, gmAny).
+		Do(func(_ sdk.Context, _ string, _ sdk.AccAddress, amt sdk.Coins) {
+			s.returned = s.returned.Add(amt...)
+		}).
+		Return(nil).
+		AnyTimes() // not expected on failed attempt
+}
+
+func (s *removeAllowedDenomSuite) AliceAttemptsToRemoveTheAllowedDenomWithProperties(a gocuke.DocString) {
+	var msg marketplace.MsgRemoveAllowedDenom
+	err := jsonpb.UnmarshalString(a.Content, &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.RemoveAllowedDenom(s.ctx, &msg)
+}
+
+func (s *removeAllowedDenomSuite) TheExpiredOrdersArePrunedAt(a string) {
+	require.NoError(s.t, s.err)
+
+	blockTime, err := time.Parse(time.RFC3339, a)
+	require.NoError(s.t, err)
+
+	s.setBlockTime(blockTime)
+	require.NoError(s.t, s.k.PruneSellOrders(s.ctx))
+	require.NoError(s.t, s.k.PruneBuyOrders(s.ctx))
+}
+
+func (s *removeAllowedDenomSuite) BobAttemptsToBuyCreditsDirectlyFromTheSellOrderWithId(a, b string) {
+	require.NoError(s.t, s.err)
+
+	bidPrice := sdk.NewInt64Coin("uregen", 10)
+	_, s.err = s.k.BuyDirect(s.ctx, &marketplace.MsgBuyDirect{
+		Buyer: s.bob.String(),
+		Orders: []*marketplace.MsgBuyDirect_Order{
+			{SellOrderId: s.parseId(b), Quantity: a, BidPrice: &bidPrice, RetirementJurisdiction: "US-WA"},
+		},
+	})
+}
+
+func (s *removeAllowedDenomSuite) TheOrdersAreProcessed() {
+	require.NoError(s.t, s.err)
+	require.NoError(s.t, s.k.ProcessOrders(s.ctx))
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *removeAllowedDenomSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *removeAllowedDenomSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *removeAllowedDenomSuite) ExpectMarketWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.MarketTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectSellOrderWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectSellOrderWithIdRemovedFromTheOrderBook(a string) {
+	require.Contains(s.t, s.orderBook.removedSellOrders, s.parseId(a))
+}
+
+func (s *removeAllowedDenomSuite) ExpectBuyOrderWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectSellOrderWithIdAndExpiration(a, b string) {
+	require.NoError(s.t, s.err)
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, sellOrder.Expiration.AsTime().Format(time.RFC3339))
+	require.Nil(s.t, sellOrder.AutoRenew)
+}
+
+func (s *removeAllowedDenomSuite) ExpectBuyOrderWithIdAndExpiration(a, b string) {
+	require.NoError(s.t, s.err)
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, buyOrder.Expiration.AsTime().Format(time.RFC3339))
+}
+
+func (s *removeAllowedDenomSuite) ExpectBuyOrderWithIdAndQuantity(a, b string) {
+	require.NoError(s.t, s.err)
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.Equal(s.t, b, buyOrder.Quantity)
+}
+
+func (s *removeAllowedDenomSuite) ExpectSellOrderWithIdIsAFailedSellOrderOfBuyOrderWithId(a, b string) {
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, s.parseId(b))
+	require.NoError(s.t, err)
+	require.Contains(s.t, buyOrder.FailedSellOrderIds, s.parseId(a))
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoQuoteRequestWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.QuoteRequestTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectAuctionWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.AuctionTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.True(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoAuctionWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.AuctionTable().Has(s.ctx, s.parseId(a))
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoAllowedDenom(a string) {
+	require.NoError(s.t, s.err)
+
+	found, err := s.marketStore.AllowedDenomTable().Has(s.ctx, a)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoSellOrderWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, id)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectNoBuyOrderWithId(a string) {
+	require.NoError(s.t, s.err)
+
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, id)
+	require.NoError(s.t, err)
+	require.False(s.t, found)
+}
+
+func (s *removeAllowedDenomSuite) ExpectAliceTradableCreditBalanceAndEscrowedCreditBalance(a string, b string) {
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, 1)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+	require.Equal(s.t, b, balance.EscrowedAmount)
+}
+
+func (s *removeAllowedDenomSuite) ExpectReturnedToBob(a string) {
+	require.NoError(s.t, s.err)
+
+	expected, err := sdk.ParseCoinsNormalized(a)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.String(), s.returned.String())
+}
+
+func (s *removeAllowedDenomSuite) parseId(a string) uint64 {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+	return id
+}
+
+func (s *removeAllowedDenomSuite) parseDec(a string) math.Dec {
+	dec, err := math.NewDecFromString(a)
+	require.NoError(s.t, err)
+	return dec
+}
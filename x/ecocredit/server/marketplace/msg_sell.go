@@ -4,11 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	marketApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	"github.com/regen-network/regen-ledger/types/math"
@@ -50,6 +52,18 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			return nil, err
 		}
 
+		if order.TimeInForce == marketplace.TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL {
+			market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+			if err != nil {
+				return nil, err
+			}
+			if market.ClearingMode != marketApi.ClearingMode_CLEARING_MODE_CONTINUOUS {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s: immediate-or-cancel sell orders are only supported in markets with continuous clearing", orderIndex,
+				)
+			}
+		}
+
 		// verify expiration is in the future
 		if order.Expiration != nil && !order.Expiration.UTC().After(sdkCtx.BlockTime()) {
 			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
@@ -62,11 +76,15 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			return nil, err
 		}
 
+		if err = validateFillConstraints(orderIndex, sellQty, order.MinFillQuantity, order.LotSize, creditType.Precision); err != nil {
+			return nil, err
+		}
+
 		if err = k.escrowCredits(ctx, orderIndex, sellerAcc, batch.Key, sellQty); err != nil {
 			return nil, err
 		}
 
-		allowed, err := isDenomAllowed(ctx, order.AskPrice.Denom, k.stateStore.AllowedDenomTable())
+		allowed, err := k.isAskDenomAllowed(ctx, order.AskPrice.Denom)
 		if err != nil {
 			return nil, err
 		}
@@ -75,26 +93,75 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 				"%s: %s is not allowed to be used in sell orders", orderIndex, order.AskPrice.Denom,
 			)
 		}
+		if order.AskPrice.Denom == batch.Denom {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: cannot ask for credits of the batch being sold", orderIndex,
+			)
+		}
+
+		allowedBuyers := make([][]byte, len(order.AllowedBuyers))
+		for j, allowedBuyer := range order.AllowedBuyers {
+			if allowedBuyers[j], err = sdk.AccAddressFromBech32(allowedBuyer); err != nil {
+				return nil, err
+			}
+		}
+
+		var groupPolicy sdk.AccAddress
+		if order.AllowedBuyerGroupPolicy != "" {
+			if groupPolicy, err = sdk.AccAddressFromBech32(order.AllowedBuyerGroupPolicy); err != nil {
+				return nil, err
+			}
+			if _, err = k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{
+				Address: order.AllowedBuyerGroupPolicy,
+			}); err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+					"%s: allowed buyer group policy %s: %s", orderIndex, order.AllowedBuyerGroupPolicy, err.Error(),
+				)
+			}
+		}
 
 		var expiration *timestamppb.Timestamp
 		if order.Expiration != nil {
 			expiration = timestamppb.New(*order.Expiration)
 		}
 
-		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, &marketApi.SellOrder{
-			Seller:            sellerAcc,
-			BatchKey:          batch.Key,
-			Quantity:          order.Quantity,
-			MarketId:          marketId,
-			AskAmount:         order.AskPrice.Amount.String(),
-			DisableAutoRetire: order.DisableAutoRetire,
-			Expiration:        expiration,
-			Maker:             true, // maker is always true for sell orders
-		})
+		var autoRenew *marketApi.SellOrder_AutoRenew
+		if order.AutoRenew != nil {
+			autoRenew = &marketApi.SellOrder_AutoRenew{
+				RenewalPeriod: durationpb.New(*order.AutoRenew.RenewalPeriod),
+				PriceDecay:    order.AutoRenew.PriceDecay,
+				FloorAmount:   order.AutoRenew.FloorAmount,
+				MaxRenewals:   order.AutoRenew.MaxRenewals,
+			}
+		}
+
+		sellOrder := &marketApi.SellOrder{
+			Seller:                  sellerAcc,
+			BatchKey:                batch.Key,
+			Quantity:                order.Quantity,
+			MarketId:                marketId,
+			AskAmount:               order.AskPrice.Amount.String(),
+			DisableAutoRetire:       order.DisableAutoRetire,
+			Expiration:              expiration,
+			Maker:                   true, // maker is always true for sell orders
+			MinFillQuantity:         order.MinFillQuantity,
+			LotSize:                 order.LotSize,
+			AllowedBuyers:           allowedBuyers,
+			AllowedBuyerGroupPolicy: groupPolicy,
+			TimeInForce:             marketApi.TimeInForce(order.TimeInForce),
+			AutoRenew:               autoRenew,
+		}
+
+		id, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, sellOrder)
 		if err != nil {
 			return nil, err
 		}
 
+		sellOrder.Id = id
+		if err = k.orderBook.OnInsertSellOrder(ctx, sellOrder, batch); err != nil {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err.Error())
+		}
+
 		sellOrderIds[i] = id
 
 		if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSell{
@@ -103,12 +170,47 @@ func (k Keeper) Sell(ctx context.Context, req *marketplace.MsgSell) (*marketplac
 			return nil, err
 		}
 
+		if order.TimeInForce == marketplace.TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL {
+			if err = k.fillImmediateOrCancel(ctx, id); err != nil {
+				return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", orderIndex, err.Error())
+			}
+		}
+
 		sdkCtx.GasMeter().ConsumeGas(ecocredit.GasCostPerIteration, "ecocredit/core/MsgSell order iteration")
 	}
 
 	return &marketplace.MsgSellResponse{SellOrderIds: sellOrderIds}, nil
 }
 
+// fillImmediateOrCancel fills an immediate-or-cancel sell order against the matching buy
+// orders in the order book at the ask price of the sell order and cancels the quantity of
+// the sell order that is not filled, returning the credits to the seller.
+func (k Keeper) fillImmediateOrCancel(ctx context.Context, sellOrderId uint64) error {
+	if err := k.orderBook.ProcessSellOrder(ctx, sellOrderId, k.fillBuyOrder); err != nil {
+		return err
+	}
+
+	sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, sellOrderId)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
+		return err
+	}
+
+	if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&marketplace.EventCancelSellOrder{
+		SellOrderId: sellOrderId,
+	})
+}
+
 // getOrCreateMarketId attempts to get a market, creating one otherwise, and return the Id.
 func (k Keeper) getOrCreateMarketId(ctx context.Context, creditTypeAbbrev, bankDenom string) (uint64, error) {
 	market, err := k.stateStore.MarketTable().GetByCreditTypeAbbrevBankDenom(ctx, creditTypeAbbrev, bankDenom)
@@ -126,6 +228,35 @@ func (k Keeper) getOrCreateMarketId(ctx context.Context, creditTypeAbbrev, bankD
 	}
 }
 
+// validateFillConstraints checks that the optional minimum fill quantity and lot size of a
+// sell order do not exceed the credit type precision and that the quantity of the sell
+// order is a multiple of the lot size.
+func validateFillConstraints(orderIndex string, quantity math.Dec, minFillQuantity, lotSize string, precision uint32) error {
+	if minFillQuantity != "" {
+		if _, err := math.NewPositiveFixedDecFromString(minFillQuantity, precision); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: min fill quantity: %s", orderIndex, err.Error())
+		}
+	}
+
+	if lotSize != "" {
+		lot, err := math.NewPositiveFixedDecFromString(lotSize, precision)
+		if err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("%s: lot size: %s", orderIndex, err.Error())
+		}
+		rem, err := quantity.Rem(lot)
+		if err != nil {
+			return err
+		}
+		if !rem.IsZero() {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: quantity %s is not a multiple of the lot size %s", orderIndex, quantity, lotSize,
+			)
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) escrowCredits(ctx context.Context, orderIndex string, account sdk.AccAddress, batchKey uint64, quantity math.Dec) error {
 	bal, err := k.coreStore.BatchBalanceTable().Get(ctx, account, batchKey)
 	if err != nil {
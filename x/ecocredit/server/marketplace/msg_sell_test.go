@@ -12,7 +12,10 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
+	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
 	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
@@ -89,6 +92,13 @@ func (s *sellSuite) ACreditTypeWithPrecision(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *sellSuite) AGroupPolicyWithAddress(a string) {
+	s.groupKeeper.EXPECT().
+		GroupPolicyInfo(gmAny, &group.QueryGroupPolicyInfoRequest{Address: a}).
+		Return(&group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: a, GroupId: 1}}, nil).
+		AnyTimes()
+}
+
 func (s *sellSuite) AnAllowedDenom() {
 	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
 		BankDenom: s.askPrice.Denom,
@@ -103,6 +113,15 @@ func (s *sellSuite) AnAllowedDenomWithBankDenom(a string) {
 	require.NoError(s.t, err)
 }
 
+func (s *sellSuite) ABasketWithBasketDenom(a string) {
+	err := s.basketStore.BasketTable().Insert(s.ctx, &basketapi.Basket{
+		BasketDenom:      a,
+		Name:             "NCT",
+		CreditTypeAbbrev: s.creditTypeAbbrev,
+	})
+	require.NoError(s.t, err)
+}
+
 func (s *sellSuite) ACreditBatchWithBatchDenom(a string) {
 	classId := core.GetClassIdFromBatchDenom(a)
 	creditTypeAbbrev := core.GetCreditTypeAbbrevFromClassId(classId)
@@ -114,6 +133,7 @@ func (s *sellSuite) ACreditBatchWithBatchDenom(a string) {
 	require.NoError(s.t, err)
 
 	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		Id:       core.GetProjectIdFromBatchDenom(a),
 		ClassKey: classKey,
 	})
 	require.NoError(s.t, err)
@@ -294,6 +314,26 @@ func (s *sellSuite) AliceAttemptsToCreateASellOrder() {
 	})
 }
 
+func (s *sellSuite) AliceAttemptsToCreateASellOrderWithAllowedBuyerGroupPolicy(a string) {
+	// group policies that have not been registered do not exist
+	s.groupKeeper.EXPECT().
+		GroupPolicyInfo(gmAny, gmAny).
+		Return(nil, sdkerrors.ErrNotFound).
+		AnyTimes()
+
+	s.res, s.err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.alice.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{
+				BatchDenom:              s.batchDenom,
+				Quantity:                s.quantity,
+				AskPrice:                s.askPrice,
+				AllowedBuyerGroupPolicy: a,
+			},
+		},
+	})
+}
+
 func (s *sellSuite) AliceAttemptsToCreateTwoSellOrders() {
 	s.res, s.err = s.k.Sell(s.ctx, &marketplace.MsgSell{
 		Seller: s.alice.String(),
@@ -394,6 +434,24 @@ func (s *sellSuite) ExpectSellOrderWithSellerAliceAndTheProperties(a gocuke.DocS
 	require.Equal(s.t, expected.DisableAutoRetire, order.DisableAutoRetire)
 	require.Equal(s.t, expected.MarketId, order.MarketId)
 	require.Equal(s.t, expected.Maker, order.Maker)
+	require.Equal(s.t, expected.MinFillQuantity, order.MinFillQuantity)
+	require.Equal(s.t, expected.LotSize, order.LotSize)
+	require.Equal(s.t, int32(expected.TimeInForce), int32(order.TimeInForce))
+	if expected.AutoRenew == nil {
+		require.Nil(s.t, order.AutoRenew)
+	} else {
+		require.Equal(s.t, expected.AutoRenew.RenewalPeriod.Seconds, order.AutoRenew.RenewalPeriod.Seconds)
+		require.Equal(s.t, expected.AutoRenew.PriceDecay, order.AutoRenew.PriceDecay)
+		require.Equal(s.t, expected.AutoRenew.FloorAmount, order.AutoRenew.FloorAmount)
+		require.Equal(s.t, expected.AutoRenew.MaxRenewals, order.AutoRenew.MaxRenewals)
+	}
+}
+
+func (s *sellSuite) ExpectSellOrderWithAllowedBuyerGroupPolicy(a string) {
+	order, err := s.marketStore.SellOrderTable().Get(s.ctx, s.res.SellOrderIds[0])
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, sdk.AccAddress(order.AllowedBuyerGroupPolicy).String())
 }
 
 func (s *sellSuite) ExpectTheResponse(a gocuke.DocString) {
@@ -0,0 +1,76 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type setMarketClearingModeSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestSetMarketClearingMode(t *testing.T) {
+	gocuke.NewRunner(t, &setMarketClearingModeSuite{}).Path("./features/msg_set_market_clearing_mode.feature").Run()
+}
+
+func (s *setMarketClearingModeSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+}
+
+func (s *setMarketClearingModeSuite) ACreditTypeWithAbbreviation(a string) {
+	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &ecoApi.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setMarketClearingModeSuite) AnAllowedDenom(a string) {
+	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom:    a,
+		DisplayDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setMarketClearingModeSuite) AliceAttemptsToSetTheMarketClearingModeWithProperties(a gocuke.DocString) {
+	var msg marketplace.MsgSetMarketClearingMode
+	err := jsonpb.UnmarshalString(a.Content, &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.SetMarketClearingMode(s.ctx, &msg)
+}
+
+func (s *setMarketClearingModeSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *setMarketClearingModeSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *setMarketClearingModeSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *setMarketClearingModeSuite) ExpectMarketWithProperties(a gocuke.DocString) {
+	var expected marketplace.Market
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.CreditTypeAbbrev, market.CreditTypeAbbrev)
+	require.Equal(s.t, expected.BankDenom, market.BankDenom)
+	require.Equal(s.t, int32(expected.ClearingMode), int32(market.ClearingMode))
+	require.Equal(s.t, expected.EpochLength, market.EpochLength)
+}
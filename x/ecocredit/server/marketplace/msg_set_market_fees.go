@@ -0,0 +1,58 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// SetMarketFees sets the buyer fee, the seller fee and the fee destination of the market
+// for a credit type and an allowed denom. The market is created if it does not exist yet.
+func (k Keeper) SetMarketFees(ctx context.Context, req *marketplace.MsgSetMarketFees) (*marketplace.MsgSetMarketFeesResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, govtypes.ErrInvalidSigner.Wrapf("invalid authority: expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if _, err := k.coreStore.CreditTypeTable().Get(ctx, req.CreditTypeAbbrev); err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("credit type %s: %s", req.CreditTypeAbbrev, err.Error())
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, req.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+	if !allowed {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("%s is not allowed to be used in the marketplace", req.BankDenom)
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, req.CreditTypeAbbrev, req.BankDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+	if err != nil {
+		return nil, err
+	}
+
+	market.BuyerFee = req.BuyerFee
+	market.SellerFee = req.SellerFee
+	market.FeeDestination = api.FeeDestination(req.FeeDestination)
+	if err = k.stateStore.MarketTable().Update(ctx, market); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSetMarketFees{
+		MarketId: marketId,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgSetMarketFeesResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type setMarketFeesSuite struct {
+	*baseSuite
+	err error
+}
+
+func TestSetMarketFees(t *testing.T) {
+	gocuke.NewRunner(t, &setMarketFeesSuite{}).Path("./features/msg_set_market_fees.feature").Run()
+}
+
+func (s *setMarketFeesSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 1)
+}
+
+func (s *setMarketFeesSuite) ACreditTypeWithAbbreviation(a string) {
+	err := s.coreStore.CreditTypeTable().Insert(s.ctx, &ecoApi.CreditType{
+		Abbreviation: a,
+		Name:         a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setMarketFeesSuite) AnAllowedDenom(a string) {
+	err := s.marketStore.AllowedDenomTable().Insert(s.ctx, &api.AllowedDenom{
+		BankDenom:    a,
+		DisplayDenom: a,
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *setMarketFeesSuite) AliceAttemptsToSetTheMarketFeesWithProperties(a gocuke.DocString) {
+	var msg marketplace.MsgSetMarketFees
+	err := jsonpb.UnmarshalString(a.Content, &msg)
+	require.NoError(s.t, err)
+
+	_, s.err = s.k.SetMarketFees(s.ctx, &msg)
+}
+
+func (s *setMarketFeesSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *setMarketFeesSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *setMarketFeesSuite) ExpectErrorContains(a string) {
+	require.ErrorContains(s.t, s.err, a)
+}
+
+func (s *setMarketFeesSuite) ExpectMarketWithProperties(a gocuke.DocString) {
+	var expected marketplace.Market
+	err := jsonpb.UnmarshalString(a.Content, &expected)
+	require.NoError(s.t, err)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, expected.Id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected.CreditTypeAbbrev, market.CreditTypeAbbrev)
+	require.Equal(s.t, expected.BankDenom, market.BankDenom)
+	require.Equal(s.t, expected.BuyerFee, market.BuyerFee)
+	require.Equal(s.t, expected.SellerFee, market.SellerFee)
+	require.Equal(s.t, int32(expected.FeeDestination), int32(market.FeeDestination))
+}
@@ -0,0 +1,129 @@
+package marketplace
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SubmitQuote submits a quote for the full quantity of a quote request. The quoted
+// credits are moved into escrow in a private sell order that only the buyer of the
+// quote request is allowed to fill.
+func (k Keeper) SubmitQuote(ctx context.Context, req *marketplace.MsgSubmitQuote) (*marketplace.MsgSubmitQuoteResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sellerAcc, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := k.stateStore.QuoteRequestTable().Get(ctx, req.RequestId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("quote request with id %d: %s", req.RequestId, err.Error())
+	}
+
+	if sellerAcc.Equals(sdk.AccAddress(request.Buyer)) {
+		return nil, sdkerrors.ErrUnauthorized.Wrap("seller cannot be the buyer of the quote request")
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	project, err := k.coreStore.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if !orderbook.SelectsBatch(request.Selection, batch, project) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"batch denom %s does not match the selection of the quote request", batch.Denom,
+		)
+	}
+
+	if req.AskPrice.Denom != request.Denom {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"ask price denom: %s, quote request denom: %s", req.AskPrice.Denom, request.Denom,
+		)
+	}
+
+	// the quote expires with the quote request if the quote does not expire before it
+	expiration := request.Expiration
+	if req.Expiration != nil {
+		if !req.Expiration.UTC().After(sdkCtx.BlockTime()) {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf("expiration must be in the future: %s", req.Expiration)
+		}
+		if expiration == nil || req.Expiration.Before(expiration.AsTime()) {
+			expiration = timestamppb.New(*req.Expiration)
+		}
+	}
+
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, batch.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	marketId, err := k.getOrCreateMarketId(ctx, creditType.Abbreviation, request.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	quantity, err := math.NewPositiveFixedDecFromString(request.Quantity, creditType.Precision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.escrowCredits(ctx, "quote", sellerAcc, batch.Key, quantity); err != nil {
+		return nil, err
+	}
+
+	sellOrder := &api.SellOrder{
+		Seller:            sellerAcc,
+		BatchKey:          batch.Key,
+		Quantity:          request.Quantity,
+		MarketId:          marketId,
+		AskAmount:         req.AskPrice.Amount.String(),
+		DisableAutoRetire: request.DisableAutoRetire,
+		Expiration:        expiration,
+		Maker:             true, // maker is always true for sell orders
+		AllowedBuyers:     [][]byte{request.Buyer},
+	}
+
+	sellOrderId, err := k.stateStore.SellOrderTable().InsertReturningID(ctx, sellOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	sellOrder.Id = sellOrderId
+	if err = k.orderBook.OnInsertSellOrder(ctx, sellOrder, batch); err != nil {
+		return nil, err
+	}
+
+	quoteId, err := k.stateStore.QuoteTable().InsertReturningID(ctx, &api.Quote{
+		QuoteRequestId: request.Id,
+		Seller:         sellerAcc,
+		SellOrderId:    sellOrderId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSubmitQuote{
+		QuoteId:   quoteId,
+		RequestId: request.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.MsgSubmitQuoteResponse{QuoteId: quoteId, SellOrderId: sellOrderId}, nil
+}
@@ -0,0 +1,192 @@
+package marketplace
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/gogo/protobuf/jsonpb"
+	"github.com/regen-network/gocuke"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	coreapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+type submitQuoteSuite struct {
+	*baseSuite
+	alice    sdk.AccAddress
+	bob      sdk.AccAddress
+	batchKey uint64
+	request  *marketplace.MsgCreateQuoteRequest
+	msg      *marketplace.MsgSubmitQuote
+	res      *marketplace.MsgSubmitQuoteResponse
+	err      error
+}
+
+func TestSubmitQuote(t *testing.T) {
+	gocuke.NewRunner(t, &submitQuoteSuite{}).Path("./features/msg_submit_quote.feature").Run()
+}
+
+func (s *submitQuoteSuite) Before(t gocuke.TestingT) {
+	s.baseSuite = setupBase(t, 2)
+	s.alice = s.addrs[0]
+	s.bob = s.addrs[1]
+	s.request = &marketplace.MsgCreateQuoteRequest{
+		Buyer: s.bob.String(),
+		Selection: &marketplace.MsgBuy_Selection{
+			Sum: &marketplace.MsgBuy_Selection_ProjectId{ProjectId: "C01-001"},
+		},
+		Quantity:          "100",
+		Denom:             "regen",
+		DisableAutoRetire: true,
+	}
+	s.msg = &marketplace.MsgSubmitQuote{
+		Seller:     s.alice.String(),
+		RequestId:  1,
+		BatchDenom: "C01-001-20200101-20210101-001",
+		AskPrice:   &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(10)},
+	}
+}
+
+func (s *submitQuoteSuite) ABlockTimeWithTimestamp(a string) {
+	blockTime, err := types.ParseDate("block time", a)
+	require.NoError(s.t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func (s *submitQuoteSuite) ACreditBatchWithBatchDenom(a string) {
+	require.Equal(s.t, "C01-001-20200101-20210101-001", a)
+	s.batchKey = s.quoteSetup()
+}
+
+func (s *submitQuoteSuite) AliceHasATradableBatchBalanceWithAmount(a string) {
+	err := s.coreStore.BatchBalanceTable().Insert(s.ctx, &coreapi.BatchBalance{
+		BatchKey:       s.batchKey,
+		Address:        s.alice,
+		TradableAmount: a,
+		EscrowedAmount: "0",
+	})
+	require.NoError(s.t, err)
+}
+
+func (s *submitQuoteSuite) BobCreatedAQuoteRequestWithQuantity(a string) {
+	s.request.Quantity = a
+	s.createQuoteRequest()
+}
+
+func (s *submitQuoteSuite) AliceCreatedAQuoteRequestWithQuantity(a string) {
+	s.request.Buyer = s.alice.String()
+	s.request.Quantity = a
+	s.createQuoteRequest()
+}
+
+func (s *submitQuoteSuite) BobCreatedAQuoteRequestWithTheSelection(a gocuke.DocString) {
+	s.request.Selection = &marketplace.MsgBuy_Selection{}
+	err := jsonpb.UnmarshalString(a.Content, s.request.Selection)
+	require.NoError(s.t, err)
+
+	s.createQuoteRequest()
+}
+
+func (s *submitQuoteSuite) BobCreatedAQuoteRequestWithExpiration(a string) {
+	expiration, err := types.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.request.Expiration = &expiration
+	s.createQuoteRequest()
+}
+
+func (s *submitQuoteSuite) AliceAttemptsToSubmitAQuoteWithAskPrice(a string) {
+	askPrice, err := sdk.ParseCoinNormalized(a)
+	require.NoError(s.t, err)
+
+	s.msg.AskPrice = &askPrice
+	s.res, s.err = s.k.SubmitQuote(s.ctx, s.msg)
+}
+
+func (s *submitQuoteSuite) AliceAttemptsToSubmitAQuoteWithExpiration(a string) {
+	expiration, err := types.ParseDate("expiration", a)
+	require.NoError(s.t, err)
+
+	s.msg.Expiration = &expiration
+	s.res, s.err = s.k.SubmitQuote(s.ctx, s.msg)
+}
+
+func (s *submitQuoteSuite) ExpectNoError() {
+	require.NoError(s.t, s.err)
+}
+
+func (s *submitQuoteSuite) ExpectTheError(a string) {
+	require.EqualError(s.t, s.err, a)
+}
+
+func (s *submitQuoteSuite) ExpectTheResponse(a gocuke.DocString) {
+	expected := &marketplace.MsgSubmitQuoteResponse{}
+	err := jsonpb.UnmarshalString(a.Content, expected)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expected, s.res)
+}
+
+func (s *submitQuoteSuite) ExpectAliceTradableCreditBalance(a string) {
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.TradableAmount)
+}
+
+func (s *submitQuoteSuite) ExpectAliceEscrowedCreditBalance(a string) {
+	balance, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.alice, s.batchKey)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, a, balance.EscrowedAmount)
+}
+
+func (s *submitQuoteSuite) ExpectSellOrderWithIdAndExpiration(a, b string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	expiration, err := types.ParseDate("expiration", b)
+	require.NoError(s.t, err)
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, expiration, sellOrder.Expiration.AsTime())
+}
+
+func (s *submitQuoteSuite) ExpectSellOrderWithIdPrivateToBob(a string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, s.alice.Bytes(), sellOrder.Seller)
+	require.Equal(s.t, "100", sellOrder.Quantity)
+	require.Equal(s.t, "10", sellOrder.AskAmount)
+	require.True(s.t, sellOrder.DisableAutoRetire)
+	require.Equal(s.t, [][]byte{s.bob.Bytes()}, sellOrder.AllowedBuyers)
+}
+
+func (s *submitQuoteSuite) ExpectQuoteWithIdForQuoteRequestAndSellOrder(a, b, c string) {
+	id, err := strconv.ParseUint(a, 10, 64)
+	require.NoError(s.t, err)
+
+	quote, err := s.marketStore.QuoteTable().Get(s.ctx, id)
+	require.NoError(s.t, err)
+
+	require.Equal(s.t, b, strconv.FormatUint(quote.QuoteRequestId, 10))
+	require.Equal(s.t, c, strconv.FormatUint(quote.SellOrderId, 10))
+	require.Equal(s.t, s.alice.Bytes(), quote.Seller)
+}
+
+func (s *submitQuoteSuite) createQuoteRequest() {
+	_, err := s.k.CreateQuoteRequest(s.ctx, s.request)
+	require.NoError(s.t, err)
+}
@@ -41,6 +41,17 @@ func (k Keeper) UpdateSellOrders(ctx context.Context, req *marketplace.MsgUpdate
 			return nil, sdkerrors.ErrUnauthorized.Wrapf("%s: seller must be the seller of the sell order", updateIndex)
 		}
 
+		// a quote is binding, so the sell order of a quote can be cancelled but not updated
+		quoted, err := k.stateStore.QuoteTable().HasBySellOrderId(ctx, sellOrder.Id)
+		if err != nil {
+			return nil, err
+		}
+		if quoted {
+			return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: sell order with id %d is the sell order of a quote and cannot be updated", updateIndex, sellOrder.Id,
+			)
+		}
+
 		if err = k.applySellOrderUpdates(ctx, updateIndex, sellOrder, update); err != nil {
 			return nil, err
 		}
@@ -62,7 +73,7 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 			return err
 		}
 
-		allowed, err := isDenomAllowed(ctx, update.NewAskPrice.Denom, k.stateStore.AllowedDenomTable())
+		allowed, err := k.isAskDenomAllowed(ctx, update.NewAskPrice.Denom)
 		if err != nil {
 			return err
 		}
@@ -73,6 +84,16 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 			)
 		}
 
+		batch, err := k.coreStore.BatchTable().Get(ctx, order.BatchKey)
+		if err != nil {
+			return err
+		}
+		if update.NewAskPrice.Denom == batch.Denom {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: cannot ask for credits of the batch being sold", updateIndex,
+			)
+		}
+
 		if market.BankDenom != update.NewAskPrice.Denom {
 			creditType, err = k.getCreditTypeFromBatchKey(ctx, order.BatchKey)
 			if err != nil {
@@ -89,6 +110,12 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 	}
 
 	if update.NewExpiration != nil {
+		if order.TimeInForce == api.TimeInForce_TIME_IN_FORCE_GOOD_TIL_CANCELLED {
+			return sdkerrors.ErrInvalidRequest.Wrapf(
+				"%s: expiration cannot be set for good-til-cancelled sell orders", updateIndex,
+			)
+		}
+
 		// force to UTC
 		expirationUTC := update.NewExpiration.UTC()
 		update.NewExpiration = &expirationUTC
@@ -144,17 +171,63 @@ func (k Keeper) applySellOrderUpdates(ctx context.Context, updateIndex string, o
 		}
 	}
 
+	if update.NewMinFillQuantity != "" || update.NewLotSize != "" {
+		if creditType == nil {
+			var err error
+			creditType, err = k.getCreditTypeFromBatchKey(ctx, order.BatchKey)
+			if err != nil {
+				return err
+			}
+		}
+		if update.NewMinFillQuantity != "" {
+			order.MinFillQuantity = fillConstraint(update.NewMinFillQuantity)
+		}
+		if update.NewLotSize != "" {
+			order.LotSize = fillConstraint(update.NewLotSize)
+		}
+	}
+
+	// the quantity of the sell order must remain a multiple of the lot size
+	// whenever the quantity or the lot size is updated
+	if update.NewQuantity != "" || update.NewMinFillQuantity != "" || update.NewLotSize != "" {
+		quantity, err := math.NewDecFromString(order.Quantity)
+		if err != nil {
+			return err
+		}
+		if err = validateFillConstraints(updateIndex, quantity, order.MinFillQuantity, order.LotSize, creditType.Precision); err != nil {
+			return err
+		}
+	}
+
 	order.Maker = true // maker is always true for sell orders
 
 	if err := k.stateStore.SellOrderTable().Update(ctx, order); err != nil {
 		return err
 	}
 
+	batch, err := k.coreStore.BatchTable().Get(ctx, order.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	if err = k.orderBook.OnInsertSellOrder(ctx, order, batch); err != nil {
+		return sdkerrors.ErrInvalidRequest.Wrapf("%s: %s", updateIndex, err.Error())
+	}
+
 	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventUpdateSellOrder{
 		SellOrderId: order.Id,
 	})
 }
 
+// fillConstraint returns the minimum fill quantity or lot size of a sell order set by
+// an update, which removes the constraint if the updated value is zero.
+func fillConstraint(value string) string {
+	if dec, err := math.NewDecFromString(value); err == nil && dec.IsZero() {
+		return ""
+	}
+	return value
+}
+
 // getCreditTypeFromBatchKey gets the credit type given a batch key.
 func (k Keeper) getCreditTypeFromBatchKey(ctx context.Context, key uint64) (*ecoApi.CreditType, error) {
 	batch, err := k.coreStore.BatchTable().Get(ctx, key)
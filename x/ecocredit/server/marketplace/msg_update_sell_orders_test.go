@@ -33,6 +33,9 @@ type updateSellOrdersSuite struct {
 	quantity            string
 	disableAutoRetire   bool
 	expiration          *time.Time
+	minFillQuantity     string
+	lotSize             string
+	timeInForce         marketplace.TimeInForce
 	res                 *marketplace.MsgUpdateSellOrdersResponse
 	err                 error
 }
@@ -166,6 +169,9 @@ func (s *updateSellOrdersSuite) AliceCreatedASellOrderWithTheProperties(a gocuke
 	s.askPrice = order.AskPrice
 	s.disableAutoRetire = order.DisableAutoRetire
 	s.expiration = order.Expiration
+	s.minFillQuantity = order.MinFillQuantity
+	s.lotSize = order.LotSize
+	s.timeInForce = order.TimeInForce
 
 	s.sellOrderSetup(1)
 }
@@ -306,11 +312,13 @@ func (s *updateSellOrdersSuite) AliceAttemptsToUpdateTheSellOrderWithTheProperti
 		Seller: s.alice.String(),
 		Updates: []*marketplace.MsgUpdateSellOrders_Update{
 			{
-				SellOrderId:       s.sellOrderId,
-				NewQuantity:       update.NewQuantity,
-				NewAskPrice:       update.NewAskPrice,
-				DisableAutoRetire: update.DisableAutoRetire,
-				NewExpiration:     update.NewExpiration,
+				SellOrderId:        s.sellOrderId,
+				NewQuantity:        update.NewQuantity,
+				NewAskPrice:        update.NewAskPrice,
+				DisableAutoRetire:  update.DisableAutoRetire,
+				NewExpiration:      update.NewExpiration,
+				NewMinFillQuantity: update.NewMinFillQuantity,
+				NewLotSize:         update.NewLotSize,
 			},
 		},
 	})
@@ -409,6 +417,8 @@ func (s *updateSellOrdersSuite) ExpectSellOrderWithSellerAliceAndTheProperties(a
 	require.Equal(s.t, expected.DisableAutoRetire, order.DisableAutoRetire)
 	require.Equal(s.t, expected.MarketId, order.MarketId)
 	require.Equal(s.t, expected.Maker, order.Maker)
+	require.Equal(s.t, expected.MinFillQuantity, order.MinFillQuantity)
+	require.Equal(s.t, expected.LotSize, order.LotSize)
 }
 
 func (s *updateSellOrdersSuite) sellOrderSetup(count int) {
@@ -423,14 +433,20 @@ func (s *updateSellOrdersSuite) sellOrderSetup(count int) {
 		totalQuantity = t.String()
 	}
 
-	err := s.coreStore.ClassTable().Insert(s.ctx, &coreapi.Class{
+	classKey, err := s.coreStore.ClassTable().InsertReturningID(s.ctx, &coreapi.Class{
 		Id:               s.classId,
 		CreditTypeAbbrev: s.creditTypeAbbrev,
 	})
 	require.NoError(s.t, err)
 
+	projectKey, err := s.coreStore.ProjectTable().InsertReturningID(s.ctx, &coreapi.Project{
+		ClassKey: classKey,
+	})
+	require.NoError(s.t, err)
+
 	batchKey, err := s.coreStore.BatchTable().InsertReturningID(s.ctx, &coreapi.Batch{
-		Denom: s.batchDenom,
+		ProjectKey: projectKey,
+		Denom:      s.batchDenom,
 	})
 	require.NoError(s.t, err)
 
@@ -460,6 +476,9 @@ func (s *updateSellOrdersSuite) sellOrderSetup(count int) {
 		MarketId:          marketKey,
 		AskAmount:         s.askPrice.Amount.String(),
 		DisableAutoRetire: s.disableAutoRetire,
+		MinFillQuantity:   s.minFillQuantity,
+		LotSize:           s.lotSize,
+		TimeInForce:       api.TimeInForce(s.timeInForce),
 	}
 
 	if s.expiration != nil {
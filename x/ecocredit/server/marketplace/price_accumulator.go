@@ -0,0 +1,211 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+// recordPrice records the price and quantity of a trade in the price observations of the
+// credit batch in the market and of all credit batches in the market at the block time.
+func (k Keeper) recordPrice(ctx context.Context, marketId, batchKey uint64, price sdk.Int, quantity math.Dec) error {
+	for _, key := range []uint64{batchKey, 0} {
+		if err := k.recordObservation(ctx, marketId, key, price, quantity); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordObservation updates the price observation of the block time if the batch key
+// was already traded in the market within the block, otherwise it inserts a new price
+// observation accumulating the value and the volume of the previous observation over the
+// elapsed time since the previous observation.
+func (k Keeper) recordObservation(ctx context.Context, marketId, batchKey uint64, price sdk.Int, quantity math.Dec) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	tradeValue, err := math.NewDecFromString(price.String())
+	if err != nil {
+		return err
+	}
+	if tradeValue, err = tradeValue.Mul(quantity); err != nil {
+		return err
+	}
+
+	last, err := k.getLastObservation(ctx, marketId, batchKey, blockTime)
+	if err != nil {
+		return err
+	}
+
+	if last != nil && last.Timestamp.AsTime().Equal(blockTime) {
+		high, low, err := parseHighLow(last)
+		if err != nil {
+			return err
+		}
+		if price.GT(high) {
+			last.High = price.String()
+		}
+		if price.LT(low) {
+			last.Low = price.String()
+		}
+		volume, value, err := parseVolumeValue(last)
+		if err != nil {
+			return err
+		}
+		if volume, err = volume.Add(quantity); err != nil {
+			return err
+		}
+		if value, err = value.Add(tradeValue); err != nil {
+			return err
+		}
+		last.Close = price.String()
+		last.Volume = volume.String()
+		last.Value = value.String()
+		return k.stateStore.PriceObservationTable().Update(ctx, last)
+	}
+
+	cumulativePrice, cumulativeVolume := math.NewDecFromInt64(0), math.NewDecFromInt64(0)
+	if last != nil {
+		if cumulativePrice, cumulativeVolume, err = cumulativeAt(last, blockTime); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.PriceObservationTable().Insert(ctx, &api.PriceObservation{
+		MarketId:         marketId,
+		BatchKey:         batchKey,
+		Timestamp:        timestamppb.New(blockTime),
+		CumulativePrice:  cumulativePrice.String(),
+		Open:             price.String(),
+		High:             price.String(),
+		Low:              price.String(),
+		Close:            price.String(),
+		Volume:           quantity.String(),
+		Value:            tradeValue.String(),
+		CumulativeVolume: cumulativeVolume.String(),
+	})
+}
+
+// getLastObservation returns the latest price observation of the batch key in the market
+// at or before the time, or nil if there is no price observation at or before the time.
+func (k Keeper) getLastObservation(ctx context.Context, marketId, batchKey uint64, t time.Time) (*api.PriceObservation, error) {
+	fromKey := api.PriceObservationMarketIdBatchKeyTimestampIndexKey{}.WithMarketIdBatchKey(marketId, batchKey)
+	toKey := api.PriceObservationMarketIdBatchKeyTimestampIndexKey{}.WithMarketIdBatchKeyTimestamp(marketId, batchKey, timestamppb.New(t))
+	it, err := k.stateStore.PriceObservationTable().ListRange(ctx, fromKey, toKey, ormlist.Reverse())
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, nil
+	}
+
+	return it.Value()
+}
+
+// getFirstObservation returns the earliest price observation of the batch key in the
+// market, or nil if the batch key has not been traded in the market.
+func (k Keeper) getFirstObservation(ctx context.Context, marketId, batchKey uint64) (*api.PriceObservation, error) {
+	it, err := k.stateStore.PriceObservationTable().List(ctx,
+		api.PriceObservationMarketIdBatchKeyTimestampIndexKey{}.WithMarketIdBatchKey(marketId, batchKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	if !it.Next() {
+		return nil, nil
+	}
+
+	return it.Value()
+}
+
+// cumulativeAt returns the cumulative price and the cumulative volume at a time at or
+// after the price observation, i.e. the cumulative price and the cumulative volume of the
+// observation plus the value and the volume of the observation multiplied by the number
+// of seconds elapsed since the observation.
+func cumulativeAt(obs *api.PriceObservation, t time.Time) (math.Dec, math.Dec, error) {
+	cumulativePrice, err := math.NewDecFromString(obs.CumulativePrice)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	cumulativeVolume, err := math.NewDecFromString(obs.CumulativeVolume)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	volume, value, err := parseVolumeValue(obs)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	elapsed := math.NewDecFromInt64(t.Unix() - obs.Timestamp.AsTime().Unix())
+
+	if value, err = value.Mul(elapsed); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+	if cumulativePrice, err = cumulativePrice.Add(value); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	if volume, err = volume.Mul(elapsed); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+	if cumulativeVolume, err = cumulativeVolume.Add(volume); err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	return cumulativePrice, cumulativeVolume, nil
+}
+
+// averagePrice returns the average price of the trades of the price observation weighted
+// by the quantity of credits traded, i.e. the value of the observation divided by the
+// volume of the observation.
+func averagePrice(obs *api.PriceObservation) (math.Dec, error) {
+	volume, value, err := parseVolumeValue(obs)
+	if err != nil {
+		return math.Dec{}, err
+	}
+
+	return value.Quo(volume)
+}
+
+// parseVolumeValue returns the volume and the value of the price observation.
+func parseVolumeValue(obs *api.PriceObservation) (math.Dec, math.Dec, error) {
+	volume, err := math.NewDecFromString(obs.Volume)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	value, err := math.NewDecFromString(obs.Value)
+	if err != nil {
+		return math.Dec{}, math.Dec{}, err
+	}
+
+	return volume, value, nil
+}
+
+// parseHighLow returns the high and low prices of the price observation.
+func parseHighLow(obs *api.PriceObservation) (sdk.Int, sdk.Int, error) {
+	high, ok := sdk.NewIntFromString(obs.High)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", obs.High, sdk.Int{})
+	}
+
+	low, ok := sdk.NewIntFromString(obs.Low)
+	if !ok {
+		return sdk.Int{}, sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", obs.Low, sdk.Int{})
+	}
+
+	return high, low, nil
+}
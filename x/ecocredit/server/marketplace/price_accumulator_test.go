@@ -0,0 +1,92 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+)
+
+var priceStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRecordPrice(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	batchKey := s.priceHistorySetup()
+
+	obs, err := s.marketStore.PriceObservationTable().Get(s.ctx, 1, batchKey, toTimestamp(&priceStart))
+	assert.NilError(t, err)
+	assertObservation(t, obs, "0", "0", "10", "14", "10", "14", "3", "38")
+
+	t1 := priceStart.Add(100 * time.Second)
+	obs, err = s.marketStore.PriceObservationTable().Get(s.ctx, 1, batchKey, toTimestamp(&t1))
+	assert.NilError(t, err)
+	assertObservation(t, obs, "3800", "300", "8", "8", "8", "8", "1", "8")
+
+	t2 := priceStart.Add(300 * time.Second)
+	obs, err = s.marketStore.PriceObservationTable().Get(s.ctx, 1, batchKey, toTimestamp(&t2))
+	assert.NilError(t, err)
+	assertObservation(t, obs, "5400", "500", "20", "20", "20", "20", "0.5", "10.0")
+
+	// the observations of the market include the trades of all credit batches
+	obs, err = s.marketStore.PriceObservationTable().Get(s.ctx, 1, 0, toTimestamp(&t1))
+	assert.NilError(t, err)
+	assertObservation(t, obs, "3800", "300", "8", "12", "8", "12", "2", "20")
+
+	obs, err = s.marketStore.PriceObservationTable().Get(s.ctx, 1, 0, toTimestamp(&t2))
+	assert.NilError(t, err)
+	assertObservation(t, obs, "7800", "700", "20", "20", "20", "20", "0.5", "10.0")
+
+	// the observations of another market are separate
+	has, err := s.marketStore.PriceObservationTable().Has(s.ctx, 2, batchKey, toTimestamp(&priceStart))
+	assert.NilError(t, err)
+	assert.Equal(t, false, has)
+}
+
+// priceHistorySetup creates a credit batch and a market and records the trades of the
+// credit batch and of another credit batch in the market, leaving the block time 400
+// seconds after the first trade.
+func (s *baseSuite) priceHistorySetup() uint64 {
+	batchKey := s.quoteSetup()
+	assert.NilError(s.t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{
+		CreditTypeAbbrev: "C",
+		BankDenom:        "regen",
+	}))
+
+	s.recordPriceAt(priceStart, batchKey, 10, "1")
+	s.recordPriceAt(priceStart, batchKey, 14, "2")
+	s.recordPriceAt(priceStart.Add(100*time.Second), batchKey, 8, "1")
+	s.recordPriceAt(priceStart.Add(100*time.Second), batchKey+1, 12, "1")
+	s.recordPriceAt(priceStart.Add(300*time.Second), batchKey, 20, "0.5")
+	s.setBlockTime(priceStart.Add(400 * time.Second))
+
+	return batchKey
+}
+
+func (s *baseSuite) recordPriceAt(blockTime time.Time, batchKey uint64, price int64, quantity string) {
+	s.setBlockTime(blockTime)
+	qty, err := math.NewDecFromString(quantity)
+	assert.NilError(s.t, err)
+	assert.NilError(s.t, s.k.recordPrice(s.ctx, 1, batchKey, sdk.NewInt(price), qty))
+}
+
+func (s *baseSuite) setBlockTime(blockTime time.Time) {
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+}
+
+func assertObservation(t *testing.T, obs *api.PriceObservation, cumulative, cumulativeVolume, open, high, low, close, volume, value string) {
+	assert.Equal(t, cumulative, obs.CumulativePrice)
+	assert.Equal(t, cumulativeVolume, obs.CumulativeVolume)
+	assert.Equal(t, open, obs.Open)
+	assert.Equal(t, high, obs.High)
+	assert.Equal(t, low, obs.Low)
+	assert.Equal(t, close, obs.Close)
+	assert.Equal(t, volume, obs.Volume)
+	assert.Equal(t, value, obs.Value)
+}
@@ -0,0 +1,178 @@
+package marketplace
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// LoadOrderBook is a BeginBlock function that rebuilds the order book from state the
+// first time it is called after a node starts up. The order book is rebuilt before any
+// transaction of the block is executed and with an infinite gas meter so that every
+// transaction sees the same order book and consumes the same gas on every node.
+func (k Keeper) LoadOrderBook(ctx context.Context) error {
+	if k.orderBook.Loaded() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(sdk.NewInfiniteGasMeter())
+	return k.orderBook.Reload(sdk.WrapSDKContext(sdkCtx))
+}
+
+// ProcessOrders is an EndBlock function that matches and fills crossing buy and sell
+// orders in the order book. Markets with batch auction clearing are only processed at
+// the end of each epoch.
+func (k Keeper) ProcessOrders(ctx context.Context) error {
+	return k.orderBook.ProcessBatch(ctx, sdk.UnwrapSDKContext(ctx).BlockHeight(), k.fillBuyOrder)
+}
+
+// fillBuyOrder fills quantity credits from the sell order for the buy order at the price
+// determined by the order book, which is never above the bid price of the buy order. The
+// cost and the buyer fee are paid from the funds of the buy order held in escrow and any
+// funds released from escrow in excess of the cost and the buyer fee are returned to the
+// buyer. If the fill fails, the failure is logged and the fill is skipped without changing
+// the balances or the quantities of the orders. Neither order is removed because the failure
+// may have been caused by either order. Instead, the sell order is added to the failed sell
+// orders of the buy order in state, which removes the match from the order book in the same
+// way on every node, including nodes that restart and reload the order book. Fills in a
+// denom that has been removed from the allowed denoms fail in the same way.
+func (k Keeper) fillBuyOrder(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder, quantity math.Dec, price string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := k.isDenomAllowed(ctx, market.BankDenom)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return k.skipFill(ctx, buyOrder, sellOrder, sdkerrors.ErrInvalidRequest.Wrapf(
+			"%s is not an allowed denom", market.BankDenom,
+		))
+	}
+
+	priceAmount, ok := sdk.NewIntFromString(price)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", price, sdk.Int{})
+	}
+
+	cost, err := getTotalCost(priceAmount, quantity)
+	if err != nil {
+		return err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdk.Int{})
+	}
+
+	buyOrderQty, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return err
+	}
+
+	remainingQty, err := math.SafeSubBalance(buyOrderQty, quantity)
+	if err != nil {
+		return err
+	}
+
+	escrowedBefore, err := getEscrowAmount(bidAmount, buyOrderQty, buyOrder.BuyerFee)
+	if err != nil {
+		return err
+	}
+
+	escrowedAfter, err := getEscrowAmount(bidAmount, remainingQty, buyOrder.BuyerFee)
+	if err != nil {
+		return err
+	}
+
+	// the bid price is greater than or equal to the price so the funds released
+	// from escrow always cover the cost of the fill, and the buyer fee is limited
+	// to the funds released in excess of the cost to absorb rounding
+	released := escrowedBefore.Sub(escrowedAfter)
+	buyerFee, err := getFee(cost, buyOrder.BuyerFee)
+	if err != nil {
+		return err
+	}
+	if maxFee := released.Sub(cost); buyerFee.GT(maxFee) {
+		buyerFee = maxFee
+	}
+
+	sellerFee, err := getFee(cost, market.SellerFee)
+	if err != nil {
+		return err
+	}
+
+	// the fill is executed in a cached context so that a failing fill does not
+	// leave partial state changes behind
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	if err = k.fillOrder(sdk.WrapSDKContext(cacheCtx), fmt.Sprintf("buy order %d", buyOrder.Id), sellOrder, buyOrder.Buyer,
+		quantity, sdk.Coin{Denom: market.BankDenom, Amount: cost}, orderOptions{
+			autoRetire:   !buyOrder.DisableAutoRetire,
+			batchDenom:   batch.Denom,
+			jurisdiction: buyOrder.RetirementJurisdiction,
+			beneficiary:  buyOrder.RetirementBeneficiary,
+			reason:       buyOrder.RetirementReason,
+			escrowed:     true,
+			buyerFee:     buyerFee,
+			sellerFee:    sellerFee,
+			price:        priceAmount,
+		}); err != nil {
+		return k.skipFill(ctx, buyOrder, sellOrder, err)
+	}
+	writeCache()
+	sdkCtx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	buyOrder.Quantity = remainingQty.String()
+
+	refund := released.Sub(cost).Sub(buyerFee)
+	if refund.IsPositive() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			sdkCtx, ecocredit.ModuleName, buyOrder.Buyer, sdk.NewCoins(sdk.NewCoin(market.BankDenom, refund)),
+		); err != nil {
+			return err
+		}
+	}
+
+	if remainingQty.IsZero() {
+		err = k.stateStore.BuyOrderTable().Delete(ctx, buyOrder)
+	} else {
+		err = k.stateStore.BuyOrderTable().Update(ctx, buyOrder)
+	}
+	if err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventFillOrder{
+		BuyOrderId:  buyOrder.Id,
+		SellOrderId: sellOrder.Id,
+		Quantity:    quantity.String(),
+		Price:       price,
+		BuyerFee:    &sdk.Coin{Denom: market.BankDenom, Amount: buyerFee},
+		SellerFee:   &sdk.Coin{Denom: market.BankDenom, Amount: sellerFee},
+	})
+}
+
+// skipFill logs the error of a failed fill and adds the sell order to the failed sell orders
+// of the buy order so that the match is removed from the order book.
+func (k Keeper) skipFill(ctx context.Context, buyOrder *api.BuyOrder, sellOrder *api.SellOrder, err error) error {
+	sdk.UnwrapSDKContext(ctx).Logger().Error("failed to fill buy order",
+		"buy_order_id", buyOrder.Id, "sell_order_id", sellOrder.Id, "err", err)
+	buyOrder.FailedSellOrderIds = append(buyOrder.FailedSellOrderIds, sellOrder.Id)
+	return k.stateStore.BuyOrderTable().Update(ctx, buyOrder)
+}
@@ -0,0 +1,716 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestProcessOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+
+	// the first buy order crosses the sell order, the second one does not
+	crossed := s.insertBuyOrder(buyer, "4", "12")
+	notCrossed := s.insertBuyOrder(buyer, "4", "8")
+
+	// the retirement beneficiary and reason of the buy order are recorded in the retirement
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, crossed)
+	assert.NilError(t, err)
+	buyOrder.RetirementBeneficiary = "Acme Corporation"
+	buyOrder.RetirementReason = "offsetting emissions"
+	assert.NilError(t, s.marketStore.BuyOrderTable().Update(s.ctx, buyOrder))
+
+	// the seller is paid the ask price of the sell order from escrow and the
+	// remainder of the escrowed bid is returned to the buyer
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 40))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 8))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, crossed)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled buy order to be removed")
+
+	found, err = s.marketStore.BuyOrderTable().Has(s.ctx, notCrossed)
+	assert.NilError(t, err)
+	assert.Check(t, found, "expected unfilled buy order to remain")
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "6", sellOrder.Quantity)
+
+	bal, _ := s.getBalanceAndSupply(1, buyer)
+	assert.Equal(t, "4", bal.RetiredAmount)
+
+	// the fill is recorded as a trade at the ask price of the sell order
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, sellOrderIds[0], trade.SellOrderId)
+	assert.DeepEqual(t, []byte(buyer), trade.Buyer)
+	assert.DeepEqual(t, []byte(seller), trade.Seller)
+	assert.Equal(t, "4", trade.Quantity)
+	assert.Equal(t, "10", trade.Price)
+	assert.Equal(t, ask.Denom, trade.Denom)
+	assert.Check(t, trade.Retired)
+
+	retirement, err := s.coreStore.RetirementTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []byte(buyer), retirement.Owner)
+	assert.Equal(t, "4", retirement.Amount)
+	assert.Equal(t, "Acme Corporation", retirement.Beneficiary)
+	assert.Equal(t, "offsetting emissions", retirement.Reason)
+
+	// processing again does not fill any further orders
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+}
+
+func TestProcessOrders_Reload(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	// the buy order is in state before the sell order is created but has not been
+	// added to the order book, e.g. because the node restarted
+	buyOrderId, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  "10",
+		MarketId:  1,
+		BidAmount: "10",
+	})
+	assert.NilError(t, err)
+
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+	assert.Check(t, !s.orderBook.Loaded())
+
+	// the order book is rebuilt in the begin blocker without consuming gas
+	gasBefore := s.sdkCtx.GasMeter().GasConsumed()
+	assert.NilError(t, s.k.LoadOrderBook(s.ctx))
+	assert.Check(t, s.orderBook.Loaded())
+	assert.Equal(t, gasBefore, s.sdkCtx.GasMeter().GasConsumed())
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 50))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyOrderId)
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled sell order to be removed")
+}
+
+func TestProcessOrders_ReloadConsistency(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	// the order book is updated incrementally as orders are created
+	s.insertBuyOrder(buyer, "4", "12")
+	s.insertClassBuyOrder(buyer, "11", &api.BuyOrder_Selection{})
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &sdk.Coin{Denom: ask.Denom, Amount: sdk.NewInt(11)}},
+		},
+	})
+	s.insertBuyOrder(buyer, "4", "10")
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+
+	// matches of orders removed from state are removed when they are processed
+	assert.NilError(t, s.marketStore.SellOrderTable().Delete(s.ctx, &api.SellOrder{Id: sellOrderIds[1]}))
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+
+	// a buy order that is not in the order book, e.g. because the node restarted
+	_, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  "10",
+		MarketId:  1,
+		BidAmount: "10",
+	})
+	assert.NilError(t, err)
+	err = s.orderBook.CheckConsistency(s.ctx)
+	assert.ErrorContains(t, err, "missing match of buy order 4 and sell order 1")
+
+	// reloading rebuilds the order book from state
+	assert.NilError(t, s.orderBook.Reload(s.ctx))
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+	assert.NilError(t, s.orderBook.Reload(s.ctx))
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+}
+
+func TestProcessOrders_PriceOutOfRange(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	// a buy order and a sell order with prices that exceed the range of the order book
+	// are still matched and the sell order is filled at its ask price
+	buyOrderId := s.insertBuyOrder(buyer, "1", "10000000000")
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "1", AskPrice: &sdk.Coin{Denom: ask.Denom, Amount: sdk.NewInt(5000000000)}},
+		},
+	})
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 5000000000))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 5000000000))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, buyOrderId)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled buy order to be removed")
+
+	found, err = s.marketStore.SellOrderTable().Has(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled sell order to be removed")
+}
+
+func TestProcessOrders_FillFailure(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+	buyOrderId := s.insertBuyOrder(buyer, "4", "12")
+
+	// the seller cannot be paid, e.g. because the seller is a blocked address
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, gmAny).
+		Return(sdkerrors.ErrUnauthorized).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	// the buy order is not filled and no funds are returned to the buyer, the sell order
+	// is recorded as a failed sell order of the buy order
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, buyOrderId)
+	assert.NilError(t, err)
+	assert.Equal(t, "4", buyOrder.Quantity)
+	assert.DeepEqual(t, []uint64{sellOrderIds[0]}, buyOrder.FailedSellOrderIds)
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, found, "expected sell order to remain")
+
+	// the match is removed from the order book and the fill is not retried in the next
+	// block, the seller is only paid once as expected by the mock
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+
+	// the match is not restored when the order book is reloaded
+	assert.NilError(t, s.orderBook.Reload(s.ctx))
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+}
+
+func TestProcessOrders_ClassSelection(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	// the buy order selects credits from any batch of the credit class
+	buyOrder := &api.BuyOrder{
+		Buyer:                  buyer,
+		Selection:              &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_ClassKey{ClassKey: 1}},
+		Quantity:               "5",
+		MarketId:               1,
+		BidAmount:              "10",
+		DisableAutoRetire:      true,
+		RetirementJurisdiction: "",
+	}
+	id, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, buyOrder)
+	assert.NilError(t, err)
+	buyOrder.Id = id
+	assert.NilError(t, s.orderBook.OnInsertBuyOrder(s.ctx, buyOrder))
+
+	// the first sell order requires auto-retirement and cannot fill the buy order
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask, DisableAutoRetire: true},
+		},
+	})
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 50))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Check(t, found, "expected sell order with auto-retire enabled to remain")
+
+	found, err = s.marketStore.SellOrderTable().Has(s.ctx, 2)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled sell order to be removed")
+
+	bal, _ := s.getBalanceAndSupply(1, buyer)
+	assert.Equal(t, "5", bal.TradableAmount)
+}
+
+func TestProcessOrders_SelectionCriteria(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	project, err := s.coreStore.ProjectTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	project.Jurisdiction = "US-OR 97201"
+	assert.NilError(t, s.coreStore.ProjectTable().Update(s.ctx, project))
+
+	before := timestamppb.New(start.AsTime().Add(-time.Hour))
+	after := timestamppb.New(end.AsTime().Add(time.Hour))
+
+	// only the buy order with criteria met by the batch and its project can be
+	// filled even though the others have a higher bid
+	matching := s.insertClassBuyOrder(buyer, "10", &api.BuyOrder_Selection{
+		ProjectJurisdiction: "US",
+		MinStartDate:        before,
+		MaxEndDate:          after,
+	})
+	otherJurisdiction := s.insertClassBuyOrder(buyer, "20", &api.BuyOrder_Selection{
+		ProjectJurisdiction: "US-WA",
+	})
+	laterStart := s.insertClassBuyOrder(buyer, "20", &api.BuyOrder_Selection{
+		MinStartDate: after,
+	})
+	earlierEnd := s.insertClassBuyOrder(buyer, "20", &api.BuyOrder_Selection{
+		MaxEndDate: before,
+	})
+
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &ask},
+		},
+	})
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 50))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, matching)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled buy order to be removed")
+
+	for _, id := range []uint64{otherJurisdiction, laterStart, earlierEnd} {
+		buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+		assert.NilError(t, err)
+		assert.Equal(t, "5", buyOrder.Quantity)
+	}
+}
+
+func TestProcessOrders_BatchAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	market.ClearingMode = api.ClearingMode_CLEARING_MODE_BATCH_AUCTION
+	market.EpochLength = 2
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &sdk.Coin{Denom: ask.Denom, Amount: sdk.NewInt(8)}},
+			{BatchDenom: batchDenom, Quantity: "5", AskPrice: &sdk.Coin{Denom: ask.Denom, Amount: sdk.NewInt(11)}},
+		},
+	})
+	highBid := s.insertBuyOrder(buyer, "4", "12")
+	lowBid := s.insertBuyOrder(buyer, "4", "9")
+
+	// the market is not cleared before the end of the epoch
+	s.sdkCtx = s.sdkCtx.WithBlockHeight(1)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, highBid)
+	assert.NilError(t, err)
+	assert.Check(t, found, "expected buy order to remain before the end of the epoch")
+
+	// the most credits are filled at a price of 8 or 9 so both buy orders are filled at
+	// the lower price of 8 and the sell order with an ask price of 11 is not filled
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 32))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 16))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 8))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1))).
+		Return(nil).
+		Times(1)
+
+	s.sdkCtx = s.sdkCtx.WithBlockHeight(2)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err = s.marketStore.BuyOrderTable().Has(s.ctx, highBid)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled buy order to be removed")
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, lowBid)
+	assert.NilError(t, err)
+	assert.Equal(t, "3", buyOrder.Quantity)
+
+	found, err = s.marketStore.SellOrderTable().Has(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled sell order to be removed")
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderIds[1])
+	assert.NilError(t, err)
+	assert.Equal(t, "5", sellOrder.Quantity)
+}
+
+func TestProcessOrders_MinFillQuantityAndLotSize(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	sellOrderIds := s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "9", AskPrice: &ask, MinFillQuantity: "6", LotSize: "3"},
+		},
+	})
+
+	// the first buy order is rounded down to the lot size and is then less than the
+	// minimum fill quantity, the second one is rounded down to the lot size
+	belowMinFill := s.insertBuyOrder(buyer, "5", "10")
+	aboveMinFill := s.insertBuyOrder(buyer, "7", "10")
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 60))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, belowMinFill)
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	buyOrder, err = s.marketStore.BuyOrderTable().Get(s.ctx, aboveMinFill)
+	assert.NilError(t, err)
+	assert.Equal(t, "1", buyOrder.Quantity)
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Equal(t, "3", sellOrder.Quantity)
+
+	// the buy orders below the minimum fill quantity are no longer matched with the sell
+	// order and are not processed again
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+	assert.NilError(t, s.orderBook.CheckConsistency(s.ctx))
+
+	// the remaining quantity of the sell order is filled in full even though it is
+	// less than the minimum fill quantity
+	fullFill := s.insertBuyOrder(buyer, "6", "10")
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 30))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	buyOrder, err = s.marketStore.BuyOrderTable().Get(s.ctx, fullFill)
+	assert.NilError(t, err)
+	assert.Equal(t, "3", buyOrder.Quantity)
+
+	buyOrder, err = s.marketStore.BuyOrderTable().Get(s.ctx, belowMinFill)
+	assert.NilError(t, err)
+	assert.Equal(t, "5", buyOrder.Quantity)
+
+	found, err := s.marketStore.SellOrderTable().Has(s.ctx, sellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled sell order to be removed")
+}
+
+func TestProcessOrders_Fees(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer := s.addrs[0], s.addrs[1]
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	market.BuyerFee = "0.1"
+	market.SellerFee = "0.1"
+	market.FeeDestination = api.FeeDestination_FEE_DESTINATION_COMMUNITY_POOL
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	s.createSellOrder(&marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{BatchDenom: batchDenom, Quantity: "10", AskPrice: &ask},
+		},
+	})
+
+	id := s.insertBuyOrder(buyer, "4", "12")
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	buyOrder.BuyerFee = market.BuyerFee
+	assert.NilError(t, s.marketStore.BuyOrderTable().Update(s.ctx, buyOrder))
+
+	// 52 is held in escrow for the bid of 48 and the buyer fee of 4. The cost of 40 is
+	// paid to the seller less the seller fee of 4, both fees are paid from escrow to the
+	// community pool and the remainder of 8 is returned to the buyer.
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 36))).
+		Return(nil).
+		Times(1)
+	s.distrKeeper.EXPECT().
+		FundCommunityPool(gmAny, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 8)), authtypes.NewModuleAddress(ecocredit.ModuleName)).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 8))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.ProcessOrders(s.ctx))
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected filled buy order to be removed")
+}
+
+func TestPruneBuyOrders(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	buyer := s.addrs[1]
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+
+	expiredId := s.insertBuyOrder(buyer, "4", "12")
+	buyOrder, err := s.marketStore.BuyOrderTable().Get(s.ctx, expiredId)
+	assert.NilError(t, err)
+	buyOrder.Expiration = timestamppb.New(expired)
+	assert.NilError(t, s.marketStore.BuyOrderTable().Update(s.ctx, buyOrder))
+
+	// buy orders without expiration are not pruned
+	notExpiredId := s.insertBuyOrder(buyer, "4", "12")
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 48))).
+		Return(nil).
+		Times(1)
+
+	assert.NilError(t, s.k.PruneBuyOrders(s.ctx))
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, expiredId)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	_, err = s.marketStore.BuyOrderTable().Get(s.ctx, notExpiredId)
+	assert.NilError(t, err)
+}
+
+// insertClassBuyOrder inserts a buy order for five credits from the credit class
+// created in testSellSetup with the criteria of the selection and adds it to the
+// order book.
+func (s *baseSuite) insertClassBuyOrder(buyer sdk.AccAddress, bidAmount string, selection *api.BuyOrder_Selection) uint64 {
+	selection.Sum = &api.BuyOrder_Selection_ClassKey{ClassKey: 1}
+	buyOrder := &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: selection,
+		Quantity:  "5",
+		MarketId:  1,
+		BidAmount: bidAmount,
+	}
+	id, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, buyOrder)
+	assert.NilError(s.t, err)
+	buyOrder.Id = id
+	assert.NilError(s.t, s.orderBook.OnInsertBuyOrder(s.ctx, buyOrder))
+	return id
+}
+
+// insertBuyOrder inserts a buy order for the batch created in testSellSetup and
+// adds it to the order book.
+func (s *baseSuite) insertBuyOrder(buyer sdk.AccAddress, quantity, bidAmount string) uint64 {
+	buyOrder := &api.BuyOrder{
+		Buyer:     buyer,
+		Selection: &api.BuyOrder_Selection{Sum: &api.BuyOrder_Selection_BatchKey{BatchKey: 1}},
+		Quantity:  quantity,
+		MarketId:  1,
+		BidAmount: bidAmount,
+	}
+	id, err := s.marketStore.BuyOrderTable().InsertReturningID(s.ctx, buyOrder)
+	assert.NilError(s.t, err)
+	buyOrder.Id = id
+	assert.NilError(s.t, s.orderBook.OnInsertBuyOrder(s.ctx, buyOrder))
+	return id
+}
+
+func TestSell_ImmediateOrCancel(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, buyer1, buyer2 := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	// the first two buy orders cross the sell order, the third one does not
+	first := s.insertBuyOrder(buyer1, "4", "12")
+	second := s.insertBuyOrder(buyer2, "3", "11")
+	notCrossed := s.insertBuyOrder(buyer1, "5", "8")
+
+	// the buy orders are filled at the ask price in price-time priority and the
+	// remainder of each escrowed bid is returned to the buyer
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 40))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer1, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 8))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 30))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, buyer2, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 3))).
+		Return(nil).
+		Times(1)
+
+	res, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: seller.String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{
+				BatchDenom:  batchDenom,
+				Quantity:    "10",
+				AskPrice:    &ask,
+				TimeInForce: marketplace.TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL,
+			},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.SellOrderIds))
+
+	for _, id := range []uint64{first, second} {
+		found, err := s.marketStore.BuyOrderTable().Has(s.ctx, id)
+		assert.NilError(t, err)
+		assert.Check(t, !found, "expected filled buy order %d to be removed", id)
+	}
+
+	found, err := s.marketStore.BuyOrderTable().Has(s.ctx, notCrossed)
+	assert.NilError(t, err)
+	assert.Check(t, found, "expected unfilled buy order to remain")
+
+	// the quantity that was not filled is cancelled and returned to the seller
+	found, err = s.marketStore.SellOrderTable().Has(s.ctx, res.SellOrderIds[0])
+	assert.NilError(t, err)
+	assert.Check(t, !found, "expected immediate-or-cancel sell order to be removed")
+
+	bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "93", bal.TradableAmount)
+	assert.Equal(t, "0", bal.EscrowedAmount)
+}
+
+func TestSell_ImmediateOrCancelBatchAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	market, err := s.marketStore.MarketTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	market.ClearingMode = api.ClearingMode_CLEARING_MODE_BATCH_AUCTION
+	market.EpochLength = 10
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	_, err = s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{
+				BatchDenom:  batchDenom,
+				Quantity:    "10",
+				AskPrice:    &ask,
+				TimeInForce: marketplace.TimeInForce_TIME_IN_FORCE_IMMEDIATE_OR_CANCEL,
+			},
+		},
+	})
+	assert.ErrorContains(t, err, "immediate-or-cancel sell orders are only supported in markets with continuous clearing")
+}
@@ -0,0 +1,108 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	marketplaceapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+)
+
+// PruneBuyOrders is a BeginBlock function that returns escrowed funds to the buyers and deletes orders
+// that have expired.
+func (k Keeper) PruneBuyOrders(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
+	// and we DO NOT want those to be deleted/unescrowed.
+	// https://github.com/cosmos/cosmos-sdk/issues/11980
+	min, blockTime := timestamppb.New(time.Unix(0, 1)), timestamppb.New(sdkCtx.BlockTime())
+	fromKey, toKey := marketplaceapi.BuyOrderExpirationIndexKey{}.WithExpiration(min), marketplaceapi.BuyOrderExpirationIndexKey{}.WithExpiration(blockTime)
+
+	it, err := k.stateStore.BuyOrderTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var expired []*marketplaceapi.BuyOrder
+	for it.Next() {
+		buyOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, buyOrder)
+	}
+	it.Close()
+
+	for _, buyOrder := range expired {
+		if err = k.unescrowFunds(ctx, buyOrder); err != nil {
+			return err
+		}
+		if err = k.orderBook.OnRemoveBuyOrder(ctx, buyOrder); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.BuyOrderTable().DeleteRange(ctx, fromKey, toKey)
+}
+
+// unescrowFunds returns the funds held in escrow for the remaining quantity of the buy order to the buyer.
+func (k Keeper) unescrowFunds(ctx context.Context, buyOrder *marketplaceapi.BuyOrder) error {
+	escrowed, err := k.getEscrowedFunds(ctx, buyOrder)
+	if err != nil {
+		return err
+	}
+
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		sdk.UnwrapSDKContext(ctx), ecocredit.ModuleName, buyOrder.Buyer, sdk.NewCoins(escrowed),
+	)
+}
+
+// getEscrowedFunds returns the funds held in escrow for the remaining quantity of the buy order
+// including the buyer fee.
+func (k Keeper) getEscrowedFunds(ctx context.Context, buyOrder *marketplaceapi.BuyOrder) (sdk.Coin, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, buyOrder.MarketId)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	quantity, err := math.NewDecFromString(buyOrder.Quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bidAmount, ok := sdk.NewIntFromString(buyOrder.BidAmount)
+	if !ok {
+		return sdk.Coin{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", buyOrder.BidAmount, sdk.Int{})
+	}
+
+	amount, err := getEscrowAmount(bidAmount, quantity, buyOrder.BuyerFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.Coin{Denom: market.BankDenom, Amount: amount}, nil
+}
+
+// getEscrowAmount returns the amount held in escrow for a buy order, i.e. the total cost
+// of the quantity of credits at the bid amount and the buyer fee for the total cost.
+func getEscrowAmount(bidAmount sdk.Int, quantity math.Dec, buyerFee string) (sdk.Int, error) {
+	cost, err := getTotalCost(bidAmount, quantity)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	fee, err := getFee(cost, buyerFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	return cost.Add(fee), nil
+}
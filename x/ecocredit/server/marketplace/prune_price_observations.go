@@ -0,0 +1,30 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// priceObservationRetention is the retention window of the price history, i.e. the
+// duration after which price observations are pruned.
+const priceObservationRetention = 90 * 24 * time.Hour
+
+// PrunePriceObservations is a BeginBlock function that deletes the price observations
+// that are older than the retention window of the price history.
+func (k Keeper) PrunePriceObservations(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// the range is inclusive so we set the cutoff to 1 ns before the start of the
+	// retention window, keeping the observations at the start of the window.
+	cutoff := sdkCtx.BlockTime().Add(-priceObservationRetention - time.Nanosecond)
+	fromKey := api.PriceObservationTimestampIndexKey{}.WithTimestamp(timestamppb.New(time.Unix(0, 0)))
+	toKey := api.PriceObservationTimestampIndexKey{}.WithTimestamp(timestamppb.New(cutoff))
+
+	return k.stateStore.PriceObservationTable().DeleteRange(ctx, fromKey, toKey)
+}
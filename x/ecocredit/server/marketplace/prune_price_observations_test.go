@@ -0,0 +1,56 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestPrunePriceObservations(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	batchKey := s.priceHistorySetup()
+
+	t1 := priceStart.Add(100 * time.Second)
+	t2 := priceStart.Add(300 * time.Second)
+
+	// no observations are older than the retention window
+	assert.NilError(t, s.k.PrunePriceObservations(s.ctx))
+	assertHasObservation(t, s, batchKey, priceStart, true)
+
+	// the observations at the start of the retention window are kept
+	s.setBlockTime(t1.Add(priceObservationRetention))
+	assert.NilError(t, s.k.PrunePriceObservations(s.ctx))
+	assertHasObservation(t, s, batchKey, priceStart, false)
+	assertHasObservation(t, s, 0, priceStart, false)
+	assertHasObservation(t, s, batchKey, t1, true)
+	assertHasObservation(t, s, batchKey+1, t1, true)
+	assertHasObservation(t, s, 0, t1, true)
+
+	// the time-weighted average price starts at the first observation that was kept
+	res, err := s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: "C01-001-20200101-20210101-001",
+		StartTime:  gogoTime(t, priceStart),
+		EndTime:    gogoTime(t, priceStart.Add(400*time.Second)),
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, gogoTime(t, t1), res.StartTime)
+	assert.Equal(t, "10.4", res.Twap)
+
+	s.setBlockTime(t2.Add(priceObservationRetention).Add(time.Second))
+	assert.NilError(t, s.k.PrunePriceObservations(s.ctx))
+	assertHasObservation(t, s, batchKey, t1, false)
+	assertHasObservation(t, s, batchKey+1, t1, false)
+	assertHasObservation(t, s, batchKey, t2, false)
+	assertHasObservation(t, s, 0, t2, false)
+}
+
+func assertHasObservation(t *testing.T, s *baseSuite, batchKey uint64, ts time.Time, expected bool) {
+	has, err := s.marketStore.PriceObservationTable().Has(s.ctx, 1, batchKey, toTimestamp(&ts))
+	assert.NilError(t, err)
+	assert.Equal(t, expected, has)
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+)
+
+// PruneQuoteRequests is a BeginBlock function that closes the quote requests that have
+// expired, returning the escrowed credits of their quotes to the sellers.
+func (k Keeper) PruneQuoteRequests(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	// we set the min to 1 ns because nil expirations are encoded as the 0 value timestamp,
+	// and we DO NOT want those to be pruned.
+	min, blockTime := timestamppb.New(time.Unix(0, 1)), timestamppb.New(sdkCtx.BlockTime())
+	fromKey := api.QuoteRequestExpirationIndexKey{}.WithExpiration(min)
+	toKey := api.QuoteRequestExpirationIndexKey{}.WithExpiration(blockTime)
+
+	it, err := k.stateStore.QuoteRequestTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var expired []*api.QuoteRequest
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			return err
+		}
+		expired = append(expired, request)
+	}
+	it.Close()
+
+	for _, request := range expired {
+		if err = k.closeQuoteRequest(ctx, request); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestPruneQuoteRequests(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	seller, buyer := s.addrs[0], s.addrs[1]
+	batchKey := s.quoteSetup()
+
+	assert.NilError(t, s.coreStore.BatchBalanceTable().Insert(s.ctx, &ecoApi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        seller,
+		TradableAmount: "20",
+		EscrowedAmount: "0",
+	}))
+
+	blockTime, err := types.ParseDate("block time", "2020-01-01")
+	assert.NilError(t, err)
+	expired, err := types.ParseDate("expiration", "2020-06-01")
+	assert.NilError(t, err)
+	notExpired, err := types.ParseDate("expiration", "2022-01-01")
+	assert.NilError(t, err)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	for _, expiration := range []*time.Time{&expired, &notExpired, nil} {
+		res, err := s.k.CreateQuoteRequest(s.ctx, &marketplace.MsgCreateQuoteRequest{
+			Buyer: buyer.String(),
+			Selection: &marketplace.MsgBuy_Selection{
+				Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+			},
+			Quantity:          "10",
+			Denom:             "regen",
+			DisableAutoRetire: true,
+			Expiration:        expiration,
+		})
+		assert.NilError(t, err)
+
+		if res.RequestId > 2 {
+			continue
+		}
+		_, err = s.k.SubmitQuote(s.ctx, &marketplace.MsgSubmitQuote{
+			Seller:     seller.String(),
+			RequestId:  res.RequestId,
+			BatchDenom: "C01-001-20200101-20210101-001",
+			AskPrice:   &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(10)},
+		})
+		assert.NilError(t, err)
+	}
+
+	// the quote request expires after its quote was pruned with its sell order
+	s.sdkCtx = s.sdkCtx.WithBlockTime(expired)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+	assert.NilError(t, s.k.PruneQuoteRequests(s.ctx))
+
+	_, err = s.marketStore.QuoteRequestTable().Get(s.ctx, 1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	_, err = s.marketStore.QuoteTable().Get(s.ctx, 1)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	for _, id := range []uint64{2, 3} {
+		_, err = s.marketStore.QuoteRequestTable().Get(s.ctx, id)
+		assert.NilError(t, err)
+	}
+
+	bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, batchKey)
+	assert.NilError(t, err)
+	assert.Equal(t, "10", bal.TradableAmount)
+	assert.Equal(t, "10", bal.EscrowedAmount)
+}
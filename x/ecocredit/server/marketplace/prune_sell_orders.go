@@ -7,13 +7,17 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	marketplaceapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
 )
 
 // PruneSellOrders is a BeginBlock function that moves escrowed credits back into their tradable balance and deletes orders
-// that have expired.
+// that have expired. Expired orders with auto-renewal are re-listed instead until they reach their maximum number of renewals.
 func (k Keeper) PruneSellOrders(ctx context.Context) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -27,19 +31,147 @@ func (k Keeper) PruneSellOrders(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	// the expired orders are collected first because re-listing an order updates the
+	// expiration index that is being iterated
+	var expired []*marketplaceapi.SellOrder
 	for it.Next() {
 		sellOrder, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		expired = append(expired, sellOrder)
+	}
+	it.Close()
+
+	for _, sellOrder := range expired {
+		renewed, err := k.renewSellOrder(ctx, sellOrder)
 		if err != nil {
 			return err
 		}
+		if renewed {
+			continue
+		}
+
 		if err = k.unescrowCredits(ctx, sellOrder.Seller, sellOrder.BatchKey, sellOrder.Quantity); err != nil {
 			return err
 		}
+		if err = k.stateStore.SellOrderTable().Delete(ctx, sellOrder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
 
+// renewSellOrder re-lists an expired sell order with auto-renewal that has not reached its
+// maximum number of renewals and returns whether the sell order was re-listed. The ask
+// amount is reduced by the price decay, but not below the floor amount, and the expiration
+// is extended by whole renewal periods until it is after the block time.
+func (k Keeper) renewSellOrder(ctx context.Context, sellOrder *marketplaceapi.SellOrder) (bool, error) {
+	autoRenew := sellOrder.AutoRenew
+	if autoRenew == nil || (autoRenew.MaxRenewals != 0 && sellOrder.Renewals >= autoRenew.MaxRenewals) {
+		return false, nil
+	}
+
+	askAmount, err := decayAskAmount(sellOrder.AskAmount, autoRenew)
+	if err != nil {
+		return false, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	period := autoRenew.RenewalPeriod.AsDuration()
+	expiration := sellOrder.Expiration.AsTime()
+	periods := blockTime.Sub(expiration)/period + 1
+	expiration = expiration.Add(periods * period)
+
+	sellOrder.AskAmount = askAmount.String()
+	sellOrder.Expiration = timestamppb.New(expiration)
+	sellOrder.Renewals++
+	if err = k.stateStore.SellOrderTable().Update(ctx, sellOrder); err != nil {
+		return false, err
+	}
+
+	// the matches of the sell order are replaced because the ask price has changed
+	batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+	if err != nil {
+		return false, err
+	}
+	k.replaceSellOrderMatches(ctx, sellOrder, batch)
+
+	return true, sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&marketplace.EventRenewSellOrder{
+		SellOrderId: sellOrder.Id,
+		AskAmount:   sellOrder.AskAmount,
+		Expiration:  types.ProtobufToGogoTimestamp(sellOrder.Expiration),
+	})
+}
+
+// replaceSellOrderMatches replaces the matches of a sell order in the order book from
+// a block-level hook. The order book is updated in a cached context and a failure is
+// logged instead of returned so that indexing the order book never halts the chain. The
+// matches of the sell order are dropped in that case and the sell order can still be
+// filled directly.
+func (k Keeper) replaceSellOrderMatches(ctx context.Context, sellOrder *marketplaceapi.SellOrder, batch *ecoApi.Batch) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+	err := k.orderBook.OnInsertSellOrder(sdk.WrapSDKContext(cacheCtx), sellOrder, batch)
+	if err == nil {
+		writeCache()
+		return
+	}
+
+	sdkCtx.Logger().Error("failed to update order book for sell order", "sell_order_id", sellOrder.Id, "err", err)
+	if err = k.orderBook.OnRemoveSellOrder(ctx, sellOrder); err != nil {
+		sdkCtx.Logger().Error("failed to remove sell order from order book", "sell_order_id", sellOrder.Id, "err", err)
+	}
+}
+
+// decayAskAmount returns the ask amount reduced by the price decay of the auto-renewal and
+// rounded down to an integer amount. The ask amount is not reduced below the floor amount
+// or below an amount of one.
+func decayAskAmount(amount string, autoRenew *marketplaceapi.SellOrder_AutoRenew) (sdk.Int, error) {
+	askAmount, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", amount, sdk.Int{})
+	}
+
+	if autoRenew.PriceDecay != "" {
+		decay, err := math.NewDecFromString(autoRenew.PriceDecay)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		factor, err := math.NewDecFromInt64(1).Sub(decay)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		ask, err := math.NewDecFromString(amount)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		decayed, err := ask.Mul(factor)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		askAmount = decayed.SdkIntTrim()
+	}
+
+	if autoRenew.FloorAmount != "" {
+		floor, ok := sdk.NewIntFromString(autoRenew.FloorAmount)
+		if !ok {
+			return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", autoRenew.FloorAmount, sdk.Int{})
+		}
+		if askAmount.LT(floor) {
+			askAmount = floor
+		}
+	}
+
+	if !askAmount.IsPositive() {
+		askAmount = sdk.OneInt()
 	}
-	it.Close()
 
-	return k.stateStore.SellOrderTable().DeleteRange(ctx, fromKey, toKey)
+	return askAmount, nil
 }
 
 // unescrowCredits moves `amount` of credits from the sellerAddr's escrowed balance, into their tradable balance.
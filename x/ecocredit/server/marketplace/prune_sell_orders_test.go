@@ -1,16 +1,22 @@
 package marketplace
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
 	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
 	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 )
 
 func TestSell_Prune(t *testing.T) {
@@ -100,3 +106,140 @@ func TestPrune_NilExpiration(t *testing.T) {
 	_, err = s.marketStore.SellOrderTable().Get(s.ctx, shouldNotExistOrder)
 	assert.ErrorIs(t, err, ormerrors.NotFound)
 }
+
+func TestPrune_AutoRenew(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	period := 24 * time.Hour
+
+	res, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{
+				BatchDenom:  batchDenom,
+				Quantity:    "10",
+				AskPrice:    &ask,
+				Expiration:  &expired,
+				TimeInForce: marketplace.TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE,
+				AutoRenew: &marketplace.MsgSell_AutoRenew{
+					RenewalPeriod: &period,
+					PriceDecay:    "0.1",
+					FloorAmount:   "9",
+					MaxRenewals:   2,
+				},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	id := res.SellOrderIds[0]
+
+	// the order is re-listed at the decayed price with the expiration extended by
+	// whole renewal periods until it is after the block time
+	s.setBlockTime(expired.Add(2 * period))
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "9", sellOrder.AskAmount)
+	assert.Equal(t, expired.Add(3*period), sellOrder.Expiration.AsTime())
+	assert.Equal(t, uint32(1), sellOrder.Renewals)
+
+	// the decayed price is limited to the floor amount
+	s.setBlockTime(expired.Add(3 * period))
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+	sellOrder, err = s.marketStore.SellOrderTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, "9", sellOrder.AskAmount)
+	assert.Equal(t, expired.Add(4*period), sellOrder.Expiration.AsTime())
+	assert.Equal(t, uint32(2), sellOrder.Renewals)
+
+	// the order is removed once it reaches the maximum number of renewals
+	s.setBlockTime(expired.Add(4 * period))
+	assert.NilError(t, s.k.PruneSellOrders(s.ctx))
+
+	_, err = s.marketStore.SellOrderTable().Get(s.ctx, id)
+	assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+
+	bal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, s.addrs[0], 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "100", bal.TradableAmount)
+	assert.Equal(t, "0", bal.EscrowedAmount)
+}
+
+// failingOrderBook is an order book that fails to insert sell orders.
+type failingOrderBook struct {
+	orderbook.OrderBook
+}
+
+func (failingOrderBook) OnInsertSellOrder(context.Context, *api.SellOrder, *ecoApi.Batch) error {
+	return fmt.Errorf("failed to insert sell order")
+}
+
+func TestPrune_AutoRenewOrderBookFailure(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+
+	expired, err := types.ParseDate("expiration", "2019-12-30")
+	assert.NilError(t, err)
+	period := 24 * time.Hour
+
+	res, err := s.k.Sell(s.ctx, &marketplace.MsgSell{
+		Seller: s.addrs[0].String(),
+		Orders: []*marketplace.MsgSell_Order{
+			{
+				BatchDenom:  batchDenom,
+				Quantity:    "10",
+				AskPrice:    &ask,
+				Expiration:  &expired,
+				TimeInForce: marketplace.TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE,
+				AutoRenew:   &marketplace.MsgSell_AutoRenew{RenewalPeriod: &period},
+			},
+		},
+	})
+	assert.NilError(t, err)
+	id := res.SellOrderIds[0]
+	s.insertBuyOrder(s.addrs[1], "4", "12")
+
+	// the order is re-listed even though the order book fails and its matches are dropped
+	k := s.k
+	k.orderBook = failingOrderBook{s.orderBook}
+	s.setBlockTime(expired.Add(period))
+	assert.NilError(t, k.PruneSellOrders(s.ctx))
+
+	sellOrder, err := s.marketStore.SellOrderTable().Get(s.ctx, id)
+	assert.NilError(t, err)
+	assert.Equal(t, uint32(1), sellOrder.Renewals)
+
+	err = s.orderBook.CheckConsistency(s.ctx)
+	assert.ErrorContains(t, err, "missing match of buy order 1 and sell order 1")
+}
+
+func TestDecayAskAmount(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name      string
+		amount    string
+		autoRenew *api.SellOrder_AutoRenew
+		expected  string
+	}{
+		{"no decay", "100", &api.SellOrder_AutoRenew{}, "100"},
+		{"decay", "100", &api.SellOrder_AutoRenew{PriceDecay: "0.05"}, "95"},
+		{"decay rounded down", "15", &api.SellOrder_AutoRenew{PriceDecay: "0.05"}, "14"},
+		{"floor", "100", &api.SellOrder_AutoRenew{PriceDecay: "0.5", FloorAmount: "60"}, "60"},
+		{"minimum of one", "1", &api.SellOrder_AutoRenew{PriceDecay: "0.5"}, "1"},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			amount, err := decayAskAmount(tc.amount, tc.autoRenew)
+			assert.NilError(t, err)
+			assert.Equal(t, tc.expected, amount.String())
+		})
+	}
+}
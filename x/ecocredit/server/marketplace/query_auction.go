@@ -0,0 +1,70 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Auction queries an auction by its unique identifier.
+func (k Keeper) Auction(ctx context.Context, req *marketplace.QueryAuctionRequest) (*marketplace.QueryAuctionResponse, error) {
+	auction, err := k.stateStore.AuctionTable().Get(ctx, req.AuctionId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get auction with id %d: %s", req.AuctionId, err.Error())
+	}
+
+	info, err := k.auctionInfo(ctx, auction)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryAuctionResponse{Auction: info}, nil
+}
+
+// auctionInfo converts an auction to the human-readable auction information. The current
+// price of a dutch auction is the price of the winning bid or the price at the block time.
+func (k Keeper) auctionInfo(ctx context.Context, auction *api.Auction) (*marketplace.AuctionInfo, error) {
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var bidder, currentPrice string
+	if len(auction.Bidder) != 0 {
+		bidder = sdk.AccAddress(auction.Bidder).String()
+	}
+	if auction.AuctionType == api.AuctionType_AUCTION_TYPE_DUTCH {
+		if len(auction.Bidder) != 0 {
+			currentPrice = auction.BidPrice
+		} else {
+			price, err := dutchAuctionPrice(auction, sdk.UnwrapSDKContext(ctx).BlockTime())
+			if err != nil {
+				return nil, err
+			}
+			currentPrice = price.String()
+		}
+	}
+
+	return &marketplace.AuctionInfo{
+		Id:             auction.Id,
+		Seller:         sdk.AccAddress(auction.Seller).String(),
+		BatchDenom:     batch.Denom,
+		Quantity:       auction.Quantity,
+		AuctionType:    marketplace.AuctionType(auction.AuctionType),
+		Denom:          auction.Denom,
+		StartPrice:     auction.StartPrice,
+		ReservePrice:   auction.ReservePrice,
+		StartTime:      types.ProtobufToGogoTimestamp(auction.StartTime),
+		EndTime:        types.ProtobufToGogoTimestamp(auction.EndTime),
+		PriceDecrement: auction.PriceDecrement,
+		DecayInterval:  types.ProtobufToGogoDuration(auction.DecayInterval),
+		Bidder:         bidder,
+		BidPrice:       auction.BidPrice,
+		CurrentPrice:   currentPrice,
+	}, nil
+}
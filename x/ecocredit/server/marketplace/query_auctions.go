@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Auctions queries all auctions in state with optional pagination.
+func (k Keeper) Auctions(ctx context.Context, req *marketplace.QueryAuctionsRequest) (*marketplace.QueryAuctionsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.AuctionTable().List(ctx, api.AuctionIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	auctions := make([]*marketplace.AuctionInfo, 0)
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.auctionInfo(ctx, auction)
+		if err != nil {
+			return nil, err
+		}
+
+		auctions = append(auctions, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryAuctionsResponse{Auctions: auctions, Pagination: pr}, nil
+}
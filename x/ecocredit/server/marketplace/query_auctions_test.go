@@ -0,0 +1,99 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestAuction(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction := insertAuction(t, s, startTime)
+
+	// the price of the dutch auction has decayed by two intervals
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime.Add(50 * time.Hour))
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	res, err := s.k.Auction(s.ctx, &marketplace.QueryAuctionRequest{AuctionId: auction.Id})
+	assert.NilError(t, err)
+	assertAuctionEqual(t, res.Auction, auction, "80")
+
+	_, err = s.k.Auction(s.ctx, &marketplace.QueryAuctionRequest{AuctionId: 2})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestAuctions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+
+	startTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	auction1 := insertAuction(t, s, startTime)
+	insertAuction(t, s, startTime)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(startTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	res, err := s.k.Auctions(s.ctx, &marketplace.QueryAuctionsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.Auctions))
+	assertAuctionEqual(t, res.Auctions[0], auction1, "100")
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
+
+func insertAuction(t *testing.T, s *baseSuite, startTime time.Time) *api.Auction {
+	auction := &api.Auction{
+		Seller:         s.addrs[0],
+		BatchKey:       1,
+		Quantity:       "10",
+		AuctionType:    api.AuctionType_AUCTION_TYPE_DUTCH,
+		Denom:          ask.Denom,
+		StartPrice:     "100",
+		ReservePrice:   "50",
+		StartTime:      timestamppb.New(startTime),
+		EndTime:        timestamppb.New(startTime.AddDate(0, 1, 0)),
+		PriceDecrement: "10",
+		DecayInterval:  durationpb.New(24 * time.Hour),
+	}
+	id, err := s.marketStore.AuctionTable().InsertReturningID(s.ctx, auction)
+	assert.NilError(t, err)
+	auction.Id = id
+
+	return auction
+}
+
+func assertAuctionEqual(t *testing.T, received *marketplace.AuctionInfo, auction *api.Auction, currentPrice string) {
+	info := marketplace.AuctionInfo{
+		Id:             auction.Id,
+		Seller:         sdk.AccAddress(auction.Seller).String(),
+		BatchDenom:     batchDenom,
+		Quantity:       auction.Quantity,
+		AuctionType:    marketplace.AuctionType(auction.AuctionType),
+		Denom:          auction.Denom,
+		StartPrice:     auction.StartPrice,
+		ReservePrice:   auction.ReservePrice,
+		StartTime:      types.ProtobufToGogoTimestamp(auction.StartTime),
+		EndTime:        types.ProtobufToGogoTimestamp(auction.EndTime),
+		PriceDecrement: auction.PriceDecrement,
+		DecayInterval:  types.ProtobufToGogoDuration(auction.DecayInterval),
+		CurrentPrice:   currentPrice,
+	}
+
+	assert.DeepEqual(t, info, *received)
+}
@@ -0,0 +1,125 @@
+package marketplace
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BestAskByBatch queries the lowest ask price of the sell orders of a credit batch in each market.
+func (k Keeper) BestAskByBatch(ctx context.Context, req *marketplace.QueryBestAskByBatchRequest) (*marketplace.QueryBestAskByBatchResponse, error) {
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("batch denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	bestAsks, err := k.getBestAsks(ctx, []uint64{batch.Key})
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBestAskByBatchResponse{BestAsks: bestAsks}, nil
+}
+
+// bestAsk is the lowest ask of a market while sell orders are being aggregated.
+type bestAsk struct {
+	market      *api.Market
+	askAmount   sdk.Int
+	quantity    math.Dec
+	sellOrderId uint64
+}
+
+// getBestAsks returns the lowest ask price of the sell orders of the credit batches in
+// each market, sorted by market id.
+func (k Keeper) getBestAsks(ctx context.Context, batchKeys []uint64) ([]*marketplace.BestAsk, error) {
+	asks := make(map[uint64]*bestAsk)
+
+	for _, batchKey := range batchKeys {
+		it, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderBatchKeyIndexKey{}.WithBatchKey(batchKey))
+		if err != nil {
+			return nil, err
+		}
+
+		for it.Next() {
+			order, err := it.Value()
+			if err != nil {
+				it.Close()
+				return nil, err
+			}
+			if err = k.addBestAsk(ctx, asks, order); err != nil {
+				it.Close()
+				return nil, err
+			}
+		}
+		it.Close()
+	}
+
+	marketIds := make([]uint64, 0, len(asks))
+	for id := range asks {
+		marketIds = append(marketIds, id)
+	}
+	sort.Slice(marketIds, func(i, j int) bool { return marketIds[i] < marketIds[j] })
+
+	bestAsks := make([]*marketplace.BestAsk, len(marketIds))
+	for i, id := range marketIds {
+		ask := asks[id]
+		bestAsks[i] = &marketplace.BestAsk{
+			MarketId:    id,
+			AskDenom:    ask.market.BankDenom,
+			AskAmount:   ask.askAmount.String(),
+			Quantity:    ask.quantity.String(),
+			SellOrderId: ask.sellOrderId,
+		}
+	}
+
+	return bestAsks, nil
+}
+
+// addBestAsk updates the best ask of the market of the sell order if the sell order asks
+// a price lower than or equal to the current best ask.
+func (k Keeper) addBestAsk(ctx context.Context, asks map[uint64]*bestAsk, order *api.SellOrder) error {
+	askAmount, ok := sdk.NewIntFromString(order.AskAmount)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", order.AskAmount, sdk.Int{})
+	}
+
+	quantity, err := math.NewDecFromString(order.Quantity)
+	if err != nil {
+		return err
+	}
+
+	ask, ok := asks[order.MarketId]
+	if !ok {
+		market, err := k.stateStore.MarketTable().Get(ctx, order.MarketId)
+		if err != nil {
+			return err
+		}
+		asks[order.MarketId] = &bestAsk{
+			market:      market,
+			askAmount:   askAmount,
+			quantity:    quantity,
+			sellOrderId: order.Id,
+		}
+		return nil
+	}
+
+	switch {
+	case askAmount.LT(ask.askAmount):
+		ask.askAmount, ask.quantity, ask.sellOrderId = askAmount, quantity, order.Id
+	case askAmount.Equal(ask.askAmount):
+		if ask.quantity, err = ask.quantity.Add(quantity); err != nil {
+			return err
+		}
+		if order.Id < ask.sellOrderId {
+			ask.sellOrderId = order.Id
+		}
+	}
+
+	return nil
+}
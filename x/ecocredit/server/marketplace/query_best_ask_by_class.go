@@ -0,0 +1,44 @@
+package marketplace
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BestAskByClass queries the lowest ask price of the sell orders of the credit batches of a credit class in each market.
+func (k Keeper) BestAskByClass(ctx context.Context, req *marketplace.QueryBestAskByClassRequest) (*marketplace.QueryBestAskByClassResponse, error) {
+	class, err := k.coreStore.ClassTable().GetById(ctx, req.ClassId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("class id %s: %s", req.ClassId, err.Error())
+	}
+
+	it, err := k.coreStore.ProjectTable().List(ctx, ecoApi.ProjectClassKeyIdIndexKey{}.WithClassKey(class.Key))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batchKeys []uint64
+	for it.Next() {
+		project, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		keys, err := k.getProjectBatchKeys(ctx, project.Key)
+		if err != nil {
+			return nil, err
+		}
+		batchKeys = append(batchKeys, keys...)
+	}
+
+	bestAsks, err := k.getBestAsks(ctx, batchKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBestAskByClassResponse{BestAsks: bestAsks}, nil
+}
@@ -0,0 +1,50 @@
+package marketplace
+
+import (
+	"context"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// BestAskByProject queries the lowest ask price of the sell orders of the credit batches of a project in each market.
+func (k Keeper) BestAskByProject(ctx context.Context, req *marketplace.QueryBestAskByProjectRequest) (*marketplace.QueryBestAskByProjectResponse, error) {
+	project, err := k.coreStore.ProjectTable().GetById(ctx, req.ProjectId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("project id %s: %s", req.ProjectId, err.Error())
+	}
+
+	batchKeys, err := k.getProjectBatchKeys(ctx, project.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	bestAsks, err := k.getBestAsks(ctx, batchKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryBestAskByProjectResponse{BestAsks: bestAsks}, nil
+}
+
+// getProjectBatchKeys returns the keys of the credit batches of the project.
+func (k Keeper) getProjectBatchKeys(ctx context.Context, projectKey uint64) ([]uint64, error) {
+	it, err := k.coreStore.BatchTable().List(ctx, ecoApi.BatchProjectKeyIndexKey{}.WithProjectKey(projectKey))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	var batchKeys []uint64
+	for it.Next() {
+		batch, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		batchKeys = append(batchKeys, batch.Key)
+	}
+
+	return batchKeys, nil
+}
@@ -0,0 +1,78 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryBestAsk(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+
+	// a second batch in the same project and a third batch in a second project
+	otherDenom := "C01-001-20210101-20220101-002"
+	assert.NilError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{ProjectKey: 1, Denom: otherDenom}))
+	assert.NilError(t, s.coreStore.ProjectTable().Insert(s.ctx, &ecoApi.Project{Id: "C01-002", ClassKey: 1}))
+	projectDenom := "C01-002-20210101-20220101-001"
+	assert.NilError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{ProjectKey: 2, Denom: projectDenom}))
+	assert.NilError(t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{CreditTypeAbbrev: "C", BankDenom: "uosmo"}))
+
+	insertBatchSellOrder(t, s, 1, 1, "12", "1")
+	insertBatchSellOrder(t, s, 1, 1, "11", "2")
+	insertBatchSellOrder(t, s, 1, 2, "30", "3")
+	insertBatchSellOrder(t, s, 2, 1, "11", "4")
+	insertBatchSellOrder(t, s, 3, 1, "9", "5")
+
+	byBatch, err := s.k.BestAskByBatch(s.ctx, &marketplace.QueryBestAskByBatchRequest{BatchDenom: batchDenom})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, byBatch.BestAsks, []*marketplace.BestAsk{
+		{MarketId: 1, AskDenom: ask.Denom, AskAmount: "11", Quantity: "2", SellOrderId: 2},
+		{MarketId: 2, AskDenom: "uosmo", AskAmount: "30", Quantity: "3", SellOrderId: 3},
+	})
+
+	byProject, err := s.k.BestAskByProject(s.ctx, &marketplace.QueryBestAskByProjectRequest{ProjectId: "C01-001"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, byProject.BestAsks, []*marketplace.BestAsk{
+		{MarketId: 1, AskDenom: ask.Denom, AskAmount: "11", Quantity: "6", SellOrderId: 2},
+		{MarketId: 2, AskDenom: "uosmo", AskAmount: "30", Quantity: "3", SellOrderId: 3},
+	})
+
+	byClass, err := s.k.BestAskByClass(s.ctx, &marketplace.QueryBestAskByClassRequest{ClassId: classId})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, byClass.BestAsks, []*marketplace.BestAsk{
+		{MarketId: 1, AskDenom: ask.Denom, AskAmount: "9", Quantity: "5", SellOrderId: 5},
+		{MarketId: 2, AskDenom: "uosmo", AskAmount: "30", Quantity: "3", SellOrderId: 3},
+	})
+
+	// batch without sell orders
+	assert.NilError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{ProjectKey: 2, Denom: "C01-002-20220101-20230101-002"}))
+	byBatch, err = s.k.BestAskByBatch(s.ctx, &marketplace.QueryBestAskByBatchRequest{BatchDenom: "C01-002-20220101-20230101-002"})
+	assert.NilError(t, err)
+	assert.Equal(t, 0, len(byBatch.BestAsks))
+
+	_, err = s.k.BestAskByBatch(s.ctx, &marketplace.QueryBestAskByBatchRequest{BatchDenom: "C02-001-20200101-20210101-001"})
+	assert.ErrorContains(t, err, "batch denom C02-001-20200101-20210101-001")
+
+	_, err = s.k.BestAskByProject(s.ctx, &marketplace.QueryBestAskByProjectRequest{ProjectId: "C01-003"})
+	assert.ErrorContains(t, err, "project id C01-003")
+
+	_, err = s.k.BestAskByClass(s.ctx, &marketplace.QueryBestAskByClassRequest{ClassId: "C02"})
+	assert.ErrorContains(t, err, "class id C02")
+}
+
+func insertBatchSellOrder(t *testing.T, s *baseSuite, batchKey, marketId uint64, askAmount, quantity string) {
+	_, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, &api.SellOrder{
+		Seller:    s.addrs[0],
+		BatchKey:  batchKey,
+		Quantity:  quantity,
+		MarketId:  marketId,
+		AskAmount: askAmount,
+	})
+	assert.NilError(t, err)
+}
@@ -0,0 +1,164 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// MaxCandles is the maximum number of intervals within the time range of a candles query.
+const MaxCandles = 1000
+
+// Candles queries the open, high, low and close prices and the volume of a market, or of
+// a credit batch in a market, in each interval of a time range.
+func (k Keeper) Candles(ctx context.Context, req *marketplace.QueryCandlesRequest) (*marketplace.QueryCandlesResponse, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time and end time are required")
+	}
+
+	if req.Interval == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("interval is required")
+	}
+
+	startTime := types.GogoToProtobufTimestamp(req.StartTime).AsTime()
+	endTime := types.GogoToProtobufTimestamp(req.EndTime).AsTime()
+	if !endTime.After(startTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time %s must be after start time %s", endTime, startTime)
+	}
+
+	interval := types.GogoToProtobufDuration(req.Interval).AsDuration()
+	if interval <= 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("interval must be positive: %s", interval)
+	}
+
+	count := (endTime.Sub(startTime) + interval - 1) / interval
+	if count > MaxCandles {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf(
+			"number of candles exceeds maximum: %d, maximum: %d", count, MaxCandles,
+		)
+	}
+
+	market, batchKey, err := k.getPriceSeries(ctx, req.MarketId, req.BatchDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	fromKey := api.PriceObservationMarketIdBatchKeyTimestampIndexKey{}.WithMarketIdBatchKeyTimestamp(
+		market.Id, batchKey, timestamppb.New(startTime),
+	)
+	toKey := api.PriceObservationMarketIdBatchKeyTimestampIndexKey{}.WithMarketIdBatchKeyTimestamp(
+		market.Id, batchKey, timestamppb.New(endTime),
+	)
+	it, err := k.stateStore.PriceObservationTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	candles := make([]*marketplace.Candle, 0)
+	var candle *candleBuilder
+	for it.Next() {
+		obs, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		// the end of the time range is exclusive
+		timestamp := obs.Timestamp.AsTime()
+		if !timestamp.Before(endTime) {
+			break
+		}
+
+		candleStart := startTime.Add(timestamp.Sub(startTime) / interval * interval)
+		if candle == nil || !candle.start.Equal(candleStart) {
+			if candle != nil {
+				candles = append(candles, candle.candle())
+			}
+			if candle, err = newCandleBuilder(candleStart, interval, obs); err != nil {
+				return nil, err
+			}
+		}
+
+		if err = candle.add(obs); err != nil {
+			return nil, err
+		}
+	}
+	if candle != nil {
+		candles = append(candles, candle.candle())
+	}
+
+	return &marketplace.QueryCandlesResponse{Denom: market.BankDenom, Candles: candles}, nil
+}
+
+// candleBuilder aggregates the price observations within an interval.
+type candleBuilder struct {
+	start    time.Time
+	interval time.Duration
+	open     string
+	high     sdk.Int
+	low      sdk.Int
+	close    string
+	volume   math.Dec
+}
+
+func newCandleBuilder(start time.Time, interval time.Duration, obs *api.PriceObservation) (*candleBuilder, error) {
+	high, low, err := parseHighLow(obs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &candleBuilder{
+		start:    start,
+		interval: interval,
+		open:     obs.Open,
+		high:     high,
+		low:      low,
+		volume:   math.NewDecFromInt64(0),
+	}, nil
+}
+
+// add aggregates the prices and volume of the price observation in the candle.
+func (c *candleBuilder) add(obs *api.PriceObservation) error {
+	high, low, err := parseHighLow(obs)
+	if err != nil {
+		return err
+	}
+	if high.GT(c.high) {
+		c.high = high
+	}
+	if low.LT(c.low) {
+		c.low = low
+	}
+
+	volume, err := math.NewDecFromString(obs.Volume)
+	if err != nil {
+		return err
+	}
+	if c.volume, err = c.volume.Add(volume); err != nil {
+		return err
+	}
+
+	c.close = obs.Close
+
+	return nil
+}
+
+func (c *candleBuilder) candle() *marketplace.Candle {
+	return &marketplace.Candle{
+		StartTime: types.ProtobufToGogoTimestamp(timestamppb.New(c.start)),
+		EndTime:   types.ProtobufToGogoTimestamp(timestamppb.New(c.start.Add(c.interval))),
+		Open:      c.open,
+		High:      c.high.String(),
+		Low:       c.low.String(),
+		Close:     c.close,
+		Volume:    c.volume.String(),
+	}
+}
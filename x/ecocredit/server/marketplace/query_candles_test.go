@@ -0,0 +1,119 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"gotest.tools/v3/assert"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryCandles(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.priceHistorySetup()
+	batchDenom := "C01-001-20200101-20210101-001"
+
+	res, err := s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart),
+		EndTime:    gogoTime(t, priceStart.Add(400*time.Second)),
+		Interval:   gogotypes.DurationProto(200 * time.Second),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "regen", res.Denom)
+	assert.DeepEqual(t, res.Candles, []*marketplace.Candle{
+		{
+			StartTime: gogoTime(t, priceStart),
+			EndTime:   gogoTime(t, priceStart.Add(200*time.Second)),
+			Open:      "10",
+			High:      "14",
+			Low:       "8",
+			Close:     "8",
+			Volume:    "4",
+		},
+		{
+			StartTime: gogoTime(t, priceStart.Add(200*time.Second)),
+			EndTime:   gogoTime(t, priceStart.Add(400*time.Second)),
+			Open:      "20",
+			High:      "20",
+			Low:       "20",
+			Close:     "20",
+			Volume:    "0.5",
+		},
+	})
+
+	// intervals without trades are omitted and the end time is exclusive
+	res, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart.Add(-20*time.Second)),
+		EndTime:    gogoTime(t, priceStart.Add(300*time.Second)),
+		Interval:   gogotypes.DurationProto(60 * time.Second),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Candles))
+	assert.DeepEqual(t, gogoTime(t, priceStart.Add(-20*time.Second)), res.Candles[0].StartTime)
+	assert.Equal(t, "14", res.Candles[0].Close)
+	assert.DeepEqual(t, gogoTime(t, priceStart.Add(100*time.Second)), res.Candles[1].StartTime)
+	assert.Equal(t, "8", res.Candles[1].Close)
+
+	// the candles of the market include the trades of all credit batches
+	res, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart.Add(100*time.Second)),
+		EndTime:   gogoTime(t, priceStart.Add(200*time.Second)),
+		Interval:  gogotypes.DurationProto(100 * time.Second),
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.Candles, []*marketplace.Candle{
+		{
+			StartTime: gogoTime(t, priceStart.Add(100*time.Second)),
+			EndTime:   gogoTime(t, priceStart.Add(200*time.Second)),
+			Open:      "8",
+			High:      "12",
+			Low:       "8",
+			Close:     "12",
+			Volume:    "2",
+		},
+	})
+
+	// too many candles
+	_, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart),
+		EndTime:   gogoTime(t, priceStart.Add(time.Hour)),
+		Interval:  gogotypes.DurationProto(time.Second),
+	})
+	assert.ErrorContains(t, err, "number of candles exceeds maximum: 3600, maximum: 1000")
+
+	// interval must be positive
+	_, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart),
+		EndTime:   gogoTime(t, priceStart.Add(time.Hour)),
+		Interval:  gogotypes.DurationProto(0),
+	})
+	assert.ErrorContains(t, err, "interval must be positive")
+
+	// end time must be after start time
+	_, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart),
+		EndTime:   gogoTime(t, priceStart),
+		Interval:  gogotypes.DurationProto(time.Minute),
+	})
+	assert.ErrorContains(t, err, "must be after start time")
+
+	// market does not exist
+	_, err = s.k.Candles(s.ctx, &marketplace.QueryCandlesRequest{
+		MarketId:  2,
+		StartTime: gogoTime(t, priceStart),
+		EndTime:   gogoTime(t, priceStart.Add(time.Hour)),
+		Interval:  gogotypes.DurationProto(time.Minute),
+	})
+	assert.ErrorContains(t, err, "market with id 2")
+}
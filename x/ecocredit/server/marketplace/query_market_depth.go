@@ -0,0 +1,145 @@
+package marketplace
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// MarketDepth queries the sell orders and buy orders of a market aggregated by price.
+func (k Keeper) MarketDepth(ctx context.Context, req *marketplace.QueryMarketDepthRequest) (*marketplace.QueryMarketDepthResponse, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, req.MarketId)
+	if err != nil {
+		return nil, sdkerrors.ErrNotFound.Wrapf("market with id %d: %s", req.MarketId, err.Error())
+	}
+
+	asks := newDepth()
+	sellIt, err := k.stateStore.SellOrderTable().List(ctx, api.SellOrderIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer sellIt.Close()
+
+	for sellIt.Next() {
+		order, err := sellIt.Value()
+		if err != nil {
+			return nil, err
+		}
+		if order.MarketId != market.Id {
+			continue
+		}
+		if err = asks.add(order.AskAmount, order.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	bids := newDepth()
+	buyIt, err := k.stateStore.BuyOrderTable().List(ctx, api.BuyOrderIdIndexKey{})
+	if err != nil {
+		return nil, err
+	}
+	defer buyIt.Close()
+
+	for buyIt.Next() {
+		order, err := buyIt.Value()
+		if err != nil {
+			return nil, err
+		}
+		if order.MarketId != market.Id {
+			continue
+		}
+		if err = bids.add(order.BidAmount, order.Quantity); err != nil {
+			return nil, err
+		}
+	}
+
+	var m marketplace.Market
+	if err = ormutil.PulsarToGogoSlow(market, &m); err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryMarketDepthResponse{
+		Market: &m,
+		Asks:   asks.levels(false, req.Limit),
+		Bids:   bids.levels(true, req.Limit),
+	}, nil
+}
+
+// depth aggregates the quantities of orders by price.
+type depth struct {
+	prices     map[string]sdk.Int
+	quantities map[string]math.Dec
+	counts     map[string]uint64
+}
+
+func newDepth() *depth {
+	return &depth{
+		prices:     make(map[string]sdk.Int),
+		quantities: make(map[string]math.Dec),
+		counts:     make(map[string]uint64),
+	}
+}
+
+// add adds the quantity of an order to the price level of the price.
+func (d *depth) add(price, quantity string) error {
+	amount, ok := sdk.NewIntFromString(price)
+	if !ok {
+		return sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", price, sdk.Int{})
+	}
+
+	qty, err := math.NewDecFromString(quantity)
+	if err != nil {
+		return err
+	}
+
+	key := amount.String()
+	total, ok := d.quantities[key]
+	if !ok {
+		d.prices[key] = amount
+		total = math.NewDecFromInt64(0)
+	}
+	if d.quantities[key], err = total.Add(qty); err != nil {
+		return err
+	}
+	d.counts[key]++
+
+	return nil
+}
+
+// levels returns the price levels sorted by price in ascending order, or in descending
+// order if descending is true. At most limit price levels are returned unless limit is
+// zero.
+func (d *depth) levels(descending bool, limit uint32) []*marketplace.PriceLevel {
+	keys := make([]string, 0, len(d.prices))
+	for key := range d.prices {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if descending {
+			return d.prices[keys[i]].GT(d.prices[keys[j]])
+		}
+		return d.prices[keys[i]].LT(d.prices[keys[j]])
+	})
+
+	if limit != 0 && len(keys) > int(limit) {
+		keys = keys[:limit]
+	}
+
+	levels := make([]*marketplace.PriceLevel, len(keys))
+	for i, key := range keys {
+		levels[i] = &marketplace.PriceLevel{
+			Price:      key,
+			Quantity:   d.quantities[key].String(),
+			OrderCount: d.counts[key],
+		}
+	}
+
+	return levels
+}
@@ -0,0 +1,75 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryMarketDepth(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+
+	// orders in another market are not included
+	assert.NilError(t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{CreditTypeAbbrev: "C", BankDenom: "uosmo"}))
+	insertMarketSellOrder(t, s, s.addrs[0], 2, "5", "1")
+
+	insertMarketSellOrder(t, s, s.addrs[0], 1, "12", "1.5")
+	insertMarketSellOrder(t, s, s.addrs[1], 1, "10", "2")
+	insertMarketSellOrder(t, s, s.addrs[0], 1, "10", "3")
+	insertMarketSellOrder(t, s, s.addrs[1], 1, "15", "4")
+	s.insertBuyOrder(s.addrs[1], "1", "8")
+	s.insertBuyOrder(s.addrs[1], "2", "9")
+	s.insertBuyOrder(s.addrs[0], "3", "9")
+
+	res, err := s.k.MarketDepth(s.ctx, &marketplace.QueryMarketDepthRequest{MarketId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), res.Market.Id)
+	assert.Equal(t, ask.Denom, res.Market.BankDenom)
+	assert.DeepEqual(t, res.Asks, []*marketplace.PriceLevel{
+		{Price: "10", Quantity: "5", OrderCount: 2},
+		{Price: "12", Quantity: "1.5", OrderCount: 1},
+		{Price: "15", Quantity: "4", OrderCount: 1},
+	})
+	assert.DeepEqual(t, res.Bids, []*marketplace.PriceLevel{
+		{Price: "9", Quantity: "5", OrderCount: 2},
+		{Price: "8", Quantity: "1", OrderCount: 1},
+	})
+
+	// limit the number of price levels on each side
+	res, err = s.k.MarketDepth(s.ctx, &marketplace.QueryMarketDepthRequest{MarketId: 1, Limit: 1})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.Asks, []*marketplace.PriceLevel{{Price: "10", Quantity: "5", OrderCount: 2}})
+	assert.DeepEqual(t, res.Bids, []*marketplace.PriceLevel{{Price: "9", Quantity: "5", OrderCount: 2}})
+
+	// market with a sell order and no buy orders
+	res, err = s.k.MarketDepth(s.ctx, &marketplace.QueryMarketDepthRequest{MarketId: 2, Limit: 1})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, res.Asks, []*marketplace.PriceLevel{{Price: "5", Quantity: "1", OrderCount: 1}})
+	assert.Equal(t, 0, len(res.Bids))
+
+	// market does not exist
+	_, err = s.k.MarketDepth(s.ctx, &marketplace.QueryMarketDepthRequest{MarketId: 3})
+	assert.ErrorContains(t, err, "market with id 3")
+}
+
+func insertMarketSellOrder(t *testing.T, s *baseSuite, addr sdk.AccAddress, marketId uint64, askAmount, quantity string) *api.SellOrder {
+	sellOrder := &api.SellOrder{
+		Seller:    addr,
+		BatchKey:  1,
+		Quantity:  quantity,
+		MarketId:  marketId,
+		AskAmount: askAmount,
+	}
+	id, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, sellOrder)
+	assert.NilError(t, err)
+	sellOrder.Id = id
+
+	return sellOrder
+}
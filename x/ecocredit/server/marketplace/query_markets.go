@@ -0,0 +1,43 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Markets queries a paginated list of all markets.
+func (k Keeper) Markets(ctx context.Context, req *marketplace.QueryMarketsRequest) (*marketplace.QueryMarketsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.MarketTable().List(ctx, &api.MarketPrimaryKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	markets := make([]*marketplace.Market, 0)
+	for it.Next() {
+		market, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+		var m marketplace.Market
+		if err = ormutil.PulsarToGogoSlow(market, &m); err != nil {
+			return nil, err
+		}
+		markets = append(markets, &m)
+	}
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+	return &marketplace.QueryMarketsResponse{Markets: markets, Pagination: pr}, nil
+}
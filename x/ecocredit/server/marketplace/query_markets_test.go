@@ -0,0 +1,33 @@
+package marketplace
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryMarkets(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 0)
+
+	market1 := api.Market{CreditTypeAbbrev: "C", BankDenom: "uregen"}
+	assert.NilError(t, s.marketStore.MarketTable().Insert(s.ctx, &market1))
+
+	market2 := api.Market{CreditTypeAbbrev: "C", BankDenom: "uosmo"}
+	assert.NilError(t, s.marketStore.MarketTable().Insert(s.ctx, &market2))
+
+	var gogoMarket marketplace.Market
+	assert.NilError(t, ormutil.PulsarToGogoSlow(&market1, &gogoMarket))
+
+	res, err := s.k.Markets(s.ctx, &marketplace.QueryMarketsRequest{Pagination: &query.PageRequest{CountTotal: true, Limit: 1}})
+	assert.NilError(t, err)
+	assert.Check(t, res.Pagination != nil)
+	assert.Equal(t, res.Pagination.Total, uint64(2))
+	assert.Equal(t, 1, len(res.Markets))
+	assert.DeepEqual(t, res.Markets[0], &gogoMarket)
+}
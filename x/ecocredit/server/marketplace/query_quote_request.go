@@ -0,0 +1,70 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// QuoteRequest queries a quote request by its unique identifier.
+func (k Keeper) QuoteRequest(ctx context.Context, req *marketplace.QueryQuoteRequestRequest) (*marketplace.QueryQuoteRequestResponse, error) {
+	request, err := k.stateStore.QuoteRequestTable().Get(ctx, req.RequestId)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get quote request with id %d: %s", req.RequestId, err.Error())
+	}
+
+	info, err := k.quoteRequestInfo(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryQuoteRequestResponse{QuoteRequest: info}, nil
+}
+
+// quoteRequestInfo converts a quote request to the human-readable quote request
+// information, replacing the table row identifier of the selection with the denom
+// of the credit batch or the id of the project or credit class.
+func (k Keeper) quoteRequestInfo(ctx context.Context, request *api.QuoteRequest) (*marketplace.QuoteRequestInfo, error) {
+	info := &marketplace.QuoteRequestInfo{
+		Id:                     request.Id,
+		Buyer:                  sdk.AccAddress(request.Buyer).String(),
+		ProjectJurisdiction:    request.Selection.ProjectJurisdiction,
+		MinStartDate:           types.ProtobufToGogoTimestamp(request.Selection.MinStartDate),
+		MaxEndDate:             types.ProtobufToGogoTimestamp(request.Selection.MaxEndDate),
+		Quantity:               request.Quantity,
+		Denom:                  request.Denom,
+		DisableAutoRetire:      request.DisableAutoRetire,
+		RetirementJurisdiction: request.RetirementJurisdiction,
+		RetirementBeneficiary:  request.RetirementBeneficiary,
+		RetirementReason:       request.RetirementReason,
+		Expiration:             types.ProtobufToGogoTimestamp(request.Expiration),
+	}
+
+	switch sum := request.Selection.Sum.(type) {
+	case *api.BuyOrder_Selection_BatchKey:
+		batch, err := k.coreStore.BatchTable().Get(ctx, sum.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+		info.BatchDenom = batch.Denom
+	case *api.BuyOrder_Selection_ProjectKey:
+		project, err := k.coreStore.ProjectTable().Get(ctx, sum.ProjectKey)
+		if err != nil {
+			return nil, err
+		}
+		info.ProjectId = project.Id
+	case *api.BuyOrder_Selection_ClassKey:
+		class, err := k.coreStore.ClassTable().Get(ctx, sum.ClassKey)
+		if err != nil {
+			return nil, err
+		}
+		info.ClassId = class.Id
+	}
+
+	return info, nil
+}
@@ -0,0 +1,47 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// QuoteRequests queries all quote requests in state with optional pagination.
+func (k Keeper) QuoteRequests(ctx context.Context, req *marketplace.QueryQuoteRequestsRequest) (*marketplace.QueryQuoteRequestsResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.QuoteRequestTable().List(ctx, api.QuoteRequestIdIndexKey{}, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	requests := make([]*marketplace.QuoteRequestInfo, 0)
+	for it.Next() {
+		request, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info, err := k.quoteRequestInfo(ctx, request)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryQuoteRequestsResponse{QuoteRequests: requests, Pagination: pr}, nil
+}
@@ -0,0 +1,128 @@
+package marketplace
+
+import (
+	"testing"
+
+	"gotest.tools/v3/assert"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQuoteRequest(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.quoteSetup()
+
+	createQuoteRequest(t, s, s.addrs[0], &marketplace.MsgBuy_Selection{
+		Sum:                 &marketplace.MsgBuy_Selection_ClassId{ClassId: "C01"},
+		ProjectJurisdiction: "US",
+	})
+
+	res, err := s.k.QuoteRequest(s.ctx, &marketplace.QueryQuoteRequestRequest{RequestId: 1})
+	assert.NilError(t, err)
+	assert.Equal(t, uint64(1), res.QuoteRequest.Id)
+	assert.Equal(t, s.addrs[0].String(), res.QuoteRequest.Buyer)
+	assert.Equal(t, "C01", res.QuoteRequest.ClassId)
+	assert.Equal(t, "", res.QuoteRequest.BatchDenom)
+	assert.Equal(t, "US", res.QuoteRequest.ProjectJurisdiction)
+	assert.Equal(t, "10", res.QuoteRequest.Quantity)
+	assert.Equal(t, "regen", res.QuoteRequest.Denom)
+	assert.Equal(t, true, res.QuoteRequest.DisableAutoRetire)
+
+	_, err = s.k.QuoteRequest(s.ctx, &marketplace.QueryQuoteRequestRequest{RequestId: 2})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestQuoteRequests(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.quoteSetup()
+
+	createQuoteRequest(t, s, s.addrs[0], &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_BatchDenom{BatchDenom: "C01-001-20200101-20210101-001"},
+	})
+	createQuoteRequest(t, s, s.addrs[0], &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_ProjectId{ProjectId: "C01-001"},
+	})
+
+	res, err := s.k.QuoteRequests(s.ctx, &marketplace.QueryQuoteRequestsRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(res.QuoteRequests))
+	assert.Equal(t, "C01-001-20200101-20210101-001", res.QuoteRequests[0].BatchDenom)
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+}
+
+func TestQuotes(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	batchKey := s.quoteSetup()
+
+	createQuoteRequest(t, s, s.addrs[1], &marketplace.MsgBuy_Selection{
+		Sum: &marketplace.MsgBuy_Selection_ProjectId{ProjectId: "C01-001"},
+	})
+
+	seller := s.addrs[0]
+	assert.NilError(t, s.coreStore.BatchBalanceTable().Insert(s.ctx, &ecoApi.BatchBalance{
+		BatchKey:       batchKey,
+		Address:        seller,
+		TradableAmount: "20",
+		EscrowedAmount: "0",
+	}))
+	for _, amount := range []int64{10, 12} {
+		_, err := s.k.SubmitQuote(s.ctx, &marketplace.MsgSubmitQuote{
+			Seller:     seller.String(),
+			RequestId:  1,
+			BatchDenom: "C01-001-20200101-20210101-001",
+			AskPrice:   &sdk.Coin{Denom: "regen", Amount: sdk.NewInt(amount)},
+		})
+		assert.NilError(t, err)
+	}
+
+	// the quote is returned without the sell order once the sell order is cancelled
+	_, err := s.k.CancelSellOrder(s.ctx, &marketplace.MsgCancelSellOrder{
+		Seller:      seller.String(),
+		SellOrderId: 2,
+	})
+	assert.NilError(t, err)
+
+	res, err := s.k.Quotes(s.ctx, &marketplace.QueryQuotesRequest{
+		RequestId:  1,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(res.Quotes))
+	assert.Equal(t, uint64(2), res.Pagination.Total)
+
+	quote := res.Quotes[0]
+	assert.Equal(t, uint64(1), quote.Id)
+	assert.Equal(t, uint64(1), quote.RequestId)
+	assert.Equal(t, seller.String(), quote.Seller)
+	assert.Equal(t, uint64(1), quote.SellOrderId)
+	assert.Equal(t, "C01-001-20200101-20210101-001", quote.BatchDenom)
+	assert.Equal(t, "10", quote.Quantity)
+	assert.Equal(t, "regen", quote.AskDenom)
+	assert.Equal(t, "10", quote.AskAmount)
+
+	assert.Equal(t, uint64(2), res.Quotes[1].SellOrderId)
+	assert.Equal(t, "", res.Quotes[1].Quantity)
+
+	_, err = s.k.Quotes(s.ctx, &marketplace.QueryQuotesRequest{RequestId: 2})
+	assert.ErrorContains(t, err, "not found")
+}
+
+func createQuoteRequest(t *testing.T, s *baseSuite, buyer sdk.AccAddress, selection *marketplace.MsgBuy_Selection) {
+	_, err := s.k.CreateQuoteRequest(s.ctx, &marketplace.MsgCreateQuoteRequest{
+		Buyer:             buyer.String(),
+		Selection:         selection,
+		Quantity:          "10",
+		Denom:             "regen",
+		DisableAutoRetire: true,
+	})
+	assert.NilError(t, err)
+}
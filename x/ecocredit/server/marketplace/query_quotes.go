@@ -0,0 +1,85 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Quotes queries the quotes of a quote request with optional pagination. Quotes whose
+// sell order has been cancelled or pruned are returned with an empty quantity.
+func (k Keeper) Quotes(ctx context.Context, req *marketplace.QueryQuotesRequest) (*marketplace.QueryQuotesResponse, error) {
+	found, err := k.stateStore.QuoteRequestTable().Has(ctx, req.RequestId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, sdkerrors.ErrNotFound.Wrapf("quote request with id %d", req.RequestId)
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.QuoteTable().List(ctx,
+		api.QuoteQuoteRequestIdIndexKey{}.WithQuoteRequestId(req.RequestId),
+		ormlist.Paginate(pg),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	quotes := make([]*marketplace.QuoteInfo, 0)
+	for it.Next() {
+		quote, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		info := &marketplace.QuoteInfo{
+			Id:          quote.Id,
+			RequestId:   quote.QuoteRequestId,
+			Seller:      sdk.AccAddress(quote.Seller).String(),
+			SellOrderId: quote.SellOrderId,
+		}
+
+		sellOrder, err := k.stateStore.SellOrderTable().Get(ctx, quote.SellOrderId)
+		switch {
+		case err == nil:
+			batch, err := k.coreStore.BatchTable().Get(ctx, sellOrder.BatchKey)
+			if err != nil {
+				return nil, err
+			}
+			market, err := k.stateStore.MarketTable().Get(ctx, sellOrder.MarketId)
+			if err != nil {
+				return nil, err
+			}
+			info.BatchDenom = batch.Denom
+			info.Quantity = sellOrder.Quantity
+			info.AskDenom = market.BankDenom
+			info.AskAmount = sellOrder.AskAmount
+			info.Expiration = types.ProtobufToGogoTimestamp(sellOrder.Expiration)
+		case !ormerrors.IsNotFound(err):
+			return nil, err
+		}
+
+		quotes = append(quotes, info)
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryQuotesResponse{Quotes: quotes, Pagination: pr}, nil
+}
@@ -29,14 +29,21 @@ func (k Keeper) SellOrder(ctx context.Context, req *marketplace.QuerySellOrderRe
 	}
 
 	info := marketplace.SellOrderInfo{
-		Id:                order.Id,
-		Seller:            seller.String(),
-		BatchDenom:        batch.Denom,
-		Quantity:          order.Quantity,
-		AskDenom:          market.BankDenom,
-		AskAmount:         order.AskAmount,
-		DisableAutoRetire: order.DisableAutoRetire,
-		Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+		Id:                      order.Id,
+		Seller:                  seller.String(),
+		BatchDenom:              batch.Denom,
+		Quantity:                order.Quantity,
+		AskDenom:                market.BankDenom,
+		AskAmount:               order.AskAmount,
+		DisableAutoRetire:       order.DisableAutoRetire,
+		Expiration:              types.ProtobufToGogoTimestamp(order.Expiration),
+		MinFillQuantity:         order.MinFillQuantity,
+		LotSize:                 order.LotSize,
+		AllowedBuyers:           addressStrings(order.AllowedBuyers),
+		AllowedBuyerGroupPolicy: sdk.AccAddress(order.AllowedBuyerGroupPolicy).String(),
+		TimeInForce:             marketplace.TimeInForce(order.TimeInForce),
+		AutoRenew:               autoRenewInfo(order.AutoRenew),
+		Renewals:                order.Renewals,
 	}
 
 	return &marketplace.QuerySellOrderResponse{SellOrder: &info}, nil
@@ -2,8 +2,10 @@ package marketplace
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
@@ -30,6 +32,13 @@ func TestQuery_SellOrder(t *testing.T) {
 		MarketId:   1,
 		AskAmount:  "100",
 		Expiration: timestamppb.New(expiration),
+		AutoRenew: &api.SellOrder_AutoRenew{
+			RenewalPeriod: durationpb.New(time.Hour),
+			PriceDecay:    "0.1",
+			MaxRenewals:   3,
+		},
+		TimeInForce: api.TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE,
+		Renewals:    1,
 	}
 	id1, err := s.marketStore.SellOrderTable().InsertReturningID(s.ctx, &order1)
 	require.NoError(t, err)
@@ -58,6 +67,11 @@ func TestQuery_SellOrder(t *testing.T) {
 	require.Equal(t, order1.Quantity, res1.SellOrder.Quantity)
 	require.Equal(t, ask.Denom, res1.SellOrder.AskDenom)
 	require.Equal(t, order1.AskAmount, res1.SellOrder.AskAmount)
+	require.Equal(t, marketplace.TimeInForce_TIME_IN_FORCE_GOOD_TIL_DATE, res1.SellOrder.TimeInForce)
+	require.Equal(t, int64(time.Hour.Seconds()), res1.SellOrder.AutoRenew.RenewalPeriod.Seconds)
+	require.Equal(t, "0.1", res1.SellOrder.AutoRenew.PriceDecay)
+	require.Equal(t, uint32(3), res1.SellOrder.AutoRenew.MaxRenewals)
+	require.Equal(t, uint32(1), res1.SellOrder.Renewals)
 	require.Equal(t, order1.DisableAutoRetire, res1.SellOrder.DisableAutoRetire)
 	require.Equal(t, types.ProtobufToGogoTimestamp(order1.Expiration), res1.SellOrder.Expiration)
 
@@ -44,14 +44,21 @@ func (k Keeper) SellOrders(ctx context.Context, req *marketplace.QuerySellOrders
 		}
 
 		info := marketplace.SellOrderInfo{
-			Id:                order.Id,
-			Seller:            seller.String(),
-			BatchDenom:        batch.Denom,
-			Quantity:          order.Quantity,
-			AskDenom:          market.BankDenom,
-			AskAmount:         order.AskAmount,
-			DisableAutoRetire: order.DisableAutoRetire,
-			Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+			Id:                      order.Id,
+			Seller:                  seller.String(),
+			BatchDenom:              batch.Denom,
+			Quantity:                order.Quantity,
+			AskDenom:                market.BankDenom,
+			AskAmount:               order.AskAmount,
+			DisableAutoRetire:       order.DisableAutoRetire,
+			Expiration:              types.ProtobufToGogoTimestamp(order.Expiration),
+			MinFillQuantity:         order.MinFillQuantity,
+			LotSize:                 order.LotSize,
+			AllowedBuyers:           addressStrings(order.AllowedBuyers),
+			AllowedBuyerGroupPolicy: sdk.AccAddress(order.AllowedBuyerGroupPolicy).String(),
+			TimeInForce:             marketplace.TimeInForce(order.TimeInForce),
+			AutoRenew:               autoRenewInfo(order.AutoRenew),
+			Renewals:                order.Renewals,
 		}
 
 		orders = append(orders, &info)
@@ -7,6 +7,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 	"gotest.tools/v3/assert"
 
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -47,6 +48,7 @@ func TestSellOrders(t *testing.T) {
 }
 
 func insertSellOrder(t *testing.T, s *baseSuite, addr sdk.AccAddress, batchKey uint64) *api.SellOrder {
+	_, _, buyer := testdata.KeyTestPubAddr()
 	sellOrder := &api.SellOrder{
 		Seller:            addr,
 		BatchKey:          batchKey,
@@ -56,6 +58,9 @@ func insertSellOrder(t *testing.T, s *baseSuite, addr sdk.AccAddress, batchKey u
 		DisableAutoRetire: false,
 		Expiration:        timestamppb.Now(),
 		Maker:             false,
+		MinFillQuantity:   "2",
+		LotSize:           "5",
+		AllowedBuyers:     [][]byte{buyer},
 	}
 	assert.NilError(t, s.marketStore.SellOrderTable().Insert(s.ctx, sellOrder))
 
@@ -72,14 +77,18 @@ func assertOrderEqual(t *testing.T, ctx context.Context, k Keeper, received *mar
 	assert.NilError(t, err)
 
 	info := marketplace.SellOrderInfo{
-		Id:                order.Id,
-		Seller:            seller.String(),
-		BatchDenom:        batch.Denom,
-		Quantity:          order.Quantity,
-		AskDenom:          market.BankDenom,
-		AskAmount:         order.AskAmount,
-		DisableAutoRetire: order.DisableAutoRetire,
-		Expiration:        types.ProtobufToGogoTimestamp(order.Expiration),
+		Id:                      order.Id,
+		Seller:                  seller.String(),
+		BatchDenom:              batch.Denom,
+		Quantity:                order.Quantity,
+		AskDenom:                market.BankDenom,
+		AskAmount:               order.AskAmount,
+		DisableAutoRetire:       order.DisableAutoRetire,
+		Expiration:              types.ProtobufToGogoTimestamp(order.Expiration),
+		MinFillQuantity:         order.MinFillQuantity,
+		LotSize:                 order.LotSize,
+		AllowedBuyers:           addressStrings(order.AllowedBuyers),
+		AllowedBuyerGroupPolicy: sdk.AccAddress(order.AllowedBuyerGroupPolicy).String(),
 	}
 
 	assert.DeepEqual(t, info, *received)
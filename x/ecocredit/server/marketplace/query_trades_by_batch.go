@@ -0,0 +1,77 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesByBatch queries all trades of the credits of a credit batch with optional pagination
+func (k Keeper) TradesByBatch(ctx context.Context, req *marketplace.QueryTradesByBatchRequest) (*marketplace.QueryTradesByBatchResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, req.BatchDenom)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", req.BatchDenom, err.Error())
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeBatchKeyIndexKey{}.WithBatchKey(batch.Key), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades, err := k.getTradeInfos(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesByBatchResponse{Trades: trades, Pagination: pr}, nil
+}
+
+// getTradeInfos returns the human-readable information of the trades of the iterator.
+func (k Keeper) getTradeInfos(ctx context.Context, it api.TradeIterator) ([]*marketplace.TradeInfo, error) {
+	trades := make([]*marketplace.TradeInfo, 0, 10)
+	for it.Next() {
+		trade, err := it.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		batch, err := k.coreStore.BatchTable().Get(ctx, trade.BatchKey)
+		if err != nil {
+			return nil, err
+		}
+
+		trades = append(trades, &marketplace.TradeInfo{
+			Id:          trade.Id,
+			SellOrderId: trade.SellOrderId,
+			AuctionId:   trade.AuctionId,
+			Buyer:       sdk.AccAddress(trade.Buyer).String(),
+			Seller:      sdk.AccAddress(trade.Seller).String(),
+			BatchDenom:  batch.Denom,
+			Quantity:    trade.Quantity,
+			Price:       trade.Price,
+			Denom:       trade.Denom,
+			Retired:     trade.Retired,
+			Timestamp:   types.ProtobufToGogoTimestamp(trade.Timestamp),
+		})
+	}
+
+	return trades, nil
+}
@@ -0,0 +1,43 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesBySeller queries all trades in which the given address sold credits with optional pagination
+func (k Keeper) TradesBySeller(ctx context.Context, req *marketplace.QueryTradesBySellerRequest) (*marketplace.QueryTradesBySellerResponse, error) {
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	seller, err := sdk.AccAddressFromBech32(req.Seller)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := k.stateStore.TradeTable().List(ctx, api.TradeSellerIndexKey{}.WithSeller(seller), ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades, err := k.getTradeInfos(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesBySellerResponse{Trades: trades, Pagination: pr}, nil
+}
@@ -0,0 +1,49 @@
+package marketplace
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/orm/model/ormlist"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/ormutil"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// TradesByTimeRange queries all trades completed within the inclusive time range with optional pagination
+func (k Keeper) TradesByTimeRange(ctx context.Context, req *marketplace.QueryTradesByTimeRangeRequest) (*marketplace.QueryTradesByTimeRangeResponse, error) {
+	if req.StartTime == nil || req.EndTime == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time and end time are required")
+	}
+
+	if req.EndTime.Compare(req.StartTime) < 0 {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time %s is before start time %s", req.EndTime, req.StartTime)
+	}
+
+	pg, err := ormutil.GogoPageReqToPulsarPageReq(req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	fromKey := api.TradeTimestampIndexKey{}.WithTimestamp(types.GogoToProtobufTimestamp(req.StartTime))
+	toKey := api.TradeTimestampIndexKey{}.WithTimestamp(types.GogoToProtobufTimestamp(req.EndTime))
+	it, err := k.stateStore.TradeTable().ListRange(ctx, fromKey, toKey, ormlist.Paginate(pg))
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	trades, err := k.getTradeInfos(ctx, it)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := ormutil.PulsarPageResToGogoPageRes(it.PageResponse())
+	if err != nil {
+		return nil, err
+	}
+
+	return &marketplace.QueryTradesByTimeRangeResponse{Trades: trades, Pagination: pr}, nil
+}
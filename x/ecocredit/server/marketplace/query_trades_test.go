@@ -0,0 +1,110 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryTrades(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 3)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], classId, start, end, creditType)
+	alice, bob, charlie := s.addrs[0], s.addrs[1], s.addrs[2]
+
+	otherDenom := "C01-001-20210101-20220101-002"
+	assert.NilError(t, s.coreStore.BatchTable().Insert(s.ctx, &ecoApi.Batch{ProjectKey: 1, Denom: otherDenom}))
+
+	day1 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	day2 := day1.Add(24 * time.Hour)
+	day3 := day2.Add(24 * time.Hour)
+
+	insertTrade(t, s, alice, bob, 1, "10", day1)
+	insertTrade(t, s, alice, charlie, 2, "20", day2)
+	insertTrade(t, s, bob, charlie, 1, "30", day3)
+
+	byBatch, err := s.k.TradesByBatch(s.ctx, &marketplace.QueryTradesByBatchRequest{
+		BatchDenom: batchDenom,
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byBatch.Trades))
+	assert.Equal(t, uint64(2), byBatch.Pagination.Total)
+	assert.DeepEqual(t, byBatch.Trades[0], &marketplace.TradeInfo{
+		Id:          1,
+		SellOrderId: 1,
+		Buyer:       bob.String(),
+		Seller:      alice.String(),
+		BatchDenom:  batchDenom,
+		Quantity:    "1",
+		Price:       "10",
+		Denom:       ask.Denom,
+		Retired:     true,
+		Timestamp:   &gogotypes.Timestamp{Seconds: day1.Unix()},
+	})
+	assert.Equal(t, uint64(3), byBatch.Trades[1].Id)
+
+	byBuyer, err := s.k.TradesByBuyer(s.ctx, &marketplace.QueryTradesByBuyerRequest{Buyer: charlie.String()})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byBuyer.Trades))
+	assert.Equal(t, uint64(2), byBuyer.Trades[0].Id)
+	assert.Equal(t, otherDenom, byBuyer.Trades[0].BatchDenom)
+	assert.Equal(t, uint64(3), byBuyer.Trades[1].Id)
+
+	bySeller, err := s.k.TradesBySeller(s.ctx, &marketplace.QueryTradesBySellerRequest{
+		Seller:     alice.String(),
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(bySeller.Trades))
+	assert.Equal(t, uint64(1), bySeller.Trades[0].Id)
+	assert.Equal(t, uint64(2), bySeller.Pagination.Total)
+
+	// the time range is inclusive of the start time and the end time
+	byTimeRange, err := s.k.TradesByTimeRange(s.ctx, &marketplace.QueryTradesByTimeRangeRequest{
+		StartTime: &gogotypes.Timestamp{Seconds: day2.Unix()},
+		EndTime:   &gogotypes.Timestamp{Seconds: day3.Unix()},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(byTimeRange.Trades))
+	assert.Equal(t, uint64(2), byTimeRange.Trades[0].Id)
+	assert.Equal(t, uint64(3), byTimeRange.Trades[1].Id)
+
+	_, err = s.k.TradesByTimeRange(s.ctx, &marketplace.QueryTradesByTimeRangeRequest{
+		StartTime: &gogotypes.Timestamp{Seconds: day3.Unix()},
+		EndTime:   &gogotypes.Timestamp{Seconds: day1.Unix()},
+	})
+	assert.ErrorContains(t, err, "is before start time")
+
+	_, err = s.k.TradesByTimeRange(s.ctx, &marketplace.QueryTradesByTimeRangeRequest{})
+	assert.ErrorContains(t, err, "start time and end time are required")
+
+	_, err = s.k.TradesByBatch(s.ctx, &marketplace.QueryTradesByBatchRequest{BatchDenom: "C02-001-20200101-20210101-001"})
+	assert.ErrorContains(t, err, "could not get batch with denom C02-001-20200101-20210101-001")
+
+	_, err = s.k.TradesByBuyer(s.ctx, &marketplace.QueryTradesByBuyerRequest{Buyer: "foobar"})
+	assert.ErrorContains(t, err, "decoding bech32 failed")
+}
+
+func insertTrade(t *testing.T, s *baseSuite, seller, buyer []byte, batchKey uint64, price string, timestamp time.Time) {
+	assert.NilError(t, s.marketStore.TradeTable().Insert(s.ctx, &api.Trade{
+		SellOrderId: 1,
+		Buyer:       buyer,
+		Seller:      seller,
+		BatchKey:    batchKey,
+		Quantity:    "1",
+		Price:       price,
+		Denom:       ask.Denom,
+		Retired:     true,
+		Timestamp:   timestamppb.New(timestamp),
+	}))
+}
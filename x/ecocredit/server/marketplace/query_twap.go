@@ -0,0 +1,119 @@
+package marketplace
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+// Twap queries the time-weighted average price of a market, or of a credit batch in a
+// market, within a time range.
+func (k Keeper) Twap(ctx context.Context, req *marketplace.QueryTwapRequest) (*marketplace.QueryTwapResponse, error) {
+	if req.StartTime == nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrap("start time is required")
+	}
+
+	market, batchKey, err := k.getPriceSeries(ctx, req.MarketId, req.BatchDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	startTime := types.GogoToProtobufTimestamp(req.StartTime).AsTime()
+	endTime := blockTime
+	if req.EndTime != nil {
+		if t := types.GogoToProtobufTimestamp(req.EndTime).AsTime(); t.Before(blockTime) {
+			endTime = t
+		}
+	}
+
+	if endTime.Before(startTime) {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("end time %s is before start time %s", endTime, startTime)
+	}
+
+	first, err := k.getFirstObservation(ctx, market.Id, batchKey)
+	if err != nil {
+		return nil, err
+	}
+	if first == nil || first.Timestamp.AsTime().After(endTime) {
+		return nil, sdkerrors.ErrNotFound.Wrapf("no price observations at or before end time %s", endTime)
+	}
+	if firstTime := first.Timestamp.AsTime(); startTime.Before(firstTime) {
+		startTime = firstTime
+	}
+
+	endObs, err := k.getLastObservation(ctx, market.Id, batchKey, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var twap math.Dec
+	elapsed := endTime.Unix() - startTime.Unix()
+	if elapsed == 0 {
+		// the time-weighted average price of an instant is the price in effect
+		if twap, err = averagePrice(endObs); err != nil {
+			return nil, err
+		}
+	} else {
+		startObs, err := k.getLastObservation(ctx, market.Id, batchKey, startTime)
+		if err != nil {
+			return nil, err
+		}
+		startPrice, startVolume, err := cumulativeAt(startObs, startTime)
+		if err != nil {
+			return nil, err
+		}
+		endPrice, endVolume, err := cumulativeAt(endObs, endTime)
+		if err != nil {
+			return nil, err
+		}
+
+		// the price in effect at each second is weighted by the volume of the trades
+		// at that price so that trades of small quantities cannot set the average
+		total, err := endPrice.Sub(startPrice)
+		if err != nil {
+			return nil, err
+		}
+		weight, err := endVolume.Sub(startVolume)
+		if err != nil {
+			return nil, err
+		}
+		if twap, err = total.Quo(weight); err != nil {
+			return nil, err
+		}
+	}
+
+	return &marketplace.QueryTwapResponse{
+		Twap:      twap.String(),
+		Denom:     market.BankDenom,
+		StartTime: types.ProtobufToGogoTimestamp(timestamppb.New(startTime)),
+		EndTime:   types.ProtobufToGogoTimestamp(timestamppb.New(endTime)),
+	}, nil
+}
+
+// getPriceSeries returns the market and the batch key of the price observations of a
+// market, or of a credit batch in a market if the batch denom is not empty.
+func (k Keeper) getPriceSeries(ctx context.Context, marketId uint64, batchDenom string) (*api.Market, uint64, error) {
+	market, err := k.stateStore.MarketTable().Get(ctx, marketId)
+	if err != nil {
+		return nil, 0, sdkerrors.ErrNotFound.Wrapf("market with id %d: %s", marketId, err.Error())
+	}
+
+	if batchDenom == "" {
+		return market, 0, nil
+	}
+
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, batchDenom)
+	if err != nil {
+		return nil, 0, sdkerrors.ErrNotFound.Wrapf("batch denom %s: %s", batchDenom, err.Error())
+	}
+
+	return market, batch.Key, nil
+}
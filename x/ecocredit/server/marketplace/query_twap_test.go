@@ -0,0 +1,134 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	gogotypes "github.com/gogo/protobuf/types"
+	"gotest.tools/v3/assert"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestQueryTwap(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	s.priceHistorySetup()
+	batchDenom := "C01-001-20200101-20210101-001"
+
+	// the end time defaults to the block time
+	res, err := s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "11.63636363636363636363636363636364", res.Twap)
+	assert.Equal(t, "regen", res.Denom)
+	assert.DeepEqual(t, gogoTime(t, priceStart), res.StartTime)
+	assert.DeepEqual(t, gogoTime(t, priceStart.Add(400*time.Second)), res.EndTime)
+
+	// the time range is within the observations
+	res, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart.Add(50*time.Second)),
+		EndTime:    gogoTime(t, priceStart.Add(200*time.Second)),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "10.8", res.Twap)
+
+	// the start time is clamped to the first observation and the end time to the block time
+	res, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart.Add(-time.Hour)),
+		EndTime:    gogoTime(t, priceStart.Add(time.Hour)),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "11.63636363636363636363636363636364", res.Twap)
+	assert.DeepEqual(t, gogoTime(t, priceStart), res.StartTime)
+	assert.DeepEqual(t, gogoTime(t, priceStart.Add(400*time.Second)), res.EndTime)
+
+	// the price of an instant is the price in effect
+	instant := gogoTime(t, priceStart.Add(150*time.Second))
+	res, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  instant,
+		EndTime:    instant,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "8", res.Twap)
+
+	// the price of the market includes the trades of all credit batches
+	res, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "11.73333333333333333333333333333333", res.Twap)
+
+	// no observations before the end time
+	_, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: batchDenom,
+		StartTime:  gogoTime(t, priceStart.Add(-time.Hour)),
+		EndTime:    gogoTime(t, priceStart.Add(-time.Second)),
+	})
+	assert.ErrorContains(t, err, "no price observations at or before end time")
+
+	// end time before start time
+	_, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart.Add(200*time.Second)),
+		EndTime:   gogoTime(t, priceStart.Add(100*time.Second)),
+	})
+	assert.ErrorContains(t, err, "is before start time")
+
+	// start time is required
+	_, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{MarketId: 1})
+	assert.ErrorContains(t, err, "start time is required")
+
+	// market does not exist
+	_, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{MarketId: 2, StartTime: gogoTime(t, priceStart)})
+	assert.ErrorContains(t, err, "market with id 2")
+
+	// batch does not exist
+	_, err = s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:   1,
+		BatchDenom: "C01-001-20200101-20210101-002",
+		StartTime:  gogoTime(t, priceStart),
+	})
+	assert.ErrorContains(t, err, "batch denom C01-001-20200101-20210101-002")
+}
+
+func TestQueryTwap_SmallQuantity(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 1)
+	batchKey := s.quoteSetup()
+	assert.NilError(t, s.marketStore.MarketTable().Insert(s.ctx, &api.Market{
+		CreditTypeAbbrev: "C",
+		BankDenom:        "regen",
+	}))
+
+	// a trade of a small quantity at a much higher price does not set the price of the
+	// following interval
+	s.recordPriceAt(priceStart, batchKey, 10, "100")
+	s.recordPriceAt(priceStart.Add(100*time.Second), batchKey, 1000, "0.000001")
+	s.setBlockTime(priceStart.Add(200 * time.Second))
+
+	res, err := s.k.Twap(s.ctx, &marketplace.QueryTwapRequest{
+		MarketId:  1,
+		StartTime: gogoTime(t, priceStart),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "10.00000989999990100000098999999010", res.Twap)
+}
+
+func gogoTime(t *testing.T, tm time.Time) *gogotypes.Timestamp {
+	ts, err := gogotypes.TimestampProto(tm)
+	assert.NilError(t, err)
+	return ts
+}
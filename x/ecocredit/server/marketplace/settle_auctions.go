@@ -0,0 +1,302 @@
+package marketplace
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
+)
+
+// SettleAuctions is a BeginBlock function that settles and deletes auctions that have ended.
+// The credits of an auction with a winning bid are transferred to the tradable balance of the
+// winning bidder and the escrowed funds of the bid are paid to the seller less the fees of the
+// market, and the trade is recorded like the fill of a sell order. Otherwise, the credits are
+// returned to the seller along with the escrowed funds of a bid of an english auction that did
+// not meet the reserve price.
+func (k Keeper) SettleAuctions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	blockTime := timestamppb.New(sdkCtx.BlockTime())
+	fromKey, toKey := api.AuctionEndTimeIndexKey{}, api.AuctionEndTimeIndexKey{}.WithEndTime(blockTime)
+
+	it, err := k.stateStore.AuctionTable().ListRange(ctx, fromKey, toKey)
+	if err != nil {
+		return err
+	}
+
+	var ended []*api.Auction
+	for it.Next() {
+		auction, err := it.Value()
+		if err != nil {
+			it.Close()
+			return err
+		}
+		ended = append(ended, auction)
+	}
+	it.Close()
+
+	for _, auction := range ended {
+		if err = k.settleAuction(ctx, auction); err != nil {
+			return err
+		}
+	}
+
+	return k.stateStore.AuctionTable().DeleteRange(ctx, fromKey, toKey)
+}
+
+// settleAuction transfers the credits of the auction to the winning bidder and pays the seller,
+// or returns the credits to the seller and the escrowed funds to the bidder if the auction has
+// no winning bid.
+func (k Keeper) settleAuction(ctx context.Context, auction *api.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	won := len(auction.Bidder) != 0
+	if won && auction.AuctionType == api.AuctionType_AUCTION_TYPE_ENGLISH {
+		bidPrice, err := parseInt(auction.BidPrice)
+		if err != nil {
+			return err
+		}
+		reservePrice, err := parseInt(auction.ReservePrice)
+		if err != nil {
+			return err
+		}
+		if bidPrice.LT(reservePrice) {
+			if err = k.refundBid(ctx, auction); err != nil {
+				return err
+			}
+			won = false
+		}
+	}
+
+	if !won {
+		if err := k.unescrowCredits(ctx, auction.Seller, auction.BatchKey, auction.Quantity); err != nil {
+			return err
+		}
+		return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSettleAuction{
+			AuctionId: auction.Id,
+		})
+	}
+
+	if err := k.transferEscrowedCredits(ctx, auction); err != nil {
+		return err
+	}
+
+	if err := k.payAuction(ctx, auction); err != nil {
+		return err
+	}
+
+	bidPrice, err := parseInt(auction.BidPrice)
+	if err != nil {
+		return err
+	}
+
+	return sdkCtx.EventManager().EmitTypedEvent(&marketplace.EventSettleAuction{
+		AuctionId: auction.Id,
+		Winner:    sdk.AccAddress(auction.Bidder).String(),
+		Price:     &sdk.Coin{Denom: auction.Denom, Amount: bidPrice},
+	})
+}
+
+// payAuction pays the escrowed funds of the winning bid of the auction to the seller less the
+// seller fee of the market, distributes the buyer fee held in escrow with the bid and the
+// seller fee to the fee destination of the market, and records the trade and the price.
+func (k Keeper) payAuction(ctx context.Context, auction *api.Auction) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	market, err := k.stateStore.MarketTable().Get(ctx, auction.MarketId)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return err
+	}
+	bidPrice, err := parseInt(auction.BidPrice)
+	if err != nil {
+		return err
+	}
+	cost, err := getAuctionCost(auction, bidPrice)
+	if err != nil {
+		return err
+	}
+	buyerFee, err := getFee(cost.Amount, auction.BuyerFee)
+	if err != nil {
+		return err
+	}
+	sellerFee, err := getFee(cost.Amount, market.SellerFee)
+	if err != nil {
+		return err
+	}
+
+	proceeds := sdk.NewCoin(cost.Denom, cost.Amount.Sub(sellerFee))
+	if err = k.bankKeeper.SendCoinsFromModuleToAccount(sdkCtx, ecocredit.ModuleName, auction.Seller, sdk.NewCoins(proceeds)); err != nil {
+		return err
+	}
+
+	if fee := buyerFee.Add(sellerFee); fee.IsPositive() {
+		if err = k.distributeFee(ctx, market, auction.BatchKey, auction.Bidder, true, sdk.NewCoin(cost.Denom, fee)); err != nil {
+			return err
+		}
+	}
+
+	if err = k.stateStore.TradeTable().Insert(ctx, &api.Trade{
+		AuctionId: auction.Id,
+		Buyer:     auction.Bidder,
+		Seller:    auction.Seller,
+		BatchKey:  auction.BatchKey,
+		Quantity:  quantity.String(),
+		Price:     bidPrice.String(),
+		Denom:     cost.Denom,
+		Timestamp: timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return err
+	}
+
+	return k.recordPrice(ctx, auction.MarketId, auction.BatchKey, bidPrice, quantity)
+}
+
+// transferEscrowedCredits moves the credits of the auction from the escrowed balance of the
+// seller to the tradable balance of the winning bidder.
+func (k Keeper) transferEscrowedCredits(ctx context.Context, auction *api.Auction) error {
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return err
+	}
+
+	sellerBal, err := k.coreStore.BatchBalanceTable().Get(ctx, auction.Seller, auction.BatchKey)
+	if err != nil {
+		return err
+	}
+	escrowed, err := math.NewDecFromString(sellerBal.EscrowedAmount)
+	if err != nil {
+		return err
+	}
+	escrowed, err = math.SafeSubBalance(escrowed, quantity)
+	if err != nil {
+		return err
+	}
+	sellerBal.EscrowedAmount = escrowed.String()
+	if err = k.coreStore.BatchBalanceTable().Update(ctx, sellerBal); err != nil {
+		return err
+	}
+
+	bidderBal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), auction.Bidder, auction.BatchKey)
+	if err != nil {
+		return err
+	}
+	tradable, err := math.NewDecFromString(bidderBal.TradableAmount)
+	if err != nil {
+		return err
+	}
+	tradable, err = math.SafeAddBalance(tradable, quantity)
+	if err != nil {
+		return err
+	}
+	bidderBal.TradableAmount = tradable.String()
+	if err = k.coreStore.BatchBalanceTable().Save(ctx, bidderBal); err != nil {
+		return err
+	}
+
+	batch, err := k.coreStore.BatchTable().Get(ctx, auction.BatchKey)
+	if err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventTransfer{
+		Sender:         sdk.AccAddress(auction.Seller).String(),
+		Recipient:      sdk.AccAddress(auction.Bidder).String(),
+		BatchDenom:     batch.Denom,
+		TradableAmount: quantity.String(),
+		RetiredAmount:  "0",
+	})
+}
+
+// refundBid returns the escrowed funds of the highest bid of the auction, including the
+// buyer fee, to the bidder.
+func (k Keeper) refundBid(ctx context.Context, auction *api.Auction) error {
+	bidPrice, err := parseInt(auction.BidPrice)
+	if err != nil {
+		return err
+	}
+
+	cost, err := getAuctionCost(auction, bidPrice)
+	if err != nil {
+		return err
+	}
+
+	buyerFee, err := getFee(cost.Amount, auction.BuyerFee)
+	if err != nil {
+		return err
+	}
+
+	refund := sdk.NewCoin(cost.Denom, cost.Amount.Add(buyerFee))
+	return k.bankKeeper.SendCoinsFromModuleToAccount(
+		sdk.UnwrapSDKContext(ctx), ecocredit.ModuleName, auction.Bidder, sdk.NewCoins(refund),
+	)
+}
+
+// getAuctionCost returns the total cost of the credits of the auction at the price.
+func getAuctionCost(auction *api.Auction, price sdk.Int) (sdk.Coin, error) {
+	quantity, err := math.NewDecFromString(auction.Quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	cost, err := getTotalCost(price, quantity)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.Coin{Denom: auction.Denom, Amount: cost}, nil
+}
+
+// dutchAuctionPrice returns the price of a dutch auction at the time, i.e. the start price
+// less the price decrement for each decay interval elapsed since the start of the auction,
+// but no less than the reserve price.
+func dutchAuctionPrice(auction *api.Auction, t time.Time) (sdk.Int, error) {
+	startPrice, err := parseInt(auction.StartPrice)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	reservePrice, err := parseInt(auction.ReservePrice)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	decrement, err := parseInt(auction.PriceDecrement)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	elapsed := t.Sub(auction.StartTime.AsTime())
+	if elapsed <= 0 {
+		return startPrice, nil
+	}
+
+	intervals := int64(elapsed / auction.DecayInterval.AsDuration())
+	price := startPrice.Sub(decrement.MulRaw(intervals))
+	if price.LT(reservePrice) {
+		return reservePrice, nil
+	}
+
+	return price, nil
+}
+
+// parseInt parses an integer amount encoded as a string.
+func parseInt(amount string) (sdk.Int, error) {
+	i, ok := sdk.NewIntFromString(amount)
+	if !ok {
+		return sdk.Int{}, sdkerrors.ErrInvalidType.Wrapf("could not convert %s to %T", amount, sdk.Int{})
+	}
+	return i, nil
+}
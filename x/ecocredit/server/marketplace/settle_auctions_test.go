@@ -0,0 +1,183 @@
+package marketplace
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/regen-network/regen-ledger/x/ecocredit"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+)
+
+func TestSettleAuctions(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder := s.addrs[0], s.addrs[1]
+
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.sdkCtx = s.sdkCtx.WithBlockTime(blockTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+
+	endTime, laterEndTime := blockTime.AddDate(0, 0, 10), blockTime.AddDate(0, 2, 0)
+	decayInterval := 24 * time.Hour
+	price := func(amount int64) *sdk.Coin {
+		coin := sdk.NewInt64Coin(ask.Denom, amount)
+		return &coin
+	}
+	createAuction := func(auctionType marketplace.AuctionType, reservePrice int64, endTime time.Time) uint64 {
+		msg := &marketplace.MsgCreateAuction{
+			Seller:       seller.String(),
+			BatchDenom:   batchDenom,
+			Quantity:     "10",
+			AuctionType:  auctionType,
+			StartPrice:   price(100),
+			ReservePrice: price(reservePrice),
+			EndTime:      &endTime,
+		}
+		if auctionType == marketplace.AuctionType_AUCTION_TYPE_DUTCH {
+			msg.PriceDecrement = price(10)
+			msg.DecayInterval = &decayInterval
+		}
+		res, err := s.k.CreateAuction(s.ctx, msg)
+		assert.NilError(t, err)
+		return res.AuctionId
+	}
+	bid := func(auctionId uint64, amount int64) {
+		_, err := s.k.Bid(s.ctx, &marketplace.MsgBid{
+			Bidder:    bidder.String(),
+			AuctionId: auctionId,
+			BidPrice:  price(amount),
+		})
+		assert.NilError(t, err)
+	}
+
+	s.bankKeeper.EXPECT().SendCoinsFromAccountToModule(gmAny, bidder, ecocredit.ModuleName, gmAny).Return(nil).Times(3)
+
+	// a dutch auction won at the start price
+	dutchWon := createAuction(marketplace.AuctionType_AUCTION_TYPE_DUTCH, 50, laterEndTime)
+	bid(dutchWon, 100)
+
+	// an english auction with a highest bid greater than the reserve price
+	englishWon := createAuction(marketplace.AuctionType_AUCTION_TYPE_ENGLISH, 120, endTime)
+	bid(englishWon, 150)
+
+	// an english auction with a highest bid less than the reserve price
+	englishReserveNotMet := createAuction(marketplace.AuctionType_AUCTION_TYPE_ENGLISH, 120, endTime)
+	bid(englishReserveNotMet, 110)
+
+	// an english auction without bids
+	englishNoBids := createAuction(marketplace.AuctionType_AUCTION_TYPE_ENGLISH, 120, endTime)
+
+	// an english auction that has not ended
+	englishNotEnded := createAuction(marketplace.AuctionType_AUCTION_TYPE_ENGLISH, 120, laterEndTime)
+
+	// the winning bids are paid to the seller and the bid that did not meet the reserve price is refunded
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(*price(1000))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(*price(1500))).Return(nil).Times(1)
+	s.bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, bidder, sdk.NewCoins(*price(1100))).Return(nil).Times(1)
+
+	s.sdkCtx = s.sdkCtx.WithBlockTime(endTime)
+	s.ctx = sdk.WrapSDKContext(s.sdkCtx)
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	for _, id := range []uint64{dutchWon, englishWon, englishReserveNotMet, englishNoBids} {
+		_, err := s.marketStore.AuctionTable().Get(s.ctx, id)
+		assert.ErrorContains(t, err, ormerrors.NotFound.Error())
+	}
+	_, err := s.marketStore.AuctionTable().Get(s.ctx, englishNotEnded)
+	assert.NilError(t, err)
+
+	sellerBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, seller, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "70", sellerBal.TradableAmount)
+	assert.Equal(t, "10", sellerBal.EscrowedAmount)
+
+	bidderBal, err := s.coreStore.BatchBalanceTable().Get(s.ctx, bidder, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, "20", bidderBal.TradableAmount)
+}
+
+func TestSettleAuctions_FeesTradeAndPrice(t *testing.T) {
+	t.Parallel()
+	s := setupBase(t, 2)
+	s.testSellSetup(batchDenom, ask.Denom, ask.Denom[1:], "C01", start, end, creditType)
+	seller, bidder := s.addrs[0], s.addrs[1]
+
+	blockTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	s.setBlockTime(blockTime)
+
+	endTime := blockTime.AddDate(0, 0, 10)
+	startPrice, reservePrice := sdk.NewInt64Coin(ask.Denom, 100), sdk.NewInt64Coin(ask.Denom, 100)
+	res, err := s.k.CreateAuction(s.ctx, &marketplace.MsgCreateAuction{
+		Seller:       seller.String(),
+		BatchDenom:   batchDenom,
+		Quantity:     "10",
+		AuctionType:  marketplace.AuctionType_AUCTION_TYPE_ENGLISH,
+		StartPrice:   &startPrice,
+		ReservePrice: &reservePrice,
+		EndTime:      &endTime,
+	})
+	assert.NilError(t, err)
+
+	auction, err := s.marketStore.AuctionTable().Get(s.ctx, res.AuctionId)
+	assert.NilError(t, err)
+	market, err := s.marketStore.MarketTable().Get(s.ctx, auction.MarketId)
+	assert.NilError(t, err)
+	market.BuyerFee = "0.1"
+	market.SellerFee = "0.05"
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	// the buyer fee is escrowed with the total cost of the bid
+	s.bankKeeper.EXPECT().
+		SendCoinsFromAccountToModule(gmAny, bidder, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 1100))).
+		Return(nil).
+		Times(1)
+
+	bidPrice := sdk.NewInt64Coin(ask.Denom, 100)
+	_, err = s.k.Bid(s.ctx, &marketplace.MsgBid{
+		Bidder:    bidder.String(),
+		AuctionId: res.AuctionId,
+		BidPrice:  &bidPrice,
+	})
+	assert.NilError(t, err)
+
+	// the seller fee is increased after the bid and applies when the auction is settled
+	market.SellerFee = "0.1"
+	assert.NilError(t, s.marketStore.MarketTable().Update(s.ctx, market))
+
+	// the seller is paid the total cost less the seller fee and the buyer fee and the
+	// seller fee are burned
+	s.bankKeeper.EXPECT().
+		SendCoinsFromModuleToAccount(gmAny, ecocredit.ModuleName, seller, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 900))).
+		Return(nil).
+		Times(1)
+	s.bankKeeper.EXPECT().
+		BurnCoins(gmAny, ecocredit.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(ask.Denom, 200))).
+		Return(nil).
+		Times(1)
+
+	s.setBlockTime(endTime)
+	assert.NilError(t, s.k.SettleAuctions(s.ctx))
+
+	// the trade is recorded with the auction
+	trade, err := s.marketStore.TradeTable().Get(s.ctx, 1)
+	assert.NilError(t, err)
+	assert.Equal(t, res.AuctionId, trade.AuctionId)
+	assert.Equal(t, uint64(0), trade.SellOrderId)
+	assert.DeepEqual(t, []byte(bidder), trade.Buyer)
+	assert.DeepEqual(t, []byte(seller), trade.Seller)
+	assert.Equal(t, "10", trade.Quantity)
+	assert.Equal(t, "100", trade.Price)
+	assert.Equal(t, ask.Denom, trade.Denom)
+
+	// the price is observed in the market
+	obs, err := s.marketStore.PriceObservationTable().Get(s.ctx, market.Id, auction.BatchKey, toTimestamp(&endTime))
+	assert.NilError(t, err)
+	assert.Equal(t, "100", obs.Close)
+	assert.Equal(t, "10", obs.Volume)
+}
@@ -3,32 +3,172 @@ package marketplace
 import (
 	"context"
 
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/cosmos/cosmos-sdk/orm/types/ormerrors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
 	api "github.com/regen-network/regen-ledger/api/regen/ecocredit/marketplace/v1"
+	ecoApi "github.com/regen-network/regen-ledger/api/regen/ecocredit/v1"
+	"github.com/regen-network/regen-ledger/types"
 	"github.com/regen-network/regen-ledger/types/math"
+	"github.com/regen-network/regen-ledger/x/ecocredit"
 	"github.com/regen-network/regen-ledger/x/ecocredit/core"
+	"github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
+	coreserver "github.com/regen-network/regen-ledger/x/ecocredit/server/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
-// isDenomAllowed checks if the denom is allowed to be used in orders.
-func isDenomAllowed(ctx context.Context, bankDenom string, table api.AllowedDenomTable) (bool, error) {
-	return table.Has(ctx, bankDenom)
+// isDenomAllowed checks if the bank denom is allowed to be used in orders, i.e. if it
+// is an allowed denom.
+func (k Keeper) isDenomAllowed(ctx context.Context, bankDenom string) (bool, error) {
+	return k.stateStore.AllowedDenomTable().Has(ctx, bankDenom)
+}
+
+// isAskDenomAllowed checks if the denom is allowed to be used in the ask price of sell
+// orders, i.e. if it is an allowed denom, the denom of a basket token or the denom of a
+// credit batch.
+func (k Keeper) isAskDenomAllowed(ctx context.Context, denom string) (bool, error) {
+	allowed, err := k.isDenomAllowed(ctx, denom)
+	if err != nil || allowed {
+		return allowed, err
+	}
+	if allowed, err = k.basketStore.BasketTable().HasByBasketDenom(ctx, denom); err != nil || allowed {
+		return allowed, err
+	}
+	return k.coreStore.BatchTable().HasByDenom(ctx, denom)
+}
+
+// getAskBatch returns the credit batch with the denom of the ask price of a market or nil
+// if the denom of the ask price is a bank denom.
+func (k Keeper) getAskBatch(ctx context.Context, denom string) (*ecoApi.Batch, error) {
+	batch, err := k.coreStore.BatchTable().GetByDenom(ctx, denom)
+	if err != nil {
+		if ormerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return batch, nil
+}
+
+// isAllowedBuyer checks if the buyer is allowed to buy credits from the sell order, i.e. if
+// the sell order is not private, the buyer is one of the allowed buyers of the sell order or
+// the buyer is a member of the group of the allowed buyer group policy of the sell order.
+func (k Keeper) isAllowedBuyer(ctx context.Context, sellOrder *api.SellOrder, buyer sdk.AccAddress) (bool, error) {
+	if !orderbook.IsPrivate(sellOrder) {
+		return true, nil
+	}
+
+	for _, allowedBuyer := range sellOrder.AllowedBuyers {
+		if buyer.Equals(sdk.AccAddress(allowedBuyer)) {
+			return true, nil
+		}
+	}
+
+	if len(sellOrder.AllowedBuyerGroupPolicy) == 0 {
+		return false, nil
+	}
+
+	return k.isGroupPolicyMember(ctx, sdk.AccAddress(sellOrder.AllowedBuyerGroupPolicy), buyer)
+}
+
+// isGroupPolicyMember checks if the account is a member of the group of the group policy.
+func (k Keeper) isGroupPolicyMember(ctx context.Context, groupPolicy, account sdk.AccAddress) (bool, error) {
+	policyRes, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{
+		Address: groupPolicy.String(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var pageKey []byte
+	for {
+		membersRes, err := k.groupKeeper.GroupMembers(ctx, &group.QueryGroupMembersRequest{
+			GroupId:    policyRes.Info.GroupId,
+			Pagination: &query.PageRequest{Key: pageKey},
+		})
+		if err != nil {
+			return false, err
+		}
+
+		for _, member := range membersRes.Members {
+			if member.Member.Address == account.String() {
+				return true, nil
+			}
+		}
+
+		if membersRes.Pagination == nil || len(membersRes.Pagination.NextKey) == 0 {
+			return false, nil
+		}
+		pageKey = membersRes.Pagination.NextKey
+	}
+}
+
+// addressStrings converts a list of addresses stored as bytes to bech32 strings.
+func addressStrings(addrs [][]byte) []string {
+	var strs []string
+	for _, addr := range addrs {
+		strs = append(strs, sdk.AccAddress(addr).String())
+	}
+	return strs
+}
+
+// autoRenewInfo converts the auto-renewal of a sell order in state to the auto-renewal of
+// the sell order info returned by queries.
+func autoRenewInfo(autoRenew *api.SellOrder_AutoRenew) *marketplace.SellOrder_AutoRenew {
+	if autoRenew == nil {
+		return nil
+	}
+	return &marketplace.SellOrder_AutoRenew{
+		RenewalPeriod: types.ProtobufToGogoDuration(autoRenew.RenewalPeriod),
+		PriceDecay:    autoRenew.PriceDecay,
+		FloorAmount:   autoRenew.FloorAmount,
+		MaxRenewals:   autoRenew.MaxRenewals,
+	}
 }
 
 type orderOptions struct {
 	autoRetire   bool
 	batchDenom   string
 	jurisdiction string
+
+	// beneficiary and reason are the optional beneficiary and reason of the
+	// retirement recorded if the credits are auto-retired.
+	beneficiary string
+	reason      string
+
+	// escrowed indicates that the cost is paid from the funds of a buy order
+	// held in escrow by the ecocredit module account.
+	escrowed bool
+
+	// askBatch is the credit batch the cost is paid in if the sell order asks
+	// for credits rather than coins.
+	askBatch *ecoApi.Batch
+
+	// buyerFee is the fee paid by the buyer in addition to the cost and
+	// sellerFee is the fee deducted from the cost paid to the seller.
+	buyerFee  sdk.Int
+	sellerFee sdk.Int
+
+	// price is the amount paid for each credit unit, recorded in the trade.
+	price sdk.Int
 }
 
 // fillOrder moves credits and coins according to the order. It will:
 // - update a sell order, removing it if quantity becomes 0 as a result of this purchase.
 // - remove the purchaseQty from the seller's escrowed balance.
-// - add credits to the buyer's tradable/retired address (based on the DisableAutoRetire field).
+// - add credits to the buyer's tradable/retired address (based on the DisableAutoRetire field)
+// and record the retirement if the credits are retired.
 // - update the supply accordingly.
-// - send the coins specified in the bid to the seller.
+// - record the trade and update the price accumulators of the batch and market.
+// - send the coins specified in the bid less the seller fee to the seller and the fees to the fee
+// destination of the market, from escrow if the order is escrowed, or transfer the credits of
+// the ask batch to the seller if the sell order asks for credits.
 func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api.SellOrder, buyerAcc sdk.AccAddress, purchaseQty math.Dec,
 	cost sdk.Coin, opts orderOptions) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -139,11 +279,25 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		if err = k.coreStore.BatchSupplyTable().Update(ctx, supply); err != nil {
 			return err
 		}
+		retirementId, err := coreserver.RecordRetirement(ctx, k.coreStore.RetirementTable(), &ecoApi.Retirement{
+			BatchKey:     sellOrder.BatchKey,
+			Amount:       purchaseQty.String(),
+			Owner:        buyerAcc,
+			Beneficiary:  opts.beneficiary,
+			Reason:       opts.reason,
+			Jurisdiction: opts.jurisdiction,
+		})
+		if err != nil {
+			return err
+		}
 		if err = sdkCtx.EventManager().EmitTypedEvent(&core.EventRetire{
 			Owner:        buyerAcc.String(),
 			BatchDenom:   opts.batchDenom,
 			Amount:       purchaseQty.String(),
 			Jurisdiction: opts.jurisdiction,
+			RetirementId: retirementId,
+			Beneficiary:  opts.beneficiary,
+			Reason:       opts.reason,
 		}); err != nil {
 			return err
 		}
@@ -152,7 +306,104 @@ func (k Keeper) fillOrder(ctx context.Context, orderIndex string, sellOrder *api
 		return err
 	}
 
-	return k.bankKeeper.SendCoins(sdkCtx, buyerAcc, sellOrder.Seller, sdk.NewCoins(cost))
+	if err = k.stateStore.TradeTable().Insert(ctx, &api.Trade{
+		SellOrderId: sellOrder.Id,
+		Buyer:       buyerAcc,
+		Seller:      sellOrder.Seller,
+		BatchKey:    sellOrder.BatchKey,
+		Quantity:    purchaseQty.String(),
+		Price:       opts.price.String(),
+		Denom:       cost.Denom,
+		Retired:     opts.autoRetire,
+		Timestamp:   timestamppb.New(sdkCtx.BlockTime()),
+	}); err != nil {
+		return err
+	}
+
+	if err = k.recordPrice(ctx, sellOrder.MarketId, sellOrder.BatchKey, opts.price, purchaseQty); err != nil {
+		return err
+	}
+
+	if opts.askBatch != nil {
+		return k.payCredits(ctx, orderIndex, buyerAcc, sellOrder.Seller, opts.askBatch, cost.Amount)
+	}
+
+	return k.payCoins(ctx, sellOrder, buyerAcc, cost, opts)
+}
+
+// payCredits transfers the cost of a sell order that asks for credits from the tradable
+// balance of the buyer to the tradable balance of the seller. The cost is an integer amount
+// of the smallest unit of the credit type precision of the ask batch.
+func (k Keeper) payCredits(ctx context.Context, orderIndex string, buyerAcc, sellerAcc sdk.AccAddress, askBatch *ecoApi.Batch, cost sdk.Int) error {
+	creditType, err := utils.GetCreditTypeFromBatchDenom(ctx, k.coreStore, askBatch.Denom)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := creditsFromUnits(cost, creditType.Precision)
+	if err != nil {
+		return err
+	}
+
+	buyerBal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), buyerAcc, askBatch.Key)
+	if err != nil {
+		return err
+	}
+	buyerTradable, err := math.NewDecFromString(buyerBal.TradableAmount)
+	if err != nil {
+		return err
+	}
+	buyerTradable, err = math.SafeSubBalance(buyerTradable, quantity)
+	if err != nil {
+		return ecocredit.ErrInsufficientCredits.Wrapf(
+			"%s: ask batch: %s, total price: %v, tradable balance: %s",
+			orderIndex, askBatch.Denom, quantity, buyerBal.TradableAmount,
+		)
+	}
+	buyerBal.TradableAmount = buyerTradable.String()
+	if err = k.coreStore.BatchBalanceTable().Save(ctx, buyerBal); err != nil {
+		return err
+	}
+
+	sellerBal, err := utils.GetBalance(ctx, k.coreStore.BatchBalanceTable(), sellerAcc, askBatch.Key)
+	if err != nil {
+		return err
+	}
+	sellerTradable, err := math.NewDecFromString(sellerBal.TradableAmount)
+	if err != nil {
+		return err
+	}
+	sellerTradable, err = math.SafeAddBalance(sellerTradable, quantity)
+	if err != nil {
+		return err
+	}
+	sellerBal.TradableAmount = sellerTradable.String()
+	if err = k.coreStore.BatchBalanceTable().Save(ctx, sellerBal); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&core.EventTransfer{
+		Sender:         buyerAcc.String(),
+		Recipient:      sellerAcc.String(),
+		BatchDenom:     askBatch.Denom,
+		TradableAmount: quantity.String(),
+		RetiredAmount:  "0",
+	})
+}
+
+// creditsFromUnits converts an integer amount of the smallest unit of a credit type
+// precision to a decimal quantity of credits.
+func creditsFromUnits(units sdk.Int, precision uint32) (math.Dec, error) {
+	amount, err := math.NewDecFromString(units.String())
+	if err != nil {
+		return math.Dec{}, err
+	}
+	credits, err := amount.QuoExact(math.NewDecFinite(1, int32(precision)))
+	if err != nil {
+		return math.Dec{}, err
+	}
+	credits, _ = credits.Reduce()
+	return credits, nil
 }
 
 // getTotalCost calculates the cost of the order by multiplying the price per credit, and the amount of credits
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/orm/model/ormdb"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	basketapi "github.com/regen-network/regen-ledger/api/regen/ecocredit/basket/v1"
@@ -17,9 +18,11 @@ import (
 	baskettypes "github.com/regen-network/regen-ledger/x/ecocredit/basket"
 	coretypes "github.com/regen-network/regen-ledger/x/ecocredit/core"
 	marketplacetypes "github.com/regen-network/regen-ledger/x/ecocredit/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/orderbook"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/basket"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/core"
 	"github.com/regen-network/regen-ledger/x/ecocredit/server/marketplace"
+	"github.com/regen-network/regen-ledger/x/ecocredit/server/utils"
 )
 
 type serverImpl struct {
@@ -28,23 +31,29 @@ type serverImpl struct {
 	paramSpace    paramtypes.Subspace
 	bankKeeper    ecocredit.BankKeeper
 	accountKeeper ecocredit.AccountKeeper
+	distrKeeper   ecocredit.DistributionKeeper
+	groupKeeper   ecocredit.GroupKeeper
 
 	coreKeeper        core.Keeper
 	basketKeeper      basket.Keeper
 	marketplaceKeeper marketplace.Keeper
 
 	db          ormdb.ModuleDB
+	memDB       ormdb.ModuleDB
 	stateStore  api.StateStore
 	basketStore basketapi.StateStore
 }
 
-func newServer(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
-	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, authority sdk.AccAddress) serverImpl {
+func newServer(storeKey, memStoreKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
+	accountKeeper ecocredit.AccountKeeper, bankKeeper ecocredit.BankKeeper, distrKeeper ecocredit.DistributionKeeper,
+	groupKeeper ecocredit.GroupKeeper, authority sdk.AccAddress) serverImpl {
 	s := serverImpl{
 		storeKey:      storeKey,
 		paramSpace:    paramSpace,
 		bankKeeper:    bankKeeper,
 		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		groupKeeper:   groupKeeper,
 	}
 
 	// ensure ecocredit module account is set
@@ -65,12 +74,33 @@ func newServer(storeKey storetypes.StoreKey, paramSpace paramtypes.Subspace,
 		panic(err)
 	}
 
+	s.memDB, err = ormstore.NewStoreKeyDB(&ecocredit.OrderBookSchema, memStoreKey, ormdb.ModuleDBOptions{})
+	if err != nil {
+		panic(err)
+	}
+
+	orderBook, err := orderbook.NewOrderBook(s.memDB, s.db)
+	if err != nil {
+		panic(err)
+	}
+
 	coreStore, basketStore, marketStore := getStateStores(s.db)
 	s.stateStore = coreStore
 	s.basketStore = basketStore
+	coretypes.SetBatchClassIdResolver(func(ctx sdk.Context, batchDenom string) (string, error) {
+		batch, err := coreStore.BatchTable().GetByDenom(ctx, batchDenom)
+		if err != nil {
+			return "", sdkerrors.ErrInvalidRequest.Wrapf("could not get batch with denom %s: %s", batchDenom, err)
+		}
+		class, err := utils.GetBatchClass(ctx, coreStore, batch)
+		if err != nil {
+			return "", err
+		}
+		return class.Id, nil
+	})
 	s.coreKeeper = core.NewKeeper(coreStore, bankKeeper, s.paramSpace, coreAddr, authority)
 	s.basketKeeper = basket.NewKeeper(basketStore, coreStore, bankKeeper, s.paramSpace, basketAddr)
-	s.marketplaceKeeper = marketplace.NewKeeper(marketStore, coreStore, bankKeeper, s.paramSpace, authority)
+	s.marketplaceKeeper = marketplace.NewKeeper(marketStore, coreStore, basketStore, bankKeeper, distrKeeper, groupKeeper, s.paramSpace, orderBook, coreAddr, authority)
 
 	return s
 }
@@ -96,9 +126,11 @@ func RegisterServices(
 	paramSpace paramtypes.Subspace,
 	accountKeeper ecocredit.AccountKeeper,
 	bankKeeper ecocredit.BankKeeper,
+	distrKeeper ecocredit.DistributionKeeper,
+	groupKeeper ecocredit.GroupKeeper,
 	authority sdk.AccAddress,
 ) Keeper {
-	impl := newServer(configurator.ModuleKey(), paramSpace, accountKeeper, bankKeeper, authority)
+	impl := newServer(configurator.ModuleKey(), configurator.MemoryStoreKey(), paramSpace, accountKeeper, bankKeeper, distrKeeper, groupKeeper, authority)
 
 	coretypes.RegisterMsgServer(configurator.MsgServer(), impl.coreKeeper)
 	coretypes.RegisterQueryServer(configurator.QueryServer(), impl.coreKeeper)
@@ -77,7 +77,7 @@ func setup(t *testing.T) (*server.FixtureFactory, paramstypes.Subspace, bankkeep
 	)
 
 	_, _, authority := testdata.KeyTestPubAddr()
-	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, authority)
+	ecocreditModule := ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, nil, nil, authority)
 	ff.SetModules([]module.Module{ecocreditModule})
 
 	return ff, ecocreditSubspace, bankKeeper, accountKeeper
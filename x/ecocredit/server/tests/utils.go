@@ -59,5 +59,5 @@ func NewEcocreditModule(ff *server.FixtureFactory) *ecocredit.Module {
 	)
 
 	_, _, addr := testdata.KeyTestPubAddr()
-	return ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, addr)
+	return ecocredit.NewModule(ecocreditSubspace, accountKeeper, bankKeeper, nil, nil, addr)
 }
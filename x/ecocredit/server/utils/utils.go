@@ -22,6 +22,17 @@ func GetCreditTypeFromBatchDenom(ctx context.Context, store api.StateStore, deno
 	return store.CreditTypeTable().Get(ctx, classInfo.CreditTypeAbbrev)
 }
 
+// GetBatchClass returns the credit class of the project that the credit batch belongs to.
+// The class ID in a batch denom is not used because projects can be transferred to another
+// credit class and credit batches issued before the transfer keep their batch denoms.
+func GetBatchClass(ctx context.Context, store api.StateStore, batch *api.Batch) (*api.Class, error) {
+	project, err := store.ProjectTable().Get(ctx, batch.ProjectKey)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest.Wrapf("could not get project of batch %s: %s", batch.Denom, err.Error())
+	}
+	return store.ClassTable().Get(ctx, project.ClassKey)
+}
+
 // GetNonNegativeFixedDecs takes an arbitrary amount of decimal strings, and returns their corresponding fixed decimals
 // in a slice.
 func GetNonNegativeFixedDecs(precision uint32, decimals ...string) ([]math.Dec, error) {